@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// AdmissionEnforcementAction selects how the API server reacts when a direct
+// RoleBinding or ClusterRoleBinding write violates an RBACPolicy.
+// +kubebuilder:validation:Enum=Deny;Warn;Audit
+type AdmissionEnforcementAction string
+
+// Admission enforcement actions. They map one to one onto the validation
+// actions of a ValidatingAdmissionPolicyBinding.
+const (
+	// AdmissionEnforcementActionDeny (the default) rejects violating writes.
+	AdmissionEnforcementActionDeny AdmissionEnforcementAction = "Deny"
+
+	// AdmissionEnforcementActionWarn admits violating writes and returns a
+	// warning to the client.
+	AdmissionEnforcementActionWarn AdmissionEnforcementAction = "Warn"
+
+	// AdmissionEnforcementActionAudit admits violating writes and records the
+	// violation in the audit event of the request.
+	AdmissionEnforcementActionAudit AdmissionEnforcementAction = "Audit"
+)
+
+// AdmissionEnforcement makes the operator generate a ValidatingAdmissionPolicy
+// from an RBACPolicy, so that the API server itself rejects direct RoleBinding
+// and ClusterRoleBinding writes in the policy scope that violate the policy.
+//
+// Only the limits expressible in CEL are enforced: forbidden role references,
+// ClusterRoleBinding bans, forbidden target namespaces and the subject kind,
+// name and ServiceAccount namespace limits. Selector-based limits and the
+// default-deny behaviour of unset limits stay operator-only.
+type AdmissionEnforcement struct {
+	// Enabled turns generation of the ValidatingAdmissionPolicy on.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+
+	// Action selects how violating writes are handled. Defaults to Deny.
+	// +kubebuilder:validation:Optional
+	Action AdmissionEnforcementAction `json:"action,omitempty"`
+}
+
+// IsEnabled reports whether admission enforcement is turned on.
+func (e *AdmissionEnforcement) IsEnabled() bool {
+	return e != nil && e.Enabled
+}
+
+// ActionOrDefault returns the configured action, or Deny when unset.
+func (e *AdmissionEnforcement) ActionOrDefault() AdmissionEnforcementAction {
+	if e == nil || e.Action == "" {
+		return AdmissionEnforcementActionDeny
+	}
+	return e.Action
+}
+
+// ValidateAdmissionEnforcement performs the validation of an
+// AdmissionEnforcement block that the CRD schema cannot express on its own.
+func ValidateAdmissionEnforcement(enforcement *AdmissionEnforcement, fldPath *field.Path) field.ErrorList {
+	if enforcement == nil {
+		return nil
+	}
+	switch enforcement.Action {
+	case "", AdmissionEnforcementActionDeny, AdmissionEnforcementActionWarn, AdmissionEnforcementActionAudit:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath.Child("action"), enforcement.Action, []string{
+			string(AdmissionEnforcementActionDeny), string(AdmissionEnforcementActionWarn), string(AdmissionEnforcementActionAudit),
+		})}
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateAdmissionEnforcement(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		enforcement *AdmissionEnforcement
+		want        string
+	}{
+		{name: "nil", enforcement: nil},
+		{name: "defaulted action", enforcement: &AdmissionEnforcement{Enabled: true}},
+		{name: "warn", enforcement: &AdmissionEnforcement{Enabled: true, Action: AdmissionEnforcementActionWarn}},
+		{
+			name:        "unknown action",
+			enforcement: &AdmissionEnforcement{Enabled: true, Action: "Block"},
+			want:        "spec.admissionEnforcement.action: Unsupported value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateAdmissionEnforcement(tc.enforcement, field.NewPath("spec", "admissionEnforcement"))
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if got := errs.ToAggregate().Error(); !strings.Contains(got, tc.want) {
+				t.Errorf("expected error to contain %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAdmissionEnforcementDefaults(t *testing.T) {
+	t.Parallel()
+
+	var unset *AdmissionEnforcement
+	if unset.IsEnabled() {
+		t.Error("nil enforcement must be disabled")
+	}
+	if got := unset.ActionOrDefault(); got != AdmissionEnforcementActionDeny {
+		t.Errorf("ActionOrDefault() = %q, want Deny", got)
+	}
+	audit := &AdmissionEnforcement{Enabled: true, Action: AdmissionEnforcementActionAudit}
+	if !audit.IsEnabled() || audit.ActionOrDefault() != AdmissionEnforcementActionAudit {
+		t.Errorf("unexpected enforcement state: %+v", audit)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// AdmissionEnforcementApplyConfiguration represents a declarative configuration of the AdmissionEnforcement type for use
+// with apply.
+//
+// AdmissionEnforcement makes the operator generate a ValidatingAdmissionPolicy
+// from an RBACPolicy, so that the API server itself rejects direct RoleBinding
+// and ClusterRoleBinding writes in the policy scope that violate the policy.
+//
+// Only the limits expressible in CEL are enforced: forbidden role references,
+// ClusterRoleBinding bans, forbidden target namespaces and the subject kind,
+// name and ServiceAccount namespace limits. Selector-based limits and the
+// default-deny behaviour of unset limits stay operator-only.
+type AdmissionEnforcementApplyConfiguration struct {
+	// Enabled turns generation of the ValidatingAdmissionPolicy on.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Action selects how violating writes are handled. Defaults to Deny.
+	Action *authorizationv1alpha1.AdmissionEnforcementAction `json:"action,omitempty"`
+}
+
+// AdmissionEnforcementApplyConfiguration constructs a declarative configuration of the AdmissionEnforcement type for use with
+// apply.
+func AdmissionEnforcement() *AdmissionEnforcementApplyConfiguration {
+	return &AdmissionEnforcementApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *AdmissionEnforcementApplyConfiguration) WithEnabled(value bool) *AdmissionEnforcementApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *AdmissionEnforcementApplyConfiguration) WithAction(value authorizationv1alpha1.AdmissionEnforcementAction) *AdmissionEnforcementApplyConfiguration {
+	b.Action = &value
+	return b
+}
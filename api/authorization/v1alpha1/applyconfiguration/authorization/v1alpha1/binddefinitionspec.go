@@ -18,6 +18,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	v1 "k8s.io/api/rbac/v1"
 )
 
@@ -49,6 +50,18 @@ type BindDefinitionSpecApplyConfiguration struct {
 	//
 	// Only applies when Subjects contain ServiceAccount entries that need to be auto-created.
 	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
+	// NamespaceTermination controls when the finalizer on generated RoleBindings is
+	// released while their namespace terminates. When unset, the controller-wide
+	// default applies (WaitForAll unless configured otherwise).
+	NamespaceTermination *NamespaceTerminationPolicyApplyConfiguration `json:"namespaceTermination,omitempty"`
+	// ServiceAccountLifecycle revokes generated ServiceAccounts after a TTL or
+	// an idle period. Pre-existing ServiceAccounts are not affected.
+	ServiceAccountLifecycle *ServiceAccountLifecycleApplyConfiguration `json:"serviceAccountLifecycle,omitempty"`
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated ClusterRoleBinding or RoleBinding with a different value:
+	// Force takes them over, Fail stops the reconcile, and Report leaves the
+	// binding unchanged. Conflicts are listed in status.conflicts in every case.
+	ConflictPolicy *authorizationv1alpha1.ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // BindDefinitionSpecApplyConfiguration constructs a declarative configuration of the BindDefinitionSpec type for use with
@@ -103,3 +116,27 @@ func (b *BindDefinitionSpecApplyConfiguration) WithAutomountServiceAccountToken(
 	b.AutomountServiceAccountToken = &value
 	return b
 }
+
+// WithNamespaceTermination sets the NamespaceTermination field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceTermination field is set to the value of the last call.
+func (b *BindDefinitionSpecApplyConfiguration) WithNamespaceTermination(value *NamespaceTerminationPolicyApplyConfiguration) *BindDefinitionSpecApplyConfiguration {
+	b.NamespaceTermination = value
+	return b
+}
+
+// WithServiceAccountLifecycle sets the ServiceAccountLifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountLifecycle field is set to the value of the last call.
+func (b *BindDefinitionSpecApplyConfiguration) WithServiceAccountLifecycle(value *ServiceAccountLifecycleApplyConfiguration) *BindDefinitionSpecApplyConfiguration {
+	b.ServiceAccountLifecycle = value
+	return b
+}
+
+// WithConflictPolicy sets the ConflictPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConflictPolicy field is set to the value of the last call.
+func (b *BindDefinitionSpecApplyConfiguration) WithConflictPolicy(value authorizationv1alpha1.ConflictPolicy) *BindDefinitionSpecApplyConfiguration {
+	b.ConflictPolicy = &value
+	return b
+}
@@ -43,6 +43,13 @@ type BindDefinitionStatusApplyConfiguration struct {
 	// in bindings but not managed (created/deleted) by the controller.
 	// Format: "<namespace>/<name>".
 	ExternalServiceAccounts []string `json:"externalServiceAccounts,omitempty"`
+	// RevokedServiceAccounts lists generated ServiceAccounts whose
+	// serviceAccountLifecycle expired. They are excluded from generated bindings.
+	RevokedServiceAccounts []RevokedServiceAccountApplyConfiguration `json:"revokedServiceAccounts,omitempty"`
+	// Conflicts lists generated bindings whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	Conflicts []FieldManagerConflictApplyConfiguration `json:"conflicts,omitempty"`
 	// Conditions defines current service state of the Bind definition. All conditions should evaluate to true to signify successful reconciliation.
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -99,6 +106,32 @@ func (b *BindDefinitionStatusApplyConfiguration) WithExternalServiceAccounts(val
 	return b
 }
 
+// WithRevokedServiceAccounts adds the given value to the RevokedServiceAccounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RevokedServiceAccounts field.
+func (b *BindDefinitionStatusApplyConfiguration) WithRevokedServiceAccounts(values ...*RevokedServiceAccountApplyConfiguration) *BindDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRevokedServiceAccounts")
+		}
+		b.RevokedServiceAccounts = append(b.RevokedServiceAccounts, *values[i])
+	}
+	return b
+}
+
+// WithConflicts adds the given value to the Conflicts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conflicts field.
+func (b *BindDefinitionStatusApplyConfiguration) WithConflicts(values ...*FieldManagerConflictApplyConfiguration) *BindDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConflicts")
+		}
+		b.Conflicts = append(b.Conflicts, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
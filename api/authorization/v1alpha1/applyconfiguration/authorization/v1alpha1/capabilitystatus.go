@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// CapabilityStatusApplyConfiguration represents a declarative configuration of the CapabilityStatus type for use
+// with apply.
+//
+// CapabilityStatus is the detected state of one API server capability.
+type CapabilityStatusApplyConfiguration struct {
+	// Name is the kube-apiserver feature gate backing the capability, e.g.
+	// AuthorizeWithSelectors.
+	Name *string `json:"name,omitempty"`
+	// State is Enabled, Disabled or Unknown.
+	State *authorizationv1alpha1.CapabilityState `json:"state,omitempty"`
+	// Reason is a machine-readable reason for the state, e.g. FeatureGateEnabled,
+	// FeatureGA or VersionTooOld.
+	Reason *string `json:"reason,omitempty"`
+	// Message explains the state and, when the capability is missing, which
+	// operator features are affected.
+	Message *string `json:"message,omitempty"`
+}
+
+// CapabilityStatusApplyConfiguration constructs a declarative configuration of the CapabilityStatus type for use with
+// apply.
+func CapabilityStatus() *CapabilityStatusApplyConfiguration {
+	return &CapabilityStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *CapabilityStatusApplyConfiguration) WithName(value string) *CapabilityStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *CapabilityStatusApplyConfiguration) WithState(value authorizationv1alpha1.CapabilityState) *CapabilityStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *CapabilityStatusApplyConfiguration) WithReason(value string) *CapabilityStatusApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *CapabilityStatusApplyConfiguration) WithMessage(value string) *CapabilityStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -32,7 +32,8 @@ import (
 // IMPORTANT — grants union, they do not correlate. The effective permission is
 // the full cross product of every granted identity and every granted action. It
 // is not possible to express "userA only for pods AND userB only for secrets" in
-// a single grant; use two separate RoleDefinitions bound to different subjects.
+// a single grant; use RoleDefinition spec.impersonationGrants, which generates a
+// separate role and binding per identity and action pairing.
 type ConstrainedImpersonationSpecApplyConfiguration struct {
 	// Mode selects the constrained impersonation mode the generated verbs target.
 	Mode *authorizationv1alpha1.ImpersonationMode `json:"mode,omitempty"`
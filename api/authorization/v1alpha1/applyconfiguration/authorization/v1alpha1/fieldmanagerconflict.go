@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// FieldManagerConflictApplyConfiguration represents a declarative configuration of the FieldManagerConflict type for use
+// with apply.
+//
+// FieldManagerConflict reports a generated resource whose last apply conflicted
+// with fields owned by other field managers.
+type FieldManagerConflictApplyConfiguration struct {
+	// Kind of the generated resource, e.g. ClusterRoleBinding.
+	Kind *string `json:"kind,omitempty"`
+	// Namespace of the generated resource. Empty for cluster-scoped resources.
+	Namespace *string `json:"namespace,omitempty"`
+	// Name of the generated resource.
+	Name *string `json:"name,omitempty"`
+	// Managers lists the competing field managers from the managedFields of the
+	// resource, e.g. "argocd-controller" or "helm".
+	Managers []string `json:"managers,omitempty"`
+}
+
+// FieldManagerConflictApplyConfiguration constructs a declarative configuration of the FieldManagerConflict type for use with
+// apply.
+func FieldManagerConflict() *FieldManagerConflictApplyConfiguration {
+	return &FieldManagerConflictApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *FieldManagerConflictApplyConfiguration) WithKind(value string) *FieldManagerConflictApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *FieldManagerConflictApplyConfiguration) WithNamespace(value string) *FieldManagerConflictApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *FieldManagerConflictApplyConfiguration) WithName(value string) *FieldManagerConflictApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithManagers adds the given value to the Managers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Managers field.
+func (b *FieldManagerConflictApplyConfiguration) WithManagers(values ...string) *FieldManagerConflictApplyConfiguration {
+	for i := range values {
+		b.Managers = append(b.Managers, values[i])
+	}
+	return b
+}
@@ -77,6 +77,15 @@ type ImpersonationConfigApplyConfiguration struct {
 	// that the configured identity actually selects the declared mode, turning a
 	// silent legacy fallback into an admission error.
 	Mode *authorizationv1alpha1.ImpersonationMode `json:"mode,omitempty"`
+	// CredentialSource selects how the operator acts as the apply identity.
+	// Impersonate (the default) sends impersonation headers and requires the
+	// operator to hold impersonate rights. TokenRequest mints short-lived tokens
+	// for ServiceAccountRef and applies as the ServiceAccount directly, so the
+	// operator needs create on serviceaccounts/token instead, which is equivalent
+	// to impersonating that ServiceAccount. TokenRequest requires
+	// ServiceAccountRef and is incompatible with Mode, as no impersonation
+	// headers are sent.
+	CredentialSource *authorizationv1alpha1.ImpersonationCredentialSource `json:"credentialSource,omitempty"`
 }
 
 // ImpersonationConfigApplyConfiguration constructs a declarative configuration of the ImpersonationConfig type for use with
@@ -147,3 +156,11 @@ func (b *ImpersonationConfigApplyConfiguration) WithMode(value authorizationv1al
 	b.Mode = &value
 	return b
 }
+
+// WithCredentialSource sets the CredentialSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialSource field is set to the value of the last call.
+func (b *ImpersonationConfigApplyConfiguration) WithCredentialSource(value authorizationv1alpha1.ImpersonationCredentialSource) *ImpersonationConfigApplyConfiguration {
+	b.CredentialSource = &value
+	return b
+}
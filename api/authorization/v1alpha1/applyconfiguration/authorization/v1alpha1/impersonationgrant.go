@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/rbac/v1"
+)
+
+// ImpersonationGrantApplyConfiguration represents a declarative configuration of the ImpersonationGrant type for use
+// with apply.
+//
+// ImpersonationGrant correlates a set of impersonation identities with the
+// actions allowed while impersonating them, for a dedicated set of subjects.
+//
+// Constrained impersonation grants union: one role carrying several identities
+// and several actions allows every identity for every action. Each grant is
+// therefore generated as its own Role or ClusterRole, named
+// "<targetName>-<name>", plus a binding of the same name to Subjects, so
+// "userA only for pods AND userB only for secrets" becomes two grants.
+type ImpersonationGrantApplyConfiguration struct {
+	// Name identifies the grant and suffixes the generated role and binding names.
+	Name *string `json:"name,omitempty"`
+	// Subjects are bound to the generated role. They are the requesters allowed
+	// to impersonate, not the impersonated identities.
+	Subjects []v1.Subject `json:"subjects,omitempty"`
+	// Grant is the identity and action pairing generated into the role. Its
+	// identities and actions still union with each other, so keep one identity
+	// class per grant when actions must differ per identity.
+	Grant *ConstrainedImpersonationSpecApplyConfiguration `json:"grant,omitempty"`
+}
+
+// ImpersonationGrantApplyConfiguration constructs a declarative configuration of the ImpersonationGrant type for use with
+// apply.
+func ImpersonationGrant() *ImpersonationGrantApplyConfiguration {
+	return &ImpersonationGrantApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ImpersonationGrantApplyConfiguration) WithName(value string) *ImpersonationGrantApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSubjects adds the given value to the Subjects field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Subjects field.
+func (b *ImpersonationGrantApplyConfiguration) WithSubjects(values ...v1.Subject) *ImpersonationGrantApplyConfiguration {
+	for i := range values {
+		b.Subjects = append(b.Subjects, values[i])
+	}
+	return b
+}
+
+// WithGrant sets the Grant field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Grant field is set to the value of the last call.
+func (b *ImpersonationGrantApplyConfiguration) WithGrant(value *ConstrainedImpersonationSpecApplyConfiguration) *ImpersonationGrantApplyConfiguration {
+	b.Grant = value
+	return b
+}
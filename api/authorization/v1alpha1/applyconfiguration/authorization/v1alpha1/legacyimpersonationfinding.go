@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyImpersonationFindingApplyConfiguration represents a declarative configuration of the LegacyImpersonationFinding type for use
+// with apply.
+//
+// LegacyImpersonationFinding reports a subject holding the legacy
+// "impersonate" verb, which wins by fallback and silently defeats every
+// constrained impersonation (KEP-5284) restriction the subject is also given.
+type LegacyImpersonationFindingApplyConfiguration struct {
+	// Subject is the user, group or ServiceAccount holding the legacy verb.
+	Subject *v1.Subject `json:"subject,omitempty"`
+	// ClusterWide is true when at least one grant comes from a
+	// ClusterRoleBinding. Namespaced grants only cover serviceaccounts in the
+	// binding's namespace.
+	ClusterWide *bool `json:"clusterWide,omitempty"`
+	// HasConstrainedGrant is true when the subject also holds a constrained
+	// impersonation grant, which the legacy verb then defeats.
+	HasConstrainedGrant *bool `json:"hasConstrainedGrant,omitempty"`
+	// Grants lists the bindings granting the legacy verb.
+	Grants []LegacyImpersonationGrantApplyConfiguration `json:"grants,omitempty"`
+}
+
+// LegacyImpersonationFindingApplyConfiguration constructs a declarative configuration of the LegacyImpersonationFinding type for use with
+// apply.
+func LegacyImpersonationFinding() *LegacyImpersonationFindingApplyConfiguration {
+	return &LegacyImpersonationFindingApplyConfiguration{}
+}
+
+// WithSubject sets the Subject field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Subject field is set to the value of the last call.
+func (b *LegacyImpersonationFindingApplyConfiguration) WithSubject(value v1.Subject) *LegacyImpersonationFindingApplyConfiguration {
+	b.Subject = &value
+	return b
+}
+
+// WithClusterWide sets the ClusterWide field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterWide field is set to the value of the last call.
+func (b *LegacyImpersonationFindingApplyConfiguration) WithClusterWide(value bool) *LegacyImpersonationFindingApplyConfiguration {
+	b.ClusterWide = &value
+	return b
+}
+
+// WithHasConstrainedGrant sets the HasConstrainedGrant field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HasConstrainedGrant field is set to the value of the last call.
+func (b *LegacyImpersonationFindingApplyConfiguration) WithHasConstrainedGrant(value bool) *LegacyImpersonationFindingApplyConfiguration {
+	b.HasConstrainedGrant = &value
+	return b
+}
+
+// WithGrants adds the given value to the Grants field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Grants field.
+func (b *LegacyImpersonationFindingApplyConfiguration) WithGrants(values ...*LegacyImpersonationGrantApplyConfiguration) *LegacyImpersonationFindingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGrants")
+		}
+		b.Grants = append(b.Grants, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// LegacyImpersonationGrantApplyConfiguration represents a declarative configuration of the LegacyImpersonationGrant type for use
+// with apply.
+//
+// LegacyImpersonationGrant is one binding through which a subject holds the
+// legacy "impersonate" verb.
+type LegacyImpersonationGrantApplyConfiguration struct {
+	// BindingKind is ClusterRoleBinding or RoleBinding.
+	BindingKind *string `json:"bindingKind,omitempty"`
+	// BindingName is the name of the binding.
+	BindingName *string `json:"bindingName,omitempty"`
+	// BindingNamespace is the namespace of a RoleBinding.
+	BindingNamespace *string `json:"bindingNamespace,omitempty"`
+	// RoleKind is ClusterRole or Role.
+	RoleKind *string `json:"roleKind,omitempty"`
+	// RoleName is the name of the role carrying the legacy verb.
+	RoleName *string `json:"roleName,omitempty"`
+	// Resources are the identity resources the legacy verb applies to through
+	// this binding, e.g. users, groups or serviceaccounts. "*" means all.
+	Resources []string `json:"resources,omitempty"`
+}
+
+// LegacyImpersonationGrantApplyConfiguration constructs a declarative configuration of the LegacyImpersonationGrant type for use with
+// apply.
+func LegacyImpersonationGrant() *LegacyImpersonationGrantApplyConfiguration {
+	return &LegacyImpersonationGrantApplyConfiguration{}
+}
+
+// WithBindingKind sets the BindingKind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BindingKind field is set to the value of the last call.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithBindingKind(value string) *LegacyImpersonationGrantApplyConfiguration {
+	b.BindingKind = &value
+	return b
+}
+
+// WithBindingName sets the BindingName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BindingName field is set to the value of the last call.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithBindingName(value string) *LegacyImpersonationGrantApplyConfiguration {
+	b.BindingName = &value
+	return b
+}
+
+// WithBindingNamespace sets the BindingNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BindingNamespace field is set to the value of the last call.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithBindingNamespace(value string) *LegacyImpersonationGrantApplyConfiguration {
+	b.BindingNamespace = &value
+	return b
+}
+
+// WithRoleKind sets the RoleKind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoleKind field is set to the value of the last call.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithRoleKind(value string) *LegacyImpersonationGrantApplyConfiguration {
+	b.RoleKind = &value
+	return b
+}
+
+// WithRoleName sets the RoleName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoleName field is set to the value of the last call.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithRoleName(value string) *LegacyImpersonationGrantApplyConfiguration {
+	b.RoleName = &value
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *LegacyImpersonationGrantApplyConfiguration) WithResources(values ...string) *LegacyImpersonationGrantApplyConfiguration {
+	for i := range values {
+		b.Resources = append(b.Resources, values[i])
+	}
+	return b
+}
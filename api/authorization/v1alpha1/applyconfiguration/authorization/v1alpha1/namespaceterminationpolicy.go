@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceTerminationPolicyApplyConfiguration represents a declarative configuration of the NamespaceTerminationPolicy type for use
+// with apply.
+//
+// NamespaceTerminationPolicy controls how long RoleBindings generated by a
+// BindDefinition are kept while their namespace terminates.
+type NamespaceTerminationPolicyApplyConfiguration struct {
+	// FinalizerRelease selects when the RoleBinding finalizer is released.
+	FinalizerRelease *authorizationv1alpha1.FinalizerReleasePolicy `json:"finalizerRelease,omitempty"`
+	// ResourceTypes lists the resource types that hold the finalizer when
+	// FinalizerRelease is WaitForListed. Core resources use their plural name
+	// (e.g. "pods", "persistentvolumeclaims"); other resources are qualified with
+	// their API group (e.g. "kustomizations.kustomize.toolkit.fluxcd.io").
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	// Timeout is how long after the namespace deletion timestamp the finalizer
+	// is released regardless of remaining resources when FinalizerRelease is
+	// ReleaseAfterTimeout.
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// NamespaceTerminationPolicyApplyConfiguration constructs a declarative configuration of the NamespaceTerminationPolicy type for use with
+// apply.
+func NamespaceTerminationPolicy() *NamespaceTerminationPolicyApplyConfiguration {
+	return &NamespaceTerminationPolicyApplyConfiguration{}
+}
+
+// WithFinalizerRelease sets the FinalizerRelease field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FinalizerRelease field is set to the value of the last call.
+func (b *NamespaceTerminationPolicyApplyConfiguration) WithFinalizerRelease(value authorizationv1alpha1.FinalizerReleasePolicy) *NamespaceTerminationPolicyApplyConfiguration {
+	b.FinalizerRelease = &value
+	return b
+}
+
+// WithResourceTypes adds the given value to the ResourceTypes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourceTypes field.
+func (b *NamespaceTerminationPolicyApplyConfiguration) WithResourceTypes(values ...string) *NamespaceTerminationPolicyApplyConfiguration {
+	for i := range values {
+		b.ResourceTypes = append(b.ResourceTypes, values[i])
+	}
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *NamespaceTerminationPolicyApplyConfiguration) WithTimeout(value v1.Duration) *NamespaceTerminationPolicyApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	internal "github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// OperatorCapabilitiesApplyConfiguration represents a declarative configuration of the OperatorCapabilities type for use
+// with apply.
+//
+// OperatorCapabilities is the Schema for the operatorcapabilities API.
+// It is a status-only object, maintained by the controller under the name
+// "cluster", that publishes which optional API server features the operator
+// detected and therefore which of its own features are active.
+type OperatorCapabilitiesApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Status                           *OperatorCapabilitiesStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// OperatorCapabilities constructs a declarative configuration of the OperatorCapabilities type for use with
+// apply.
+func OperatorCapabilities(name string) *OperatorCapabilitiesApplyConfiguration {
+	b := &OperatorCapabilitiesApplyConfiguration{}
+	b.WithName(name)
+	b.WithKind("OperatorCapabilities")
+	b.WithAPIVersion("authorization.t-caas.telekom.com/v1alpha1")
+	return b
+}
+
+// ExtractOperatorCapabilitiesFrom extracts the applied configuration owned by fieldManager from
+// operatorCapabilities for the specified subresource. Pass an empty string for subresource to extract
+// the main resource. Common subresources include "status", "scale", etc.
+// operatorCapabilities must be a unmodified OperatorCapabilities API object that was retrieved from the Kubernetes API.
+// ExtractOperatorCapabilitiesFrom provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractOperatorCapabilitiesFrom(operatorCapabilities *authorizationv1alpha1.OperatorCapabilities, fieldManager string, subresource string) (*OperatorCapabilitiesApplyConfiguration, error) {
+	b := &OperatorCapabilitiesApplyConfiguration{}
+	err := managedfields.ExtractInto(operatorCapabilities, internal.Parser().Type("com.github.telekom.auth-operator.api.authorization.v1alpha1.OperatorCapabilities"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(operatorCapabilities.Name)
+
+	b.WithKind("OperatorCapabilities")
+	b.WithAPIVersion("authorization.t-caas.telekom.com/v1alpha1")
+	return b, nil
+}
+
+// ExtractOperatorCapabilities extracts the applied configuration owned by fieldManager from
+// operatorCapabilities. If no managedFields are found in operatorCapabilities for fieldManager, a
+// OperatorCapabilitiesApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// operatorCapabilities must be a unmodified OperatorCapabilities API object that was retrieved from the Kubernetes API.
+// ExtractOperatorCapabilities provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractOperatorCapabilities(operatorCapabilities *authorizationv1alpha1.OperatorCapabilities, fieldManager string) (*OperatorCapabilitiesApplyConfiguration, error) {
+	return ExtractOperatorCapabilitiesFrom(operatorCapabilities, fieldManager, "")
+}
+
+// ExtractOperatorCapabilitiesStatus extracts the applied configuration owned by fieldManager from
+// operatorCapabilities for the status subresource.
+func ExtractOperatorCapabilitiesStatus(operatorCapabilities *authorizationv1alpha1.OperatorCapabilities, fieldManager string) (*OperatorCapabilitiesApplyConfiguration, error) {
+	return ExtractOperatorCapabilitiesFrom(operatorCapabilities, fieldManager, "status")
+}
+
+func (b OperatorCapabilitiesApplyConfiguration) IsApplyConfiguration() {}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithKind(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithAPIVersion(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithName(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithGenerateName(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithNamespace(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithUID(value types.UID) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithResourceVersion(value string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithGeneration(value int64) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithCreationTimestamp(value metav1.Time) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *OperatorCapabilitiesApplyConfiguration) WithLabels(entries map[string]string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *OperatorCapabilitiesApplyConfiguration) WithAnnotations(entries map[string]string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *OperatorCapabilitiesApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *OperatorCapabilitiesApplyConfiguration) WithFinalizers(values ...string) *OperatorCapabilitiesApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *OperatorCapabilitiesApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *OperatorCapabilitiesApplyConfiguration) WithStatus(value *OperatorCapabilitiesStatusApplyConfiguration) *OperatorCapabilitiesApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetKind retrieves the value of the Kind field in the declarative configuration.
+func (b *OperatorCapabilitiesApplyConfiguration) GetKind() *string {
+	return b.TypeMetaApplyConfiguration.Kind
+}
+
+// GetAPIVersion retrieves the value of the APIVersion field in the declarative configuration.
+func (b *OperatorCapabilitiesApplyConfiguration) GetAPIVersion() *string {
+	return b.TypeMetaApplyConfiguration.APIVersion
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *OperatorCapabilitiesApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
+
+// GetNamespace retrieves the value of the Namespace field in the declarative configuration.
+func (b *OperatorCapabilitiesApplyConfiguration) GetNamespace() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Namespace
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// OperatorCapabilitiesStatusApplyConfiguration represents a declarative configuration of the OperatorCapabilitiesStatus type for use
+// with apply.
+//
+// OperatorCapabilitiesStatus is the result of the latest API server capability
+// probe.
+type OperatorCapabilitiesStatusApplyConfiguration struct {
+	// ServerVersion is the detected API server version, when known.
+	ServerVersion *string `json:"serverVersion,omitempty"`
+	// LastProbeTime is when the capabilities were last probed.
+	LastProbeTime *v1.Time `json:"lastProbeTime,omitempty"`
+	// Capabilities lists every capability the operator detects.
+	Capabilities []CapabilityStatusApplyConfiguration `json:"capabilities,omitempty"`
+	// DegradedGroupVersions lists the API GroupVersions whose discovery is
+	// failing. It is empty while API discovery is healthy.
+	DegradedGroupVersions []DegradedGroupVersionApplyConfiguration `json:"degradedGroupVersions,omitempty"`
+	// Conditions defines the current state of the capabilities.
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// OperatorCapabilitiesStatusApplyConfiguration constructs a declarative configuration of the OperatorCapabilitiesStatus type for use with
+// apply.
+func OperatorCapabilitiesStatus() *OperatorCapabilitiesStatusApplyConfiguration {
+	return &OperatorCapabilitiesStatusApplyConfiguration{}
+}
+
+// WithServerVersion sets the ServerVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerVersion field is set to the value of the last call.
+func (b *OperatorCapabilitiesStatusApplyConfiguration) WithServerVersion(value string) *OperatorCapabilitiesStatusApplyConfiguration {
+	b.ServerVersion = &value
+	return b
+}
+
+// WithLastProbeTime sets the LastProbeTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastProbeTime field is set to the value of the last call.
+func (b *OperatorCapabilitiesStatusApplyConfiguration) WithLastProbeTime(value v1.Time) *OperatorCapabilitiesStatusApplyConfiguration {
+	b.LastProbeTime = &value
+	return b
+}
+
+// WithCapabilities adds the given value to the Capabilities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Capabilities field.
+func (b *OperatorCapabilitiesStatusApplyConfiguration) WithCapabilities(values ...*CapabilityStatusApplyConfiguration) *OperatorCapabilitiesStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCapabilities")
+		}
+		b.Capabilities = append(b.Capabilities, *values[i])
+	}
+	return b
+}
+
+// WithDegradedGroupVersions adds the given value to the DegradedGroupVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DegradedGroupVersions field.
+func (b *OperatorCapabilitiesStatusApplyConfiguration) WithDegradedGroupVersions(values ...*DegradedGroupVersionApplyConfiguration) *OperatorCapabilitiesStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDegradedGroupVersions")
+		}
+		b.DegradedGroupVersions = append(b.DegradedGroupVersions, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *OperatorCapabilitiesStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *OperatorCapabilitiesStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
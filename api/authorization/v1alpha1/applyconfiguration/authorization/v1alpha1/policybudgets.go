@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PolicyBudgetsApplyConfiguration represents a declarative configuration of the PolicyBudgets type for use
+// with apply.
+//
+// PolicyBudgets caps the aggregate RBAC volume produced by all
+// RestrictedBindDefinitions referencing a policy or any of its descendant
+// policies. Per-definition limits such as
+// maxTargetNamespaces cannot stop a tenant from fanning out through many
+// definitions; budgets can.
+type PolicyBudgetsApplyConfiguration struct {
+	// MaxRestrictedBindDefinitions caps the number of RestrictedBindDefinitions
+	// referencing the policy.
+	MaxRestrictedBindDefinitions *int32 `json:"maxRestrictedBindDefinitions,omitempty"`
+	// MaxRoleBindings caps the total RoleBindings generated by all
+	// RestrictedBindDefinitions referencing the policy. Every resolved namespace
+	// and role reference pair of a definition counts as one RoleBinding.
+	MaxRoleBindings *int32 `json:"maxRoleBindings,omitempty"`
+	// MaxServiceAccounts caps the distinct ServiceAccount subjects across all
+	// RestrictedBindDefinitions referencing the policy. Every ServiceAccount
+	// subject counts, whether the operator generates it or it already exists.
+	MaxServiceAccounts *int32 `json:"maxServiceAccounts,omitempty"`
+}
+
+// PolicyBudgetsApplyConfiguration constructs a declarative configuration of the PolicyBudgets type for use with
+// apply.
+func PolicyBudgets() *PolicyBudgetsApplyConfiguration {
+	return &PolicyBudgetsApplyConfiguration{}
+}
+
+// WithMaxRestrictedBindDefinitions sets the MaxRestrictedBindDefinitions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRestrictedBindDefinitions field is set to the value of the last call.
+func (b *PolicyBudgetsApplyConfiguration) WithMaxRestrictedBindDefinitions(value int32) *PolicyBudgetsApplyConfiguration {
+	b.MaxRestrictedBindDefinitions = &value
+	return b
+}
+
+// WithMaxRoleBindings sets the MaxRoleBindings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRoleBindings field is set to the value of the last call.
+func (b *PolicyBudgetsApplyConfiguration) WithMaxRoleBindings(value int32) *PolicyBudgetsApplyConfiguration {
+	b.MaxRoleBindings = &value
+	return b
+}
+
+// WithMaxServiceAccounts sets the MaxServiceAccounts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxServiceAccounts field is set to the value of the last call.
+func (b *PolicyBudgetsApplyConfiguration) WithMaxServiceAccounts(value int32) *PolicyBudgetsApplyConfiguration {
+	b.MaxServiceAccounts = &value
+	return b
+}
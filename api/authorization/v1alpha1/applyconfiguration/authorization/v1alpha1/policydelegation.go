@@ -0,0 +1,59 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PolicyDelegationApplyConfiguration represents a declarative configuration of the PolicyDelegation type for use
+// with apply.
+//
+// PolicyDelegation defines requester identities that may author child
+// RBACPolicies of the delegating policy.
+type PolicyDelegationApplyConfiguration struct {
+	// Groups lists requester group names allowed to author child policies.
+	Groups []string `json:"groups,omitempty"`
+	// ServiceAccounts lists requester ServiceAccounts allowed to author child policies.
+	ServiceAccounts []SARefApplyConfiguration `json:"serviceAccounts,omitempty"`
+}
+
+// PolicyDelegationApplyConfiguration constructs a declarative configuration of the PolicyDelegation type for use with
+// apply.
+func PolicyDelegation() *PolicyDelegationApplyConfiguration {
+	return &PolicyDelegationApplyConfiguration{}
+}
+
+// WithGroups adds the given value to the Groups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Groups field.
+func (b *PolicyDelegationApplyConfiguration) WithGroups(values ...string) *PolicyDelegationApplyConfiguration {
+	for i := range values {
+		b.Groups = append(b.Groups, values[i])
+	}
+	return b
+}
+
+// WithServiceAccounts adds the given value to the ServiceAccounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServiceAccounts field.
+func (b *PolicyDelegationApplyConfiguration) WithServiceAccounts(values ...*SARefApplyConfiguration) *PolicyDelegationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithServiceAccounts")
+		}
+		b.ServiceAccounts = append(b.ServiceAccounts, *values[i])
+	}
+	return b
+}
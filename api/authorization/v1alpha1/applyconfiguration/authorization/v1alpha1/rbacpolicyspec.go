@@ -39,6 +39,31 @@ type RBACPolicySpecApplyConfiguration struct {
 	// Impersonation configures ServiceAccount impersonation for restricted resource
 	// apply operations governed by this policy.
 	Impersonation *ImpersonationConfigApplyConfiguration `json:"impersonation,omitempty"`
+	// ParentPolicyRef makes this policy a delegated sub-policy of another
+	// RBACPolicy. Admission proves that the child is a subset of the parent:
+	// scope and allowed sets may only shrink, forbidden sets may only grow, and
+	// numeric limits may only decrease. Narrowing a parent after its children
+	// were admitted is reported as an admission warning on the parent.
+	ParentPolicyRef *RBACPolicyReferenceApplyConfiguration `json:"parentPolicyRef,omitempty"`
+	// Delegation lists the identities (typically tenant admins) that may create,
+	// update and delete child RBACPolicies whose parentPolicyRef names this
+	// policy. Delegated identities cannot author policies outside that subtree.
+	Delegation *PolicyDelegationApplyConfiguration `json:"delegation,omitempty"`
+	// OnViolation controls what happens to the RBAC managed by dependent
+	// restricted resources once they violate this policy, for example after the
+	// policy was tightened. Defaults to Revoke, which deprovisions immediately.
+	OnViolation *ViolationPolicyApplyConfiguration `json:"onViolation,omitempty"`
+	// Budgets caps the aggregate RBAC volume produced by all
+	// RestrictedBindDefinitions referencing this policy or any of its
+	// descendant policies. Budgets are enforced at
+	// admission and on every reconcile, where the newest definitions that no
+	// longer fit are policy violations; usage is reported in status.usage.
+	Budgets *PolicyBudgetsApplyConfiguration `json:"budgets,omitempty"`
+	// AdmissionEnforcement generates a ValidatingAdmissionPolicy from this
+	// policy so that direct RoleBinding and ClusterRoleBinding writes in the
+	// policy scope are checked by the API server, not only the RBAC produced
+	// by restricted resources. Only limits expressible in CEL are enforced.
+	AdmissionEnforcement *AdmissionEnforcementApplyConfiguration `json:"admissionEnforcement,omitempty"`
 }
 
 // RBACPolicySpecApplyConfiguration constructs a declarative configuration of the RBACPolicySpec type for use with
@@ -94,3 +119,43 @@ func (b *RBACPolicySpecApplyConfiguration) WithImpersonation(value *Impersonatio
 	b.Impersonation = value
 	return b
 }
+
+// WithParentPolicyRef sets the ParentPolicyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentPolicyRef field is set to the value of the last call.
+func (b *RBACPolicySpecApplyConfiguration) WithParentPolicyRef(value *RBACPolicyReferenceApplyConfiguration) *RBACPolicySpecApplyConfiguration {
+	b.ParentPolicyRef = value
+	return b
+}
+
+// WithDelegation sets the Delegation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Delegation field is set to the value of the last call.
+func (b *RBACPolicySpecApplyConfiguration) WithDelegation(value *PolicyDelegationApplyConfiguration) *RBACPolicySpecApplyConfiguration {
+	b.Delegation = value
+	return b
+}
+
+// WithOnViolation sets the OnViolation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnViolation field is set to the value of the last call.
+func (b *RBACPolicySpecApplyConfiguration) WithOnViolation(value *ViolationPolicyApplyConfiguration) *RBACPolicySpecApplyConfiguration {
+	b.OnViolation = value
+	return b
+}
+
+// WithBudgets sets the Budgets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budgets field is set to the value of the last call.
+func (b *RBACPolicySpecApplyConfiguration) WithBudgets(value *PolicyBudgetsApplyConfiguration) *RBACPolicySpecApplyConfiguration {
+	b.Budgets = value
+	return b
+}
+
+// WithAdmissionEnforcement sets the AdmissionEnforcement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdmissionEnforcement field is set to the value of the last call.
+func (b *RBACPolicySpecApplyConfiguration) WithAdmissionEnforcement(value *AdmissionEnforcementApplyConfiguration) *RBACPolicySpecApplyConfiguration {
+	b.AdmissionEnforcement = value
+	return b
+}
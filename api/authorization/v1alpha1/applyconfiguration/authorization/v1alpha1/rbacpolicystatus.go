@@ -31,6 +31,16 @@ type RBACPolicyStatusApplyConfiguration struct {
 	// BoundResourceCount is the number of RestrictedBindDefinitions and
 	// RestrictedRoleDefinitions currently referencing this policy.
 	BoundResourceCount *int32 `json:"boundResourceCount,omitempty"`
+	// Usage is the aggregate RBAC volume of the RestrictedBindDefinitions
+	// referencing this policy or any of its descendant policies, counted
+	// against spec.budgets.
+	Usage *PolicyBudgetUsageApplyConfiguration `json:"usage,omitempty"`
+	// Catalog lists the ClusterRoles that satisfy bindingLimits today, so
+	// tenants can discover what they may bind without reading the policy.
+	Catalog []RoleOfferingApplyConfiguration `json:"catalog,omitempty"`
+	// CatalogOmitted is the number of matching ClusterRoles left out of
+	// Catalog because it reached its maximum size.
+	CatalogOmitted *int32 `json:"catalogOmitted,omitempty"`
 	// Conditions defines current service state of the RBACPolicy.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -57,6 +67,35 @@ func (b *RBACPolicyStatusApplyConfiguration) WithBoundResourceCount(value int32)
 	return b
 }
 
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *RBACPolicyStatusApplyConfiguration) WithUsage(value *PolicyBudgetUsageApplyConfiguration) *RBACPolicyStatusApplyConfiguration {
+	b.Usage = value
+	return b
+}
+
+// WithCatalog adds the given value to the Catalog field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Catalog field.
+func (b *RBACPolicyStatusApplyConfiguration) WithCatalog(values ...*RoleOfferingApplyConfiguration) *RBACPolicyStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCatalog")
+		}
+		b.Catalog = append(b.Catalog, *values[i])
+	}
+	return b
+}
+
+// WithCatalogOmitted sets the CatalogOmitted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CatalogOmitted field is set to the value of the last call.
+func (b *RBACPolicyStatusApplyConfiguration) WithCatalogOmitted(value int32) *RBACPolicyStatusApplyConfiguration {
+	b.CatalogOmitted = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -18,6 +18,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	v1 "k8s.io/api/rbac/v1"
 )
 
@@ -47,6 +48,14 @@ type RestrictedBindDefinitionSpecApplyConfiguration struct {
 	// AutomountServiceAccountToken controls whether to automount API credentials
 	// for ServiceAccounts created by this RestrictedBindDefinition.
 	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
+	// ServiceAccountLifecycle revokes generated ServiceAccounts after a TTL or
+	// an idle period. Pre-existing ServiceAccounts are not affected.
+	ServiceAccountLifecycle *ServiceAccountLifecycleApplyConfiguration `json:"serviceAccountLifecycle,omitempty"`
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated ClusterRoleBinding or RoleBinding with a different value:
+	// Force takes them over, Fail stops the reconcile, and Report leaves the
+	// binding unchanged. Conflicts are listed in status.conflicts in every case.
+	ConflictPolicy *authorizationv1alpha1.ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RestrictedBindDefinitionSpecApplyConfiguration constructs a declarative configuration of the RestrictedBindDefinitionSpec type for use with
@@ -109,3 +118,19 @@ func (b *RestrictedBindDefinitionSpecApplyConfiguration) WithAutomountServiceAcc
 	b.AutomountServiceAccountToken = &value
 	return b
 }
+
+// WithServiceAccountLifecycle sets the ServiceAccountLifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountLifecycle field is set to the value of the last call.
+func (b *RestrictedBindDefinitionSpecApplyConfiguration) WithServiceAccountLifecycle(value *ServiceAccountLifecycleApplyConfiguration) *RestrictedBindDefinitionSpecApplyConfiguration {
+	b.ServiceAccountLifecycle = value
+	return b
+}
+
+// WithConflictPolicy sets the ConflictPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConflictPolicy field is set to the value of the last call.
+func (b *RestrictedBindDefinitionSpecApplyConfiguration) WithConflictPolicy(value authorizationv1alpha1.ConflictPolicy) *RestrictedBindDefinitionSpecApplyConfiguration {
+	b.ConflictPolicy = &value
+	return b
+}
@@ -19,7 +19,8 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/rbac/v1"
-	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // RestrictedBindDefinitionStatusApplyConfiguration represents a declarative configuration of the RestrictedBindDefinitionStatus type for use
@@ -44,12 +45,23 @@ type RestrictedBindDefinitionStatusApplyConfiguration struct {
 	// created or bound during the last reconciliation.
 	// Format: "<namespace>/<name>: <reason>".
 	SkippedServiceAccounts []string `json:"skippedServiceAccounts,omitempty"`
+	// RevokedServiceAccounts lists generated ServiceAccounts whose
+	// serviceAccountLifecycle expired. They are excluded from generated bindings.
+	RevokedServiceAccounts []RevokedServiceAccountApplyConfiguration `json:"revokedServiceAccounts,omitempty"`
 	// PolicyViolations lists policy violations detected during the last reconciliation.
 	// Format: "<fieldPath>: <message>" when a field path is available.
 	// Empty when all checks pass.
 	PolicyViolations []string `json:"policyViolations,omitempty"`
+	// PolicyViolationSince is when the current run of policy violations was first
+	// detected. It anchors the grace period of an RBACPolicy onViolation
+	// RevokeAfter action and is cleared once the resource complies again.
+	PolicyViolationSince *metav1.Time `json:"policyViolationSince,omitempty"`
+	// Conflicts lists generated bindings whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	Conflicts []FieldManagerConflictApplyConfiguration `json:"conflicts,omitempty"`
 	// Conditions defines current service state.
-	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions []applyconfigurationsmetav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // RestrictedBindDefinitionStatusApplyConfiguration constructs a declarative configuration of the RestrictedBindDefinitionStatus type for use with
@@ -114,6 +126,19 @@ func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithSkippedServiceAcc
 	return b
 }
 
+// WithRevokedServiceAccounts adds the given value to the RevokedServiceAccounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RevokedServiceAccounts field.
+func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithRevokedServiceAccounts(values ...*RevokedServiceAccountApplyConfiguration) *RestrictedBindDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRevokedServiceAccounts")
+		}
+		b.RevokedServiceAccounts = append(b.RevokedServiceAccounts, *values[i])
+	}
+	return b
+}
+
 // WithPolicyViolations adds the given value to the PolicyViolations field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the PolicyViolations field.
@@ -124,10 +149,31 @@ func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithPolicyViolations(
 	return b
 }
 
+// WithPolicyViolationSince sets the PolicyViolationSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyViolationSince field is set to the value of the last call.
+func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithPolicyViolationSince(value metav1.Time) *RestrictedBindDefinitionStatusApplyConfiguration {
+	b.PolicyViolationSince = &value
+	return b
+}
+
+// WithConflicts adds the given value to the Conflicts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conflicts field.
+func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithConflicts(values ...*FieldManagerConflictApplyConfiguration) *RestrictedBindDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConflicts")
+		}
+		b.Conflicts = append(b.Conflicts, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
-func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *RestrictedBindDefinitionStatusApplyConfiguration {
+func (b *RestrictedBindDefinitionStatusApplyConfiguration) WithConditions(values ...*applyconfigurationsmetav1.ConditionApplyConfiguration) *RestrictedBindDefinitionStatusApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithConditions")
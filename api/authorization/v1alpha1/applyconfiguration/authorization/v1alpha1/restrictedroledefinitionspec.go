@@ -18,6 +18,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -74,6 +75,11 @@ type RestrictedRoleDefinitionSpecApplyConfiguration struct {
 	// roleLimits.constrainedImpersonation can restrict the allowed modes, identity
 	// resources and identity names.
 	ConstrainedImpersonation *ConstrainedImpersonationSpecApplyConfiguration `json:"constrainedImpersonation,omitempty"`
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of the generated role with a different value: Force takes them over, Fail
+	// stops the reconcile, and Report leaves the role unchanged. Conflicts are
+	// listed in status.conflicts in every case.
+	ConflictPolicy *authorizationv1alpha1.ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RestrictedRoleDefinitionSpecApplyConfiguration constructs a declarative configuration of the RestrictedRoleDefinitionSpec type for use with
@@ -162,3 +168,11 @@ func (b *RestrictedRoleDefinitionSpecApplyConfiguration) WithConstrainedImperson
 	b.ConstrainedImpersonation = value
 	return b
 }
+
+// WithConflictPolicy sets the ConflictPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConflictPolicy field is set to the value of the last call.
+func (b *RestrictedRoleDefinitionSpecApplyConfiguration) WithConflictPolicy(value authorizationv1alpha1.ConflictPolicy) *RestrictedRoleDefinitionSpecApplyConfiguration {
+	b.ConflictPolicy = &value
+	return b
+}
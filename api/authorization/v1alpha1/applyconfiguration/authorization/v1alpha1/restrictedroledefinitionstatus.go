@@ -18,7 +18,8 @@ limitations under the License.
 package v1alpha1
 
 import (
-	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // RestrictedRoleDefinitionStatusApplyConfiguration represents a declarative configuration of the RestrictedRoleDefinitionStatus type for use
@@ -32,8 +33,15 @@ type RestrictedRoleDefinitionStatusApplyConfiguration struct {
 	RoleReconciled *bool `json:"roleReconciled,omitempty"`
 	// PolicyViolations lists policy violations detected during the last reconciliation.
 	PolicyViolations []string `json:"policyViolations,omitempty"`
+	// PolicyViolationSince is when the current run of policy violations was first
+	// detected. It anchors the grace period of an RBACPolicy onViolation
+	// RevokeAfter action and is cleared once the resource complies again.
+	PolicyViolationSince *v1.Time `json:"policyViolationSince,omitempty"`
+	// Conflicts lists the generated role when its last apply conflicted with
+	// fields owned by other field managers. See spec.conflictPolicy.
+	Conflicts []FieldManagerConflictApplyConfiguration `json:"conflicts,omitempty"`
 	// Conditions defines current service state.
-	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // RestrictedRoleDefinitionStatusApplyConfiguration constructs a declarative configuration of the RestrictedRoleDefinitionStatus type for use with
@@ -68,10 +76,31 @@ func (b *RestrictedRoleDefinitionStatusApplyConfiguration) WithPolicyViolations(
 	return b
 }
 
+// WithPolicyViolationSince sets the PolicyViolationSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyViolationSince field is set to the value of the last call.
+func (b *RestrictedRoleDefinitionStatusApplyConfiguration) WithPolicyViolationSince(value v1.Time) *RestrictedRoleDefinitionStatusApplyConfiguration {
+	b.PolicyViolationSince = &value
+	return b
+}
+
+// WithConflicts adds the given value to the Conflicts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conflicts field.
+func (b *RestrictedRoleDefinitionStatusApplyConfiguration) WithConflicts(values ...*FieldManagerConflictApplyConfiguration) *RestrictedRoleDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConflicts")
+		}
+		b.Conflicts = append(b.Conflicts, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
-func (b *RestrictedRoleDefinitionStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *RestrictedRoleDefinitionStatusApplyConfiguration {
+func (b *RestrictedRoleDefinitionStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *RestrictedRoleDefinitionStatusApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithConditions")
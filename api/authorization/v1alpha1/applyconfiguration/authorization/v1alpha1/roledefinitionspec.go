@@ -18,6 +18,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -41,13 +42,13 @@ type RoleDefinitionSpecApplyConfiguration struct {
 	ScopeNamespaced *bool `json:"scopeNamespaced,omitempty"`
 	// RestrictedAPIs defines API group-level restrictions for the generated role.
 	// Each entry can either fully block an API group or restrict only certain verbs:
-	// - When Verbs is empty or omitted, the entire API group is fully blocked
-	// (no resources from that group appear in the generated role).
-	// - When Verbs is specified, only those verbs are removed for resources in
-	// the group — the remaining verbs are still allowed (partial restriction).
+	//   - When Verbs is empty or omitted, the entire API group is fully blocked
+	//     (no resources from that group appear in the generated role).
+	//   - When Verbs is specified, only those verbs are removed for resources in
+	//     the group — the remaining verbs are still allowed (partial restriction).
 	// Version filtering narrows which API versions are affected:
-	// - When Versions is empty, all versions of the group are affected.
-	// - When Versions is specified, only those API versions are restricted.
+	//   - When Versions is empty, all versions of the group are affected.
+	//   - When Versions is specified, only those API versions are restricted.
 	// Note: Kubernetes RBAC PolicyRules are version-agnostic. If the same resource
 	// exists in a non-restricted version of the same group, it will still appear
 	// in the generated role.
@@ -102,6 +103,21 @@ type RoleDefinitionSpecApplyConfiguration struct {
 	// Mutually exclusive with AggregateFrom, whose rules are owned by the
 	// Kubernetes aggregation controller.
 	ConstrainedImpersonation *ConstrainedImpersonationSpecApplyConfiguration `json:"constrainedImpersonation,omitempty"`
+	// ImpersonationGrants correlates impersonation identities with actions. Each
+	// grant is generated as a separate Role or ClusterRole named
+	// "<targetName>-<name>", matching targetRole and targetNamespace, plus a
+	// binding of the same name to the grant's subjects. Unlike
+	// ConstrainedImpersonation, this lets different subjects impersonate
+	// different identities for different actions without the grants unioning.
+	// Because the bindings name arbitrary subjects, grants are only generated
+	// when the controller runs with --enable-impersonation-grants.
+	ImpersonationGrants []ImpersonationGrantApplyConfiguration `json:"impersonationGrants,omitempty"`
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated role, or of an impersonation grant role or binding, with a
+	// different value: Force takes them over, Fail stops the reconcile, and Report
+	// leaves the resource unchanged. Conflicts are listed in status.conflicts in
+	// every case.
+	ConflictPolicy *authorizationv1alpha1.ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RoleDefinitionSpecApplyConfiguration constructs a declarative configuration of the RoleDefinitionSpec type for use with
@@ -220,3 +236,24 @@ func (b *RoleDefinitionSpecApplyConfiguration) WithConstrainedImpersonation(valu
 	b.ConstrainedImpersonation = value
 	return b
 }
+
+// WithImpersonationGrants adds the given value to the ImpersonationGrants field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImpersonationGrants field.
+func (b *RoleDefinitionSpecApplyConfiguration) WithImpersonationGrants(values ...*ImpersonationGrantApplyConfiguration) *RoleDefinitionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImpersonationGrants")
+		}
+		b.ImpersonationGrants = append(b.ImpersonationGrants, *values[i])
+	}
+	return b
+}
+
+// WithConflictPolicy sets the ConflictPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConflictPolicy field is set to the value of the last call.
+func (b *RoleDefinitionSpecApplyConfiguration) WithConflictPolicy(value authorizationv1alpha1.ConflictPolicy) *RoleDefinitionSpecApplyConfiguration {
+	b.ConflictPolicy = &value
+	return b
+}
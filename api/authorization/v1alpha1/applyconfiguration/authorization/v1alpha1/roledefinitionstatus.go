@@ -31,6 +31,13 @@ type RoleDefinitionStatusApplyConfiguration struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 	// RoleReconciled indicates whether the target role has been successfully reconciled.
 	RoleReconciled *bool `json:"roleReconciled,omitempty"`
+	// ImpersonationGrants reports the RBAC generated for spec.impersonationGrants
+	// and the effective identity by action permissions of each grant.
+	ImpersonationGrants []ImpersonationGrantStatusApplyConfiguration `json:"impersonationGrants,omitempty"`
+	// Conflicts lists generated resources whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	Conflicts []FieldManagerConflictApplyConfiguration `json:"conflicts,omitempty"`
 	// Conditions defines current service state of the Role definition. All conditions should evaluate to true to signify successful reconciliation.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -57,6 +64,32 @@ func (b *RoleDefinitionStatusApplyConfiguration) WithRoleReconciled(value bool)
 	return b
 }
 
+// WithImpersonationGrants adds the given value to the ImpersonationGrants field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImpersonationGrants field.
+func (b *RoleDefinitionStatusApplyConfiguration) WithImpersonationGrants(values ...*ImpersonationGrantStatusApplyConfiguration) *RoleDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImpersonationGrants")
+		}
+		b.ImpersonationGrants = append(b.ImpersonationGrants, *values[i])
+	}
+	return b
+}
+
+// WithConflicts adds the given value to the Conflicts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conflicts field.
+func (b *RoleDefinitionStatusApplyConfiguration) WithConflicts(values ...*FieldManagerConflictApplyConfiguration) *RoleDefinitionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConflicts")
+		}
+		b.Conflicts = append(b.Conflicts, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
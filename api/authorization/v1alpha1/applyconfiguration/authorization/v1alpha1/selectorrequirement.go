@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// SelectorRequirementApplyConfiguration represents a declarative configuration of the SelectorRequirement type for use
+// with apply.
+//
+// SelectorRequirement is a requirement that the field or label selector of a
+// request must imply for a SelectorResourceRule to match. A request selecting
+// spec.nodeName=node-a implies "spec.nodeName In [node-a, node-b]"; a request
+// without any selector on the key implies nothing.
+type SelectorRequirementApplyConfiguration struct {
+	// Key is the field path or label key, e.g. spec.nodeName or team.
+	Key *string `json:"key,omitempty"`
+	// Operator is In, NotIn, Exists or DoesNotExist.
+	Operator *authorizationv1alpha1.SelectorOperator `json:"operator,omitempty"`
+	// Values are the accepted (In) or excluded (NotIn) values.
+	Values []string `json:"values,omitempty"`
+	// ValuesFromExtra names a SubjectAccessReview spec.extra key whose values are
+	// added to Values for the request being evaluated. With
+	// authentication.kubernetes.io/node-name a node agent may only select
+	// objects on its own node.
+	ValuesFromExtra *string `json:"valuesFromExtra,omitempty"`
+}
+
+// SelectorRequirementApplyConfiguration constructs a declarative configuration of the SelectorRequirement type for use with
+// apply.
+func SelectorRequirement() *SelectorRequirementApplyConfiguration {
+	return &SelectorRequirementApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *SelectorRequirementApplyConfiguration) WithKey(value string) *SelectorRequirementApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *SelectorRequirementApplyConfiguration) WithOperator(value authorizationv1alpha1.SelectorOperator) *SelectorRequirementApplyConfiguration {
+	b.Operator = &value
+	return b
+}
+
+// WithValues adds the given value to the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Values field.
+func (b *SelectorRequirementApplyConfiguration) WithValues(values ...string) *SelectorRequirementApplyConfiguration {
+	for i := range values {
+		b.Values = append(b.Values, values[i])
+	}
+	return b
+}
+
+// WithValuesFromExtra sets the ValuesFromExtra field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValuesFromExtra field is set to the value of the last call.
+func (b *SelectorRequirementApplyConfiguration) WithValuesFromExtra(value string) *SelectorRequirementApplyConfiguration {
+	b.ValuesFromExtra = &value
+	return b
+}
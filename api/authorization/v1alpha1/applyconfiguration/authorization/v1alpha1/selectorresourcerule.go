@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// SelectorResourceRuleApplyConfiguration represents a declarative configuration of the SelectorResourceRule type for use
+// with apply.
+//
+// SelectorResourceRule is a resource rule that only matches requests whose
+// field and label selectors imply every listed requirement, e.g. "list pods
+// only with spec.nodeName=<own node>".
+//
+// The selectors are only sent by API servers with the AuthorizeWithSelectors
+// feature (Kubernetes 1.31+, on by default from 1.32). Without it requests carry
+// no selectors and these rules never match.
+type SelectorResourceRuleApplyConfiguration struct {
+	// Verbs is a list of kubernetes resource API verbs, like get, list, watch.
+	// "*" means all.
+	Verbs []string `json:"verbs,omitempty"`
+	// APIGroups is the name of the APIGroup that contains the resources. "*"
+	// means all.
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// Resources is a list of resources this rule applies to. "*" means all in
+	// the specified apiGroups, "*/foo" represents the subresource 'foo' for all
+	// resources in the specified apiGroups.
+	Resources []string `json:"resources,omitempty"`
+	// ResourceNames is an optional allow list of names that the rule applies
+	// to. "*" means all.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// FieldSelector lists requirements the request's field selector must imply.
+	FieldSelector []SelectorRequirementApplyConfiguration `json:"fieldSelector,omitempty"`
+	// LabelSelector lists requirements the request's label selector must imply.
+	LabelSelector []SelectorRequirementApplyConfiguration `json:"labelSelector,omitempty"`
+}
+
+// SelectorResourceRuleApplyConfiguration constructs a declarative configuration of the SelectorResourceRule type for use with
+// apply.
+func SelectorResourceRule() *SelectorResourceRuleApplyConfiguration {
+	return &SelectorResourceRuleApplyConfiguration{}
+}
+
+// WithVerbs adds the given value to the Verbs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Verbs field.
+func (b *SelectorResourceRuleApplyConfiguration) WithVerbs(values ...string) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		b.Verbs = append(b.Verbs, values[i])
+	}
+	return b
+}
+
+// WithAPIGroups adds the given value to the APIGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIGroups field.
+func (b *SelectorResourceRuleApplyConfiguration) WithAPIGroups(values ...string) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		b.APIGroups = append(b.APIGroups, values[i])
+	}
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *SelectorResourceRuleApplyConfiguration) WithResources(values ...string) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		b.Resources = append(b.Resources, values[i])
+	}
+	return b
+}
+
+// WithResourceNames adds the given value to the ResourceNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourceNames field.
+func (b *SelectorResourceRuleApplyConfiguration) WithResourceNames(values ...string) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		b.ResourceNames = append(b.ResourceNames, values[i])
+	}
+	return b
+}
+
+// WithFieldSelector adds the given value to the FieldSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FieldSelector field.
+func (b *SelectorResourceRuleApplyConfiguration) WithFieldSelector(values ...*SelectorRequirementApplyConfiguration) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFieldSelector")
+		}
+		b.FieldSelector = append(b.FieldSelector, *values[i])
+	}
+	return b
+}
+
+// WithLabelSelector adds the given value to the LabelSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LabelSelector field.
+func (b *SelectorResourceRuleApplyConfiguration) WithLabelSelector(values ...*SelectorRequirementApplyConfiguration) *SelectorResourceRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLabelSelector")
+		}
+		b.LabelSelector = append(b.LabelSelector, *values[i])
+	}
+	return b
+}
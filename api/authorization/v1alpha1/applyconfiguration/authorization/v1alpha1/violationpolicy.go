@@ -0,0 +1,59 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ViolationPolicyApplyConfiguration represents a declarative configuration of the ViolationPolicy type for use
+// with apply.
+//
+// ViolationPolicy controls what happens to the RBAC managed by restricted
+// resources when they violate their RBACPolicy, for example after the policy
+// was tightened.
+type ViolationPolicyApplyConfiguration struct {
+	// Action selects how violations are handled.
+	Action *authorizationv1alpha1.ViolationAction `json:"action,omitempty"`
+	// GracePeriod is how long the last compliant RBAC is kept after a violation
+	// is first detected when Action is RevokeAfter.
+	GracePeriod *v1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// ViolationPolicyApplyConfiguration constructs a declarative configuration of the ViolationPolicy type for use with
+// apply.
+func ViolationPolicy() *ViolationPolicyApplyConfiguration {
+	return &ViolationPolicyApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *ViolationPolicyApplyConfiguration) WithAction(value authorizationv1alpha1.ViolationAction) *ViolationPolicyApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithGracePeriod sets the GracePeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GracePeriod field is set to the value of the last call.
+func (b *ViolationPolicyApplyConfiguration) WithGracePeriod(value v1.Duration) *ViolationPolicyApplyConfiguration {
+	b.GracePeriod = &value
+	return b
+}
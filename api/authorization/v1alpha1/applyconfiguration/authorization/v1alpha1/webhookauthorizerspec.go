@@ -30,12 +30,16 @@ import (
 type WebhookAuthorizerSpecApplyConfiguration struct {
 	// Resources which will be used to evaluate the SubjectAccessReviewSpec.ResourceAttributes
 	ResourceRules []v1.ResourceRule `json:"resourceRules,omitempty"`
+	// SelectorRules are resource rules that additionally constrain the field and
+	// label selectors of list, watch and deletecollection requests. They are
+	// evaluated after ResourceRules.
+	SelectorRules []SelectorResourceRuleApplyConfiguration `json:"selectorRules,omitempty"`
 	// Resources which will be used to evaluate the SubjectAccessReviewSpec.NonResourceAttributes
 	NonResourceRules []v1.NonResourceRule `json:"nonResourceRules,omitempty"`
 	// AllowedPrincipals is a slice of principals this authorizer should allow.
 	AllowedPrincipals []PrincipalApplyConfiguration `json:"allowedPrincipals,omitempty"`
 	// DeniedPrincipals is a slice of principals this authorizer should deny
-	// when the request also matches ResourceRules or NonResourceRules.
+	// when the request also matches ResourceRules, SelectorRules or NonResourceRules.
 	DeniedPrincipals []PrincipalApplyConfiguration `json:"deniedPrincipals,omitempty"`
 	// NamespaceSelector is a label selector to match namespaces that should allow the specified API calls.
 	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
@@ -66,6 +70,19 @@ func (b *WebhookAuthorizerSpecApplyConfiguration) WithResourceRules(values ...v1
 	return b
 }
 
+// WithSelectorRules adds the given value to the SelectorRules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SelectorRules field.
+func (b *WebhookAuthorizerSpecApplyConfiguration) WithSelectorRules(values ...*SelectorResourceRuleApplyConfiguration) *WebhookAuthorizerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSelectorRules")
+		}
+		b.SelectorRules = append(b.SelectorRules, *values[i])
+	}
+	return b
+}
+
 // WithNonResourceRules adds the given value to the NonResourceRules field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the NonResourceRules field.
@@ -38,6 +38,17 @@ func Parser() *typed.Parser {
 var parserOnce sync.Once
 var parser *typed.Parser
 var schemaYAML = typed.YAMLObject(`types:
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.AdmissionEnforcement
+  map:
+    fields:
+    - name: action
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.AdmissionEnforcementAction
+    - name: enabled
+      type:
+        scalar: boolean
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.AdmissionEnforcementAction
+  scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.BindDefinition
   map:
     fields:
@@ -66,12 +77,22 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: clusterRoleBindings
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ClusterBinding
+    - name: conflictPolicy
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConflictPolicy
+      default: Force
+    - name: namespaceTermination
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.NamespaceTerminationPolicy
     - name: roleBindings
       type:
         list:
           elementType:
             namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.NamespaceBinding
           elementRelationship: atomic
+    - name: serviceAccountLifecycle
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ServiceAccountLifecycle
     - name: subjects
       type:
         list:
@@ -93,6 +114,16 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
           elementRelationship: atomic
+    - name: conflicts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.FieldManagerConflict
+          elementRelationship: associative
+          keys:
+          - kind
+          - namespace
+          - name
     - name: externalServiceAccounts
       type:
         list:
@@ -114,6 +145,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: observedGeneration
       type:
         scalar: numeric
+    - name: revokedServiceAccounts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RevokedServiceAccount
+          elementRelationship: atomic
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.BindingLimits
   map:
     fields:
@@ -130,6 +167,23 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: targetNamespaceLimits
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.NamespaceLimits
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.CapabilityState
+  scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.CapabilityStatus
+  map:
+    fields:
+    - name: message
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: state
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.CapabilityState
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ClusterBinding
   map:
     fields:
@@ -139,6 +193,8 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConflictPolicy
+  scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConstrainedImpersonationLimits
   map:
     fields:
@@ -207,6 +263,43 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SARef
           elementRelationship: atomic
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.DegradedGroupVersion
+  map:
+    fields:
+    - name: failingSince
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: groupVersion
+      type:
+        scalar: string
+    - name: lastSuccessTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: stale
+      type:
+        scalar: boolean
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.FieldManagerConflict
+  map:
+    fields:
+    - name: kind
+      type:
+        scalar: string
+    - name: managers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+      default: ""
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationActionRule
   map:
     fields:
@@ -237,6 +330,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationConfig
   map:
     fields:
+    - name: credentialSource
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationCredentialSource
     - name: enabled
       type:
         scalar: boolean
@@ -265,6 +361,53 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: userName
       type:
         scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationCredentialSource
+  scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationExposureReport
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+    - name: status
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationExposureReportStatus
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationExposureReportStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
+    - name: constrainedSubjects
+      type:
+        scalar: numeric
+    - name: exposedSubjects
+      type:
+        scalar: numeric
+    - name: findings
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.LegacyImpersonationFinding
+          elementRelationship: atomic
+    - name: lastScanTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: legacyRoles
+      type:
+        scalar: numeric
+    - name: truncated
+      type:
+        scalar: boolean
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationExtra
   map:
     fields:
@@ -277,6 +420,39 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationGrant
+  map:
+    fields:
+    - name: grant
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConstrainedImpersonationSpec
+    - name: name
+      type:
+        scalar: string
+    - name: subjects
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.rbac.v1.Subject
+          elementRelationship: atomic
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationGrantStatus
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: permissionCount
+      type:
+        scalar: numeric
+    - name: permissions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: roleName
+      type:
+        scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationIdentityResource
   scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationIdentityRule
@@ -298,6 +474,50 @@ var schemaYAML = typed.YAMLObject(`types:
   scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationVerbPolicy
   scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.LegacyImpersonationFinding
+  map:
+    fields:
+    - name: clusterWide
+      type:
+        scalar: boolean
+      default: false
+    - name: grants
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.LegacyImpersonationGrant
+          elementRelationship: atomic
+    - name: hasConstrainedGrant
+      type:
+        scalar: boolean
+      default: false
+    - name: subject
+      type:
+        namedType: io.k8s.api.rbac.v1.Subject
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.LegacyImpersonationGrant
+  map:
+    fields:
+    - name: bindingKind
+      type:
+        scalar: string
+    - name: bindingName
+      type:
+        scalar: string
+    - name: bindingNamespace
+      type:
+        scalar: string
+    - name: resources
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: roleKind
+      type:
+        scalar: string
+    - name: roleName
+      type:
+        scalar: string
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.NameMatchLimits
   map:
     fields:
@@ -382,6 +602,106 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: maxTargetNamespaces
       type:
         scalar: numeric
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.NamespaceTerminationPolicy
+  map:
+    fields:
+    - name: finalizerRelease
+      type:
+        scalar: string
+    - name: resourceTypes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.OperatorCapabilities
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+    - name: status
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.OperatorCapabilitiesStatus
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.OperatorCapabilitiesStatus
+  map:
+    fields:
+    - name: capabilities
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.CapabilityStatus
+          elementRelationship: associative
+          keys:
+          - name
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
+    - name: degradedGroupVersions
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.DegradedGroupVersion
+          elementRelationship: associative
+          keys:
+          - groupVersion
+    - name: lastProbeTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: serverVersion
+      type:
+        scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyBudgetUsage
+  map:
+    fields:
+    - name: restrictedBindDefinitions
+      type:
+        scalar: numeric
+    - name: roleBindings
+      type:
+        scalar: numeric
+    - name: serviceAccounts
+      type:
+        scalar: numeric
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyBudgets
+  map:
+    fields:
+    - name: maxRestrictedBindDefinitions
+      type:
+        scalar: numeric
+    - name: maxRoleBindings
+      type:
+        scalar: numeric
+    - name: maxServiceAccounts
+      type:
+        scalar: numeric
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyDelegation
+  map:
+    fields:
+    - name: groups
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: serviceAccounts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SARef
+          elementRelationship: atomic
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyScope
   map:
     fields:
@@ -457,18 +777,33 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RBACPolicySpec
   map:
     fields:
+    - name: admissionEnforcement
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.AdmissionEnforcement
     - name: appliesTo
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyScope
     - name: bindingLimits
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.BindingLimits
+    - name: budgets
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyBudgets
     - name: defaultAssignment
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.DefaultPolicyAssignment
+    - name: delegation
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyDelegation
     - name: impersonation
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationConfig
+    - name: onViolation
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ViolationPolicy
+    - name: parentPolicyRef
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RBACPolicyReference
     - name: roleLimits
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RoleLimits
@@ -481,6 +816,17 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: boundResourceCount
       type:
         scalar: numeric
+    - name: catalog
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RoleOffering
+          elementRelationship: associative
+          keys:
+          - name
+    - name: catalogOmitted
+      type:
+        scalar: numeric
     - name: conditions
       type:
         list:
@@ -490,6 +836,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: observedGeneration
       type:
         scalar: numeric
+    - name: usage
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.PolicyBudgetUsage
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ResourceVerbRule
   map:
     fields:
@@ -551,6 +900,10 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: clusterRoleBindings
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ClusterBinding
+    - name: conflictPolicy
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConflictPolicy
+      default: Force
     - name: policyRef
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RBACPolicyReference
@@ -560,6 +913,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.NamespaceBinding
           elementRelationship: atomic
+    - name: serviceAccountLifecycle
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ServiceAccountLifecycle
     - name: subjects
       type:
         list:
@@ -581,6 +937,16 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
           elementRelationship: atomic
+    - name: conflicts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.FieldManagerConflict
+          elementRelationship: associative
+          keys:
+          - kind
+          - namespace
+          - name
     - name: externalServiceAccounts
       type:
         list:
@@ -608,6 +974,15 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: policyViolationSince
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: revokedServiceAccounts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.RevokedServiceAccount
+          elementRelationship: atomic
     - name: skippedServiceAccounts
       type:
         list:
@@ -635,6 +1010,10 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RestrictedRoleDefinitionSpec
   map:
     fields:
+    - name: conflictPolicy
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConflictPolicy
+      default: Force
     - name: constrainedImpersonation
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConstrainedImpersonationSpec
@@ -680,6 +1059,16 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
           elementRelationship: atomic
+    - name: conflicts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.FieldManagerConflict
+          elementRelationship: associative
+          keys:
+          - kind
+          - namespace
+          - name
     - name: observedGeneration
       type:
         scalar: numeric
@@ -689,9 +1078,27 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: policyViolationSince
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
     - name: roleReconciled
       type:
         scalar: boolean
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RevokedServiceAccount
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: revokedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RoleDefinition
   map:
     fields:
@@ -725,9 +1132,21 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: boolean
       default: false
+    - name: conflictPolicy
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConflictPolicy
+      default: Force
     - name: constrainedImpersonation
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ConstrainedImpersonationSpec
+    - name: impersonationGrants
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationGrant
+          elementRelationship: associative
+          keys:
+          - name
     - name: metricsAccessAllowed
       type:
         scalar: boolean
@@ -771,6 +1190,24 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
           elementRelationship: atomic
+    - name: conflicts
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.FieldManagerConflict
+          elementRelationship: associative
+          keys:
+          - kind
+          - namespace
+          - name
+    - name: impersonationGrants
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.ImpersonationGrantStatus
+          elementRelationship: associative
+          keys:
+          - name
     - name: observedGeneration
       type:
         scalar: numeric
@@ -814,6 +1251,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: maxRulesPerRole
       type:
         scalar: numeric
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RoleOffering
+  map:
+    fields:
+    - name: clusterWide
+      type:
+        scalar: boolean
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: ruleCount
+      type:
+        scalar: numeric
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.RoleRefLimits
   map:
     fields:
@@ -867,6 +1319,77 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: namespace
       type:
         scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorOperator
+  scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+    - name: operator
+      type:
+        namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorOperator
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: valuesFromExtra
+      type:
+        scalar: string
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorResourceRule
+  map:
+    fields:
+    - name: apiGroups
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: fieldSelector
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorRequirement
+          elementRelationship: atomic
+    - name: labelSelector
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorRequirement
+          elementRelationship: atomic
+    - name: resourceNames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: resources
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: verbs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ServiceAccountLifecycle
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: idleRevokeAfter
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: ttl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ServiceAccountLimits
   map:
     fields:
@@ -912,6 +1435,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: userLimits
       type:
         namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.NameMatchLimits
+- name: com.github.telekom.auth-operator.api.authorization.v1alpha1.ViolationPolicy
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: gracePeriod
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.WebhookAuthorizer
   map:
     fields:
@@ -964,6 +1496,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.api.authorization.v1.ResourceRule
           elementRelationship: atomic
+    - name: selectorRules
+      type:
+        list:
+          elementType:
+            namedType: com.github.telekom.auth-operator.api.authorization.v1alpha1.SelectorResourceRule
+          elementRelationship: atomic
 - name: com.github.telekom.auth-operator.api.authorization.v1alpha1.WebhookAuthorizerStatus
   map:
     fields:
@@ -1108,6 +1646,8 @@ var schemaYAML = typed.YAMLObject(`types:
         scalar: string
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.ConditionStatus
   scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
   map:
     elementType:
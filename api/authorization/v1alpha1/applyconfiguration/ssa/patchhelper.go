@@ -135,6 +135,15 @@ func roleDefinitionStatusEqual(a, b *authorizationv1alpha1.RoleDefinitionStatus)
 	if a.RoleReconciled != b.RoleReconciled {
 		return false
 	}
+	if !slices.EqualFunc(a.ImpersonationGrants, b.ImpersonationGrants, func(x, y authorizationv1alpha1.ImpersonationGrantStatus) bool {
+		return x.Name == y.Name && x.RoleName == y.RoleName &&
+			x.PermissionCount == y.PermissionCount && slices.Equal(x.Permissions, y.Permissions)
+	}) {
+		return false
+	}
+	if !fieldManagerConflictsEqual(a.Conflicts, b.Conflicts) {
+		return false
+	}
 	return conditionsEqual(a.Conditions, b.Conditions)
 }
 
@@ -155,6 +164,12 @@ func bindDefinitionStatusEqual(a, b *authorizationv1alpha1.BindDefinitionStatus)
 	if !slices.Equal(a.ExternalServiceAccounts, b.ExternalServiceAccounts) {
 		return false
 	}
+	if !revokedServiceAccountsEqual(a.RevokedServiceAccounts, b.RevokedServiceAccounts) {
+		return false
+	}
+	if !fieldManagerConflictsEqual(a.Conflicts, b.Conflicts) {
+		return false
+	}
 	return conditionsEqual(a.Conditions, b.Conditions)
 }
 
@@ -202,6 +217,30 @@ func subjectsEqual(a, b []rbacv1.Subject) bool {
 	return true
 }
 
+// revokedServiceAccountsEqual compares two RevokedServiceAccount slices for equality.
+func revokedServiceAccountsEqual(a, b []authorizationv1alpha1.RevokedServiceAccount) bool {
+	return slices.EqualFunc(a, b, func(x, y authorizationv1alpha1.RevokedServiceAccount) bool {
+		return x.Namespace == y.Namespace && x.Name == y.Name &&
+			x.Reason == y.Reason && x.RevokedAt.Equal(&y.RevokedAt)
+	})
+}
+
+// timePtrEqual compares two optional timestamps; two nil values are equal.
+func timePtrEqual(a, b *metav1.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b)
+}
+
+// fieldManagerConflictsEqual compares two FieldManagerConflict slices for equality.
+func fieldManagerConflictsEqual(a, b []authorizationv1alpha1.FieldManagerConflict) bool {
+	return slices.EqualFunc(a, b, func(x, y authorizationv1alpha1.FieldManagerConflict) bool {
+		return x.Kind == y.Kind && x.Namespace == y.Namespace && x.Name == y.Name &&
+			slices.Equal(x.Managers, y.Managers)
+	})
+}
+
 // PatchApplyRBACPolicyStatus compares the desired RBACPolicy status
 // against the cached version and skips the API call when nothing changed.
 func PatchApplyRBACPolicyStatus(ctx context.Context, c client.Client, rp *authorizationv1alpha1.RBACPolicy) (pkgssa.PatchApplyResult, error) {
@@ -235,6 +274,74 @@ func PatchApplyRBACPolicyStatus(ctx context.Context, c client.Client, rp *author
 	return pkgssa.PatchApplyResultPatched, nil
 }
 
+// PatchApplyImpersonationExposureReportStatus compares the desired
+// ImpersonationExposureReport status against the cached version and skips the
+// API call when nothing changed.
+func PatchApplyImpersonationExposureReportStatus(ctx context.Context, c client.Client, report *authorizationv1alpha1.ImpersonationExposureReport) (pkgssa.PatchApplyResult, error) {
+	if report == nil {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("impersonationExposureReport must not be nil")
+	}
+	if report.Name == "" {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("impersonationExposureReport must have a name")
+	}
+
+	logger := log.FromContext(ctx)
+
+	var cached authorizationv1alpha1.ImpersonationExposureReport
+	if err := c.Get(ctx, types.NamespacedName{Name: report.Name}, &cached); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(2).Info("ImpersonationExposureReport not in cache, applying status unconditionally", "name", report.Name)
+		} else {
+			return pkgssa.PatchApplyResultPatched, fmt.Errorf("get cached ImpersonationExposureReport %s: %w", report.Name, err)
+		}
+	} else if impersonationExposureReportStatusEqual(&cached.Status, &report.Status) {
+		logger.V(2).Info("ImpersonationExposureReport status unchanged, skipping apply", "name", report.Name)
+		return pkgssa.PatchApplyResultSkipped, nil
+	}
+
+	applyConfig := ac.ImpersonationExposureReport(report.Name).
+		WithStatus(ImpersonationExposureReportStatusFrom(&report.Status))
+
+	if err := applyStatus(ctx, c, applyConfig); err != nil {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("apply ImpersonationExposureReport %s status: %w", report.Name, err)
+	}
+	return pkgssa.PatchApplyResultPatched, nil
+}
+
+// PatchApplyOperatorCapabilitiesStatus compares the desired
+// OperatorCapabilities status against the cached version and skips the API
+// call when nothing changed.
+func PatchApplyOperatorCapabilitiesStatus(ctx context.Context, c client.Client, caps *authorizationv1alpha1.OperatorCapabilities) (pkgssa.PatchApplyResult, error) {
+	if caps == nil {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("operatorCapabilities must not be nil")
+	}
+	if caps.Name == "" {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("operatorCapabilities must have a name")
+	}
+
+	logger := log.FromContext(ctx)
+
+	var cached authorizationv1alpha1.OperatorCapabilities
+	if err := c.Get(ctx, types.NamespacedName{Name: caps.Name}, &cached); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(2).Info("OperatorCapabilities not in cache, applying status unconditionally", "name", caps.Name)
+		} else {
+			return pkgssa.PatchApplyResultPatched, fmt.Errorf("get cached OperatorCapabilities %s: %w", caps.Name, err)
+		}
+	} else if operatorCapabilitiesStatusEqual(&cached.Status, &caps.Status) {
+		logger.V(2).Info("OperatorCapabilities status unchanged, skipping apply", "name", caps.Name)
+		return pkgssa.PatchApplyResultSkipped, nil
+	}
+
+	applyConfig := ac.OperatorCapabilities(caps.Name).
+		WithStatus(OperatorCapabilitiesStatusFrom(&caps.Status))
+
+	if err := applyStatus(ctx, c, applyConfig); err != nil {
+		return pkgssa.PatchApplyResultPatched, fmt.Errorf("apply OperatorCapabilities %s status: %w", caps.Name, err)
+	}
+	return pkgssa.PatchApplyResultPatched, nil
+}
+
 // PatchApplyRestrictedBindDefinitionStatus compares the desired RestrictedBindDefinition status
 // against the cached version and skips the API call when nothing changed.
 func PatchApplyRestrictedBindDefinitionStatus(ctx context.Context, c client.Client, rbd *authorizationv1alpha1.RestrictedBindDefinition) (pkgssa.PatchApplyResult, error) {
@@ -350,6 +457,35 @@ func rbacPolicyStatusEqual(a, b *authorizationv1alpha1.RBACPolicyStatus) bool {
 	if a.BoundResourceCount != b.BoundResourceCount {
 		return false
 	}
+	if (a.Usage == nil) != (b.Usage == nil) || (a.Usage != nil && *a.Usage != *b.Usage) {
+		return false
+	}
+	if a.CatalogOmitted != b.CatalogOmitted || !slices.Equal(a.Catalog, b.Catalog) {
+		return false
+	}
+	return conditionsEqual(a.Conditions, b.Conditions)
+}
+
+// impersonationExposureReportStatusEqual compares two ImpersonationExposureReportStatus values for equality.
+func impersonationExposureReportStatusEqual(a, b *authorizationv1alpha1.ImpersonationExposureReportStatus) bool {
+	if (a.LastScanTime == nil) != (b.LastScanTime == nil) || (a.LastScanTime != nil && !a.LastScanTime.Equal(b.LastScanTime)) {
+		return false
+	}
+	if a.ExposedSubjects != b.ExposedSubjects || a.ConstrainedSubjects != b.ConstrainedSubjects ||
+		a.LegacyRoles != b.LegacyRoles || a.Truncated != b.Truncated {
+		return false
+	}
+	if !slices.EqualFunc(a.Findings, b.Findings, func(x, y authorizationv1alpha1.LegacyImpersonationFinding) bool {
+		return x.Subject == y.Subject && x.ClusterWide == y.ClusterWide &&
+			x.HasConstrainedGrant == y.HasConstrainedGrant &&
+			slices.EqualFunc(x.Grants, y.Grants, func(g, h authorizationv1alpha1.LegacyImpersonationGrant) bool {
+				return g.BindingKind == h.BindingKind && g.BindingName == h.BindingName &&
+					g.BindingNamespace == h.BindingNamespace && g.RoleKind == h.RoleKind &&
+					g.RoleName == h.RoleName && slices.Equal(g.Resources, h.Resources)
+			})
+	}) {
+		return false
+	}
 	return conditionsEqual(a.Conditions, b.Conditions)
 }
 
@@ -373,9 +509,18 @@ func restrictedBindDefinitionStatusEqual(a, b *authorizationv1alpha1.RestrictedB
 	if !slices.Equal(a.SkippedServiceAccounts, b.SkippedServiceAccounts) {
 		return false
 	}
+	if !revokedServiceAccountsEqual(a.RevokedServiceAccounts, b.RevokedServiceAccounts) {
+		return false
+	}
 	if !slices.Equal(a.PolicyViolations, b.PolicyViolations) {
 		return false
 	}
+	if !timePtrEqual(a.PolicyViolationSince, b.PolicyViolationSince) {
+		return false
+	}
+	if !fieldManagerConflictsEqual(a.Conflicts, b.Conflicts) {
+		return false
+	}
 	return conditionsEqual(a.Conditions, b.Conditions)
 }
 
@@ -390,5 +535,31 @@ func restrictedRoleDefinitionStatusEqual(a, b *authorizationv1alpha1.RestrictedR
 	if !slices.Equal(a.PolicyViolations, b.PolicyViolations) {
 		return false
 	}
+	if !timePtrEqual(a.PolicyViolationSince, b.PolicyViolationSince) {
+		return false
+	}
+	if !fieldManagerConflictsEqual(a.Conflicts, b.Conflicts) {
+		return false
+	}
+	return conditionsEqual(a.Conditions, b.Conditions)
+}
+
+// operatorCapabilitiesStatusEqual compares two OperatorCapabilitiesStatus values for equality.
+func operatorCapabilitiesStatusEqual(a, b *authorizationv1alpha1.OperatorCapabilitiesStatus) bool {
+	if (a.LastProbeTime == nil) != (b.LastProbeTime == nil) || (a.LastProbeTime != nil && !a.LastProbeTime.Equal(b.LastProbeTime)) {
+		return false
+	}
+	if a.ServerVersion != b.ServerVersion || !slices.Equal(a.Capabilities, b.Capabilities) {
+		return false
+	}
+	if !slices.EqualFunc(a.DegradedGroupVersions, b.DegradedGroupVersions, degradedGroupVersionEqual) {
+		return false
+	}
 	return conditionsEqual(a.Conditions, b.Conditions)
 }
+
+// degradedGroupVersionEqual compares two DegradedGroupVersion values for equality.
+func degradedGroupVersionEqual(a, b authorizationv1alpha1.DegradedGroupVersion) bool {
+	return a.GroupVersion == b.GroupVersion && a.Stale == b.Stale && a.Message == b.Message &&
+		a.FailingSince.Equal(b.FailingSince) && a.LastSuccessTime.Equal(b.LastSuccessTime)
+}
@@ -73,6 +73,23 @@ func RoleDefinitionStatusFrom(status *authorizationv1alpha1.RoleDefinitionStatus
 	// Set RoleReconciled
 	result.WithRoleReconciled(status.RoleReconciled)
 
+	// Set ImpersonationGrants
+	for i := range status.ImpersonationGrants {
+		grant := &status.ImpersonationGrants[i]
+		result.WithImpersonationGrants(ac.ImpersonationGrantStatus().
+			WithName(grant.Name).
+			WithRoleName(grant.RoleName).
+			WithPermissionCount(grant.PermissionCount).
+			WithPermissions(grant.Permissions...))
+	}
+
+	// Set Conflicts — always initialise the slice (even when empty) so that SSA
+	// retains field ownership and can clear a previously populated list.
+	result.Conflicts = make([]ac.FieldManagerConflictApplyConfiguration, 0, len(status.Conflicts))
+	for i := range status.Conflicts {
+		result.WithConflicts(FieldManagerConflictFrom(&status.Conflicts[i]))
+	}
+
 	// Set conditions
 	for i := range status.Conditions {
 		result.WithConditions(ConditionFrom(&status.Conditions[i]))
@@ -116,6 +133,20 @@ func BindDefinitionStatusFrom(status *authorizationv1alpha1.BindDefinitionStatus
 		result.WithExternalServiceAccounts(sa)
 	}
 
+	// Set RevokedServiceAccounts — always initialise the slice (even when empty)
+	// so that SSA retains field ownership and can clear a previously populated list.
+	result.RevokedServiceAccounts = make([]ac.RevokedServiceAccountApplyConfiguration, 0, len(status.RevokedServiceAccounts))
+	for i := range status.RevokedServiceAccounts {
+		result.WithRevokedServiceAccounts(RevokedServiceAccountFrom(&status.RevokedServiceAccounts[i]))
+	}
+
+	// Set Conflicts — always initialise the slice (even when empty) so that SSA
+	// retains field ownership and can clear a previously populated list.
+	result.Conflicts = make([]ac.FieldManagerConflictApplyConfiguration, 0, len(status.Conflicts))
+	for i := range status.Conflicts {
+		result.WithConflicts(FieldManagerConflictFrom(&status.Conflicts[i]))
+	}
+
 	// Set conditions
 	for i := range status.Conditions {
 		result.WithConditions(ConditionFrom(&status.Conditions[i]))
@@ -154,6 +185,22 @@ func ApplyRBACPolicyStatus(ctx context.Context, c client.Client, rp *authorizati
 	return err
 }
 
+// ApplyImpersonationExposureReportStatus applies a status update to an ImpersonationExposureReport using native SSA.
+// It delegates to PatchApplyImpersonationExposureReportStatus which compares against the cache first
+// and skips the API call when the status is already up-to-date.
+func ApplyImpersonationExposureReportStatus(ctx context.Context, c client.Client, report *authorizationv1alpha1.ImpersonationExposureReport) error {
+	_, err := PatchApplyImpersonationExposureReportStatus(ctx, c, report)
+	return err
+}
+
+// ApplyOperatorCapabilitiesStatus applies a status update to an OperatorCapabilities using native SSA.
+// It delegates to PatchApplyOperatorCapabilitiesStatus which compares against the cache first
+// and skips the API call when the status is already up-to-date.
+func ApplyOperatorCapabilitiesStatus(ctx context.Context, c client.Client, caps *authorizationv1alpha1.OperatorCapabilities) error {
+	_, err := PatchApplyOperatorCapabilitiesStatus(ctx, c, caps)
+	return err
+}
+
 // ApplyRestrictedBindDefinitionStatus applies a status update to a RestrictedBindDefinition using native SSA.
 // It delegates to PatchApplyRestrictedBindDefinitionStatus which compares against the cache first
 // and skips the API call when the status is already up-to-date.
@@ -179,6 +226,26 @@ func RBACPolicyStatusFrom(status *authorizationv1alpha1.RBACPolicyStatus) *ac.RB
 	result := ac.RBACPolicyStatus()
 	result.WithObservedGeneration(status.ObservedGeneration)
 	result.WithBoundResourceCount(status.BoundResourceCount)
+	if status.Usage != nil {
+		result.WithUsage(ac.PolicyBudgetUsage().
+			WithRestrictedBindDefinitions(status.Usage.RestrictedBindDefinitions).
+			WithRoleBindings(status.Usage.RoleBindings).
+			WithServiceAccounts(status.Usage.ServiceAccounts))
+	}
+	for i := range status.Catalog {
+		offering := &status.Catalog[i]
+		entry := ac.RoleOffering().
+			WithName(offering.Name).
+			WithRuleCount(offering.RuleCount)
+		if offering.Description != "" {
+			entry.WithDescription(offering.Description)
+		}
+		if offering.ClusterWide {
+			entry.WithClusterWide(true)
+		}
+		result.WithCatalog(entry)
+	}
+	result.WithCatalogOmitted(status.CatalogOmitted)
 
 	for i := range status.Conditions {
 		result.WithConditions(ConditionFrom(&status.Conditions[i]))
@@ -187,6 +254,124 @@ func RBACPolicyStatusFrom(status *authorizationv1alpha1.RBACPolicyStatus) *ac.RB
 	return result
 }
 
+// ImpersonationExposureReportStatusFrom converts an ImpersonationExposureReportStatus to its ApplyConfiguration.
+func ImpersonationExposureReportStatusFrom(status *authorizationv1alpha1.ImpersonationExposureReportStatus) *ac.ImpersonationExposureReportStatusApplyConfiguration {
+	if status == nil {
+		return nil
+	}
+
+	result := ac.ImpersonationExposureReportStatus()
+	if status.LastScanTime != nil {
+		result.WithLastScanTime(*status.LastScanTime)
+	}
+	result.WithExposedSubjects(status.ExposedSubjects)
+	result.WithConstrainedSubjects(status.ConstrainedSubjects)
+	result.WithLegacyRoles(status.LegacyRoles)
+	result.WithTruncated(status.Truncated)
+
+	for i := range status.Findings {
+		finding := &status.Findings[i]
+		findingAC := ac.LegacyImpersonationFinding().
+			WithSubject(finding.Subject).
+			WithClusterWide(finding.ClusterWide).
+			WithHasConstrainedGrant(finding.HasConstrainedGrant)
+		for j := range finding.Grants {
+			grant := &finding.Grants[j]
+			grantAC := ac.LegacyImpersonationGrant().
+				WithBindingKind(grant.BindingKind).
+				WithBindingName(grant.BindingName).
+				WithRoleKind(grant.RoleKind).
+				WithRoleName(grant.RoleName).
+				WithResources(grant.Resources...)
+			if grant.BindingNamespace != "" {
+				grantAC.WithBindingNamespace(grant.BindingNamespace)
+			}
+			findingAC.WithGrants(grantAC)
+		}
+		result.WithFindings(findingAC)
+	}
+
+	for i := range status.Conditions {
+		result.WithConditions(ConditionFrom(&status.Conditions[i]))
+	}
+
+	return result
+}
+
+// OperatorCapabilitiesStatusFrom converts an OperatorCapabilitiesStatus to its ApplyConfiguration.
+func OperatorCapabilitiesStatusFrom(status *authorizationv1alpha1.OperatorCapabilitiesStatus) *ac.OperatorCapabilitiesStatusApplyConfiguration {
+	if status == nil {
+		return nil
+	}
+
+	result := ac.OperatorCapabilitiesStatus()
+	if status.ServerVersion != "" {
+		result.WithServerVersion(status.ServerVersion)
+	}
+	if status.LastProbeTime != nil {
+		result.WithLastProbeTime(*status.LastProbeTime)
+	}
+
+	for i := range status.Capabilities {
+		capability := &status.Capabilities[i]
+		capabilityAC := ac.CapabilityStatus().
+			WithName(capability.Name).
+			WithState(capability.State)
+		if capability.Reason != "" {
+			capabilityAC.WithReason(capability.Reason)
+		}
+		if capability.Message != "" {
+			capabilityAC.WithMessage(capability.Message)
+		}
+		result.WithCapabilities(capabilityAC)
+	}
+
+	for i := range status.DegradedGroupVersions {
+		degraded := &status.DegradedGroupVersions[i]
+		degradedAC := ac.DegradedGroupVersion().
+			WithGroupVersion(degraded.GroupVersion)
+		if degraded.Stale {
+			degradedAC.WithStale(true)
+		}
+		if degraded.FailingSince != nil {
+			degradedAC.WithFailingSince(*degraded.FailingSince)
+		}
+		if degraded.LastSuccessTime != nil {
+			degradedAC.WithLastSuccessTime(*degraded.LastSuccessTime)
+		}
+		if degraded.Message != "" {
+			degradedAC.WithMessage(degraded.Message)
+		}
+		result.WithDegradedGroupVersions(degradedAC)
+	}
+
+	for i := range status.Conditions {
+		result.WithConditions(ConditionFrom(&status.Conditions[i]))
+	}
+
+	return result
+}
+
+// RevokedServiceAccountFrom converts a RevokedServiceAccount to its ApplyConfiguration.
+func RevokedServiceAccountFrom(revoked *authorizationv1alpha1.RevokedServiceAccount) *ac.RevokedServiceAccountApplyConfiguration {
+	return ac.RevokedServiceAccount().
+		WithNamespace(revoked.Namespace).
+		WithName(revoked.Name).
+		WithReason(revoked.Reason).
+		WithRevokedAt(revoked.RevokedAt)
+}
+
+// FieldManagerConflictFrom converts a FieldManagerConflict to its ApplyConfiguration.
+// The namespace is always set, also when empty, as it is a key of the
+// conflicts list map.
+func FieldManagerConflictFrom(conflict *authorizationv1alpha1.FieldManagerConflict) *ac.FieldManagerConflictApplyConfiguration {
+	return ac.FieldManagerConflict().
+		WithKind(conflict.Kind).
+		WithNamespace(conflict.Namespace).
+		WithName(conflict.Name).
+		WithManagers(conflict.Managers...)
+}
+
 // RestrictedBindDefinitionStatusFrom converts a RestrictedBindDefinitionStatus to its ApplyConfiguration.
 func RestrictedBindDefinitionStatusFrom(status *authorizationv1alpha1.RestrictedBindDefinitionStatus) *ac.RestrictedBindDefinitionStatusApplyConfiguration {
 	if status == nil {
@@ -217,10 +402,25 @@ func RestrictedBindDefinitionStatusFrom(status *authorizationv1alpha1.Restricted
 		result.WithSkippedServiceAccounts(sa)
 	}
 
+	result.RevokedServiceAccounts = make([]ac.RevokedServiceAccountApplyConfiguration, 0, len(status.RevokedServiceAccounts))
+	for i := range status.RevokedServiceAccounts {
+		result.WithRevokedServiceAccounts(RevokedServiceAccountFrom(&status.RevokedServiceAccounts[i]))
+	}
+
 	result.PolicyViolations = make([]string, 0, len(status.PolicyViolations))
 	for _, v := range status.PolicyViolations {
 		result.WithPolicyViolations(v)
 	}
+	if status.PolicyViolationSince != nil {
+		result.WithPolicyViolationSince(*status.PolicyViolationSince)
+	}
+
+	// Set Conflicts — always initialise the slice (even when empty) so that SSA
+	// retains field ownership and can clear a previously populated list.
+	result.Conflicts = make([]ac.FieldManagerConflictApplyConfiguration, 0, len(status.Conflicts))
+	for i := range status.Conflicts {
+		result.WithConflicts(FieldManagerConflictFrom(&status.Conflicts[i]))
+	}
 
 	for i := range status.Conditions {
 		result.WithConditions(ConditionFrom(&status.Conditions[i]))
@@ -243,6 +443,16 @@ func RestrictedRoleDefinitionStatusFrom(status *authorizationv1alpha1.Restricted
 	for _, v := range status.PolicyViolations {
 		result.WithPolicyViolations(v)
 	}
+	if status.PolicyViolationSince != nil {
+		result.WithPolicyViolationSince(*status.PolicyViolationSince)
+	}
+
+	// Set Conflicts — always initialise the slice (even when empty) so that SSA
+	// retains field ownership and can clear a previously populated list.
+	result.Conflicts = make([]ac.FieldManagerConflictApplyConfiguration, 0, len(status.Conflicts))
+	for i := range status.Conflicts {
+		result.WithConflicts(FieldManagerConflictFrom(&status.Conflicts[i]))
+	}
 
 	for i := range status.Conditions {
 		result.WithConditions(ConditionFrom(&status.Conditions[i]))
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -578,6 +579,146 @@ var _ = Describe("SSA Status Apply Functions", func() {
 			Expect(result).To(Equal(pkgssa.PatchApplyResultSkipped))
 		})
 	})
+	Context("PatchApplyRestrictedBindDefinitionStatus skip path", func() {
+		It("should apply when only policyViolationSince has changed", func() {
+			scheme := newTestScheme()
+			rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: authorizationv1alpha1.GroupVersion.String(),
+					Kind:       "RestrictedBindDefinition",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rbd-violation-since"},
+				Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+					PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "policy"},
+					TargetName: "violation-since",
+				},
+				Status: authorizationv1alpha1.RestrictedBindDefinitionStatus{
+					PolicyViolations: []string{"subject not allowed"},
+				},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rbd).
+				WithStatusSubresource(&authorizationv1alpha1.RestrictedBindDefinition{}).
+				Build()
+
+			result, err := ssa.PatchApplyRestrictedBindDefinitionStatus(context.Background(), c, rbd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultSkipped))
+
+			since := metav1.Now()
+			rbd.Status.PolicyViolationSince = &since
+			result, err = ssa.PatchApplyRestrictedBindDefinitionStatus(context.Background(), c, rbd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultPatched))
+
+			var updated authorizationv1alpha1.RestrictedBindDefinition
+			Expect(c.Get(context.Background(), client.ObjectKeyFromObject(rbd), &updated)).To(Succeed())
+			Expect(updated.Status.PolicyViolationSince).NotTo(BeNil())
+		})
+
+		It("should apply when only conflicts have changed", func() {
+			scheme := newTestScheme()
+			rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: authorizationv1alpha1.GroupVersion.String(),
+					Kind:       "RestrictedBindDefinition",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rbd-conflicts"},
+				Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+					PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "policy"},
+					TargetName: "conflicts",
+				},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rbd).
+				WithStatusSubresource(&authorizationv1alpha1.RestrictedBindDefinition{}).
+				Build()
+
+			rbd.Status.Conflicts = []authorizationv1alpha1.FieldManagerConflict{
+				{Kind: "ClusterRoleBinding", Name: "conflicts-view-binding", Managers: []string{"argocd-controller"}},
+			}
+			result, err := ssa.PatchApplyRestrictedBindDefinitionStatus(context.Background(), c, rbd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultPatched))
+
+			var updated authorizationv1alpha1.RestrictedBindDefinition
+			Expect(c.Get(context.Background(), client.ObjectKeyFromObject(rbd), &updated)).To(Succeed())
+			Expect(updated.Status.Conflicts).To(HaveLen(1))
+		})
+	})
+
+	Context("PatchApplyRestrictedRoleDefinitionStatus skip path", func() {
+		It("should apply when only policyViolationSince has changed", func() {
+			scheme := newTestScheme()
+			// Serialized timestamps have second precision.
+			since := metav1.NewTime(time.Now().Truncate(time.Second))
+			rrd := &authorizationv1alpha1.RestrictedRoleDefinition{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: authorizationv1alpha1.GroupVersion.String(),
+					Kind:       "RestrictedRoleDefinition",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rrd-violation-since"},
+				Spec: authorizationv1alpha1.RestrictedRoleDefinitionSpec{
+					PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "policy"},
+					TargetRole: authorizationv1alpha1.DefinitionClusterRole,
+					TargetName: "violation-since",
+				},
+				Status: authorizationv1alpha1.RestrictedRoleDefinitionStatus{
+					PolicyViolations:     []string{"verb not allowed"},
+					PolicyViolationSince: &since,
+				},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rrd).
+				WithStatusSubresource(&authorizationv1alpha1.RestrictedRoleDefinition{}).
+				Build()
+
+			result, err := ssa.PatchApplyRestrictedRoleDefinitionStatus(context.Background(), c, rrd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultSkipped))
+
+			later := metav1.NewTime(since.Add(time.Hour))
+			rrd.Status.PolicyViolationSince = &later
+			result, err = ssa.PatchApplyRestrictedRoleDefinitionStatus(context.Background(), c, rrd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultPatched))
+		})
+
+		It("should apply when only conflicts have changed", func() {
+			scheme := newTestScheme()
+			rrd := &authorizationv1alpha1.RestrictedRoleDefinition{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: authorizationv1alpha1.GroupVersion.String(),
+					Kind:       "RestrictedRoleDefinition",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rrd-conflicts"},
+				Spec: authorizationv1alpha1.RestrictedRoleDefinitionSpec{
+					PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "policy"},
+					TargetRole: authorizationv1alpha1.DefinitionClusterRole,
+					TargetName: "conflicts",
+				},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rrd).
+				WithStatusSubresource(&authorizationv1alpha1.RestrictedRoleDefinition{}).
+				Build()
+
+			result, err := ssa.PatchApplyRestrictedRoleDefinitionStatus(context.Background(), c, rrd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultSkipped))
+
+			rrd.Status.Conflicts = []authorizationv1alpha1.FieldManagerConflict{
+				{Kind: "ClusterRole", Name: "conflicts", Managers: []string{"helm"}},
+			}
+			result, err = ssa.PatchApplyRestrictedRoleDefinitionStatus(context.Background(), c, rrd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(pkgssa.PatchApplyResultPatched))
+		})
+	})
 })
 
 var _ = Describe("SSA Status Conversion Functions", func() {
@@ -655,6 +796,25 @@ var _ = Describe("SSA Status Conversion Functions", func() {
 			Expect(result.GeneratedServiceAccounts).To(HaveLen(2))
 			Expect(result.Conditions).To(HaveLen(1))
 		})
+
+		It("should convert field manager conflicts and keep an empty list", func() {
+			result := ssa.BindDefinitionStatusFrom(&authorizationv1alpha1.BindDefinitionStatus{})
+			Expect(result.Conflicts).NotTo(BeNil())
+			Expect(result.Conflicts).To(BeEmpty())
+
+			status := &authorizationv1alpha1.BindDefinitionStatus{
+				Conflicts: []authorizationv1alpha1.FieldManagerConflict{
+					{Kind: "ClusterRoleBinding", Name: "team-view", Managers: []string{"argocd-controller"}},
+					{Kind: "RoleBinding", Namespace: "ns1", Name: "team-edit", Managers: []string{"helm", "kubectl-edit"}},
+				},
+			}
+			result = ssa.BindDefinitionStatusFrom(status)
+			Expect(result.Conflicts).To(HaveLen(2))
+			Expect(*result.Conflicts[0].Kind).To(Equal("ClusterRoleBinding"))
+			Expect(*result.Conflicts[0].Namespace).To(BeEmpty())
+			Expect(*result.Conflicts[1].Namespace).To(Equal("ns1"))
+			Expect(result.Conflicts[1].Managers).To(Equal([]string{"helm", "kubectl-edit"}))
+		})
 	})
 
 	Context("WebhookAuthorizerStatusFrom", func() {
@@ -31,6 +31,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=authorization.t-caas.telekom.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AdmissionEnforcement"):
+		return &authorizationv1alpha1.AdmissionEnforcementApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("BindDefinition"):
 		return &authorizationv1alpha1.BindDefinitionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("BindDefinitionSpec"):
@@ -39,6 +41,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &authorizationv1alpha1.BindDefinitionStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("BindingLimits"):
 		return &authorizationv1alpha1.BindingLimitsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapabilityStatus"):
+		return &authorizationv1alpha1.CapabilityStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterBinding"):
 		return &authorizationv1alpha1.ClusterBindingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ConstrainedImpersonationLimits"):
@@ -47,20 +51,48 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &authorizationv1alpha1.ConstrainedImpersonationSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DefaultPolicyAssignment"):
 		return &authorizationv1alpha1.DefaultPolicyAssignmentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DegradedGroupVersion"):
+		return &authorizationv1alpha1.DegradedGroupVersionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FieldManagerConflict"):
+		return &authorizationv1alpha1.FieldManagerConflictApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationActionRule"):
 		return &authorizationv1alpha1.ImpersonationActionRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationConfig"):
 		return &authorizationv1alpha1.ImpersonationConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationExposureReport"):
+		return &authorizationv1alpha1.ImpersonationExposureReportApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationExposureReportStatus"):
+		return &authorizationv1alpha1.ImpersonationExposureReportStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationExtra"):
 		return &authorizationv1alpha1.ImpersonationExtraApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationGrant"):
+		return &authorizationv1alpha1.ImpersonationGrantApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationGrantStatus"):
+		return &authorizationv1alpha1.ImpersonationGrantStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ImpersonationIdentityRule"):
 		return &authorizationv1alpha1.ImpersonationIdentityRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LegacyImpersonationFinding"):
+		return &authorizationv1alpha1.LegacyImpersonationFindingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LegacyImpersonationGrant"):
+		return &authorizationv1alpha1.LegacyImpersonationGrantApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NameMatchLimits"):
 		return &authorizationv1alpha1.NameMatchLimitsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceBinding"):
 		return &authorizationv1alpha1.NamespaceBindingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceLimits"):
 		return &authorizationv1alpha1.NamespaceLimitsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceTerminationPolicy"):
+		return &authorizationv1alpha1.NamespaceTerminationPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorCapabilities"):
+		return &authorizationv1alpha1.OperatorCapabilitiesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorCapabilitiesStatus"):
+		return &authorizationv1alpha1.OperatorCapabilitiesStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PolicyBudgets"):
+		return &authorizationv1alpha1.PolicyBudgetsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PolicyBudgetUsage"):
+		return &authorizationv1alpha1.PolicyBudgetUsageApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PolicyDelegation"):
+		return &authorizationv1alpha1.PolicyDelegationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PolicyScope"):
 		return &authorizationv1alpha1.PolicyScopeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Principal"):
@@ -91,6 +123,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &authorizationv1alpha1.RestrictedRoleDefinitionSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RestrictedRoleDefinitionStatus"):
 		return &authorizationv1alpha1.RestrictedRoleDefinitionStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RevokedServiceAccount"):
+		return &authorizationv1alpha1.RevokedServiceAccountApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoleDefinition"):
 		return &authorizationv1alpha1.RoleDefinitionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoleDefinitionSpec"):
@@ -99,16 +133,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &authorizationv1alpha1.RoleDefinitionStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoleLimits"):
 		return &authorizationv1alpha1.RoleLimitsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RoleOffering"):
+		return &authorizationv1alpha1.RoleOfferingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoleRefLimits"):
 		return &authorizationv1alpha1.RoleRefLimitsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SACreationConfig"):
 		return &authorizationv1alpha1.SACreationConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SARef"):
 		return &authorizationv1alpha1.SARefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SelectorRequirement"):
+		return &authorizationv1alpha1.SelectorRequirementApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SelectorResourceRule"):
+		return &authorizationv1alpha1.SelectorResourceRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccountLifecycle"):
+		return &authorizationv1alpha1.ServiceAccountLifecycleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccountLimits"):
 		return &authorizationv1alpha1.ServiceAccountLimitsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SubjectLimits"):
 		return &authorizationv1alpha1.SubjectLimitsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ViolationPolicy"):
+		return &authorizationv1alpha1.ViolationPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookAuthorizer"):
 		return &authorizationv1alpha1.WebhookAuthorizerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookAuthorizerSpec"):
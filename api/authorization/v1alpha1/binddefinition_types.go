@@ -122,6 +122,25 @@ type BindDefinitionSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=true
 	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
+
+	// NamespaceTermination controls when the finalizer on generated RoleBindings is
+	// released while their namespace terminates. When unset, the controller-wide
+	// default applies (WaitForAll unless configured otherwise).
+	// +kubebuilder:validation:Optional
+	NamespaceTermination *NamespaceTerminationPolicy `json:"namespaceTermination,omitempty"`
+
+	// ServiceAccountLifecycle revokes generated ServiceAccounts after a TTL or
+	// an idle period. Pre-existing ServiceAccounts are not affected.
+	// +kubebuilder:validation:Optional
+	ServiceAccountLifecycle *ServiceAccountLifecycle `json:"serviceAccountLifecycle,omitempty"`
+
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated ClusterRoleBinding or RoleBinding with a different value:
+	// Force takes them over, Fail stops the reconcile, and Report leaves the
+	// binding unchanged. Conflicts are listed in status.conflicts in every case.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Force
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // unmarshalRoleBindings handles backward-compatible unmarshaling of the
@@ -211,6 +230,22 @@ type BindDefinitionStatus struct {
 	// +kubebuilder:validation:Optional
 	ExternalServiceAccounts []string `json:"externalServiceAccounts,omitempty"`
 
+	// RevokedServiceAccounts lists generated ServiceAccounts whose
+	// serviceAccountLifecycle expired. They are excluded from generated bindings.
+	// +kubebuilder:validation:Optional
+	RevokedServiceAccounts []RevokedServiceAccount `json:"revokedServiceAccounts,omitempty"`
+
+	// Conflicts lists generated bindings whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty"`
+
 	// Conditions defines current service state of the Bind definition. All conditions should evaluate to true to signify successful reconciliation.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -111,6 +111,12 @@ func (v *BindDefinitionValidator) validateBindDefinitionSpec(ctx context.Context
 	if err := validateBindDefinitionSubjects(kind, r.Name, r.Spec.Subjects); err != nil {
 		return warnings, err
 	}
+	if errs := ValidateNamespaceTerminationPolicy(r.Spec.NamespaceTermination, field.NewPath("spec", "namespaceTermination")); len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(kind, r.Name, errs)
+	}
+	if errs := ValidateServiceAccountLifecycle(r.Spec.ServiceAccountLifecycle, field.NewPath("spec", "serviceAccountLifecycle")); len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(kind, r.Name, errs)
+	}
 
 	existingBD, err := v.findBindDefinitionTargetNameConflict(ctx, r)
 	if err != nil {
@@ -569,9 +575,18 @@ func validateBindDefinitionRequiredFields(kind schema.GroupKind, obj *BindDefini
 }
 
 func validateBindDefinitionSubjects(kind schema.GroupKind, name string, subjects []rbacv1.Subject) error {
+	if allErrs := validateSubjectList(subjects, field.NewPath("spec", "subjects")); len(allErrs) > 0 {
+		return apierrors.NewInvalid(kind, name, allErrs)
+	}
+	return nil
+}
+
+// validateSubjectList checks the kind, apiGroup and namespace of every subject
+// the operator writes into a generated binding.
+func validateSubjectList(subjects []rbacv1.Subject, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	for i, subject := range subjects {
-		subjectPath := field.NewPath("spec", "subjects").Index(i)
+		subjectPath := fldPath.Index(i)
 		if subject.Name == "" {
 			allErrs = append(allErrs, field.Required(subjectPath.Child("name"), "subject name is required"))
 		}
@@ -598,8 +613,5 @@ func validateBindDefinitionSubjects(kind schema.GroupKind, name string, subjects
 			allErrs = append(allErrs, field.NotSupported(subjectPath.Child("kind"), subject.Kind, supportedSubjectKinds))
 		}
 	}
-	if len(allErrs) > 0 {
-		return apierrors.NewInvalid(kind, name, allErrs)
-	}
-	return nil
+	return allErrs
 }
@@ -236,6 +236,19 @@ func TestBindDefinitionValidatorRejectsRequiredAndSubjectShape(t *testing.T) {
 			}}),
 			want: []string{"spec.subjects[0].namespace", "Bad/Name"},
 		},
+		{
+			name: "namespace termination policy without resource types",
+			bd: func() *BindDefinition {
+				bd := bindDefinitionForSubjectValidation("termination-policy", []rbacv1.Subject{{
+					Kind:     rbacv1.UserKind,
+					APIGroup: rbacv1.GroupName,
+					Name:     "alice",
+				}})
+				bd.Spec.NamespaceTermination = &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseWaitForListed}
+				return bd
+			}(),
+			want: []string{"spec.namespaceTermination.resourceTypes", "must be set when finalizerRelease is WaitForListed"},
+		},
 	}
 
 	for _, tc := range testCases {
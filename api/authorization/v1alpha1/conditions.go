@@ -74,6 +74,12 @@ const (
 	NamespaceTerminationAllowedReason AuthZConditionReason = "AuthOperatorResourcesCleanedUp"
 	// NamespaceTerminationAllowedMessage is the message when termination is allowed.
 	NamespaceTerminationAllowedMessage AuthZConditionMessage = "All role bindings created by auth-operator have been cleaned up"
+
+	// NamespaceTerminationReleasedByPolicyReason is the reason when role bindings are released
+	// by a BindDefinition namespace termination policy while other resources remain.
+	NamespaceTerminationReleasedByPolicyReason AuthZConditionReason = "AuthOperatorReleasedByPolicy"
+	// NamespaceTerminationReleasedByPolicyMessage is the message when role bindings are released by policy.
+	NamespaceTerminationReleasedByPolicyMessage AuthZConditionMessage = "Auth-operator released role bindings according to the namespace termination policy despite remaining resources"
 )
 
 // Owner reference related condition constants.
@@ -106,6 +112,22 @@ const (
 	APIDiscoveryMessage AuthZConditionMessage = "Fetching all available API groups"
 )
 
+// API discovery degradation related condition constants.
+const (
+	// APIDiscoveryDegradedCondition is True while discovery of one or more
+	// GroupVersions the RoleDefinition resolves rules from fails; GroupVersions
+	// excluded entirely by spec.restrictedApis are ignored. The generated role
+	// then uses the last known good resources of stale GroupVersions and omits
+	// GroupVersions past the staleness window. The condition is removed once
+	// discovery recovers.
+	APIDiscoveryDegradedCondition AuthZConditionType = "APIDiscoveryDegraded"
+	// APIDiscoveryDegradedReason is the reason for the API discovery degraded condition.
+	APIDiscoveryDegradedReason AuthZConditionReason = "GroupVersionDiscoveryFailed"
+	// APIDiscoveryDegradedMessage is the format string for the API discovery
+	// degraded condition. Use with the list of failing GroupVersions.
+	APIDiscoveryDegradedMessage AuthZConditionMessage = "API discovery is failing for group versions: %s"
+)
+
 // Resource discovery related condition constants.
 const (
 	// ResourceDiscoveryCondition indicates resource discovery status.
@@ -295,6 +317,33 @@ const (
 	WAPrincipalMessageOverlap AuthZConditionMessage = "A principal appears in both allowed and denied lists: %s"
 )
 
+// WebhookAuthorizer SelectorRulesEffective condition constants.
+//
+// Selector rules only match requests whose SubjectAccessReview carries parsed
+// field and label selectors, which requires the AuthorizeWithSelectors feature
+// on the API server. Without it the rules are accepted but never match.
+const (
+	// WASelectorRulesEffectiveCondition reports whether spec.selectorRules can
+	// match on this cluster. It is only set when selector rules are configured.
+	WASelectorRulesEffectiveCondition AuthZConditionType = "SelectorRulesEffective"
+
+	// WASelectorRulesReasonEffective indicates the API server sends selectors.
+	WASelectorRulesReasonEffective AuthZConditionReason = "FeatureGateEnabled"
+	// WASelectorRulesMessageEffective is the message when selector rules are effective.
+	WASelectorRulesMessageEffective AuthZConditionMessage = "Selector rules are effective: %s"
+
+	// WASelectorRulesReasonInert indicates the API server does not send selectors,
+	// so selector rules never match.
+	WASelectorRulesReasonInert AuthZConditionReason = "FeatureGateDisabled"
+	// WASelectorRulesMessageInert is the message when selector rules are inert.
+	WASelectorRulesMessageInert AuthZConditionMessage = "Selector rules are INERT and never match: %s"
+
+	// WASelectorRulesReasonUnknown indicates the feature state could not be determined.
+	WASelectorRulesReasonUnknown AuthZConditionReason = "FeatureStateUnknown"
+	// WASelectorRulesMessageUnknown is the message when the state is unknown.
+	WASelectorRulesMessageUnknown AuthZConditionMessage = "Selector rule support could not be determined: %s"
+)
+
 // RBACPolicy compliance condition constants.
 const (
 	// PolicyCompliantCondition indicates whether the resource complies with its RBACPolicy.
@@ -331,6 +380,101 @@ const (
 	DeprovisionedReason AuthZConditionReason = "Deprovisioned"
 )
 
+// RBACPolicy onViolation constants for restricted resources that keep their last
+// compliant RBAC instead of being deprovisioned immediately.
+const (
+	// RevocationPendingCondition counts down to the revocation of the last
+	// compliant RBAC under an onViolation RevokeAfter action.
+	RevocationPendingCondition AuthZConditionType = "RevocationPending"
+	// RevocationPendingReasonGracePeriod is the reason while the grace period runs.
+	RevocationPendingReasonGracePeriod AuthZConditionReason = "GracePeriodActive"
+	// RevocationPendingMessageGracePeriod is the format message while the grace period runs.
+	RevocationPendingMessageGracePeriod AuthZConditionMessage = "Managed RBAC will be revoked at %s unless the policy violations are resolved"
+
+	// ViolationGracePeriodReason is the Ready reason while the last compliant RBAC
+	// is kept during an onViolation RevokeAfter grace period.
+	ViolationGracePeriodReason AuthZConditionReason = "ViolationGracePeriod"
+	// FrozenReason is the Ready reason while an onViolation Freeze action keeps
+	// the last compliant RBAC and withholds new changes.
+	FrozenReason AuthZConditionReason = "Frozen"
+)
+
+// RBACPolicy budget condition constants.
+const (
+	// WithinBudgetCondition reports whether the resources referencing an
+	// RBACPolicy stay within its spec.budgets.
+	WithinBudgetCondition AuthZConditionType = "WithinBudget"
+	// WithinBudgetReasonWithinLimits is the reason when usage is within every budget.
+	WithinBudgetReasonWithinLimits AuthZConditionReason = "WithinLimits"
+	// WithinBudgetMessageWithinLimits is the message when usage is within every budget.
+	WithinBudgetMessageWithinLimits AuthZConditionMessage = "Usage is within all policy budgets"
+	// WithinBudgetReasonExceeded is the reason when usage exceeds a budget, for
+	// example after a budget was lowered below existing usage.
+	WithinBudgetReasonExceeded AuthZConditionReason = "BudgetExceeded"
+	// WithinBudgetMessageExceeded is the format message when usage exceeds a budget.
+	WithinBudgetMessageExceeded AuthZConditionMessage = "Policy budgets exceeded: %s"
+)
+
+// RBACPolicy admission enforcement condition constants.
+const (
+	// AdmissionPolicyEnforcedCondition reports whether the ValidatingAdmissionPolicy
+	// generated for spec.admissionEnforcement is in place.
+	AdmissionPolicyEnforcedCondition AuthZConditionType = "AdmissionPolicyEnforced"
+	// AdmissionPolicyEnforcedReasonEnforced is the reason when the generated policy is applied.
+	AdmissionPolicyEnforcedReasonEnforced AuthZConditionReason = "Enforced"
+	// AdmissionPolicyEnforcedMessageEnforced is the format message when the generated policy is applied.
+	AdmissionPolicyEnforcedMessageEnforced AuthZConditionMessage = "ValidatingAdmissionPolicy %s enforces %d CEL validations with action %s"
+	// AdmissionPolicyEnforcedReasonNothingToEnforce is the reason when no limit
+	// of the policy is expressible in CEL.
+	AdmissionPolicyEnforcedReasonNothingToEnforce AuthZConditionReason = "NothingToEnforce"
+	// AdmissionPolicyEnforcedMessageNothingToEnforce is the message when no limit
+	// of the policy is expressible in CEL.
+	AdmissionPolicyEnforcedMessageNothingToEnforce AuthZConditionMessage = "No limit of the policy can be enforced by a ValidatingAdmissionPolicy"
+	// AdmissionPolicyEnforcedReasonOperatorIdentityUnknown is the reason when the
+	// operator could not determine its own username and so cannot exempt itself.
+	AdmissionPolicyEnforcedReasonOperatorIdentityUnknown AuthZConditionReason = "OperatorIdentityUnknown"
+	// AdmissionPolicyEnforcedMessageOperatorIdentityUnknown is the message when the
+	// operator username is unknown.
+	AdmissionPolicyEnforcedMessageOperatorIdentityUnknown AuthZConditionMessage = "The operator username is unknown, so the generated policy could not exempt the operator"
+	// AdmissionPolicyEnforcedReasonApplyFailed is the reason when applying the
+	// generated policy failed, for example because the cluster does not serve
+	// admissionregistration.k8s.io/v1 ValidatingAdmissionPolicies.
+	AdmissionPolicyEnforcedReasonApplyFailed AuthZConditionReason = "ApplyFailed"
+	// AdmissionPolicyEnforcedMessageApplyFailed is the format message when applying failed.
+	AdmissionPolicyEnforcedMessageApplyFailed AuthZConditionMessage = "Failed to apply ValidatingAdmissionPolicy: %s"
+)
+
+// ImpersonationExposureReport condition constants.
+const (
+	// LegacyImpersonationExposedCondition reports whether any subject in the
+	// cluster holds the legacy "impersonate" verb.
+	LegacyImpersonationExposedCondition AuthZConditionType = "LegacyImpersonationExposed"
+	// LegacyImpersonationExposedReasonNone is the reason when no subject holds the verb.
+	LegacyImpersonationExposedReasonNone AuthZConditionReason = "NoExposure"
+	// LegacyImpersonationExposedMessageNone is the message when no subject holds the verb.
+	LegacyImpersonationExposedMessageNone AuthZConditionMessage = "No subject holds the legacy impersonate verb"
+	// LegacyImpersonationExposedReasonFound is the reason when subjects hold the verb.
+	LegacyImpersonationExposedReasonFound AuthZConditionReason = "SubjectsExposed"
+	// LegacyImpersonationExposedMessageFound is the format message when subjects hold the verb.
+	LegacyImpersonationExposedMessageFound AuthZConditionMessage = "%d subjects hold the legacy impersonate verb, %d of them alongside constrained impersonation grants"
+)
+
+// OperatorCapabilities condition constants.
+const (
+	// CapabilitiesAvailableCondition reports whether every API server capability
+	// the operator detects is enabled.
+	CapabilitiesAvailableCondition AuthZConditionType = "CapabilitiesAvailable"
+	// CapabilitiesAvailableReasonAll is the reason when every capability is enabled.
+	CapabilitiesAvailableReasonAll AuthZConditionReason = "AllEnabled"
+	// CapabilitiesAvailableMessageAll is the message when every capability is enabled.
+	CapabilitiesAvailableMessageAll AuthZConditionMessage = "All detected API server capabilities are enabled"
+	// CapabilitiesAvailableReasonMissing is the reason when a capability is
+	// disabled or its state is unknown.
+	CapabilitiesAvailableReasonMissing AuthZConditionReason = "CapabilitiesMissing"
+	// CapabilitiesAvailableMessageMissing is the format message when capabilities are missing.
+	CapabilitiesAvailableMessageMissing AuthZConditionMessage = "Capabilities not enabled: %s"
+)
+
 // ConstrainedImpersonation condition constants.
 //
 // The condition exists because a constrained-impersonation grant is a
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// MaxFieldManagerConflicts caps the conflicts listed in status.conflicts.
+// Keep in sync with the MaxItems marker on the Conflicts status fields.
+const MaxFieldManagerConflicts = 64
+
+// ConflictPolicy selects how the controller applies a generated RBAC resource
+// when another field manager, such as Helm, Argo CD or kubectl, owns some of
+// its fields with a different value.
+// +kubebuilder:validation:Enum=Force;Fail;Report
+type ConflictPolicy string
+
+// Conflict policies for generated RBAC resources.
+const (
+	// ConflictPolicyForce (the default) reports the conflict and then takes
+	// ownership of the conflicting fields, so generated resources always match
+	// the definition.
+	ConflictPolicyForce ConflictPolicy = "Force"
+
+	// ConflictPolicyFail reports the conflict and leaves the resource unchanged.
+	// The reconcile fails and the definition is not Ready until the other field
+	// manager releases the fields.
+	ConflictPolicyFail ConflictPolicy = "Fail"
+
+	// ConflictPolicyReport reports the conflict and leaves the resource
+	// unchanged, but keeps reconciling the remaining resources. Use it to let
+	// another tool own a generated resource while the conflict stays visible.
+	ConflictPolicyReport ConflictPolicy = "Report"
+)
+
+// FieldManagerConflict reports a generated resource whose last apply conflicted
+// with fields owned by other field managers.
+type FieldManagerConflict struct {
+	// Kind of the generated resource, e.g. ClusterRoleBinding.
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Namespace of the generated resource. Empty for cluster-scoped resources.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=""
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the generated resource.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Managers lists the competing field managers from the managedFields of the
+	// resource, e.g. "argocd-controller" or "helm".
+	// +kubebuilder:validation:Required
+	Managers []string `json:"managers"`
+}
+
+// effectiveConflictPolicy returns policy, or ConflictPolicyForce when unset.
+func effectiveConflictPolicy(policy ConflictPolicy) ConflictPolicy {
+	if policy == "" {
+		return ConflictPolicyForce
+	}
+	return policy
+}
+
+// GetConflictPolicy returns the conflict policy of the BindDefinition,
+// defaulting to ConflictPolicyForce.
+func (bd *BindDefinition) GetConflictPolicy() ConflictPolicy {
+	return effectiveConflictPolicy(bd.Spec.ConflictPolicy)
+}
+
+// GetConflictPolicy returns the conflict policy of the RoleDefinition,
+// defaulting to ConflictPolicyForce.
+func (rd *RoleDefinition) GetConflictPolicy() ConflictPolicy {
+	return effectiveConflictPolicy(rd.Spec.ConflictPolicy)
+}
+
+// GetConflictPolicy returns the conflict policy of the RestrictedBindDefinition,
+// defaulting to ConflictPolicyForce.
+func (rbd *RestrictedBindDefinition) GetConflictPolicy() ConflictPolicy {
+	return effectiveConflictPolicy(rbd.Spec.ConflictPolicy)
+}
+
+// GetConflictPolicy returns the conflict policy of the RestrictedRoleDefinition,
+// defaulting to ConflictPolicyForce.
+func (rrd *RestrictedRoleDefinition) GetConflictPolicy() ConflictPolicy {
+	return effectiveConflictPolicy(rrd.Spec.ConflictPolicy)
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import "testing"
+
+func TestGetConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		policy ConflictPolicy
+		want   ConflictPolicy
+	}{
+		{policy: "", want: ConflictPolicyForce},
+		{policy: ConflictPolicyForce, want: ConflictPolicyForce},
+		{policy: ConflictPolicyFail, want: ConflictPolicyFail},
+		{policy: ConflictPolicyReport, want: ConflictPolicyReport},
+	} {
+		bd := &BindDefinition{Spec: BindDefinitionSpec{ConflictPolicy: tc.policy}}
+		if got := bd.GetConflictPolicy(); got != tc.want {
+			t.Errorf("BindDefinition.GetConflictPolicy() with %q = %q, want %q", tc.policy, got, tc.want)
+		}
+		rd := &RoleDefinition{Spec: RoleDefinitionSpec{ConflictPolicy: tc.policy}}
+		if got := rd.GetConflictPolicy(); got != tc.want {
+			t.Errorf("RoleDefinition.GetConflictPolicy() with %q = %q, want %q", tc.policy, got, tc.want)
+		}
+	}
+}
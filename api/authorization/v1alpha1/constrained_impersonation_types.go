@@ -211,7 +211,8 @@ type ImpersonationActionRule struct {
 // IMPORTANT — grants union, they do not correlate. The effective permission is
 // the full cross product of every granted identity and every granted action. It
 // is not possible to express "userA only for pods AND userB only for secrets" in
-// a single grant; use two separate RoleDefinitions bound to different subjects.
+// a single grant; use RoleDefinition spec.impersonationGrants, which generates a
+// separate role and binding per identity and action pairing.
 //
 // +kubebuilder:validation:XValidation:rule="self.mode != 'associated-node' || !self.identities.exists(r, has(r.names) && size(r.names) > 0)",message="associated-node identity rules must not set names; the apiserver performs the node association check itself"
 // +kubebuilder:validation:XValidation:rule="self.mode != 'associated-node' || self.identities.all(r, r.resource == 'nodes')",message="associated-node mode only supports the 'nodes' identity resource"
@@ -641,7 +642,7 @@ func ConstrainedImpersonationWarnings(spec *ConstrainedImpersonationSpec, fieldN
 		warnings = append(warnings, fmt.Sprintf(
 			"%s: constrained impersonation grants UNION rather than correlate. The effective permission is the full "+
 				"cross product of all %d identities and all %d action rules; it cannot express per-identity actions. "+
-				"Split into separate definitions if per-identity scoping is required.",
+				"Split into separate impersonationGrants if per-identity scoping is required.",
 			fieldName, len(spec.Identities), len(spec.Actions)))
 	}
 
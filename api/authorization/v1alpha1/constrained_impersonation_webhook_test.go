@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	authzv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -260,6 +261,44 @@ var _ = Describe("Constrained impersonation CRD schema and admission", func() {
 		})
 	})
 
+	Context("RoleDefinition spec.impersonationGrants", func() {
+		oncall := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "oncall"}
+		grantFor := func(user, resource string) ConstrainedImpersonationSpec {
+			return ConstrainedImpersonationSpec{
+				Mode:       ImpersonationModeUserInfo,
+				Identities: []ImpersonationIdentityRule{{Resource: ImpersonationResourceUsers, Names: []string{user}}},
+				Actions: []ImpersonationActionRule{
+					{APIGroups: []string{""}, Resources: []string{resource}, Verbs: []string{"get"}},
+				},
+			}
+		}
+
+		It("accepts correlated grants", func() {
+			rd := newRoleDefinition(uniqueName("ci-grants"), nil)
+			rd.Spec.ImpersonationGrants = []ImpersonationGrant{
+				{Name: "pods", Subjects: []rbacv1.Subject{oncall}, Grant: grantFor("user-a", "pods")},
+				{Name: "secrets", Subjects: []rbacv1.Subject{oncall}, Grant: grantFor("user-b", "secrets")},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, rd)).To(Succeed())
+		})
+
+		It("rejects duplicate grant names", func() {
+			rd := newRoleDefinition(uniqueName("ci-grants-dup"), nil)
+			rd.Spec.ImpersonationGrants = []ImpersonationGrant{
+				{Name: "pods", Subjects: []rbacv1.Subject{oncall}, Grant: grantFor("user-a", "pods")},
+				{Name: "pods", Subjects: []rbacv1.Subject{oncall}, Grant: grantFor("user-b", "secrets")},
+			}
+			Expect(k8sClient.Create(ctx, rd)).NotTo(Succeed())
+		})
+
+		It("rejects a grant without subjects", func() {
+			rd := newRoleDefinition(uniqueName("ci-grants-nosub"), nil)
+			rd.Spec.ImpersonationGrants = []ImpersonationGrant{{Name: "pods", Grant: grantFor("user-a", "pods")}}
+			Expect(k8sClient.Create(ctx, rd)).NotTo(Succeed())
+		})
+	})
+
 	Context("RoleDefinition spec.restrictedVerbs with impersonation verbs", func() {
 		It("accepts constrained impersonation verbs in restrictedVerbs", func() {
 			// Blocker #1: the historical pattern ^([a-z]+|\\*)$ rejected colon-bearing
@@ -46,9 +46,14 @@ func requesterMatchesDefaultAssignment(da *DefaultPolicyAssignment, username str
 	if da == nil {
 		return false
 	}
+	return requesterMatchesIdentities(da.Groups, da.ServiceAccounts, username, groups)
+}
 
+// requesterMatchesIdentities reports whether the requester is a member of one of
+// the assigned groups or is one of the assigned ServiceAccounts.
+func requesterMatchesIdentities(assignedGroups []string, assignedServiceAccounts []SARef, username string, groups []string) bool {
 	for _, requesterGroup := range groups {
-		for _, assignedGroup := range da.Groups {
+		for _, assignedGroup := range assignedGroups {
 			if requesterGroup == assignedGroup {
 				return true
 			}
@@ -60,7 +65,7 @@ func requesterMatchesDefaultAssignment(da *DefaultPolicyAssignment, username str
 		return false
 	}
 
-	for _, assignedSA := range da.ServiceAccounts {
+	for _, assignedSA := range assignedServiceAccounts {
 		if assignedSA.Name == sa.Name && assignedSA.Namespace == sa.Namespace {
 			return true
 		}
@@ -54,6 +54,10 @@ const (
 	// because other BindDefinitions still reference it.
 	EventReasonServiceAccountRetained = "ServiceAccountRetained"
 
+	// EventReasonServiceAccountRevoked indicates a generated ServiceAccount was
+	// unbound or deleted because its serviceAccountLifecycle expired.
+	EventReasonServiceAccountRevoked = "ServiceAccountRevoked"
+
 	// EventReasonReconciled indicates a resource was successfully reconciled.
 	EventReasonReconciled = "Reconciled"
 
@@ -76,6 +80,26 @@ const (
 
 	// EventReasonDeprovisioned indicates resources were deprovisioned due to policy violation.
 	EventReasonDeprovisioned = "Deprovisioned"
+
+	// EventReasonNamespaceTerminationStuck indicates a terminating namespace has
+	// been blocked by remaining resources for longer than the configured grace period.
+	EventReasonNamespaceTerminationStuck = "NamespaceTerminationStuck"
+
+	// EventReasonFinalizerReleasedByPolicy indicates a RoleBinding finalizer was released
+	// by a namespace termination policy while other resources still remained.
+	EventReasonFinalizerReleasedByPolicy = "FinalizerReleasedByPolicy"
+
+	// EventReasonRevocationPending indicates a non-compliant resource keeps its last
+	// compliant RBAC until the RBACPolicy onViolation grace period expires.
+	EventReasonRevocationPending = "RevocationPending"
+
+	// EventReasonFrozen indicates a non-compliant resource keeps its last compliant
+	// RBAC because its RBACPolicy freezes changes on violation.
+	EventReasonFrozen = "Frozen"
+
+	// EventReasonFieldManagerConflict indicates a generated RBAC resource has
+	// fields owned by another field manager, handled by the conflictPolicy.
+	EventReasonFieldManagerConflict = "FieldManagerConflict"
 )
 
 // Event action constants for the events.k8s.io/v1 API.
@@ -325,6 +325,46 @@ func TestValidateImpersonationConfig(t *testing.T) {
 				Mode:              ImpersonationModeServiceAccount,
 			},
 		},
+		// TokenRequest credentials authenticate as the ServiceAccount itself.
+		{
+			name: "TokenRequest with a serviceAccountRef is valid",
+			ic: &ImpersonationConfig{
+				Enabled:           true,
+				ServiceAccountRef: &SARef{Namespace: "team-a", Name: "applier"},
+				CredentialSource:  ImpersonationCredentialSourceTokenRequest,
+			},
+		},
+		{
+			name: "TokenRequest with a userName is rejected",
+			ic: &ImpersonationConfig{
+				Enabled:          true,
+				UserName:         "jane@example.com",
+				CredentialSource: ImpersonationCredentialSourceTokenRequest,
+			},
+			wantErrs:     1,
+			wantContains: "credentialSource TokenRequest requires serviceAccountRef",
+		},
+		{
+			name: "TokenRequest with a declared mode is rejected",
+			ic: &ImpersonationConfig{
+				Enabled:           true,
+				ServiceAccountRef: &SARef{Namespace: "team-a", Name: "applier"},
+				CredentialSource:  ImpersonationCredentialSourceTokenRequest,
+				Mode:              ImpersonationModeServiceAccount,
+			},
+			wantErrs:     1,
+			wantContains: "selects no constrained-impersonation mode",
+		},
+		{
+			name: "unknown credential source is rejected",
+			ic: &ImpersonationConfig{
+				Enabled:           true,
+				ServiceAccountRef: &SARef{Namespace: "team-a", Name: "applier"},
+				CredentialSource:  "Kubeconfig",
+			},
+			wantErrs:     1,
+			wantContains: "Unsupported value",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MaxImpersonationGrantPermissions caps the permissions listed per grant in
+// RoleDefinition status. The full count is always reported.
+const MaxImpersonationGrantPermissions = 64
+
+// associatedNodeIdentity is how status renders the nameless identity of an
+// associated-node grant, where the apiserver checks the association itself.
+const associatedNodeIdentity = "<associated-node>"
+
+// ImpersonationGrant correlates a set of impersonation identities with the
+// actions allowed while impersonating them, for a dedicated set of subjects.
+//
+// Constrained impersonation grants union: one role carrying several identities
+// and several actions allows every identity for every action. Each grant is
+// therefore generated as its own Role or ClusterRole, named
+// "<targetName>-<name>", plus a binding of the same name to Subjects, so
+// "userA only for pods AND userB only for secrets" becomes two grants.
+type ImpersonationGrant struct {
+	// Name identifies the grant and suffixes the generated role and binding names.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Subjects are bound to the generated role. They are the requesters allowed
+	// to impersonate, not the impersonated identities.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Subjects []rbacv1.Subject `json:"subjects"`
+
+	// Grant is the identity and action pairing generated into the role. Its
+	// identities and actions still union with each other, so keep one identity
+	// class per grant when actions must differ per identity.
+	// +kubebuilder:validation:Required
+	Grant ConstrainedImpersonationSpec `json:"grant"`
+}
+
+// ImpersonationGrantStatus reports the RBAC generated for one ImpersonationGrant
+// and the effective permissions it yields.
+type ImpersonationGrantStatus struct {
+	// Name is the grant name.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// RoleName is the name of the generated Role or ClusterRole and of the
+	// binding that grants it to the subjects.
+	// +kubebuilder:validation:Required
+	RoleName string `json:"roleName"`
+
+	// PermissionCount is the size of the identity by action cross product the
+	// grant allows.
+	// +kubebuilder:validation:Optional
+	PermissionCount int32 `json:"permissionCount"`
+
+	// Permissions lists the cross product as "<identity>: <verbs> <resources>"
+	// entries, truncated to the first 64.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// ImpersonationGrantRoleName returns the name of the role and binding generated
+// for a grant of the RoleDefinition whose target is targetName.
+func ImpersonationGrantRoleName(targetName, grantName string) string {
+	return targetName + "-" + grantName
+}
+
+// ImpersonationGrantPermissions expands a grant into its identity by action
+// cross product. It returns the total count and at most
+// MaxImpersonationGrantPermissions rendered entries in deterministic order.
+// A grant without actions authorizes nothing and yields no permissions.
+func ImpersonationGrantPermissions(spec *ConstrainedImpersonationSpec) (int, []string) {
+	var identities []string
+	for i := range spec.Identities {
+		rule := &spec.Identities[i]
+		resource := rule.identityRuleResource()
+		if len(rule.Names) == 0 {
+			identities = append(identities, resource+"/"+associatedNodeIdentity)
+			continue
+		}
+		for _, name := range dedupeSorted(rule.Names) {
+			identities = append(identities, resource+"/"+name)
+		}
+	}
+	identities = dedupeSorted(identities)
+
+	actions := make([]string, 0, len(spec.Actions))
+	for i := range spec.Actions {
+		actions = append(actions, renderImpersonationAction(&spec.Actions[i]))
+	}
+
+	total := len(identities) * len(actions)
+	permissions := make([]string, 0, min(total, MaxImpersonationGrantPermissions))
+	for _, identity := range identities {
+		for _, action := range actions {
+			if len(permissions) == MaxImpersonationGrantPermissions {
+				return total, permissions
+			}
+			permissions = append(permissions, identity+": "+action)
+		}
+	}
+	return total, permissions
+}
+
+// renderImpersonationAction renders an action rule as "<verbs> <resources>",
+// qualifying resources outside the core group like kubectl does.
+func renderImpersonationAction(action *ImpersonationActionRule) string {
+	var resources []string
+	for _, group := range dedupeSortedAllowEmpty(action.APIGroups) {
+		for _, resource := range dedupeSorted(action.Resources) {
+			if group != "" {
+				resource += "." + group
+			}
+			resources = append(resources, resource)
+		}
+	}
+	rendered := strings.Join(dedupeSorted(action.Verbs), ",") + " " + strings.Join(resources, ",")
+	if names := dedupeSorted(action.ResourceNames); len(names) > 0 {
+		rendered += " [" + strings.Join(names, ",") + "]"
+	}
+	return rendered
+}
+
+// ValidateImpersonationGrants performs the semantic validation of
+// spec.impersonationGrants that CEL cannot express.
+func ValidateImpersonationGrants(
+	grants []ImpersonationGrant,
+	targetName string,
+	targetIsClusterRole bool,
+	fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[string]int, len(grants))
+	for i := range grants {
+		grant := &grants[i]
+		grantPath := fldPath.Index(i)
+		namePath := grantPath.Child("name")
+
+		for _, msg := range utilvalidation.IsDNS1123Label(grant.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, grant.Name, msg))
+		}
+		if prev, ok := seen[grant.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(namePath,
+				fmt.Sprintf("%s (already used at impersonationGrants[%d])", grant.Name, prev)))
+		}
+		seen[grant.Name] = i
+		if roleName := ImpersonationGrantRoleName(targetName, grant.Name); len(roleName) > utilvalidation.DNS1123SubdomainMaxLength {
+			allErrs = append(allErrs, field.TooLong(namePath, roleName, utilvalidation.DNS1123SubdomainMaxLength))
+		}
+
+		if len(grant.Subjects) == 0 {
+			allErrs = append(allErrs, field.Required(grantPath.Child("subjects"), "at least one subject is required"))
+		}
+		allErrs = append(allErrs, validateSubjectList(grant.Subjects, grantPath.Child("subjects"))...)
+		allErrs = append(allErrs, ValidateConstrainedImpersonationSpec(&grant.Grant, targetIsClusterRole, grantPath.Child("grant"))...)
+	}
+	return allErrs
+}
+
+// ImpersonationGrantWarnings returns the constrained impersonation admission
+// warnings of every grant.
+func ImpersonationGrantWarnings(grants []ImpersonationGrant, fldPath *field.Path) []string {
+	var warnings []string
+	for i := range grants {
+		warnings = append(warnings,
+			ConstrainedImpersonationWarnings(&grants[i].Grant, fldPath.Index(i).Child("grant").String())...)
+	}
+	return warnings
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestImpersonationGrantPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		spec      *ConstrainedImpersonationSpec
+		wantCount int
+		want      []string
+	}{
+		{
+			name: "identity by action cross product",
+			spec: &ConstrainedImpersonationSpec{
+				Mode: ImpersonationModeUserInfo,
+				Identities: []ImpersonationIdentityRule{
+					{Resource: ImpersonationResourceUsers, Names: []string{"jane", "bob", "jane"}},
+				},
+				Actions: []ImpersonationActionRule{
+					{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"list", "get"}},
+					{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get"}, ResourceNames: []string{"web"}},
+				},
+			},
+			wantCount: 4,
+			want: []string{
+				"users/bob: get,list pods",
+				"users/bob: get deployments.apps [web]",
+				"users/jane: get,list pods",
+				"users/jane: get deployments.apps [web]",
+			},
+		},
+		{
+			name: "associated node has no names",
+			spec: &ConstrainedImpersonationSpec{
+				Mode:       ImpersonationModeAssociatedNode,
+				Identities: []ImpersonationIdentityRule{{Resource: ImpersonationResourceNodes}},
+				Actions:    []ImpersonationActionRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+			},
+			wantCount: 1,
+			want:      []string{"nodes/<associated-node>: get pods"},
+		},
+		{
+			name: "no actions grants nothing",
+			spec: &ConstrainedImpersonationSpec{
+				Mode:       ImpersonationModeUserInfo,
+				Identities: []ImpersonationIdentityRule{{Resource: ImpersonationResourceUsers, Names: []string{"jane"}}},
+			},
+			wantCount: 0,
+			want:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			count, got := ImpersonationGrantPermissions(tt.spec)
+			if count != tt.wantCount {
+				t.Errorf("count = %d, want %d", count, tt.wantCount)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("permissions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImpersonationGrantPermissionsTruncates(t *testing.T) {
+	t.Parallel()
+
+	names := make([]string, 40)
+	for i := range names {
+		names[i] = fmt.Sprintf("user-%02d", i)
+	}
+	spec := &ConstrainedImpersonationSpec{
+		Mode:       ImpersonationModeUserInfo,
+		Identities: []ImpersonationIdentityRule{{Resource: ImpersonationResourceUsers, Names: names}},
+		Actions: []ImpersonationActionRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+		},
+	}
+	count, got := ImpersonationGrantPermissions(spec)
+	if count != 80 {
+		t.Errorf("count = %d, want 80", count)
+	}
+	if len(got) != MaxImpersonationGrantPermissions {
+		t.Errorf("len(permissions) = %d, want %d", len(got), MaxImpersonationGrantPermissions)
+	}
+}
+
+func TestValidateImpersonationGrants(t *testing.T) {
+	t.Parallel()
+
+	group := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "oncall"}
+	userGrant := ConstrainedImpersonationSpec{
+		Mode:       ImpersonationModeUserInfo,
+		Identities: []ImpersonationIdentityRule{{Resource: ImpersonationResourceUsers, Names: []string{"jane"}}},
+		Actions:    []ImpersonationActionRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"list"}}},
+	}
+	saGrant := ConstrainedImpersonationSpec{
+		Mode: ImpersonationModeServiceAccount,
+		Identities: []ImpersonationIdentityRule{
+			{Resource: ImpersonationResourceServiceAccounts, Names: []string{"deployer"}},
+		},
+		Actions: []ImpersonationActionRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}},
+	}
+
+	tests := []struct {
+		name            string
+		grants          []ImpersonationGrant
+		targetName      string
+		clusterRole     bool
+		wantErrContains []string
+	}{
+		{
+			name: "correlated grants are valid",
+			grants: []ImpersonationGrant{
+				{Name: "pods", Subjects: []rbacv1.Subject{group}, Grant: userGrant},
+				{Name: "secrets", Subjects: []rbacv1.Subject{group}, Grant: saGrant},
+			},
+			targetName:  "oncall-impersonation",
+			clusterRole: true,
+		},
+		{
+			name: "duplicate names",
+			grants: []ImpersonationGrant{
+				{Name: "pods", Subjects: []rbacv1.Subject{group}, Grant: userGrant},
+				{Name: "pods", Subjects: []rbacv1.Subject{group}, Grant: userGrant},
+			},
+			targetName:      "oncall-impersonation",
+			clusterRole:     true,
+			wantErrContains: []string{"spec.impersonationGrants[1].name: Duplicate value"},
+		},
+		{
+			name:            "missing subjects",
+			grants:          []ImpersonationGrant{{Name: "pods", Grant: userGrant}},
+			targetName:      "oncall-impersonation",
+			clusterRole:     true,
+			wantErrContains: []string{"spec.impersonationGrants[0].subjects: Required value"},
+		},
+		{
+			name:            "cluster-scoped identity on a namespaced Role",
+			grants:          []ImpersonationGrant{{Name: "pods", Subjects: []rbacv1.Subject{group}, Grant: userGrant}},
+			targetName:      "oncall-impersonation",
+			wantErrContains: []string{"spec.impersonationGrants[0].grant"},
+		},
+		{
+			name:            "generated role name too long",
+			grants:          []ImpersonationGrant{{Name: "pods", Subjects: []rbacv1.Subject{group}, Grant: userGrant}},
+			targetName:      strings.Repeat("a", 250),
+			clusterRole:     true,
+			wantErrContains: []string{"spec.impersonationGrants[0].name: Too long"},
+		},
+		{
+			name:            "invalid name",
+			grants:          []ImpersonationGrant{{Name: "Pods_", Subjects: []rbacv1.Subject{group}, Grant: userGrant}},
+			targetName:      "oncall-impersonation",
+			clusterRole:     true,
+			wantErrContains: []string{"spec.impersonationGrants[0].name: Invalid value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateImpersonationGrants(tt.grants, tt.targetName, tt.clusterRole,
+				field.NewPath("spec", "impersonationGrants"))
+			if len(tt.wantErrContains) == 0 {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			for _, want := range tt.wantErrContains {
+				if !strings.Contains(errs.ToAggregate().Error(), want) {
+					t.Errorf("expected error containing %q, got %v", want, errs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImpersonationExposureReportName is the name of the singleton report the
+// controller maintains.
+const ImpersonationExposureReportName = "cluster"
+
+// MaxLegacyImpersonationFindings caps the findings listed in the report status.
+// The summary counts always cover every finding.
+const MaxLegacyImpersonationFindings = 256
+
+// LegacyImpersonationGrant is one binding through which a subject holds the
+// legacy "impersonate" verb.
+type LegacyImpersonationGrant struct {
+	// BindingKind is ClusterRoleBinding or RoleBinding.
+	// +kubebuilder:validation:Enum=ClusterRoleBinding;RoleBinding
+	BindingKind string `json:"bindingKind"`
+
+	// BindingName is the name of the binding.
+	BindingName string `json:"bindingName"`
+
+	// BindingNamespace is the namespace of a RoleBinding.
+	// +kubebuilder:validation:Optional
+	BindingNamespace string `json:"bindingNamespace,omitempty"`
+
+	// RoleKind is ClusterRole or Role.
+	// +kubebuilder:validation:Enum=ClusterRole;Role
+	RoleKind string `json:"roleKind"`
+
+	// RoleName is the name of the role carrying the legacy verb.
+	RoleName string `json:"roleName"`
+
+	// Resources are the identity resources the legacy verb applies to through
+	// this binding, e.g. users, groups or serviceaccounts. "*" means all.
+	// +kubebuilder:validation:Optional
+	Resources []string `json:"resources,omitempty"`
+}
+
+// LegacyImpersonationFinding reports a subject holding the legacy
+// "impersonate" verb, which wins by fallback and silently defeats every
+// constrained impersonation (KEP-5284) restriction the subject is also given.
+type LegacyImpersonationFinding struct {
+	// Subject is the user, group or ServiceAccount holding the legacy verb.
+	Subject rbacv1.Subject `json:"subject"`
+
+	// ClusterWide is true when at least one grant comes from a
+	// ClusterRoleBinding. Namespaced grants only cover serviceaccounts in the
+	// binding's namespace.
+	ClusterWide bool `json:"clusterWide"`
+
+	// HasConstrainedGrant is true when the subject also holds a constrained
+	// impersonation grant, which the legacy verb then defeats.
+	HasConstrainedGrant bool `json:"hasConstrainedGrant"`
+
+	// Grants lists the bindings granting the legacy verb.
+	// +kubebuilder:validation:Optional
+	Grants []LegacyImpersonationGrant `json:"grants,omitempty"`
+}
+
+// ImpersonationExposureReportStatus is the result of the latest cluster-wide
+// legacy impersonation scan.
+type ImpersonationExposureReportStatus struct {
+	// LastScanTime is when the cluster was last scanned.
+	// +kubebuilder:validation:Optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+
+	// ExposedSubjects is the number of subjects holding the legacy verb.
+	// +kubebuilder:validation:Optional
+	ExposedSubjects int32 `json:"exposedSubjects"`
+
+	// ConstrainedSubjects is the number of exposed subjects that also hold a
+	// constrained impersonation grant, i.e. whose constraints are defeated.
+	// +kubebuilder:validation:Optional
+	ConstrainedSubjects int32 `json:"constrainedSubjects"`
+
+	// LegacyRoles is the number of ClusterRoles and Roles carrying the legacy
+	// verb, bound or not.
+	// +kubebuilder:validation:Optional
+	LegacyRoles int32 `json:"legacyRoles"`
+
+	// Findings lists the exposed subjects, those with constrained grants first,
+	// truncated to the first 256.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=256
+	Findings []LegacyImpersonationFinding `json:"findings,omitempty"`
+
+	// Truncated is true when Findings does not list every exposed subject.
+	// +kubebuilder:validation:Optional
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Conditions defines the current state of the report.
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ImpersonationExposureReport is the Schema for the impersonationexposurereports API.
+// It is a status-only report, maintained by the controller under the name
+// "cluster", of every subject in the cluster that holds the legacy
+// "impersonate" verb, whether or not the RBAC granting it is operator-managed.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=impersonationexposurereports,scope=Cluster,shortName=impexp
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Exposed",type="integer",JSONPath=".status.exposedSubjects",description="Subjects holding the legacy impersonate verb"
+// +kubebuilder:printcolumn:name="Constrained",type="integer",JSONPath=".status.constrainedSubjects",description="Exposed subjects whose constrained grants are defeated"
+// +kubebuilder:printcolumn:name="Scanned",type="date",JSONPath=".status.lastScanTime",description="Time since the last scan"
+type ImpersonationExposureReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ImpersonationExposureReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImpersonationExposureReportList contains a list of ImpersonationExposureReport.
+type ImpersonationExposureReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImpersonationExposureReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImpersonationExposureReport{}, &ImpersonationExposureReportList{})
+}
+
+// GetConditions returns the conditions of the ImpersonationExposureReport.
+func (r *ImpersonationExposureReport) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the conditions of the ImpersonationExposureReport.
+func (r *ImpersonationExposureReport) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
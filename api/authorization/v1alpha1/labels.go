@@ -14,6 +14,10 @@ const (
 	LabelKeyThirdParty = "t-caas.telekom.com/thirdparty"
 )
 
+// LabelKeyImpersonationGrant marks the roles and bindings generated for a
+// RoleDefinition spec.impersonationGrants entry. The value is the grant name.
+const LabelKeyImpersonationGrant = "authorization.t-caas.telekom.com/impersonation-grant"
+
 // Annotation keys used by the auth-operator.
 const (
 	// AnnotationKeyReferencedBy tracks which BindDefinitions reference an external ServiceAccount.
@@ -21,6 +25,16 @@ const (
 	// This annotation is added to external (pre-existing) ServiceAccounts when a BindDefinition
 	// references them, and removed when no BindDefinitions reference them anymore.
 	AnnotationKeyReferencedBy = "authorization.t-caas.telekom.com/referenced-by"
+
+	// AnnotationKeyLastUsed records when a generated ServiceAccount was last seen
+	// making a request, as an RFC 3339 timestamp. It drives the idleRevokeAfter
+	// of a serviceAccountLifecycle.
+	AnnotationKeyLastUsed = "authorization.t-caas.telekom.com/last-used"
+
+	// AnnotationKeyDescription is a human-readable description of a ClusterRole,
+	// published in the access catalog of RBACPolicies that allow binding it.
+	// The well-known kubernetes.io/description annotation is used as a fallback.
+	AnnotationKeyDescription = "authorization.t-caas.telekom.com/description"
 )
 
 // Owner label values.
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// FinalizerReleasePolicy selects when the RoleBinding terminator releases the
+// finalizer on RoleBindings in a terminating namespace.
+// +kubebuilder:validation:Enum=WaitForAll;WaitForListed;ReleaseAfterTimeout
+type FinalizerReleasePolicy string
+
+// Finalizer release policies for RoleBindings in terminating namespaces.
+const (
+	// FinalizerReleaseWaitForAll (the default) keeps the finalizer until no other
+	// namespaced resource is left, so subjects keep their permissions while any
+	// cleanup is still pending.
+	FinalizerReleaseWaitForAll FinalizerReleasePolicy = "WaitForAll"
+
+	// FinalizerReleaseWaitForListed keeps the finalizer only while resources of
+	// the listed types remain. Other leftovers, such as custom resources whose own
+	// finalizers never complete, no longer deadlock namespace deletion.
+	FinalizerReleaseWaitForListed FinalizerReleasePolicy = "WaitForListed"
+
+	// FinalizerReleaseAfterTimeout waits for all resources like WaitForAll, but
+	// releases the finalizer once the namespace has been terminating for longer
+	// than the configured timeout.
+	FinalizerReleaseAfterTimeout FinalizerReleasePolicy = "ReleaseAfterTimeout"
+)
+
+// NamespaceTerminationPolicy controls how long RoleBindings generated by a
+// BindDefinition are kept while their namespace terminates.
+// +kubebuilder:validation:XValidation:rule="self.finalizerRelease != 'WaitForListed' || (has(self.resourceTypes) && size(self.resourceTypes) > 0)",message="resourceTypes must be set when finalizerRelease is WaitForListed"
+// +kubebuilder:validation:XValidation:rule="self.finalizerRelease == 'WaitForListed' || !has(self.resourceTypes) || size(self.resourceTypes) == 0",message="resourceTypes is only supported when finalizerRelease is WaitForListed"
+// +kubebuilder:validation:XValidation:rule="self.finalizerRelease != 'ReleaseAfterTimeout' || has(self.timeout)",message="timeout must be set when finalizerRelease is ReleaseAfterTimeout"
+// +kubebuilder:validation:XValidation:rule="self.finalizerRelease == 'ReleaseAfterTimeout' || !has(self.timeout)",message="timeout is only supported when finalizerRelease is ReleaseAfterTimeout"
+type NamespaceTerminationPolicy struct {
+	// FinalizerRelease selects when the RoleBinding finalizer is released.
+	// +kubebuilder:validation:Required
+	FinalizerRelease FinalizerReleasePolicy `json:"finalizerRelease"`
+
+	// ResourceTypes lists the resource types that hold the finalizer when
+	// FinalizerRelease is WaitForListed. Core resources use their plural name
+	// (e.g. "pods", "persistentvolumeclaims"); other resources are qualified with
+	// their API group (e.g. "kustomizations.kustomize.toolkit.fluxcd.io").
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// Timeout is how long after the namespace deletion timestamp the finalizer
+	// is released regardless of remaining resources when FinalizerRelease is
+	// ReleaseAfterTimeout.
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ValidateNamespaceTerminationPolicy performs the semantic validation that CEL
+// cannot express: resource type syntax, duplicates, and a positive timeout. It is
+// shared by the BindDefinition webhook and the controller's global policy flags.
+func ValidateNamespaceTerminationPolicy(policy *NamespaceTerminationPolicy, fldPath *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	switch policy.FinalizerRelease {
+	case FinalizerReleaseWaitForAll, FinalizerReleaseWaitForListed, FinalizerReleaseAfterTimeout:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("finalizerRelease"), policy.FinalizerRelease, []string{
+			string(FinalizerReleaseWaitForAll), string(FinalizerReleaseWaitForListed), string(FinalizerReleaseAfterTimeout),
+		}))
+	}
+
+	typesPath := fldPath.Child("resourceTypes")
+	if policy.FinalizerRelease == FinalizerReleaseWaitForListed && len(policy.ResourceTypes) == 0 {
+		allErrs = append(allErrs, field.Required(typesPath, "resourceTypes must be set when finalizerRelease is WaitForListed"))
+	}
+	if policy.FinalizerRelease != FinalizerReleaseWaitForListed && len(policy.ResourceTypes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(typesPath, "resourceTypes is only supported when finalizerRelease is WaitForListed"))
+	}
+	seen := make(map[string]struct{}, len(policy.ResourceTypes))
+	for i, resourceType := range policy.ResourceTypes {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(resourceType) {
+			allErrs = append(allErrs, field.Invalid(typesPath.Index(i), resourceType, msg))
+		}
+		if _, dup := seen[resourceType]; dup {
+			allErrs = append(allErrs, field.Duplicate(typesPath.Index(i), resourceType))
+		}
+		seen[resourceType] = struct{}{}
+	}
+
+	timeoutPath := fldPath.Child("timeout")
+	switch {
+	case policy.FinalizerRelease == FinalizerReleaseAfterTimeout && policy.Timeout == nil:
+		allErrs = append(allErrs, field.Required(timeoutPath, "timeout must be set when finalizerRelease is ReleaseAfterTimeout"))
+	case policy.FinalizerRelease != FinalizerReleaseAfterTimeout && policy.Timeout != nil:
+		allErrs = append(allErrs, field.Forbidden(timeoutPath, "timeout is only supported when finalizerRelease is ReleaseAfterTimeout"))
+	case policy.Timeout != nil && policy.Timeout.Duration <= 0:
+		allErrs = append(allErrs, field.Invalid(timeoutPath, policy.Timeout.Duration.String(), "timeout must be positive"))
+	}
+
+	return allErrs
+}
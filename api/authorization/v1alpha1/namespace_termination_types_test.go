@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateNamespaceTerminationPolicy(t *testing.T) {
+	t.Parallel()
+
+	hour := &metav1.Duration{Duration: time.Hour}
+	testCases := []struct {
+		name   string
+		policy *NamespaceTerminationPolicy
+		want   []string
+	}{
+		{name: "nil policy", policy: nil},
+		{name: "wait for all", policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseWaitForAll}},
+		{
+			name: "wait for listed",
+			policy: &NamespaceTerminationPolicy{
+				FinalizerRelease: FinalizerReleaseWaitForListed,
+				ResourceTypes:    []string{"pods", "kustomizations.kustomize.toolkit.fluxcd.io"},
+			},
+		},
+		{name: "release after timeout", policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseAfterTimeout, Timeout: hour}},
+		{
+			name:   "unknown policy",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: "Never"},
+			want:   []string{"spec.namespaceTermination.finalizerRelease", "Unsupported value"},
+		},
+		{
+			name:   "wait for listed without resource types",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseWaitForListed},
+			want:   []string{"spec.namespaceTermination.resourceTypes: Required value"},
+		},
+		{
+			name:   "resource types on wait for all",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseWaitForAll, ResourceTypes: []string{"pods"}},
+			want:   []string{"spec.namespaceTermination.resourceTypes: Forbidden"},
+		},
+		{
+			name: "invalid and duplicate resource types",
+			policy: &NamespaceTerminationPolicy{
+				FinalizerRelease: FinalizerReleaseWaitForListed,
+				ResourceTypes:    []string{"Pods", "pods", "pods"},
+			},
+			want: []string{"spec.namespaceTermination.resourceTypes[0]: Invalid value", "spec.namespaceTermination.resourceTypes[2]: Duplicate value"},
+		},
+		{
+			name:   "release after timeout without timeout",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseAfterTimeout},
+			want:   []string{"spec.namespaceTermination.timeout: Required value"},
+		},
+		{
+			name:   "timeout on wait for listed",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseWaitForListed, ResourceTypes: []string{"pods"}, Timeout: hour},
+			want:   []string{"spec.namespaceTermination.timeout: Forbidden"},
+		},
+		{
+			name:   "non-positive timeout",
+			policy: &NamespaceTerminationPolicy{FinalizerRelease: FinalizerReleaseAfterTimeout, Timeout: &metav1.Duration{}},
+			want:   []string{"spec.namespaceTermination.timeout: Invalid value", "must be positive"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateNamespaceTerminationPolicy(tc.policy, field.NewPath("spec", "namespaceTermination"))
+			if len(tc.want) == 0 {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			got := errs.ToAggregate().Error()
+			for _, want := range tc.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected error to contain %q, got %q", want, got)
+				}
+			}
+		})
+	}
+}
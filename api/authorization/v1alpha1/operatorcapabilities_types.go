@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperatorCapabilitiesName is the name of the singleton OperatorCapabilities
+// object the controller maintains.
+const OperatorCapabilitiesName = "cluster"
+
+// CapabilityState is the detected state of an API server capability.
+// +kubebuilder:validation:Enum=Enabled;Disabled;Unknown
+type CapabilityState string
+
+// Capability states.
+const (
+	// CapabilityStateEnabled means the capability was confirmed as available.
+	CapabilityStateEnabled CapabilityState = "Enabled"
+	// CapabilityStateDisabled means the capability was confirmed as unavailable.
+	CapabilityStateDisabled CapabilityState = "Disabled"
+	// CapabilityStateUnknown means detection was inconclusive, e.g. the operator
+	// cannot read the API server /metrics endpoint on an alpha release.
+	CapabilityStateUnknown CapabilityState = "Unknown"
+)
+
+// CapabilityStatus is the detected state of one API server capability.
+type CapabilityStatus struct {
+	// Name is the kube-apiserver feature gate backing the capability, e.g.
+	// AuthorizeWithSelectors.
+	Name string `json:"name"`
+
+	// State is Enabled, Disabled or Unknown.
+	State CapabilityState `json:"state"`
+
+	// Reason is a machine-readable reason for the state, e.g. FeatureGateEnabled,
+	// FeatureGA or VersionTooOld.
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message explains the state and, when the capability is missing, which
+	// operator features are affected.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// DegradedGroupVersion is an API GroupVersion whose latest discovery failed.
+type DegradedGroupVersion struct {
+	// GroupVersion is the failing GroupVersion, e.g. metrics.k8s.io/v1beta1.
+	GroupVersion string `json:"groupVersion"`
+
+	// Stale is true while RoleDefinitions still use the last known good
+	// resources of the GroupVersion. It is false once the staleness window
+	// elapsed, or when the GroupVersion never succeeded, and its resources are
+	// omitted from generated roles.
+	// +kubebuilder:validation:Optional
+	Stale bool `json:"stale,omitempty"`
+
+	// FailingSince is when the current streak of failed discoveries started.
+	// +kubebuilder:validation:Optional
+	FailingSince *metav1.Time `json:"failingSince,omitempty"`
+
+	// LastSuccessTime is when the GroupVersion was last discovered
+	// successfully. Unset if it never succeeded since the operator started.
+	// +kubebuilder:validation:Optional
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// Message is the latest discovery error.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// OperatorCapabilitiesStatus is the result of the latest API server capability
+// probe.
+type OperatorCapabilitiesStatus struct {
+	// ServerVersion is the detected API server version, when known.
+	// +kubebuilder:validation:Optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// LastProbeTime is when the capabilities were last probed.
+	// +kubebuilder:validation:Optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Capabilities lists every capability the operator detects.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Capabilities []CapabilityStatus `json:"capabilities,omitempty"`
+
+	// DegradedGroupVersions lists the API GroupVersions whose discovery is
+	// failing. It is empty while API discovery is healthy.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=groupVersion
+	DegradedGroupVersions []DegradedGroupVersion `json:"degradedGroupVersions,omitempty"`
+
+	// Conditions defines the current state of the capabilities.
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OperatorCapabilities is the Schema for the operatorcapabilities API.
+// It is a status-only object, maintained by the controller under the name
+// "cluster", that publishes which optional API server features the operator
+// detected and therefore which of its own features are active.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=operatorcapabilities,scope=Cluster,shortName=opcaps
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.serverVersion",description="Detected API server version"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type==\"CapabilitiesAvailable\")].status",description="Whether every capability is enabled"
+// +kubebuilder:printcolumn:name="Probed",type="date",JSONPath=".status.lastProbeTime",description="Time since the last probe"
+type OperatorCapabilities struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status OperatorCapabilitiesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OperatorCapabilitiesList contains a list of OperatorCapabilities.
+type OperatorCapabilitiesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorCapabilities `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorCapabilities{}, &OperatorCapabilitiesList{})
+}
+
+// GetConditions returns the conditions of the OperatorCapabilities.
+func (o *OperatorCapabilities) GetConditions() []metav1.Condition {
+	return o.Status.Conditions
+}
+
+// SetConditions sets the conditions of the OperatorCapabilities.
+func (o *OperatorCapabilities) SetConditions(conditions []metav1.Condition) {
+	o.Status.Conditions = conditions
+}
+
+// Capability returns the status of the named capability, if listed.
+func (s *OperatorCapabilitiesStatus) Capability(name string) (CapabilityStatus, bool) {
+	for _, c := range s.Capabilities {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return CapabilityStatus{}, false
+}
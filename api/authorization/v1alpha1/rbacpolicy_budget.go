@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/telekom/auth-operator/pkg/helpers"
+)
+
+// PolicyBudgetUsageFor counts the aggregate RBAC volume of the given
+// RestrictedBindDefinitions. namespaces resolves roleBinding namespace
+// selectors; terminating namespaces are skipped like during reconciliation.
+// The admission webhook and the RBACPolicy controller share this function so
+// enforcement and status agree.
+func PolicyBudgetUsageFor(rbds []RestrictedBindDefinition, namespaces []corev1.Namespace) (PolicyBudgetUsage, error) {
+	roleBindings := 0
+	serviceAccounts := make(map[string]struct{})
+	for i := range rbds {
+		n, err := restrictedRoleBindingCount(&rbds[i], namespaces)
+		if err != nil {
+			return PolicyBudgetUsage{}, err
+		}
+		roleBindings += n
+		for _, subject := range rbds[i].Spec.Subjects {
+			if subject.Kind == rbacv1.ServiceAccountKind {
+				serviceAccounts[subject.Namespace+"/"+subject.Name] = struct{}{}
+			}
+		}
+	}
+	return PolicyBudgetUsage{
+		RestrictedBindDefinitions: clampInt32(len(rbds)),
+		RoleBindings:              clampInt32(roleBindings),
+		ServiceAccounts:           clampInt32(len(serviceAccounts)),
+	}, nil
+}
+
+// restrictedRoleBindingCount returns the number of distinct RoleBindings a
+// RestrictedBindDefinition generates: one per resolved namespace and role reference.
+func restrictedRoleBindingCount(rbd *RestrictedBindDefinition, namespaces []corev1.Namespace) (int, error) {
+	keys := make(map[string]struct{})
+	for i, binding := range rbd.Spec.RoleBindings {
+		targets := []string{binding.Namespace}
+		if binding.Namespace == "" {
+			var err error
+			targets, err = namespacesMatchingAny(binding.NamespaceSelector, namespaces)
+			if err != nil {
+				return 0, fmt.Errorf("resolve spec.roleBindings[%d].namespaceSelector of RestrictedBindDefinition %s: %w", i, rbd.Name, err)
+			}
+		}
+		for _, namespace := range targets {
+			for _, roleRef := range binding.ClusterRoleRefs {
+				keys[namespace+"/ClusterRole/"+roleRef] = struct{}{}
+			}
+			for _, roleRef := range binding.RoleRefs {
+				keys[namespace+"/Role/"+roleRef] = struct{}{}
+			}
+		}
+	}
+	return len(keys), nil
+}
+
+func namespacesMatchingAny(selectors []metav1.LabelSelector, namespaces []corev1.Namespace) ([]string, error) {
+	parsed := make([]labels.Selector, 0, len(selectors))
+	for i := range selectors {
+		selector, err := metav1.LabelSelectorAsSelector(&selectors[i])
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, selector)
+	}
+	var matched []string
+	for i := range namespaces {
+		namespace := &namespaces[i]
+		if namespace.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+		for _, selector := range parsed {
+			if selector.Matches(labels.Set(namespace.Labels)) {
+				matched = append(matched, namespace.Name)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+func clampInt32(n int) int32 {
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(n) // #nosec G115 -- bounded above, counts are never negative
+}
+
+// UsesNamespaceSelectors reports whether any roleBinding resolves its target
+// namespaces by label selector, in which case the namespace list is needed to
+// count the RoleBindings the definition generates.
+func (rbd *RestrictedBindDefinition) UsesNamespaceSelectors() bool {
+	for _, binding := range rbd.Spec.RoleBindings {
+		if binding.Namespace == "" && len(binding.NamespaceSelector) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// PolicyLineage returns policy followed by its ancestors, nearest first, as
+// resolved from policies through spec.parentPolicyRef. The chain ends at a
+// missing parent, a cycle or MaxPolicyDelegationDepth. A RestrictedBindDefinition
+// counts against the budgets of every policy in the lineage of its policyRef.
+func PolicyLineage(policies []RBACPolicy, policy *RBACPolicy) []RBACPolicy {
+	byName := make(map[string]*RBACPolicy, len(policies))
+	for i := range policies {
+		byName[policies[i].Name] = &policies[i]
+	}
+	lineage := []RBACPolicy{*policy}
+	current := policy
+	for len(lineage) < MaxPolicyDelegationDepth && current.Spec.ParentPolicyRef != nil {
+		parent, ok := byName[current.Spec.ParentPolicyRef.Name]
+		if !ok || slices.ContainsFunc(lineage, func(p RBACPolicy) bool { return p.Name == parent.Name }) {
+			break
+		}
+		lineage = append(lineage, *parent)
+		current = parent
+	}
+	return lineage
+}
+
+// PolicySubtree returns name followed by the names of every RBACPolicy that
+// descends from it through spec.parentPolicyRef. The RestrictedBindDefinitions
+// referencing any of them count against the budgets of name.
+func PolicySubtree(policies []RBACPolicy, name string) []string {
+	children := make(map[string][]string)
+	for i := range policies {
+		if ref := policies[i].Spec.ParentPolicyRef; ref != nil {
+			children[ref.Name] = append(children[ref.Name], policies[i].Name)
+		}
+	}
+	subtree := []string{name}
+	for i := 0; i < len(subtree); i++ {
+		for _, child := range children[subtree[i]] {
+			if !slices.Contains(subtree, child) {
+				subtree = append(subtree, child)
+			}
+		}
+	}
+	return subtree
+}
+
+// validatePolicyBudgets rejects a RestrictedBindDefinition create or update that
+// would push the aggregate usage of its RBACPolicy, or of any ancestor of it,
+// above spec.budgets. The usage of a policy includes the definitions of its
+// descendant policies, so delegated children share their parent's budgets.
+// Updates that do not grow a budget's usage are always admitted, so lowering a
+// budget below current usage never blocks unrelated edits or cleanups.
+//
+// Policies and sibling definitions are listed from the informer cache, the
+// latter through the policyRef field index. Budgets are a capacity guardrail
+// rather than an authorization boundary, so a briefly stale cache is
+// acceptable here.
+func (v *RestrictedBindDefinitionValidator) validatePolicyBudgets(
+	ctx context.Context,
+	oldObj, newObj *RestrictedBindDefinition,
+	rbacPolicy *RBACPolicy,
+) error {
+	if rbacPolicy.Spec.Budgets == nil && rbacPolicy.Spec.ParentPolicyRef == nil {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithName("restrictedbinddefinition-webhook")
+
+	policies, err := listBudgetPolicies(ctx, v.Client)
+	if err != nil {
+		logger.Error(err, "failed to list RBACPolicies for policy budgets", "policyRef", rbacPolicy.Name)
+		return apierrors.NewInternalError(errors.New("unable to list RBACPolicies for policy budget validation"))
+	}
+
+	var namespaces []corev1.Namespace
+	namespacesListed := false
+	var allErrs field.ErrorList
+	for _, budgetPolicy := range PolicyLineage(policies, rbacPolicy) {
+		budgets := budgetPolicy.Spec.Budgets
+		if budgets == nil {
+			continue
+		}
+
+		var others []RestrictedBindDefinition
+		for _, policyName := range PolicySubtree(policies, budgetPolicy.Name) {
+			items, err := listPolicyRestrictedBindDefinitions(ctx, v.Client, policyName)
+			if err != nil {
+				logger.Error(err, "failed to list RestrictedBindDefinitions for policy budgets", "policyRef", policyName)
+				return apierrors.NewInternalError(errors.New("unable to list RestrictedBindDefinitions for policy budget validation"))
+			}
+			for i := range items {
+				if items[i].Name != newObj.Name {
+					others = append(others, items[i])
+				}
+			}
+		}
+
+		candidates := append([]RestrictedBindDefinition{*newObj}, others...)
+		needNamespaces := oldObj != nil && oldObj.UsesNamespaceSelectors()
+		for i := range candidates {
+			needNamespaces = needNamespaces || candidates[i].UsesNamespaceSelectors()
+		}
+		if needNamespaces && !namespacesListed {
+			namespaces, err = v.listNamespacesForBudgets(ctx)
+			if err != nil {
+				logger.Error(err, "failed to list namespaces for policy budgets", "policyRef", rbacPolicy.Name)
+				return apierrors.NewInternalError(errors.New("unable to list namespaces for policy budget validation"))
+			}
+			namespacesListed = true
+		}
+
+		after, err := PolicyBudgetUsageFor(candidates, namespaces)
+		if err != nil {
+			return apierrors.NewInvalid(
+				schema.GroupKind{Group: GroupVersion.Group, Kind: RestrictedBindDefinitionKind},
+				newObj.Name,
+				field.ErrorList{field.Invalid(field.NewPath("spec", "roleBindings"), newObj.Spec.RoleBindings, err.Error())})
+		}
+		var before PolicyBudgetUsage
+		if oldObj != nil {
+			// The old object was admitted under the same rules; an unparsable legacy
+			// selector simply counts as no prior usage.
+			before, _ = PolicyBudgetUsageFor(append([]RestrictedBindDefinition{*oldObj}, others...), namespaces)
+		}
+
+		checkBudget := func(name string, limit *int32, used, previous int32, fldPath *field.Path) {
+			if limit == nil || used <= *limit || used <= previous {
+				return
+			}
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf(
+				"would raise %s of RBACPolicy %q to %d, exceeding its budget of %d", name, budgetPolicy.Name, used, *limit)))
+		}
+		checkBudget("the number of RestrictedBindDefinitions", budgets.MaxRestrictedBindDefinitions,
+			after.RestrictedBindDefinitions, before.RestrictedBindDefinitions, field.NewPath("spec", "policyRef", "name"))
+		checkBudget("the generated RoleBindings", budgets.MaxRoleBindings,
+			after.RoleBindings, before.RoleBindings, field.NewPath("spec", "roleBindings"))
+		checkBudget("the ServiceAccount subjects", budgets.MaxServiceAccounts,
+			after.ServiceAccounts, before.ServiceAccounts, field.NewPath("spec", "subjects"))
+	}
+
+	if len(allErrs) > 0 {
+		logger.Info("validation failed: RBACPolicy budget exceeded", "name", newObj.Name, "policyRef", rbacPolicy.Name)
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: RestrictedBindDefinitionKind},
+			newObj.Name, allErrs)
+	}
+	return nil
+}
+
+// listBudgetPolicies lists every RBACPolicy to resolve budget lineages and subtrees.
+func listBudgetPolicies(ctx context.Context, reader client.Reader) ([]RBACPolicy, error) {
+	var policies []RBACPolicy
+	continueToken := ""
+	for {
+		list := &RBACPolicyList{}
+		nextContinueToken, err := listAdmissionPage(ctx, reader, list, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, list.Items...)
+		if nextContinueToken == "" {
+			return policies, nil
+		}
+		continueToken = nextContinueToken
+	}
+}
+
+// listPolicyRestrictedBindDefinitions lists the RestrictedBindDefinitions that
+// reference policyName, using the policyRef field index when the reader has it.
+func listPolicyRestrictedBindDefinitions(ctx context.Context, reader client.Reader, policyName string) ([]RestrictedBindDefinition, error) {
+	items, err := listRestrictedBindDefinitionsForPolicy(ctx, reader, policyName, true)
+	if err != nil && helpers.IsMissingFieldIndexError(err) {
+		return listRestrictedBindDefinitionsForPolicy(ctx, reader, policyName, false)
+	}
+	return items, err
+}
+
+func listRestrictedBindDefinitionsForPolicy(ctx context.Context, reader client.Reader, policyName string, useIndex bool) ([]RestrictedBindDefinition, error) {
+	var items []RestrictedBindDefinition
+	continueToken := ""
+	for {
+		list := &RestrictedBindDefinitionList{}
+		var listOpts []client.ListOption
+		if useIndex {
+			listOpts = append(listOpts, client.MatchingFields{PolicyRefField: policyName})
+		}
+		nextContinueToken, err := listAdmissionPage(ctx, reader, list, continueToken, listOpts...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			if list.Items[i].Spec.PolicyRef.Name == policyName {
+				items = append(items, list.Items[i])
+			}
+		}
+		if nextContinueToken == "" {
+			return items, nil
+		}
+		continueToken = nextContinueToken
+	}
+}
+
+func (v *RestrictedBindDefinitionValidator) listNamespacesForBudgets(ctx context.Context) ([]corev1.Namespace, error) {
+	var namespaces []corev1.Namespace
+	continueToken := ""
+	for {
+		list := &corev1.NamespaceList{}
+		nextContinueToken, err := listAdmissionPage(ctx, v.defaultPolicyReader(), list, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, list.Items...)
+		if nextContinueToken == "" {
+			return namespaces, nil
+		}
+		continueToken = nextContinueToken
+	}
+}
@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func budgetTestRBD(name string, subjects []rbacv1.Subject, bindings ...NamespaceBinding) RestrictedBindDefinition {
+	return RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: RestrictedBindDefinitionSpec{
+			PolicyRef:    RBACPolicyReference{Name: "team-policy"},
+			TargetName:   name,
+			Subjects:     subjects,
+			RoleBindings: bindings,
+		},
+	}
+}
+
+func budgetTestNamespaces() []corev1.Namespace {
+	return []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a-dev", Labels: map[string]string{"team": "a"}}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-old", Labels: map[string]string{"team": "a"}},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	}
+}
+
+func TestPolicyBudgetUsageFor(t *testing.T) {
+	deployer := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "team-a"}
+	builder := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "builder", Namespace: "team-a"}
+	group := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-a"}
+	teamSelector := []metav1.LabelSelector{{MatchLabels: map[string]string{"team": "a"}}}
+
+	tests := []struct {
+		name string
+		rbds []RestrictedBindDefinition
+		want PolicyBudgetUsage
+	}{
+		{
+			name: "no definitions",
+			want: PolicyBudgetUsage{},
+		},
+		{
+			name: "explicit namespace with duplicate role refs",
+			rbds: []RestrictedBindDefinition{budgetTestRBD("a", []rbacv1.Subject{group},
+				NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view", "edit"}, RoleRefs: []string{"view"}},
+				NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view"}},
+			)},
+			want: PolicyBudgetUsage{RestrictedBindDefinitions: 1, RoleBindings: 3},
+		},
+		{
+			name: "selector skips terminating namespaces",
+			rbds: []RestrictedBindDefinition{budgetTestRBD("a", []rbacv1.Subject{deployer},
+				NamespaceBinding{NamespaceSelector: teamSelector, ClusterRoleRefs: []string{"view"}},
+			)},
+			want: PolicyBudgetUsage{RestrictedBindDefinitions: 1, RoleBindings: 2, ServiceAccounts: 1},
+		},
+		{
+			name: "service accounts are counted once across definitions",
+			rbds: []RestrictedBindDefinition{
+				budgetTestRBD("a", []rbacv1.Subject{deployer, group}, NamespaceBinding{Namespace: "team-a", RoleRefs: []string{"ci"}}),
+				budgetTestRBD("b", []rbacv1.Subject{deployer, builder}, NamespaceBinding{Namespace: "team-a", RoleRefs: []string{"ci"}}),
+			},
+			want: PolicyBudgetUsage{RestrictedBindDefinitions: 2, RoleBindings: 2, ServiceAccounts: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := PolicyBudgetUsageFor(tt.rbds, budgetTestNamespaces())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPolicyBudgetUsageForInvalidSelector(t *testing.T) {
+	rbd := budgetTestRBD("a", nil, NamespaceBinding{
+		NamespaceSelector: []metav1.LabelSelector{{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "team", Operator: "Bogus"},
+		}}},
+		ClusterRoleRefs: []string{"view"},
+	})
+	if _, err := PolicyBudgetUsageFor([]RestrictedBindDefinition{rbd}, budgetTestNamespaces()); err == nil {
+		t.Fatal("expected an invalid namespace selector to be reported")
+	}
+}
+
+func newBudgetTestValidator(t *testing.T, objs ...client.Object) *RestrictedBindDefinitionValidator {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithIndex(&RestrictedBindDefinition{}, PolicyRefField, func(obj client.Object) []string {
+			return []string{obj.(*RestrictedBindDefinition).Spec.PolicyRef.Name}
+		}).
+		Build()
+	return &RestrictedBindDefinitionValidator{Client: c, Reader: c}
+}
+
+func TestValidatePolicyBudgets(t *testing.T) {
+	policy := &RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-policy"},
+		Spec: RBACPolicySpec{Budgets: &PolicyBudgets{
+			MaxRestrictedBindDefinitions: ptr.To[int32](2),
+			MaxRoleBindings:              ptr.To[int32](3),
+			MaxServiceAccounts:           ptr.To[int32](1),
+		}},
+	}
+	deployer := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "team-a"}
+	builder := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "builder", Namespace: "team-a"}
+	existing := budgetTestRBD("existing", []rbacv1.Subject{deployer},
+		NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view", "edit"}})
+	unrelated := budgetTestRBD("unrelated", []rbacv1.Subject{builder},
+		NamespaceBinding{Namespace: "team-b", ClusterRoleRefs: []string{"view", "edit", "admin"}})
+	unrelated.Spec.PolicyRef.Name = "other-policy"
+
+	t.Run("create within budgets", func(t *testing.T) {
+		v := newBudgetTestValidator(t, existing.DeepCopy(), unrelated.DeepCopy())
+		newObj := budgetTestRBD("new", []rbacv1.Subject{deployer}, NamespaceBinding{Namespace: "team-a", RoleRefs: []string{"ci"}})
+		if err := v.validatePolicyBudgets(t.Context(), nil, &newObj, policy); err != nil {
+			t.Fatalf("expected create within budgets to be admitted, got %v", err)
+		}
+	})
+
+	t.Run("create exceeding budgets", func(t *testing.T) {
+		v := newBudgetTestValidator(t, existing.DeepCopy(), unrelated.DeepCopy())
+		newObj := budgetTestRBD("new", []rbacv1.Subject{builder},
+			NamespaceBinding{Namespace: "team-a", RoleRefs: []string{"ci", "deploy"}})
+		err := v.validatePolicyBudgets(t.Context(), nil, &newObj, policy)
+		if !apierrors.IsInvalid(err) {
+			t.Fatalf("expected an Invalid error, got %v", err)
+		}
+		for _, want := range []string{"spec.roleBindings", "spec.subjects"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error to mention %s, got %v", want, err)
+			}
+		}
+		if strings.Contains(err.Error(), "spec.policyRef.name") {
+			t.Errorf("expected the RestrictedBindDefinition budget to be respected, got %v", err)
+		}
+	})
+
+	t.Run("update that does not grow usage is admitted over budget", func(t *testing.T) {
+		overBudget := existing.DeepCopy()
+		overBudget.Spec.RoleBindings[0].ClusterRoleRefs = []string{"view", "edit", "admin", "debug"}
+		v := newBudgetTestValidator(t, overBudget.DeepCopy())
+		newObj := overBudget.DeepCopy()
+		newObj.Spec.RoleBindings[0].ClusterRoleRefs = []string{"view", "edit", "admin"}
+		if err := v.validatePolicyBudgets(t.Context(), overBudget, newObj, policy); err != nil {
+			t.Fatalf("expected a shrinking update to be admitted, got %v", err)
+		}
+	})
+
+	t.Run("update that grows usage beyond budget", func(t *testing.T) {
+		v := newBudgetTestValidator(t, existing.DeepCopy())
+		newObj := existing.DeepCopy()
+		newObj.Spec.RoleBindings[0].ClusterRoleRefs = []string{"view", "edit", "admin", "debug"}
+		if err := v.validatePolicyBudgets(t.Context(), &existing, newObj, policy); !apierrors.IsInvalid(err) {
+			t.Fatalf("expected a growing update to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("selector bindings count matching namespaces", func(t *testing.T) {
+		objs := []client.Object{existing.DeepCopy()}
+		for _, ns := range budgetTestNamespaces() {
+			objs = append(objs, ns.DeepCopy())
+		}
+		v := newBudgetTestValidator(t, objs...)
+		newObj := budgetTestRBD("new", nil, NamespaceBinding{
+			NamespaceSelector: []metav1.LabelSelector{{MatchLabels: map[string]string{"team": "a"}}},
+			ClusterRoleRefs:   []string{"view"},
+		})
+		if err := v.validatePolicyBudgets(t.Context(), nil, &newObj, policy); !apierrors.IsInvalid(err) {
+			t.Fatalf("expected selector-resolved RoleBindings to exceed the budget, got %v", err)
+		}
+	})
+
+	t.Run("policy without budgets", func(t *testing.T) {
+		v := newBudgetTestValidator(t)
+		newObj := existing.DeepCopy()
+		if err := v.validatePolicyBudgets(t.Context(), nil, newObj, &RBACPolicy{}); err != nil {
+			t.Fatalf("expected no budget validation without budgets, got %v", err)
+		}
+	})
+}
+
+func TestValidatePolicyBudgetsCountsDescendantPolicies(t *testing.T) {
+	parent := &RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a"},
+		Spec:       RBACPolicySpec{Budgets: &PolicyBudgets{MaxRestrictedBindDefinitions: ptr.To[int32](2)}},
+	}
+	child := &RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev"},
+		Spec: RBACPolicySpec{
+			ParentPolicyRef: &RBACPolicyReference{Name: "tenant-a"},
+			Budgets:         &PolicyBudgets{MaxRestrictedBindDefinitions: ptr.To[int32](2)},
+		},
+	}
+	sibling := &RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-ops"},
+		Spec:       RBACPolicySpec{ParentPolicyRef: &RBACPolicyReference{Name: "tenant-a"}},
+	}
+	parentRBD := budgetTestRBD("parent-binding", nil, NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view"}})
+	parentRBD.Spec.PolicyRef.Name = parent.Name
+	siblingRBD := budgetTestRBD("ops-binding", nil, NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view"}})
+	siblingRBD.Spec.PolicyRef.Name = sibling.Name
+
+	v := newBudgetTestValidator(t, parent.DeepCopy(), child.DeepCopy(), sibling.DeepCopy(), parentRBD.DeepCopy(), siblingRBD.DeepCopy())
+	newObj := budgetTestRBD("dev-binding", nil, NamespaceBinding{Namespace: "team-a", ClusterRoleRefs: []string{"view"}})
+	newObj.Spec.PolicyRef.Name = child.Name
+
+	err := v.validatePolicyBudgets(t.Context(), nil, &newObj, child)
+	if !apierrors.IsInvalid(err) {
+		t.Fatalf("expected the parent budget to reject the child definition, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `RBACPolicy "tenant-a" to 3`) {
+		t.Errorf("expected the error to report the parent usage, got %v", err)
+	}
+	if strings.Contains(err.Error(), `RBACPolicy "tenant-a-dev"`) {
+		t.Errorf("expected the child budget to be respected, got %v", err)
+	}
+}
+
+func TestPolicyLineageAndSubtree(t *testing.T) {
+	policy := func(name, parent string) RBACPolicy {
+		p := RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if parent != "" {
+			p.Spec.ParentPolicyRef = &RBACPolicyReference{Name: parent}
+		}
+		return p
+	}
+	policies := []RBACPolicy{
+		policy("platform", ""),
+		policy("tenant-a", "platform"),
+		policy("tenant-a-dev", "tenant-a"),
+		policy("tenant-a-ops", "tenant-a"),
+		policy("tenant-b", "platform"),
+		policy("loop-a", "loop-b"),
+		policy("loop-b", "loop-a"),
+	}
+
+	var lineage []string
+	for _, p := range PolicyLineage(policies, &policies[2]) {
+		lineage = append(lineage, p.Name)
+	}
+	if want := []string{"tenant-a-dev", "tenant-a", "platform"}; !slices.Equal(lineage, want) {
+		t.Errorf("PolicyLineage() = %v, want %v", lineage, want)
+	}
+	if got := PolicyLineage(policies, &policies[5]); len(got) != 2 {
+		t.Errorf("expected a cyclic lineage to stop after 2 policies, got %d", len(got))
+	}
+
+	if got, want := PolicySubtree(policies, "tenant-a"), []string{"tenant-a", "tenant-a-dev", "tenant-a-ops"}; !slices.Equal(got, want) {
+		t.Errorf("PolicySubtree() = %v, want %v", got, want)
+	}
+	if got := PolicySubtree(policies, "loop-a"); len(got) != 2 {
+		t.Errorf("expected a cyclic subtree to contain 2 policies, got %v", got)
+	}
+}
@@ -0,0 +1,687 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/telekom/auth-operator/pkg/helpers"
+)
+
+// MaxPolicyDelegationDepth bounds the length of a parentPolicyRef chain,
+// counting the policy being admitted. It keeps admission lookups bounded and
+// makes delegation cycles impossible to admit.
+const MaxPolicyDelegationDepth = 5
+
+// allNamespacesScope is the appliesTo.namespaces entry that makes a policy
+// cluster-wide.
+const allNamespacesScope = "*"
+
+var rbacPolicyGroupKind = schema.GroupKind{Group: GroupVersion.Group, Kind: "RBACPolicy"}
+
+// namespaceLabelsFunc resolves namespace labels during subset validation. found
+// is false when the namespace does not exist.
+type namespaceLabelsFunc func(namespace string) (nsLabels map[string]string, found bool, err error)
+
+// validateParentPolicy resolves the parentPolicyRef chain of obj and proves that
+// obj is a subset of its direct parent. Ancestors were proven against their own
+// parents when they were admitted, so the direct parent is sufficient.
+func (v *RBACPolicyValidator) validateParentPolicy(ctx context.Context, obj *RBACPolicy) error {
+	if obj.Spec.ParentPolicyRef == nil {
+		return nil
+	}
+
+	refPath := field.NewPath("spec", "parentPolicyRef", "name")
+	reader := v.defaultPolicyReader()
+
+	var parent *RBACPolicy
+	visited := []string{obj.Name}
+	next := obj.Spec.ParentPolicyRef.Name
+	for next != "" {
+		if slices.Contains(visited, next) {
+			return apierrors.NewInvalid(rbacPolicyGroupKind, obj.Name, field.ErrorList{
+				field.Invalid(refPath, obj.Spec.ParentPolicyRef.Name,
+					fmt.Sprintf("parentPolicyRef chain forms a cycle: %s -> %s", strings.Join(visited, " -> "), next)),
+			})
+		}
+		if len(visited) >= MaxPolicyDelegationDepth {
+			return apierrors.NewInvalid(rbacPolicyGroupKind, obj.Name, field.ErrorList{
+				field.Invalid(refPath, obj.Spec.ParentPolicyRef.Name,
+					fmt.Sprintf("parentPolicyRef chain exceeds the maximum delegation depth of %d", MaxPolicyDelegationDepth)),
+			})
+		}
+
+		ancestor := &RBACPolicy{}
+		if err := reader.Get(ctx, client.ObjectKey{Name: next}, ancestor); err != nil {
+			if apierrors.IsNotFound(err) {
+				if parent == nil {
+					return apierrors.NewInvalid(rbacPolicyGroupKind, obj.Name, field.ErrorList{
+						field.NotFound(refPath, next),
+					})
+				}
+				// A dangling reference further up the chain does not widen the
+				// direct parent; it is reported when that ancestor is updated.
+				break
+			}
+			log.FromContext(ctx).Error(err, "failed to get ancestor RBACPolicy", "policy", next)
+			return apierrors.NewInternalError(errors.New("unable to resolve parent RBACPolicy"))
+		}
+		if parent == nil {
+			parent = ancestor
+			if parent.GetDeletionTimestamp() != nil {
+				return apierrors.NewInvalid(rbacPolicyGroupKind, obj.Name, field.ErrorList{
+					field.Forbidden(refPath, fmt.Sprintf("parent RBACPolicy %q is being deleted", parent.Name)),
+				})
+			}
+		}
+		visited = append(visited, next)
+		next = ""
+		if ancestor.Spec.ParentPolicyRef != nil {
+			next = ancestor.Spec.ParentPolicyRef.Name
+		}
+	}
+
+	allErrs := validatePolicySubset(&obj.Spec, &parent.Spec, parent.Name, v.namespaceLabels(ctx), field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(rbacPolicyGroupKind, obj.Name, allErrs)
+	}
+	return nil
+}
+
+// namespaceLabels returns a namespaceLabelsFunc backed by the validator's
+// non-cached reader.
+func (v *RBACPolicyValidator) namespaceLabels(ctx context.Context) namespaceLabelsFunc {
+	reader := v.defaultPolicyReader()
+	return func(namespace string) (map[string]string, bool, error) {
+		ns := &corev1.Namespace{}
+		if err := reader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+		return ns.Labels, true, nil
+	}
+}
+
+// validateDelegatedRequester restricts requesters that are delegates of at least
+// one RBACPolicy to the subtrees delegated to them. Requesters that are not
+// delegates anywhere (platform admins) are not restricted here; Kubernetes RBAC
+// on rbacpolicies remains the authoritative permission check for them.
+func (v *RBACPolicyValidator) validateDelegatedRequester(ctx context.Context, oldObj, newObj *RBACPolicy) error {
+	req, reqFound := requestFromAdmissionContext(ctx)
+	if !reqFound {
+		return nil
+	}
+
+	delegatingPolicies, err := v.resolveDelegatingPoliciesForRequester(ctx, req.UserInfo.Username, req.UserInfo.Groups)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to resolve RBACPolicy delegations")
+		return apierrors.NewInternalError(errors.New("unable to resolve RBACPolicy delegations"))
+	}
+	if len(delegatingPolicies) == 0 {
+		return nil
+	}
+
+	refPath := field.NewPath("spec", "parentPolicyRef", "name")
+	detail := fmt.Sprintf("requester %q may only manage child policies of: %s",
+		req.UserInfo.Username, strings.Join(delegatingPolicies, ", "))
+
+	var allErrs field.ErrorList
+	for _, obj := range []*RBACPolicy{oldObj, newObj} {
+		if obj == nil {
+			continue
+		}
+		if obj.Spec.ParentPolicyRef == nil {
+			allErrs = append(allErrs, field.Required(refPath, detail))
+			break
+		}
+		if !slices.Contains(delegatingPolicies, obj.Spec.ParentPolicyRef.Name) {
+			allErrs = append(allErrs, field.Forbidden(refPath, detail))
+			break
+		}
+	}
+	if newObj != nil && newObj.Spec.DefaultAssignment != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "defaultAssignment"),
+			"delegated requesters may not assign default policies"))
+	}
+
+	if len(allErrs) > 0 {
+		name := ""
+		if newObj != nil {
+			name = newObj.Name
+		} else if oldObj != nil {
+			name = oldObj.Name
+		}
+		return apierrors.NewInvalid(rbacPolicyGroupKind, name, allErrs)
+	}
+	return nil
+}
+
+// resolveDelegatingPoliciesForRequester returns the sorted names of the
+// RBACPolicies whose delegation block matches the requester.
+func (v *RBACPolicyValidator) resolveDelegatingPoliciesForRequester(ctx context.Context, username string, groups []string) ([]string, error) {
+	var matched []string
+	reader := v.defaultPolicyReader()
+	continueToken := ""
+	for {
+		policyList := &RBACPolicyList{}
+		nextContinueToken, err := listAdmissionPage(ctx, reader, policyList, continueToken)
+		if err != nil {
+			return nil, fmt.Errorf("list admission page for RBACPolicies: %w", err)
+		}
+		for i := range policyList.Items {
+			d := policyList.Items[i].Spec.Delegation
+			if d == nil {
+				continue
+			}
+			if requesterMatchesIdentities(d.Groups, d.ServiceAccounts, username, groups) {
+				matched = append(matched, policyList.Items[i].Name)
+			}
+		}
+		if nextContinueToken == "" {
+			break
+		}
+		continueToken = nextContinueToken
+	}
+	slices.Sort(matched)
+	return matched, nil
+}
+
+// childPolicyWarnings returns admission warnings for child policies that are no
+// longer a subset of obj. Children are not rejected retroactively: the parent
+// author is told which tenants must tighten their policies.
+func (v *RBACPolicyValidator) childPolicyWarnings(ctx context.Context, obj *RBACPolicy) admission.Warnings {
+	children, err := listChildPolicies(ctx, v.defaultPolicyReader(), obj.Name)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to list child RBACPolicies", "policy", obj.Name)
+		return admission.Warnings{"unable to verify that child RBACPolicies remain within this policy's limits"}
+	}
+
+	var warnings admission.Warnings
+	lookup := v.namespaceLabels(ctx)
+	for i := range children {
+		errs := validatePolicySubset(&children[i].Spec, &obj.Spec, obj.Name, lookup, field.NewPath("spec"))
+		if len(errs) == 0 {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("child RBACPolicy %q is no longer within this policy's limits: %s",
+			children[i].Name, errs.ToAggregate().Error()))
+	}
+	return warnings
+}
+
+// listChildPolicies returns the RBACPolicies whose parentPolicyRef names parentName.
+func listChildPolicies(ctx context.Context, reader client.Reader, parentName string) ([]RBACPolicy, error) {
+	var children []RBACPolicy
+	continueToken := ""
+	for {
+		policyList := &RBACPolicyList{}
+		nextContinueToken, err := listAdmissionPage(ctx, reader, policyList, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		for i := range policyList.Items {
+			ref := policyList.Items[i].Spec.ParentPolicyRef
+			if ref != nil && ref.Name == parentName {
+				children = append(children, policyList.Items[i])
+			}
+		}
+		if nextContinueToken == "" {
+			return children, nil
+		}
+		continueToken = nextContinueToken
+	}
+}
+
+// validatePolicySubset proves that child grants nothing that parent does not.
+// Every check is conservative: a child is rejected whenever it cannot be shown
+// to be at least as strict as the parent.
+func validatePolicySubset(child, parent *RBACPolicySpec, parentName string, nsLabels namespaceLabelsFunc, fldPath *field.Path) field.ErrorList {
+	s := &subsetChecker{parentName: parentName, nsLabels: nsLabels}
+	s.scope(child.AppliesTo, parent.AppliesTo, fldPath.Child("appliesTo"))
+	s.bindingLimits(child.BindingLimits, parent.BindingLimits, fldPath.Child("bindingLimits"))
+	s.roleLimits(child.RoleLimits, parent.RoleLimits, fldPath.Child("roleLimits"))
+	s.subjectLimits(child.SubjectLimits, parent.SubjectLimits, fldPath.Child("subjectLimits"))
+	s.impersonation(child.Impersonation, parent.Impersonation, fldPath.Child("impersonation"))
+	s.onViolation(child.OnViolation, parent.OnViolation, fldPath.Child("onViolation"))
+	s.budgets(child.Budgets, parent.Budgets, fldPath.Child("budgets"))
+	return s.errs
+}
+
+type subsetChecker struct {
+	parentName string
+	nsLabels   namespaceLabelsFunc
+	errs       field.ErrorList
+}
+
+func (s *subsetChecker) exceeds(fldPath *field.Path, format string, args ...any) {
+	s.errs = append(s.errs, field.Forbidden(fldPath,
+		fmt.Sprintf("%s: exceeds parent RBACPolicy %q", fmt.Sprintf(format, args...), s.parentName)))
+}
+
+func (s *subsetChecker) scope(child, parent PolicyScope, fldPath *field.Path) {
+	parentAll := slices.Contains(parent.Namespaces, allNamespacesScope)
+	if parentAll && len(parent.Namespaces) == 1 && parent.NamespaceSelector == nil {
+		return
+	}
+
+	// A bare "*" covers every namespace, while "*" next to concrete namespaces
+	// or a selector only enables cluster-scoped resources. The parent is not a
+	// bare "*" here, so the child must bound namespaced targets itself.
+	childBareAll := child.NamespaceSelector == nil &&
+		len(child.Namespaces) > 0 &&
+		!slices.ContainsFunc(child.Namespaces, func(ns string) bool { return ns != allNamespacesScope })
+
+	for i, ns := range child.Namespaces {
+		nsPath := fldPath.Child("namespaces").Index(i)
+		switch {
+		case ns == allNamespacesScope:
+			if !parentAll {
+				s.exceeds(nsPath, "cluster-wide scope %q requires the parent scope to include it", ns)
+			} else if childBareAll {
+				s.exceeds(nsPath, "scope %q without concrete namespaces or a namespaceSelector covers every namespace", ns)
+			}
+		case slices.Contains(parent.Namespaces, ns):
+		case parent.NamespaceSelector != nil:
+			s.namespaceMatchesParentSelector(ns, parent.NamespaceSelector, nsPath)
+		default:
+			s.exceeds(nsPath, "namespace %q is outside the parent scope", ns)
+		}
+	}
+
+	if child.NamespaceSelector != nil &&
+		(parent.NamespaceSelector == nil || !selectorNarrows(child.NamespaceSelector, parent.NamespaceSelector)) {
+		s.exceeds(fldPath.Child("namespaceSelector"),
+			"namespaceSelector must include every requirement of the parent namespaceSelector")
+	}
+}
+
+func (s *subsetChecker) namespaceMatchesParentSelector(ns string, selector *metav1.LabelSelector, fldPath *field.Path) {
+	if s.nsLabels == nil {
+		s.exceeds(fldPath, "namespace %q cannot be checked against the parent namespaceSelector", ns)
+		return
+	}
+	nsLabels, found, err := s.nsLabels(ns)
+	if err != nil {
+		s.errs = append(s.errs, field.InternalError(fldPath, fmt.Errorf("resolve namespace %q labels: %w", ns, err)))
+		return
+	}
+	sel, selErr := metav1.LabelSelectorAsSelector(selector)
+	if !found || selErr != nil || !sel.Matches(labels.Set(nsLabels)) {
+		s.exceeds(fldPath, "namespace %q does not match the parent namespaceSelector", ns)
+	}
+}
+
+func (s *subsetChecker) bindingLimits(child, parent *BindingLimits, fldPath *field.Path) {
+	if child == nil {
+		return
+	}
+	if parent == nil {
+		s.exceeds(fldPath, "bindingLimits must be omitted because the parent allows no bindings")
+		return
+	}
+	if child.AllowClusterRoleBindings && !parent.AllowClusterRoleBindings {
+		s.exceeds(fldPath.Child("allowClusterRoleBindings"), "ClusterRoleBindings are not allowed")
+	}
+	s.roleRefLimits(child.ClusterRoleBindingLimits, parent.ClusterRoleBindingLimits, fldPath.Child("clusterRoleBindingLimits"))
+	s.roleRefLimits(child.RoleBindingLimits, parent.RoleBindingLimits, fldPath.Child("roleBindingLimits"))
+	s.namespaceLimits(child.TargetNamespaceLimits, parent.TargetNamespaceLimits, fldPath.Child("targetNamespaceLimits"))
+}
+
+func (s *subsetChecker) roleRefLimits(child, parent *RoleRefLimits, fldPath *field.Path) {
+	if child == nil {
+		return
+	}
+	if parent == nil {
+		s.exceeds(fldPath, "must be omitted because the parent allows no role refs here")
+		return
+	}
+	for i, ref := range child.AllowedRoleRefs {
+		if !slices.ContainsFunc(parent.AllowedRoleRefs, func(p string) bool { return helpers.WildcardCovers(p, ref) }) {
+			s.exceeds(fldPath.Child("allowedRoleRefs").Index(i), "role ref %q is not allowed", ref)
+		}
+	}
+	if child.AllowedRoleRefSelector != nil &&
+		(parent.AllowedRoleRefSelector == nil || !selectorNarrows(child.AllowedRoleRefSelector, parent.AllowedRoleRefSelector)) {
+		s.exceeds(fldPath.Child("allowedRoleRefSelector"),
+			"allowedRoleRefSelector must include every requirement of the parent allowedRoleRefSelector")
+	}
+	s.forbiddenPatterns(child.ForbiddenRoleRefs, parent.ForbiddenRoleRefs, fldPath.Child("forbiddenRoleRefs"))
+	if parent.ForbiddenRoleRefSelector != nil &&
+		(child.ForbiddenRoleRefSelector == nil || !selectorNarrows(parent.ForbiddenRoleRefSelector, child.ForbiddenRoleRefSelector)) {
+		s.exceeds(fldPath.Child("forbiddenRoleRefSelector"),
+			"forbiddenRoleRefSelector must not require more than the parent forbiddenRoleRefSelector")
+	}
+}
+
+func (s *subsetChecker) namespaceLimits(child, parent *NamespaceLimits, fldPath *field.Path) {
+	if parent == nil {
+		return
+	}
+	if child == nil {
+		s.exceeds(fldPath, "targetNamespaceLimits must be set because the parent sets them")
+		return
+	}
+	if parent.AllowedNamespaceSelector != nil &&
+		(child.AllowedNamespaceSelector == nil || !selectorNarrows(child.AllowedNamespaceSelector, parent.AllowedNamespaceSelector)) {
+		s.exceeds(fldPath.Child("allowedNamespaceSelector"),
+			"allowedNamespaceSelector must include every requirement of the parent allowedNamespaceSelector")
+	}
+	s.forbiddenNamesAndPrefixes(child.ForbiddenNamespaces, child.ForbiddenNamespacePrefixes,
+		parent.ForbiddenNamespaces, parent.ForbiddenNamespacePrefixes,
+		fldPath.Child("forbiddenNamespaces"), fldPath.Child("forbiddenNamespacePrefixes"))
+	s.maxLimit(child.MaxTargetNamespaces, parent.MaxTargetNamespaces, fldPath.Child("maxTargetNamespaces"))
+}
+
+func (s *subsetChecker) roleLimits(child, parent *RoleLimits, fldPath *field.Path) {
+	if child == nil {
+		return
+	}
+	if parent == nil {
+		s.exceeds(fldPath, "roleLimits must be omitted because the parent allows no role generation")
+		return
+	}
+	if child.AllowClusterRoles && !parent.AllowClusterRoles {
+		s.exceeds(fldPath.Child("allowClusterRoles"), "ClusterRoles are not allowed")
+	}
+	s.forbiddenPatterns(child.ForbiddenVerbs, parent.ForbiddenVerbs, fldPath.Child("forbiddenVerbs"))
+	s.forbiddenPatterns(child.ForbiddenResources, parent.ForbiddenResources, fldPath.Child("forbiddenResources"))
+	s.forbiddenPatterns(child.ForbiddenAPIGroups, parent.ForbiddenAPIGroups, fldPath.Child("forbiddenAPIGroups"))
+	for i, rule := range parent.ForbiddenResourceVerbs {
+		covered := slices.ContainsFunc(child.ForbiddenResourceVerbs, func(c ResourceVerbRule) bool {
+			if c.Resource != rule.Resource || c.APIGroup != rule.APIGroup {
+				return false
+			}
+			for _, verb := range rule.Verbs {
+				if !slices.ContainsFunc(c.Verbs, func(cv string) bool { return helpers.WildcardCovers(cv, verb) }) {
+					return false
+				}
+			}
+			return true
+		})
+		if !covered {
+			s.exceeds(fldPath.Child("forbiddenResourceVerbs"),
+				"parent forbiddenResourceVerbs[%d] (resource %q, apiGroup %q) must be kept", i, rule.Resource, rule.APIGroup)
+		}
+	}
+	s.maxLimit(child.MaxRulesPerRole, parent.MaxRulesPerRole, fldPath.Child("maxRulesPerRole"))
+	s.constrainedImpersonation(child.ConstrainedImpersonation, parent.ConstrainedImpersonation, fldPath.Child("constrainedImpersonation"))
+}
+
+func (s *subsetChecker) constrainedImpersonation(child, parent *ConstrainedImpersonationLimits, fldPath *field.Path) {
+	if child == nil || !child.Allowed {
+		return
+	}
+	if parent == nil || !parent.Allowed {
+		s.exceeds(fldPath.Child("allowed"), "constrained impersonation is not allowed")
+		return
+	}
+	if len(parent.AllowedModes) > 0 {
+		if len(child.AllowedModes) == 0 {
+			s.exceeds(fldPath.Child("allowedModes"), "allowedModes must be restricted to the parent allowedModes")
+		}
+		for i, mode := range child.AllowedModes {
+			if !slices.Contains(parent.AllowedModes, mode) {
+				s.exceeds(fldPath.Child("allowedModes").Index(i), "mode %q is not allowed", mode)
+			}
+		}
+	}
+	if len(parent.AllowedIdentityResources) > 0 {
+		if len(child.AllowedIdentityResources) == 0 {
+			s.exceeds(fldPath.Child("allowedIdentityResources"),
+				"allowedIdentityResources must be restricted to the parent allowedIdentityResources")
+		}
+		for i, resource := range child.AllowedIdentityResources {
+			if !slices.Contains(parent.AllowedIdentityResources, resource) {
+				s.exceeds(fldPath.Child("allowedIdentityResources").Index(i), "identity resource %q is not allowed", resource)
+			}
+		}
+	}
+	s.nameMatchLimits(child.IdentityNameLimits, parent.IdentityNameLimits, fldPath.Child("identityNameLimits"))
+	s.forbiddenPatterns(child.ForbiddenActionVerbs, parent.ForbiddenActionVerbs, fldPath.Child("forbiddenActionVerbs"))
+	if parent.ForbidLegacyFallback && !child.ForbidLegacyFallback {
+		s.exceeds(fldPath.Child("forbidLegacyFallback"), "the parent forbids the legacy impersonation fallback")
+	}
+	s.maxLimit(child.MaxIdentityNames, parent.MaxIdentityNames, fldPath.Child("maxIdentityNames"))
+}
+
+func (s *subsetChecker) subjectLimits(child, parent *SubjectLimits, fldPath *field.Path) {
+	if child == nil {
+		return
+	}
+	if parent == nil {
+		s.exceeds(fldPath, "subjectLimits must be omitted because the parent allows no subjects")
+		return
+	}
+	for i, kind := range child.AllowedKinds {
+		if !slices.Contains(parent.AllowedKinds, kind) {
+			s.exceeds(fldPath.Child("allowedKinds").Index(i), "subject kind %q is not allowed", kind)
+		}
+	}
+	for _, kind := range parent.ForbiddenKinds {
+		if !slices.Contains(child.ForbiddenKinds, kind) {
+			s.exceeds(fldPath.Child("forbiddenKinds"), "forbidden subject kind %q must be kept", kind)
+		}
+	}
+	s.nameMatchLimits(child.UserLimits, parent.UserLimits, fldPath.Child("userLimits"))
+	s.nameMatchLimits(child.GroupLimits, parent.GroupLimits, fldPath.Child("groupLimits"))
+	s.serviceAccountLimits(child.ServiceAccountLimits, parent.ServiceAccountLimits, fldPath.Child("serviceAccountLimits"))
+}
+
+func (s *subsetChecker) nameMatchLimits(child, parent *NameMatchLimits, fldPath *field.Path) {
+	if parent == nil {
+		return
+	}
+	if child == nil {
+		s.exceeds(fldPath, "must be set because the parent sets it")
+		return
+	}
+
+	if len(parent.AllowedNames) > 0 {
+		if len(child.AllowedNames) == 0 {
+			s.exceeds(fldPath.Child("allowedNames"), "allowedNames must be restricted to the parent allowedNames")
+		}
+		for i, name := range child.AllowedNames {
+			if !slices.Contains(parent.AllowedNames, name) {
+				s.exceeds(fldPath.Child("allowedNames").Index(i), "name %q is not allowed", name)
+			}
+		}
+	}
+	s.allowedAffixes(child.AllowedPrefixes, parent.AllowedPrefixes, strings.HasPrefix, fldPath.Child("allowedPrefixes"))
+	s.allowedAffixes(child.AllowedSuffixes, parent.AllowedSuffixes, strings.HasSuffix, fldPath.Child("allowedSuffixes"))
+
+	for _, name := range parent.ForbiddenNames {
+		if !slices.Contains(child.ForbiddenNames, name) &&
+			!slices.ContainsFunc(child.ForbiddenPrefixes, func(p string) bool { return strings.HasPrefix(name, p) }) &&
+			!slices.ContainsFunc(child.ForbiddenSuffixes, func(p string) bool { return strings.HasSuffix(name, p) }) {
+			s.exceeds(fldPath.Child("forbiddenNames"), "forbidden name %q must be kept", name)
+		}
+	}
+	s.forbiddenAffixes(child.ForbiddenPrefixes, parent.ForbiddenPrefixes, strings.HasPrefix, fldPath.Child("forbiddenPrefixes"))
+	s.forbiddenAffixes(child.ForbiddenSuffixes, parent.ForbiddenSuffixes, strings.HasSuffix, fldPath.Child("forbiddenSuffixes"))
+}
+
+func (s *subsetChecker) serviceAccountLimits(child, parent *ServiceAccountLimits, fldPath *field.Path) {
+	if parent == nil {
+		return
+	}
+	if child == nil {
+		s.exceeds(fldPath, "serviceAccountLimits must be set because the parent sets them")
+		return
+	}
+	if parent.AllowedNamespaceSelector != nil &&
+		(child.AllowedNamespaceSelector == nil || !selectorNarrows(child.AllowedNamespaceSelector, parent.AllowedNamespaceSelector)) {
+		s.exceeds(fldPath.Child("allowedNamespaceSelector"),
+			"allowedNamespaceSelector must include every requirement of the parent allowedNamespaceSelector")
+	}
+	s.forbiddenNamesAndPrefixes(child.ForbiddenNamespaces, child.ForbiddenNamespacePrefixes,
+		parent.ForbiddenNamespaces, parent.ForbiddenNamespacePrefixes,
+		fldPath.Child("forbiddenNamespaces"), fldPath.Child("forbiddenNamespacePrefixes"))
+	s.serviceAccountCreation(child.Creation, parent.Creation, fldPath.Child("creation"))
+}
+
+func (s *subsetChecker) serviceAccountCreation(child, parent *SACreationConfig, fldPath *field.Path) {
+	if parent != nil && parent.DisableAdoption && (child == nil || !child.DisableAdoption) {
+		s.exceeds(fldPath.Child("disableAdoption"), "the parent disables ServiceAccount adoption")
+	}
+	if child == nil || !child.AllowAutoCreate {
+		return
+	}
+	if parent == nil || !parent.AllowAutoCreate {
+		s.exceeds(fldPath.Child("allowAutoCreate"), "ServiceAccount auto-creation is not allowed")
+		return
+	}
+	if len(parent.AllowedCreationNamespaces) > 0 {
+		for i, ns := range child.AllowedCreationNamespaces {
+			if !slices.Contains(parent.AllowedCreationNamespaces, ns) {
+				s.exceeds(fldPath.Child("allowedCreationNamespaces").Index(i), "namespace %q is not allowed", ns)
+			}
+		}
+	}
+	if parent.AllowedCreationNamespaceSelector != nil &&
+		(child.AllowedCreationNamespaceSelector == nil ||
+			!selectorNarrows(child.AllowedCreationNamespaceSelector, parent.AllowedCreationNamespaceSelector)) {
+		s.exceeds(fldPath.Child("allowedCreationNamespaceSelector"),
+			"allowedCreationNamespaceSelector must include every requirement of the parent selector")
+	}
+	if parent.AutomountServiceAccountToken != nil &&
+		!equality.Semantic.DeepEqual(child.AutomountServiceAccountToken, parent.AutomountServiceAccountToken) {
+		s.exceeds(fldPath.Child("automountServiceAccountToken"), "must match the parent setting")
+	}
+}
+
+// impersonation requires a child to keep the parent's apply identity: dropping
+// it would make the operator apply with its own, broader identity.
+func (s *subsetChecker) impersonation(child, parent *ImpersonationConfig, fldPath *field.Path) {
+	parentEnabled := parent != nil && parent.Enabled
+	childEnabled := child != nil && child.Enabled
+	switch {
+	case parentEnabled && !equality.Semantic.DeepEqual(child, parent):
+		s.exceeds(fldPath, "impersonation must match the parent impersonation identity")
+	case !parentEnabled && childEnabled:
+		s.exceeds(fldPath.Child("enabled"), "impersonation is not configured on the parent")
+	}
+}
+
+// onViolation requires a child to revoke no later than its parent, so a
+// delegate cannot keep non-compliant RBAC alive longer than the parent allows.
+func (s *subsetChecker) onViolation(child, parent *ViolationPolicy, fldPath *field.Path) {
+	parentDelay, parentRevokes := parent.RevocationDelay()
+	if !parentRevokes {
+		return
+	}
+	childDelay, childRevokes := child.RevocationDelay()
+	switch {
+	case !childRevokes:
+		s.exceeds(fldPath.Child("action"), "%s never revokes", child.ActionOrDefault())
+	case childDelay > parentDelay:
+		s.exceeds(fldPath.Child("gracePeriod"), "%s is longer than %s", childDelay, parentDelay)
+	}
+}
+
+func (s *subsetChecker) budgets(child, parent *PolicyBudgets, fldPath *field.Path) {
+	if parent == nil {
+		return
+	}
+	if child == nil {
+		s.exceeds(fldPath, "budgets must be set because the parent sets them")
+		return
+	}
+	s.maxLimit(child.MaxRestrictedBindDefinitions, parent.MaxRestrictedBindDefinitions, fldPath.Child("maxRestrictedBindDefinitions"))
+	s.maxLimit(child.MaxRoleBindings, parent.MaxRoleBindings, fldPath.Child("maxRoleBindings"))
+	s.maxLimit(child.MaxServiceAccounts, parent.MaxServiceAccounts, fldPath.Child("maxServiceAccounts"))
+}
+
+func (s *subsetChecker) forbiddenPatterns(child, parent []string, fldPath *field.Path) {
+	for _, p := range parent {
+		if !slices.ContainsFunc(child, func(c string) bool { return helpers.WildcardCovers(c, p) }) {
+			s.exceeds(fldPath, "parent entry %q must be kept", p)
+		}
+	}
+}
+
+func (s *subsetChecker) forbiddenNamesAndPrefixes(childNames, childPrefixes, parentNames, parentPrefixes []string, namesPath, prefixesPath *field.Path) {
+	for _, name := range parentNames {
+		if !slices.Contains(childNames, name) &&
+			!slices.ContainsFunc(childPrefixes, func(p string) bool { return strings.HasPrefix(name, p) }) {
+			s.exceeds(namesPath, "parent entry %q must be kept", name)
+		}
+	}
+	s.forbiddenAffixes(childPrefixes, parentPrefixes, strings.HasPrefix, prefixesPath)
+}
+
+// allowedAffixes requires every child affix to extend a parent affix when the
+// parent restricts affixes.
+func (s *subsetChecker) allowedAffixes(child, parent []string, extends func(s, affix string) bool, fldPath *field.Path) {
+	if len(parent) == 0 {
+		return
+	}
+	if len(child) == 0 {
+		s.exceeds(fldPath, "must be restricted to the parent entries")
+		return
+	}
+	for i, c := range child {
+		if !slices.ContainsFunc(parent, func(p string) bool { return extends(c, p) }) {
+			s.exceeds(fldPath.Index(i), "%q does not extend any parent entry", c)
+		}
+	}
+}
+
+// forbiddenAffixes requires every parent affix to be covered by a child affix
+// that is equal or shorter.
+func (s *subsetChecker) forbiddenAffixes(child, parent []string, extends func(s, affix string) bool, fldPath *field.Path) {
+	for _, p := range parent {
+		if !slices.ContainsFunc(child, func(c string) bool { return extends(p, c) }) {
+			s.exceeds(fldPath, "parent entry %q must be kept", p)
+		}
+	}
+}
+
+func (s *subsetChecker) maxLimit(child, parent *int32, fldPath *field.Path) {
+	if parent == nil {
+		return
+	}
+	if child == nil {
+		s.exceeds(fldPath, "must be set to at most %d", *parent)
+		return
+	}
+	if *child > *parent {
+		s.exceeds(fldPath, "%d is greater than %d", *child, *parent)
+	}
+}
+
+// selectorNarrows reports whether narrow carries every requirement of wide, so
+// every object matched by narrow is also matched by wide.
+func selectorNarrows(narrow, wide *metav1.LabelSelector) bool {
+	for k, v := range wide.MatchLabels {
+		if got, ok := narrow.MatchLabels[k]; !ok || got != v {
+			return false
+		}
+	}
+	for _, req := range wide.MatchExpressions {
+		if !slices.ContainsFunc(narrow.MatchExpressions, func(r metav1.LabelSelectorRequirement) bool {
+			return equality.Semantic.DeepEqual(r, req)
+		}) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,575 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const testTenantAdminsGroup = "oidc:tenant-a-admins"
+
+func tenantParentPolicySpec() RBACPolicySpec {
+	return RBACPolicySpec{
+		AppliesTo: PolicyScope{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+		},
+		BindingLimits: &BindingLimits{
+			RoleBindingLimits: &RoleRefLimits{
+				AllowedRoleRefs:   []string{"tenant-a-*", "view"},
+				ForbiddenRoleRefs: []string{"tenant-a-admin"},
+			},
+			TargetNamespaceLimits: &NamespaceLimits{
+				ForbiddenNamespacePrefixes: []string{"kube-"},
+				MaxTargetNamespaces:        ptr.To[int32](10),
+			},
+		},
+		RoleLimits: &RoleLimits{
+			ForbiddenVerbs:  []string{"escalate", "bind"},
+			MaxRulesPerRole: ptr.To[int32](20),
+		},
+		SubjectLimits: &SubjectLimits{
+			AllowedKinds:   []string{"User", "Group"},
+			ForbiddenKinds: []string{"ServiceAccount"},
+			GroupLimits: &NameMatchLimits{
+				AllowedPrefixes:   []string{"oidc:tenant-a-"},
+				ForbiddenPrefixes: []string{"system:"},
+			},
+		},
+		Delegation: &PolicyDelegation{Groups: []string{testTenantAdminsGroup}},
+	}
+}
+
+func tenantChildPolicySpec() RBACPolicySpec {
+	return RBACPolicySpec{
+		AppliesTo: PolicyScope{Namespaces: []string{"tenant-a-dev"}},
+		BindingLimits: &BindingLimits{
+			RoleBindingLimits: &RoleRefLimits{
+				AllowedRoleRefs:   []string{"tenant-a-dev-*"},
+				ForbiddenRoleRefs: []string{"tenant-a-admin*"},
+			},
+			TargetNamespaceLimits: &NamespaceLimits{
+				ForbiddenNamespacePrefixes: []string{"kube"},
+				MaxTargetNamespaces:        ptr.To[int32](2),
+			},
+		},
+		RoleLimits: &RoleLimits{
+			ForbiddenVerbs:  []string{"escalate", "bind", "impersonate"},
+			MaxRulesPerRole: ptr.To[int32](5),
+		},
+		SubjectLimits: &SubjectLimits{
+			AllowedKinds:   []string{"Group"},
+			ForbiddenKinds: []string{"ServiceAccount", "User"},
+			GroupLimits: &NameMatchLimits{
+				AllowedPrefixes:   []string{"oidc:tenant-a-dev-"},
+				ForbiddenPrefixes: []string{"system:"},
+			},
+		},
+		ParentPolicyRef: &RBACPolicyReference{Name: "tenant-a"},
+	}
+}
+
+func tenantNamespaceLabels(namespace string) (map[string]string, bool, error) {
+	if strings.HasPrefix(namespace, "tenant-a-") {
+		return map[string]string{"tenant": "a"}, true, nil
+	}
+	return map[string]string{"tenant": "other"}, true, nil
+}
+
+func TestValidatePolicySubset(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(child *RBACPolicySpec)
+		wantField string
+	}{
+		{
+			name:   "narrower child is accepted",
+			mutate: func(*RBACPolicySpec) {},
+		},
+		{
+			name:      "namespace outside parent selector",
+			mutate:    func(c *RBACPolicySpec) { c.AppliesTo.Namespaces = []string{"tenant-b-dev"} },
+			wantField: "spec.appliesTo.namespaces[0]",
+		},
+		{
+			name:      "cluster-wide scope",
+			mutate:    func(c *RBACPolicySpec) { c.AppliesTo.Namespaces = []string{"*"} },
+			wantField: "spec.appliesTo.namespaces[0]",
+		},
+		{
+			name: "child selector drops parent requirement",
+			mutate: func(c *RBACPolicySpec) {
+				c.AppliesTo.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}
+			},
+			wantField: "spec.appliesTo.namespaceSelector",
+		},
+		{
+			name: "child selector adds requirement",
+			mutate: func(c *RBACPolicySpec) {
+				c.AppliesTo.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a", "env": "dev"}}
+			},
+		},
+		{
+			name:      "allowed role ref outside parent",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.RoleBindingLimits.AllowedRoleRefs = []string{"edit"} },
+			wantField: "spec.bindingLimits.roleBindingLimits.allowedRoleRefs[0]",
+		},
+		{
+			name:      "wider wildcard role ref",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.RoleBindingLimits.AllowedRoleRefs = []string{"tenant-*"} },
+			wantField: "spec.bindingLimits.roleBindingLimits.allowedRoleRefs[0]",
+		},
+		{
+			name:      "forbidden role ref dropped",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.RoleBindingLimits.ForbiddenRoleRefs = nil },
+			wantField: "spec.bindingLimits.roleBindingLimits.forbiddenRoleRefs",
+		},
+		{
+			name:      "cluster role bindings enabled",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.AllowClusterRoleBindings = true },
+			wantField: "spec.bindingLimits.allowClusterRoleBindings",
+		},
+		{
+			name: "cluster role ref limits the parent does not have",
+			mutate: func(c *RBACPolicySpec) {
+				c.BindingLimits.ClusterRoleBindingLimits = &RoleRefLimits{AllowedRoleRefs: []string{"view"}}
+			},
+			wantField: "spec.bindingLimits.clusterRoleBindingLimits",
+		},
+		{
+			name:      "target namespace limits dropped",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.TargetNamespaceLimits = nil },
+			wantField: "spec.bindingLimits.targetNamespaceLimits",
+		},
+		{
+			name:      "max target namespaces raised",
+			mutate:    func(c *RBACPolicySpec) { c.BindingLimits.TargetNamespaceLimits.MaxTargetNamespaces = ptr.To[int32](11) },
+			wantField: "spec.bindingLimits.targetNamespaceLimits.maxTargetNamespaces",
+		},
+		{
+			name: "forbidden namespace prefix narrowed",
+			mutate: func(c *RBACPolicySpec) {
+				c.BindingLimits.TargetNamespaceLimits.ForbiddenNamespacePrefixes = []string{"kube-system"}
+			},
+			wantField: "spec.bindingLimits.targetNamespaceLimits.forbiddenNamespacePrefixes",
+		},
+		{
+			name:      "forbidden verb dropped",
+			mutate:    func(c *RBACPolicySpec) { c.RoleLimits.ForbiddenVerbs = []string{"escalate"} },
+			wantField: "spec.roleLimits.forbiddenVerbs",
+		},
+		{
+			name:   "forbidden verbs replaced by wildcard",
+			mutate: func(c *RBACPolicySpec) { c.RoleLimits.ForbiddenVerbs = []string{"*"} },
+		},
+		{
+			name:      "max rules per role unset",
+			mutate:    func(c *RBACPolicySpec) { c.RoleLimits.MaxRulesPerRole = nil },
+			wantField: "spec.roleLimits.maxRulesPerRole",
+		},
+		{
+			name:      "cluster roles enabled",
+			mutate:    func(c *RBACPolicySpec) { c.RoleLimits.AllowClusterRoles = true },
+			wantField: "spec.roleLimits.allowClusterRoles",
+		},
+		{
+			name: "constrained impersonation enabled",
+			mutate: func(c *RBACPolicySpec) {
+				c.RoleLimits.ConstrainedImpersonation = &ConstrainedImpersonationLimits{Allowed: true}
+			},
+			wantField: "spec.roleLimits.constrainedImpersonation.allowed",
+		},
+		{
+			name:      "subject kind outside parent",
+			mutate:    func(c *RBACPolicySpec) { c.SubjectLimits.AllowedKinds = []string{"ServiceAccount"} },
+			wantField: "spec.subjectLimits.allowedKinds[0]",
+		},
+		{
+			name:      "forbidden subject kind dropped",
+			mutate:    func(c *RBACPolicySpec) { c.SubjectLimits.ForbiddenKinds = nil },
+			wantField: "spec.subjectLimits.forbiddenKinds",
+		},
+		{
+			name:      "group prefix outside parent",
+			mutate:    func(c *RBACPolicySpec) { c.SubjectLimits.GroupLimits.AllowedPrefixes = []string{"oidc:"} },
+			wantField: "spec.subjectLimits.groupLimits.allowedPrefixes[0]",
+		},
+		{
+			name:      "group limits dropped",
+			mutate:    func(c *RBACPolicySpec) { c.SubjectLimits.GroupLimits = nil },
+			wantField: "spec.subjectLimits.groupLimits",
+		},
+		{
+			name: "impersonation enabled without parent impersonation",
+			mutate: func(c *RBACPolicySpec) {
+				c.Impersonation = &ImpersonationConfig{Enabled: true, UserName: "tenant-applier"}
+			},
+			wantField: "spec.impersonation.enabled",
+		},
+		{
+			name: "freeze under revoking parent",
+			mutate: func(c *RBACPolicySpec) {
+				c.OnViolation = &ViolationPolicy{Action: ViolationActionFreeze}
+			},
+			wantField: "spec.onViolation.action",
+		},
+		{
+			name: "grace period under revoking parent",
+			mutate: func(c *RBACPolicySpec) {
+				c.OnViolation = &ViolationPolicy{Action: ViolationActionRevokeAfter, GracePeriod: &metav1.Duration{Duration: time.Hour}}
+			},
+			wantField: "spec.onViolation.gracePeriod",
+		},
+		{
+			name: "omitted limit blocks are narrower",
+			mutate: func(c *RBACPolicySpec) {
+				c.BindingLimits = nil
+				c.RoleLimits = nil
+				c.SubjectLimits = nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			parent := tenantParentPolicySpec()
+			child := tenantChildPolicySpec()
+			tt.mutate(&child)
+
+			errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec"))
+			if tt.wantField == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) == 0 {
+				t.Fatalf("expected an error on %s, got none", tt.wantField)
+			}
+			found := false
+			for _, err := range errs {
+				if err.Field == tt.wantField {
+					found = true
+				}
+				if !strings.Contains(err.Detail, `parent RBACPolicy "tenant-a"`) {
+					t.Errorf("expected error detail to name the parent, got %q", err.Detail)
+				}
+			}
+			if !found {
+				t.Errorf("expected an error on %s, got %v", tt.wantField, errs)
+			}
+		})
+	}
+}
+
+func TestValidatePolicySubsetClusterWideChildScope(t *testing.T) {
+	tenantSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+	tests := []struct {
+		name    string
+		parent  PolicyScope
+		child   PolicyScope
+		wantErr bool
+	}{
+		{
+			name:    "bare wildcard under wildcard with namespaces",
+			parent:  PolicyScope{Namespaces: []string{"*", "tenant-a-dev"}},
+			child:   PolicyScope{Namespaces: []string{"*"}},
+			wantErr: true,
+		},
+		{
+			name:    "bare wildcard under wildcard with selector",
+			parent:  PolicyScope{Namespaces: []string{"*"}, NamespaceSelector: tenantSelector},
+			child:   PolicyScope{Namespaces: []string{"*"}},
+			wantErr: true,
+		},
+		{
+			name:   "wildcard with parent namespace",
+			parent: PolicyScope{Namespaces: []string{"*", "tenant-a-dev"}},
+			child:  PolicyScope{Namespaces: []string{"*", "tenant-a-dev"}},
+		},
+		{
+			name:   "wildcard with namespace matching parent selector",
+			parent: PolicyScope{Namespaces: []string{"*"}, NamespaceSelector: tenantSelector},
+			child:  PolicyScope{Namespaces: []string{"*", "tenant-a-dev"}},
+		},
+		{
+			name:   "wildcard with parent selector",
+			parent: PolicyScope{Namespaces: []string{"*"}, NamespaceSelector: tenantSelector},
+			child:  PolicyScope{Namespaces: []string{"*"}, NamespaceSelector: tenantSelector},
+		},
+		{
+			name:    "wildcard with namespace outside parent",
+			parent:  PolicyScope{Namespaces: []string{"*", "tenant-a-dev"}},
+			child:   PolicyScope{Namespaces: []string{"*", "tenant-b-dev"}},
+			wantErr: true,
+		},
+		{
+			name:   "bare wildcard under bare wildcard",
+			parent: PolicyScope{Namespaces: []string{"*"}},
+			child:  PolicyScope{Namespaces: []string{"*"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			parent := RBACPolicySpec{AppliesTo: tt.parent}
+			child := RBACPolicySpec{AppliesTo: tt.child, ParentPolicyRef: &RBACPolicyReference{Name: "tenant-a"}}
+
+			errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec"))
+			if tt.wantErr && len(errs) == 0 {
+				t.Fatal("expected the child scope to be rejected, got no errors")
+			}
+			if !tt.wantErr && len(errs) != 0 {
+				t.Fatalf("expected no errors, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidatePolicySubsetParentImpersonation(t *testing.T) {
+	parent := tenantParentPolicySpec()
+	parent.Impersonation = &ImpersonationConfig{
+		Enabled:           true,
+		ServiceAccountRef: &SARef{Name: "tenant-a-applier", Namespace: "tenant-a-system"},
+	}
+
+	child := tenantChildPolicySpec()
+	errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec"))
+	if len(errs) != 1 || errs[0].Field != "spec.impersonation" {
+		t.Fatalf("expected dropping the parent impersonation identity to be rejected, got %v", errs)
+	}
+
+	child.Impersonation = parent.Impersonation.DeepCopy()
+	if errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec")); len(errs) != 0 {
+		t.Fatalf("expected the inherited impersonation identity to be accepted, got %v", errs)
+	}
+}
+
+func TestValidatePolicySubsetBudgets(t *testing.T) {
+	parent := tenantParentPolicySpec()
+	parent.Budgets = &PolicyBudgets{MaxRoleBindings: ptr.To[int32](20)}
+
+	child := tenantChildPolicySpec()
+	errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec"))
+	if len(errs) != 1 || errs[0].Field != "spec.budgets" {
+		t.Fatalf("expected dropping the parent budgets to be rejected, got %v", errs)
+	}
+
+	child.Budgets = &PolicyBudgets{MaxRoleBindings: ptr.To[int32](50)}
+	errs = validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec"))
+	if len(errs) != 1 || errs[0].Field != "spec.budgets.maxRoleBindings" {
+		t.Fatalf("expected a larger RoleBinding budget to be rejected, got %v", errs)
+	}
+
+	child.Budgets = &PolicyBudgets{MaxRoleBindings: ptr.To[int32](10), MaxServiceAccounts: ptr.To[int32](5)}
+	if errs := validatePolicySubset(&child, &parent, "tenant-a", tenantNamespaceLabels, field.NewPath("spec")); len(errs) != 0 {
+		t.Fatalf("expected narrower budgets to be accepted, got %v", errs)
+	}
+}
+
+func newDelegationTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func tenantPolicyFixtures() []client.Object {
+	return []client.Object{
+		&RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a"}, Spec: tenantParentPolicySpec()},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev", Labels: map[string]string{"tenant": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b-dev", Labels: map[string]string{"tenant": "b"}}},
+	}
+}
+
+func TestRBACPolicyValidatorParentPolicy(t *testing.T) {
+	c := newDelegationTestClient(t, tenantPolicyFixtures()...)
+	v := &RBACPolicyValidator{Client: c, Reader: c}
+	ctx := context.Background()
+
+	child := &RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev"}, Spec: tenantChildPolicySpec()}
+	if _, err := v.ValidateCreate(ctx, child); err != nil {
+		t.Fatalf("expected narrower child to be admitted, got: %v", err)
+	}
+
+	wider := child.DeepCopy()
+	wider.Spec.AppliesTo.Namespaces = []string{"tenant-b-dev"}
+	if _, err := v.ValidateCreate(ctx, wider); err == nil {
+		t.Fatal("expected child outside the parent scope to be rejected")
+	} else if !apierrors.IsInvalid(err) || !strings.Contains(err.Error(), "spec.appliesTo.namespaces[0]") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	missing := child.DeepCopy()
+	missing.Spec.ParentPolicyRef.Name = "missing"
+	if _, err := v.ValidateCreate(ctx, missing); err == nil || !strings.Contains(err.Error(), "spec.parentPolicyRef.name") {
+		t.Fatalf("expected missing parent to be rejected, got: %v", err)
+	}
+
+	self := child.DeepCopy()
+	self.Spec.ParentPolicyRef.Name = self.Name
+	if _, err := v.ValidateCreate(ctx, self); err == nil || !strings.Contains(err.Error(), "cannot be its own parent") {
+		t.Fatalf("expected self reference to be rejected, got: %v", err)
+	}
+}
+
+func TestRBACPolicyValidatorParentPolicyCycleAndDepth(t *testing.T) {
+	scope := PolicyScope{Namespaces: []string{"*"}}
+	chain := func(name, parent string) *RBACPolicy {
+		p := &RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: RBACPolicySpec{AppliesTo: scope}}
+		if parent != "" {
+			p.Spec.ParentPolicyRef = &RBACPolicyReference{Name: parent}
+		}
+		return p
+	}
+
+	c := newDelegationTestClient(t,
+		chain("loop-a", "loop-b"),
+		chain("loop-b", "loop-a"),
+		chain("level-1", ""),
+		chain("level-2", "level-1"),
+		chain("level-3", "level-2"),
+		chain("level-4", "level-3"),
+	)
+	v := &RBACPolicyValidator{Client: c, Reader: c}
+	ctx := context.Background()
+
+	if _, err := v.ValidateCreate(ctx, chain("loop-c", "loop-a")); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected cycle to be rejected, got: %v", err)
+	}
+	if _, err := v.ValidateUpdate(ctx, chain("loop-a", ""), chain("loop-a", "loop-b")); err == nil ||
+		!strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected update closing a cycle to be rejected, got: %v", err)
+	}
+	if _, err := v.ValidateCreate(ctx, chain("level-5", "level-4")); err != nil {
+		t.Fatalf("expected chain at the maximum depth to be admitted, got: %v", err)
+	}
+	if _, err := v.ValidateCreate(ctx, chain("level-6", "level-5")); err == nil {
+		t.Fatal("expected missing intermediate parent to be rejected")
+	}
+	c2 := newDelegationTestClient(t,
+		chain("level-1", ""), chain("level-2", "level-1"), chain("level-3", "level-2"),
+		chain("level-4", "level-3"), chain("level-5", "level-4"),
+	)
+	v2 := &RBACPolicyValidator{Client: c2, Reader: c2}
+	if _, err := v2.ValidateCreate(ctx, chain("level-6", "level-5")); err == nil ||
+		!strings.Contains(err.Error(), "maximum delegation depth") {
+		t.Fatalf("expected chain beyond the maximum depth to be rejected, got: %v", err)
+	}
+}
+
+func TestRBACPolicyValidatorDelegatedRequester(t *testing.T) {
+	objs := append(tenantPolicyFixtures(),
+		&RBACPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-b"},
+			Spec:       RBACPolicySpec{AppliesTo: PolicyScope{Namespaces: []string{"tenant-b-dev"}}},
+		},
+	)
+	c := newDelegationTestClient(t, objs...)
+	v := &RBACPolicyValidator{Client: c, Reader: c}
+
+	tenantCtx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "alice", Groups: []string{testTenantAdminsGroup}},
+		},
+	})
+	platformCtx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "platform-admin", Groups: []string{"oidc:platform"}},
+		},
+	})
+
+	child := &RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev"}, Spec: tenantChildPolicySpec()}
+	if _, err := v.ValidateCreate(tenantCtx, child); err != nil {
+		t.Fatalf("expected delegate to create a child policy, got: %v", err)
+	}
+
+	unparented := &RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-rogue"},
+		Spec:       RBACPolicySpec{AppliesTo: PolicyScope{Namespaces: []string{"*"}}},
+	}
+	if _, err := v.ValidateCreate(tenantCtx, unparented); err == nil ||
+		!strings.Contains(err.Error(), "may only manage child policies of: tenant-a") {
+		t.Fatalf("expected delegate to be restricted to its subtree, got: %v", err)
+	}
+	if _, err := v.ValidateCreate(platformCtx, unparented); err != nil {
+		t.Fatalf("expected non-delegate to be unrestricted, got: %v", err)
+	}
+
+	foreign := unparented.DeepCopy()
+	foreign.Spec.ParentPolicyRef = &RBACPolicyReference{Name: "tenant-b"}
+	if _, err := v.ValidateCreate(tenantCtx, foreign); err == nil || !apierrors.IsInvalid(err) {
+		t.Fatalf("expected delegate to be rejected under a foreign parent, got: %v", err)
+	}
+
+	withDefault := child.DeepCopy()
+	withDefault.Spec.DefaultAssignment = &DefaultPolicyAssignment{Groups: []string{"oidc:tenant-a-dev"}}
+	if _, err := v.ValidateCreate(tenantCtx, withDefault); err == nil ||
+		!strings.Contains(err.Error(), "spec.defaultAssignment") {
+		t.Fatalf("expected delegate default assignment to be rejected, got: %v", err)
+	}
+
+	parent := &RBACPolicy{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "tenant-a"}, parent); err != nil {
+		t.Fatalf("get parent: %v", err)
+	}
+	if _, err := v.ValidateDelete(tenantCtx, parent); err == nil {
+		t.Fatal("expected delegate to be unable to delete its own parent policy")
+	}
+}
+
+func TestRBACPolicyValidatorParentWithChildren(t *testing.T) {
+	objs := append(tenantPolicyFixtures(),
+		&RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev"}, Spec: tenantChildPolicySpec()},
+	)
+	c := newDelegationTestClient(t, objs...)
+	v := &RBACPolicyValidator{Client: c, Reader: c}
+	ctx := context.Background()
+
+	parent := &RBACPolicy{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "tenant-a"}, parent); err != nil {
+		t.Fatalf("get parent: %v", err)
+	}
+
+	if _, err := v.ValidateDelete(ctx, parent); err == nil || !apierrors.IsForbidden(err) {
+		t.Fatalf("expected deleting a parent with children to be forbidden, got: %v", err)
+	}
+
+	warnings, err := v.ValidateUpdate(ctx, parent, parent.DeepCopy())
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("expected unchanged parent update without warnings, got warnings=%v err=%v", warnings, err)
+	}
+
+	narrowed := parent.DeepCopy()
+	narrowed.Spec.RoleLimits.MaxRulesPerRole = ptr.To[int32](3)
+	warnings, err = v.ValidateUpdate(ctx, parent, narrowed)
+	if err != nil {
+		t.Fatalf("expected narrowing the parent to be admitted, got: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `child RBACPolicy "tenant-a-dev"`) {
+		t.Fatalf("expected a warning for the child outside the new limits, got: %v", warnings)
+	}
+}
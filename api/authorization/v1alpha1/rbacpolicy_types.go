@@ -369,6 +369,21 @@ type DefaultPolicyAssignment struct {
 	ServiceAccounts []SARef `json:"serviceAccounts,omitempty"`
 }
 
+// PolicyDelegation defines requester identities that may author child
+// RBACPolicies of the delegating policy.
+type PolicyDelegation struct {
+	// Groups lists requester group names allowed to author child policies.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:items:MinLength=1
+	Groups []string `json:"groups,omitempty"`
+
+	// ServiceAccounts lists requester ServiceAccounts allowed to author child policies.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=128
+	ServiceAccounts []SARef `json:"serviceAccounts,omitempty"`
+}
+
 // ImpersonationExtra is a single Impersonate-Extra-<key> entry used for
 // apply-time impersonation.
 type ImpersonationExtra struct {
@@ -389,6 +404,23 @@ type ImpersonationExtra struct {
 	Values []string `json:"values"`
 }
 
+// ImpersonationCredentialSource selects how the operator obtains the apply
+// identity of an ImpersonationConfig.
+// +kubebuilder:validation:Enum=Impersonate;TokenRequest
+type ImpersonationCredentialSource string
+
+const (
+	// ImpersonationCredentialSourceImpersonate sends impersonation headers with the
+	// operator's own credentials. The operator needs impersonate rights on the
+	// identity.
+	ImpersonationCredentialSourceImpersonate ImpersonationCredentialSource = "Impersonate"
+	// ImpersonationCredentialSourceTokenRequest mints short-lived tokens for
+	// ServiceAccountRef through the TokenRequest API and authenticates as the
+	// ServiceAccount itself. The operator needs create on serviceaccounts/token
+	// instead of impersonate, and no impersonation headers are sent.
+	ImpersonationCredentialSourceTokenRequest ImpersonationCredentialSource = "TokenRequest"
+)
+
 // ImpersonationConfig controls apply-time impersonation for
 // RestrictedBindDefinition and RestrictedRoleDefinition reconciliation.
 // RBACPolicy write access is a cluster trust boundary: a policy author can choose
@@ -408,6 +440,7 @@ type ImpersonationExtra struct {
 //
 // +kubebuilder:validation:XValidation:rule="!(has(self.serviceAccountRef) && ((has(self.uid) && size(self.uid) > 0) || (has(self.groups) && size(self.groups) > 0) || (has(self.extra) && size(self.extra) > 0)))",message="serviceAccountRef is mutually exclusive with uid, groups and extra: setting any of them makes the apiserver skip the serviceaccount constrained-impersonation mode and silently fall back to legacy impersonation"
 // +kubebuilder:validation:XValidation:rule="!(has(self.userName) && has(self.serviceAccountRef))",message="userName and serviceAccountRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.credentialSource) || self.credentialSource != 'TokenRequest' || has(self.serviceAccountRef)",message="credentialSource TokenRequest requires serviceAccountRef"
 // +kubebuilder:validation:XValidation:rule="!has(self.groups) || !self.groups.exists(g, g == 'system:masters')",message="impersonating the system:masters group is not allowed"
 type ImpersonationConfig struct {
 	// Enabled enables impersonation during restricted resource apply operations.
@@ -466,6 +499,26 @@ type ImpersonationConfig struct {
 	// silent legacy fallback into an admission error.
 	// +kubebuilder:validation:Optional
 	Mode ImpersonationMode `json:"mode,omitempty"`
+
+	// CredentialSource selects how the operator acts as the apply identity.
+	// Impersonate (the default) sends impersonation headers and requires the
+	// operator to hold impersonate rights. TokenRequest mints short-lived tokens
+	// for ServiceAccountRef and applies as the ServiceAccount directly, so the
+	// operator needs create on serviceaccounts/token instead, which is equivalent
+	// to impersonating that ServiceAccount. TokenRequest requires
+	// ServiceAccountRef and is incompatible with Mode, as no impersonation
+	// headers are sent.
+	// +kubebuilder:validation:Optional
+	CredentialSource ImpersonationCredentialSource `json:"credentialSource,omitempty"`
+}
+
+// EffectiveCredentialSource returns the credential source, defaulting to
+// Impersonate when unset.
+func (ic *ImpersonationConfig) EffectiveCredentialSource() ImpersonationCredentialSource {
+	if ic == nil || ic.CredentialSource == "" {
+		return ImpersonationCredentialSourceImpersonate
+	}
+	return ic.CredentialSource
 }
 
 // EffectiveUsername renders the impersonated username for the configuration, or
@@ -514,6 +567,78 @@ func (ic *ImpersonationConfig) SelectedMode() ImpersonationMode {
 	}
 }
 
+// PolicyBudgets caps the aggregate RBAC volume produced by all
+// RestrictedBindDefinitions referencing a policy or any of its descendant
+// policies. Per-definition limits such as
+// maxTargetNamespaces cannot stop a tenant from fanning out through many
+// definitions; budgets can.
+type PolicyBudgets struct {
+	// MaxRestrictedBindDefinitions caps the number of RestrictedBindDefinitions
+	// referencing the policy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRestrictedBindDefinitions *int32 `json:"maxRestrictedBindDefinitions,omitempty"`
+
+	// MaxRoleBindings caps the total RoleBindings generated by all
+	// RestrictedBindDefinitions referencing the policy. Every resolved namespace
+	// and role reference pair of a definition counts as one RoleBinding.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRoleBindings *int32 `json:"maxRoleBindings,omitempty"`
+
+	// MaxServiceAccounts caps the distinct ServiceAccount subjects across all
+	// RestrictedBindDefinitions referencing the policy. Every ServiceAccount
+	// subject counts, whether the operator generates it or it already exists.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxServiceAccounts *int32 `json:"maxServiceAccounts,omitempty"`
+}
+
+// PolicyBudgetUsage reports the aggregate RBAC volume counted against
+// PolicyBudgets.
+type PolicyBudgetUsage struct {
+	// RestrictedBindDefinitions is the number of RestrictedBindDefinitions
+	// referencing the policy.
+	// +kubebuilder:validation:Optional
+	RestrictedBindDefinitions int32 `json:"restrictedBindDefinitions"`
+
+	// RoleBindings is the number of RoleBindings generated for them.
+	// +kubebuilder:validation:Optional
+	RoleBindings int32 `json:"roleBindings"`
+
+	// ServiceAccounts is the number of distinct ServiceAccount subjects,
+	// generated or pre-existing.
+	// +kubebuilder:validation:Optional
+	ServiceAccounts int32 `json:"serviceAccounts"`
+}
+
+// MaxRoleCatalogEntries caps the number of entries in status.catalog of an
+// RBACPolicy. Entries are sorted by name; ClusterRoles beyond the cap are
+// omitted and counted in status.catalogOmitted.
+const MaxRoleCatalogEntries = 256
+
+// RoleOffering is a ClusterRole that RestrictedBindDefinitions governed by
+// the policy may reference.
+type RoleOffering struct {
+	// Name of the ClusterRole.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Description is taken from the authorization.t-caas.telekom.com/description
+	// annotation of the ClusterRole, or from kubernetes.io/description.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// RuleCount is the number of policy rules in the ClusterRole.
+	// +kubebuilder:validation:Optional
+	RuleCount int32 `json:"ruleCount"`
+
+	// ClusterWide reports whether the ClusterRole may also be bound through a
+	// ClusterRoleBinding. Otherwise it may only be bound in RoleBindings.
+	// +kubebuilder:validation:Optional
+	ClusterWide bool `json:"clusterWide,omitempty"`
+}
+
 // RBACPolicySpec defines the desired state of RBACPolicy.
 // +kubebuilder:validation:XValidation:rule="has(self.appliesTo.namespaceSelector) || (has(self.appliesTo.namespaces) && size(self.appliesTo.namespaces) > 0)",message="appliesTo must specify at least namespaceSelector or namespaces"
 type RBACPolicySpec struct {
@@ -545,6 +670,41 @@ type RBACPolicySpec struct {
 	// apply operations governed by this policy.
 	// +kubebuilder:validation:Optional
 	Impersonation *ImpersonationConfig `json:"impersonation,omitempty"`
+
+	// ParentPolicyRef makes this policy a delegated sub-policy of another
+	// RBACPolicy. Admission proves that the child is a subset of the parent:
+	// scope and allowed sets may only shrink, forbidden sets may only grow, and
+	// numeric limits may only decrease. Narrowing a parent after its children
+	// were admitted is reported as an admission warning on the parent.
+	// +kubebuilder:validation:Optional
+	ParentPolicyRef *RBACPolicyReference `json:"parentPolicyRef,omitempty"`
+
+	// Delegation lists the identities (typically tenant admins) that may create,
+	// update and delete child RBACPolicies whose parentPolicyRef names this
+	// policy. Delegated identities cannot author policies outside that subtree.
+	// +kubebuilder:validation:Optional
+	Delegation *PolicyDelegation `json:"delegation,omitempty"`
+
+	// OnViolation controls what happens to the RBAC managed by dependent
+	// restricted resources once they violate this policy, for example after the
+	// policy was tightened. Defaults to Revoke, which deprovisions immediately.
+	// +kubebuilder:validation:Optional
+	OnViolation *ViolationPolicy `json:"onViolation,omitempty"`
+
+	// Budgets caps the aggregate RBAC volume produced by all
+	// RestrictedBindDefinitions referencing this policy or any of its
+	// descendant policies. Budgets are enforced at
+	// admission and on every reconcile, where the newest definitions that no
+	// longer fit are policy violations; usage is reported in status.usage.
+	// +kubebuilder:validation:Optional
+	Budgets *PolicyBudgets `json:"budgets,omitempty"`
+
+	// AdmissionEnforcement generates a ValidatingAdmissionPolicy from this
+	// policy so that direct RoleBinding and ClusterRoleBinding writes in the
+	// policy scope are checked by the API server, not only the RBAC produced
+	// by restricted resources. Only limits expressible in CEL are enforced.
+	// +kubebuilder:validation:Optional
+	AdmissionEnforcement *AdmissionEnforcement `json:"admissionEnforcement,omitempty"`
 }
 
 // RBACPolicyStatus defines the observed state of RBACPolicy.
@@ -558,6 +718,25 @@ type RBACPolicyStatus struct {
 	// +kubebuilder:validation:Optional
 	BoundResourceCount int32 `json:"boundResourceCount,omitempty"`
 
+	// Usage is the aggregate RBAC volume of the RestrictedBindDefinitions
+	// referencing this policy or any of its descendant policies, counted
+	// against spec.budgets.
+	// +kubebuilder:validation:Optional
+	Usage *PolicyBudgetUsage `json:"usage,omitempty"`
+
+	// Catalog lists the ClusterRoles that satisfy bindingLimits today, so
+	// tenants can discover what they may bind without reading the policy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=256
+	// +listType=map
+	// +listMapKey=name
+	Catalog []RoleOffering `json:"catalog,omitempty"`
+
+	// CatalogOmitted is the number of matching ClusterRoles left out of
+	// Catalog because it reached its maximum size.
+	// +kubebuilder:validation:Optional
+	CatalogOmitted int32 `json:"catalogOmitted,omitempty"`
+
 	// Conditions defines current service state of the RBACPolicy.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -573,6 +752,7 @@ type RBACPolicyStatus struct {
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the RBACPolicy is ready"
 // +kubebuilder:printcolumn:name="Bound",type="integer",JSONPath=".status.boundResourceCount",description="Number of bound restricted resources"
 // +kubebuilder:printcolumn:name="Namespaces",type="string",JSONPath=".spec.appliesTo.namespaces",priority=1,description="Explicit namespace scope"
+// +kubebuilder:printcolumn:name="Parent",type="string",JSONPath=".spec.parentPolicyRef.name",priority=1,description="Parent RBACPolicy of a delegated sub-policy"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
 type RBACPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
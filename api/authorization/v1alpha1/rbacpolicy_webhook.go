@@ -71,6 +71,12 @@ func (v *RBACPolicyValidator) ValidateCreate(ctx context.Context, obj *RBACPolic
 	if err := v.validateDefaultAssignmentDoesNotOverlap(ctx, obj); err != nil {
 		return nil, err
 	}
+	if err := v.validateDelegatedRequester(ctx, nil, obj); err != nil {
+		return nil, err
+	}
+	if err := v.validateParentPolicy(ctx, obj); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
@@ -89,8 +95,14 @@ func (v *RBACPolicyValidator) ValidateUpdate(ctx context.Context, oldObj, newObj
 	if err := v.validateDefaultAssignmentDoesNotOverlap(ctx, newObj); err != nil {
 		return nil, err
 	}
+	if err := v.validateDelegatedRequester(ctx, oldObj, newObj); err != nil {
+		return nil, err
+	}
+	if err := v.validateParentPolicy(ctx, newObj); err != nil {
+		return nil, err
+	}
 
-	return nil, nil
+	return v.childPolicyWarnings(ctx, newObj), nil
 }
 
 // ValidateDelete checks if any RestrictedBindDefinitions or RestrictedRoleDefinitions
@@ -107,6 +119,23 @@ func (v *RBACPolicyValidator) ValidateDelete(ctx context.Context, obj *RBACPolic
 	// referencing resources actually exist, incorrectly allowing deletion.
 	reader := v.defaultPolicyReader()
 
+	if err := v.validateDelegatedRequester(ctx, obj, nil); err != nil {
+		return nil, err
+	}
+
+	children, err := listChildPolicies(ctx, reader, obj.Name)
+	if err != nil {
+		logger.Error(err, "failed to list child RBACPolicies")
+		return nil, apierrors.NewInternalError(errors.New("unable to list child RBACPolicies"))
+	}
+	if len(children) > 0 {
+		return nil, apierrors.NewForbidden(
+			schema.GroupResource{Group: GroupVersion.Group, Resource: "rbacpolicies"},
+			obj.Name,
+			fmt.Errorf("cannot delete: child RBACPolicy(s) still reference this policy via parentPolicyRef"),
+		)
+	}
+
 	hasRBDReference, err := policyHasRestrictedBindDefinitionReference(ctx, reader, obj.Name)
 	if err != nil {
 		logger.Error(err, "failed to list RestrictedBindDefinitions")
@@ -346,8 +375,19 @@ func validateRBACPolicySpec(obj *RBACPolicy) error {
 
 	allErrs = append(allErrs, validateDefaultAssignment(obj.Spec.DefaultAssignment,
 		field.NewPath("spec", "defaultAssignment"))...)
+	allErrs = append(allErrs, validatePolicyDelegation(obj.Spec.Delegation,
+		field.NewPath("spec", "delegation"))...)
+	if obj.Spec.ParentPolicyRef != nil && obj.Spec.ParentPolicyRef.Name == obj.Name {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec", "parentPolicyRef", "name"),
+			obj.Spec.ParentPolicyRef.Name, "a policy cannot be its own parent"))
+	}
 	allErrs = append(allErrs, validateImpersonationConfig(obj.Spec.Impersonation,
 		field.NewPath("spec", "impersonation"))...)
+	allErrs = append(allErrs, ValidateViolationPolicy(obj.Spec.OnViolation,
+		field.NewPath("spec", "onViolation"))...)
+	allErrs = append(allErrs, ValidateAdmissionEnforcement(obj.Spec.AdmissionEnforcement,
+		field.NewPath("spec", "admissionEnforcement"))...)
 
 	if len(allErrs) > 0 {
 		return apierrors.NewInvalid(
@@ -392,6 +432,38 @@ func validateDefaultAssignment(da *DefaultPolicyAssignment, fldPath *field.Path)
 	return allErrs
 }
 
+// validatePolicyDelegation validates the optional delegation block.
+func validatePolicyDelegation(d *PolicyDelegation, fldPath *field.Path) field.ErrorList {
+	if d == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if len(d.Groups) == 0 && len(d.ServiceAccounts) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, d,
+			"must define at least one group or serviceAccount"))
+	}
+	for i, g := range d.Groups {
+		if g == "" {
+			allErrs = append(allErrs, field.Invalid(
+				fldPath.Child("groups").Index(i), g, "must not be empty"))
+		}
+	}
+	for i, sa := range d.ServiceAccounts {
+		if sa.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				fldPath.Child("serviceAccounts").Index(i).Child("name"), "name is required"))
+		}
+		if sa.Namespace == "" {
+			allErrs = append(allErrs, field.Required(
+				fldPath.Child("serviceAccounts").Index(i).Child("namespace"),
+				"namespace is required for delegated serviceAccount matching"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateConstrainedImpersonationLimits validates the policy-side limits on
 // constrained impersonation grants.
 func validateConstrainedImpersonationLimits(limits *ConstrainedImpersonationLimits, fldPath *field.Path) field.ErrorList {
@@ -462,6 +534,7 @@ func validateImpersonationConfig(ic *ImpersonationConfig, fldPath *field.Path) f
 	allErrs = append(allErrs, validateImpersonationExclusivity(ic, fldPath)...)
 	allErrs = append(allErrs, validateImpersonationIdentityFields(ic, fldPath)...)
 	allErrs = append(allErrs, validateImpersonationExtra(ic.Extra, fldPath.Child("extra"))...)
+	allErrs = append(allErrs, validateImpersonationCredentialSource(ic, fldPath)...)
 
 	if !ic.Enabled {
 		return allErrs
@@ -525,6 +598,35 @@ func validateImpersonationExclusivity(ic *ImpersonationConfig, fldPath *field.Pa
 	return allErrs
 }
 
+// validateImpersonationCredentialSource checks that TokenRequest credentials
+// are only used with a ServiceAccount identity. TokenRequest authenticates as
+// the ServiceAccount itself, so a declared impersonation mode would never be
+// selected.
+func validateImpersonationCredentialSource(ic *ImpersonationConfig, fldPath *field.Path) field.ErrorList {
+	sourcePath := fldPath.Child("credentialSource")
+	switch ic.CredentialSource {
+	case "", ImpersonationCredentialSourceImpersonate:
+		return nil
+	case ImpersonationCredentialSourceTokenRequest:
+	default:
+		return field.ErrorList{field.NotSupported(sourcePath, string(ic.CredentialSource), []string{
+			string(ImpersonationCredentialSourceImpersonate), string(ImpersonationCredentialSourceTokenRequest),
+		})}
+	}
+
+	var allErrs field.ErrorList
+	if ic.ServiceAccountRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountRef"),
+			"credentialSource TokenRequest requires serviceAccountRef: tokens can only be requested for ServiceAccounts"))
+	}
+	if ic.Mode != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("mode"),
+			"credentialSource TokenRequest authenticates as the ServiceAccount directly and selects no "+
+				"constrained-impersonation mode"))
+	}
+	return allErrs
+}
+
 func validateImpersonationIdentityFields(ic *ImpersonationConfig, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
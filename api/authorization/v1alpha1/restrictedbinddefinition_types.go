@@ -60,6 +60,19 @@ type RestrictedBindDefinitionSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=true
 	AutomountServiceAccountToken *bool `json:"automountServiceAccountToken,omitempty"`
+
+	// ServiceAccountLifecycle revokes generated ServiceAccounts after a TTL or
+	// an idle period. Pre-existing ServiceAccounts are not affected.
+	// +kubebuilder:validation:Optional
+	ServiceAccountLifecycle *ServiceAccountLifecycle `json:"serviceAccountLifecycle,omitempty"`
+
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated ClusterRoleBinding or RoleBinding with a different value:
+	// Force takes them over, Fail stops the reconcile, and Report leaves the
+	// binding unchanged. Conflicts are listed in status.conflicts in every case.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Force
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RestrictedBindDefinitionStatus defines the observed state of RestrictedBindDefinition.
@@ -93,12 +106,34 @@ type RestrictedBindDefinitionStatus struct {
 	// +kubebuilder:validation:Optional
 	SkippedServiceAccounts []string `json:"skippedServiceAccounts,omitempty"`
 
+	// RevokedServiceAccounts lists generated ServiceAccounts whose
+	// serviceAccountLifecycle expired. They are excluded from generated bindings.
+	// +kubebuilder:validation:Optional
+	RevokedServiceAccounts []RevokedServiceAccount `json:"revokedServiceAccounts,omitempty"`
+
 	// PolicyViolations lists policy violations detected during the last reconciliation.
 	// Format: "<fieldPath>: <message>" when a field path is available.
 	// Empty when all checks pass.
 	// +kubebuilder:validation:Optional
 	PolicyViolations []string `json:"policyViolations,omitempty"`
 
+	// PolicyViolationSince is when the current run of policy violations was first
+	// detected. It anchors the grace period of an RBACPolicy onViolation
+	// RevokeAfter action and is cleared once the resource complies again.
+	// +kubebuilder:validation:Optional
+	PolicyViolationSince *metav1.Time `json:"policyViolationSince,omitempty"`
+
+	// Conflicts lists generated bindings whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty"`
+
 	// Conditions defines current service state.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
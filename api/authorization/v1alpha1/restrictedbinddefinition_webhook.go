@@ -64,10 +64,13 @@ func (v *RestrictedBindDefinitionValidator) ValidateCreate(ctx context.Context,
 	}
 
 	// Verify that the referenced RBACPolicy exists and collect any admission warnings.
-	warnings, err := v.validatePolicyRefExists(ctx, obj)
+	rbacPolicy, warnings, err := v.validatePolicyRefExists(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
+	if err := v.validatePolicyBudgets(ctx, nil, obj, rbacPolicy); err != nil {
+		return nil, err
+	}
 
 	// Enforce requester-based default policy assignment, if configured.
 	if err := validateDefaultPolicyForRequester(
@@ -134,10 +137,13 @@ func (v *RestrictedBindDefinitionValidator) ValidateUpdate(ctx context.Context,
 	}
 
 	// Verify that the referenced RBACPolicy exists and collect any admission warnings.
-	warnings, err := v.validatePolicyRefExists(ctx, newObj)
+	rbacPolicy, warnings, err := v.validatePolicyRefExists(ctx, newObj)
 	if err != nil {
 		return nil, err
 	}
+	if err := v.validatePolicyBudgets(ctx, oldObj, newObj, rbacPolicy); err != nil {
+		return nil, err
+	}
 
 	// Enforce requester-based default policy assignment for mutable spec updates
 	// too. The policyRef is immutable, but subjects and role bindings can change
@@ -180,6 +186,10 @@ func (v *RestrictedBindDefinitionValidator) defaultPolicyReader() client.Reader
 func (v *RestrictedBindDefinitionValidator) validateRestrictedBindDefinitionSpec(ctx context.Context, obj *RestrictedBindDefinition) error {
 	logger := log.FromContext(ctx).WithName("restrictedbinddefinition-webhook")
 
+	if errs := ValidateServiceAccountLifecycle(obj.Spec.ServiceAccountLifecycle, field.NewPath("spec", "serviceAccountLifecycle")); len(errs) > 0 {
+		return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: RestrictedBindDefinitionKind}, obj.Name, errs)
+	}
+
 	// Check duplicate targetName through the uncached admission reader. Admission
 	// collision checks are a security boundary and must not fail open when the
 	// informer cache lags behind the API server.
@@ -481,13 +491,13 @@ func recordRoleBindingNameClaim(
 // validatePolicyRefExists verifies that the referenced RBACPolicy exists and returns
 // admission warnings for constraints that cannot be evaluated at admission time.
 // Full policy compliance evaluation is performed by the controller during reconciliation.
-func (v *RestrictedBindDefinitionValidator) validatePolicyRefExists(ctx context.Context, obj *RestrictedBindDefinition) (admission.Warnings, error) {
+func (v *RestrictedBindDefinitionValidator) validatePolicyRefExists(ctx context.Context, obj *RestrictedBindDefinition) (*RBACPolicy, admission.Warnings, error) {
 	logger := log.FromContext(ctx).WithName("restrictedbinddefinition-webhook")
 
 	rbacPolicy := &RBACPolicy{}
 	if err := v.defaultPolicyReader().Get(ctx, client.ObjectKey{Name: obj.Spec.PolicyRef.Name}, rbacPolicy); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, apierrors.NewInvalid(
+			return nil, nil, apierrors.NewInvalid(
 				schema.GroupKind{Group: GroupVersion.Group, Kind: RestrictedBindDefinitionKind},
 				obj.Name,
 				field.ErrorList{field.NotFound(
@@ -498,14 +508,14 @@ func (v *RestrictedBindDefinitionValidator) validatePolicyRefExists(ctx context.
 		}
 		if apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || apierrors.IsServiceUnavailable(err) {
 			logger.Error(err, "transient error fetching RBACPolicy", "policyRef", obj.Spec.PolicyRef.Name)
-			return nil, apierrors.NewInternalError(errors.New("transient error validating policy reference"))
+			return nil, nil, apierrors.NewInternalError(errors.New("transient error validating policy reference"))
 		}
 		logger.Error(err, "failed to get RBACPolicy", "policyRef", obj.Spec.PolicyRef.Name)
-		return nil, apierrors.NewInternalError(errors.New("unable to validate policy reference"))
+		return nil, nil, apierrors.NewInternalError(errors.New("unable to validate policy reference"))
 	}
 
 	if rbacPolicy.GetDeletionTimestamp() != nil {
-		return nil, invalidDeletingPolicyRef(
+		return nil, nil, invalidDeletingPolicyRef(
 			schema.GroupKind{Group: GroupVersion.Group, Kind: RestrictedBindDefinitionKind},
 			obj.Name,
 			obj.Spec.PolicyRef.Name,
@@ -533,5 +543,5 @@ func (v *RestrictedBindDefinitionValidator) validatePolicyRefExists(ctx context.
 	if selectorWarningIssued {
 		warnings = append(warnings, "AllowedNamespaceSelector constraints will be enforced at reconciliation time, not at admission")
 	}
-	return warnings, nil
+	return rbacPolicy, warnings, nil
 }
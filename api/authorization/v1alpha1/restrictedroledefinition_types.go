@@ -97,6 +97,14 @@ type RestrictedRoleDefinitionSpec struct {
 	// resources and identity names.
 	// +kubebuilder:validation:Optional
 	ConstrainedImpersonation *ConstrainedImpersonationSpec `json:"constrainedImpersonation,omitempty"`
+
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of the generated role with a different value: Force takes them over, Fail
+	// stops the reconcile, and Report leaves the role unchanged. Conflicts are
+	// listed in status.conflicts in every case.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Force
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RestrictedRoleDefinitionStatus defines the observed state of RestrictedRoleDefinition.
@@ -113,6 +121,22 @@ type RestrictedRoleDefinitionStatus struct {
 	// +kubebuilder:validation:Optional
 	PolicyViolations []string `json:"policyViolations,omitempty"`
 
+	// PolicyViolationSince is when the current run of policy violations was first
+	// detected. It anchors the grace period of an RBACPolicy onViolation
+	// RevokeAfter action and is cleared once the resource complies again.
+	// +kubebuilder:validation:Optional
+	PolicyViolationSince *metav1.Time `json:"policyViolationSince,omitempty"`
+
+	// Conflicts lists the generated role when its last apply conflicted with
+	// fields owned by other field managers. See spec.conflictPolicy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty"`
+
 	// Conditions defines current service state.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
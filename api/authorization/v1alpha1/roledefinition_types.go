@@ -169,6 +169,29 @@ type RoleDefinitionSpec struct {
 	// Kubernetes aggregation controller.
 	// +kubebuilder:validation:Optional
 	ConstrainedImpersonation *ConstrainedImpersonationSpec `json:"constrainedImpersonation,omitempty"`
+
+	// ImpersonationGrants correlates impersonation identities with actions. Each
+	// grant is generated as a separate Role or ClusterRole named
+	// "<targetName>-<name>", matching targetRole and targetNamespace, plus a
+	// binding of the same name to the grant's subjects. Unlike
+	// ConstrainedImpersonation, this lets different subjects impersonate
+	// different identities for different actions without the grants unioning.
+	// Because the bindings name arbitrary subjects, grants are only generated
+	// when the controller runs with --enable-impersonation-grants.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	ImpersonationGrants []ImpersonationGrant `json:"impersonationGrants,omitempty"`
+
+	// ConflictPolicy controls what happens when another field manager owns fields
+	// of a generated role, or of an impersonation grant role or binding, with a
+	// different value: Force takes them over, Fail stops the reconcile, and Report
+	// leaves the resource unchanged. Conflicts are listed in status.conflicts in
+	// every case.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Force
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // RoleDefinitionStatus defines the observed state of RoleDefinition.
@@ -182,6 +205,24 @@ type RoleDefinitionStatus struct {
 	// +kubebuilder:validation:Optional
 	RoleReconciled bool `json:"roleReconciled,omitempty"`
 
+	// ImpersonationGrants reports the RBAC generated for spec.impersonationGrants
+	// and the effective identity by action permissions of each grant.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	ImpersonationGrants []ImpersonationGrantStatus `json:"impersonationGrants,omitempty"`
+
+	// Conflicts lists generated resources whose last apply conflicted with fields
+	// owned by other field managers, truncated to the first 64. See
+	// spec.conflictPolicy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty"`
+
 	// Conditions defines current service state of the Role definition. All conditions should evaluate to true to signify successful reconciliation.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -109,6 +109,7 @@ func (v *RoleDefinitionValidator) ValidateCreate(ctx context.Context, obj *RoleD
 	}
 
 	warnings := ConstrainedImpersonationWarnings(obj.Spec.ConstrainedImpersonation, "spec.constrainedImpersonation")
+	warnings = append(warnings, ImpersonationGrantWarnings(obj.Spec.ImpersonationGrants, field.NewPath("spec", "impersonationGrants"))...)
 
 	existingRD, err := v.findRoleDefinitionTargetConflict(ctx, obj)
 	if err != nil {
@@ -186,6 +187,7 @@ func (v *RoleDefinitionValidator) ValidateUpdate(ctx context.Context, oldObj, ne
 	}
 
 	warnings := ConstrainedImpersonationWarnings(newObj.Spec.ConstrainedImpersonation, "spec.constrainedImpersonation")
+	warnings = append(warnings, ImpersonationGrantWarnings(newObj.Spec.ImpersonationGrants, field.NewPath("spec", "impersonationGrants"))...)
 
 	existingRD, err := v.findRoleDefinitionTargetConflict(ctx, newObj)
 	if err != nil {
@@ -362,6 +364,15 @@ func validateRoleDefinitionSpec(obj *RoleDefinition) error {
 		return apierrors.NewInvalid(
 			schema.GroupKind{Group: GroupVersion.Group, Kind: "RoleDefinition"}, obj.Name, errs)
 	}
+	if errs := ValidateImpersonationGrants(
+		obj.Spec.ImpersonationGrants,
+		obj.Spec.TargetName,
+		obj.Spec.TargetRole == DefinitionClusterRole,
+		field.NewPath("spec", "impersonationGrants"),
+	); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "RoleDefinition"}, obj.Name, errs)
+	}
 
 	return nil
 }
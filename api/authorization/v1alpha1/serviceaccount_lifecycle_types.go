@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MinServiceAccountIdleRevokeAfter is the shortest accepted idleRevokeAfter.
+// Usage is recorded at most every ServiceAccountUsageRecordInterval, so a
+// shorter window could revoke a ServiceAccount that is still in use.
+const MinServiceAccountIdleRevokeAfter = 2 * ServiceAccountUsageRecordInterval
+
+// ServiceAccountUsageRecordInterval is how often the webhook authorizer
+// refreshes the last-used annotation of a ServiceAccount that keeps making
+// requests.
+const ServiceAccountUsageRecordInterval = 5 * time.Minute
+
+// ServiceAccountExpiryAction selects what happens to a generated ServiceAccount
+// once its lifecycle expires.
+// +kubebuilder:validation:Enum=Unbind;Delete
+type ServiceAccountExpiryAction string
+
+// ServiceAccount expiry actions.
+const (
+	// ServiceAccountExpiryActionUnbind (the default) removes the ServiceAccount
+	// from the generated bindings and keeps the ServiceAccount object.
+	ServiceAccountExpiryActionUnbind ServiceAccountExpiryAction = "Unbind"
+
+	// ServiceAccountExpiryActionDelete removes the ServiceAccount from the
+	// generated bindings and deletes it, which also invalidates its tokens.
+	ServiceAccountExpiryActionDelete ServiceAccountExpiryAction = "Delete"
+)
+
+// ServiceAccountRevocationReason records why a generated ServiceAccount was revoked.
+type ServiceAccountRevocationReason string
+
+// ServiceAccount revocation reasons.
+const (
+	// ServiceAccountRevocationReasonTTLExpired means the ServiceAccount is older than ttl.
+	ServiceAccountRevocationReasonTTLExpired ServiceAccountRevocationReason = "TTLExpired"
+
+	// ServiceAccountRevocationReasonIdle means the ServiceAccount made no request
+	// for longer than idleRevokeAfter.
+	ServiceAccountRevocationReasonIdle ServiceAccountRevocationReason = "Idle"
+)
+
+// ServiceAccountLifecycle bounds how long ServiceAccounts generated for a
+// binding definition stay bound. It applies only to generated ServiceAccounts;
+// pre-existing (external) ServiceAccounts are never revoked.
+//
+// Idleness is measured from the authorization.t-caas.telekom.com/last-used
+// annotation on the ServiceAccount, or from its creation when the annotation is
+// missing. The webhook authorizer maintains the annotation when it runs with
+// --record-service-account-usage; other usage sources may set it as well.
+//
+// A revoked ServiceAccount stays revoked until its subject is removed from the
+// spec or the lifecycle is removed.
+// +kubebuilder:validation:XValidation:rule="has(self.ttl) || has(self.idleRevokeAfter)",message="at least one of ttl or idleRevokeAfter must be set"
+type ServiceAccountLifecycle struct {
+	// TTL is the maximum age of a generated ServiceAccount, measured from its
+	// creation timestamp.
+	// +kubebuilder:validation:Optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// IdleRevokeAfter revokes a generated ServiceAccount that has not been used
+	// for this long. Must be at least 10m.
+	// +kubebuilder:validation:Optional
+	IdleRevokeAfter *metav1.Duration `json:"idleRevokeAfter,omitempty"`
+
+	// Action selects what happens to an expired ServiceAccount. Defaults to Unbind.
+	// +kubebuilder:validation:Optional
+	Action ServiceAccountExpiryAction `json:"action,omitempty"`
+}
+
+// RevokedServiceAccount records a generated ServiceAccount whose lifecycle expired.
+type RevokedServiceAccount struct {
+	// Namespace of the ServiceAccount.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// Name of the ServiceAccount.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Reason is TTLExpired or Idle.
+	// +kubebuilder:validation:Required
+	Reason ServiceAccountRevocationReason `json:"reason"`
+
+	// RevokedAt is when the controller revoked the ServiceAccount.
+	// +kubebuilder:validation:Required
+	RevokedAt metav1.Time `json:"revokedAt"`
+}
+
+// ActionOrDefault returns the configured action, or Unbind when unset.
+func (l *ServiceAccountLifecycle) ActionOrDefault() ServiceAccountExpiryAction {
+	if l == nil || l.Action == "" {
+		return ServiceAccountExpiryActionUnbind
+	}
+	return l.Action
+}
+
+// IsEnabled reports whether a ttl or idleRevokeAfter is configured.
+func (l *ServiceAccountLifecycle) IsEnabled() bool {
+	return l != nil && (l.TTL != nil || l.IdleRevokeAfter != nil)
+}
+
+// Expiry returns when a ServiceAccount created at createdAt and last used at
+// lastUsed (zero when unknown) expires under the lifecycle, and the reason.
+// ok is false when the lifecycle is disabled.
+func (l *ServiceAccountLifecycle) Expiry(createdAt, lastUsed time.Time) (expiresAt time.Time, reason ServiceAccountRevocationReason, ok bool) {
+	if !l.IsEnabled() {
+		return time.Time{}, "", false
+	}
+	if l.TTL != nil {
+		expiresAt, reason, ok = createdAt.Add(l.TTL.Duration), ServiceAccountRevocationReasonTTLExpired, true
+	}
+	if l.IdleRevokeAfter != nil {
+		idleSince := createdAt
+		if lastUsed.After(idleSince) {
+			idleSince = lastUsed
+		}
+		if idleAt := idleSince.Add(l.IdleRevokeAfter.Duration); !ok || idleAt.Before(expiresAt) {
+			expiresAt, reason, ok = idleAt, ServiceAccountRevocationReasonIdle, true
+		}
+	}
+	return expiresAt, reason, ok
+}
+
+// ValidateServiceAccountLifecycle performs the validation of a
+// ServiceAccountLifecycle block that the CRD schema cannot express on its own.
+func ValidateServiceAccountLifecycle(lifecycle *ServiceAccountLifecycle, fldPath *field.Path) field.ErrorList {
+	if lifecycle == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if !lifecycle.IsEnabled() {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of ttl or idleRevokeAfter must be set"))
+	}
+	if lifecycle.TTL != nil && lifecycle.TTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), lifecycle.TTL.Duration.String(), "ttl must be positive"))
+	}
+	if lifecycle.IdleRevokeAfter != nil && lifecycle.IdleRevokeAfter.Duration < MinServiceAccountIdleRevokeAfter {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleRevokeAfter"), lifecycle.IdleRevokeAfter.Duration.String(),
+			"idleRevokeAfter must be at least "+MinServiceAccountIdleRevokeAfter.String()))
+	}
+	switch lifecycle.Action {
+	case "", ServiceAccountExpiryActionUnbind, ServiceAccountExpiryActionDelete:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), lifecycle.Action, []string{
+			string(ServiceAccountExpiryActionUnbind), string(ServiceAccountExpiryActionDelete),
+		}))
+	}
+	return allErrs
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateServiceAccountLifecycle(t *testing.T) {
+	t.Parallel()
+
+	hour := &metav1.Duration{Duration: time.Hour}
+	testCases := []struct {
+		name      string
+		lifecycle *ServiceAccountLifecycle
+		want      []string
+	}{
+		{name: "nil lifecycle", lifecycle: nil},
+		{name: "ttl only", lifecycle: &ServiceAccountLifecycle{TTL: hour}},
+		{name: "idle only", lifecycle: &ServiceAccountLifecycle{IdleRevokeAfter: hour, Action: ServiceAccountExpiryActionDelete}},
+		{
+			name:      "neither ttl nor idle",
+			lifecycle: &ServiceAccountLifecycle{Action: ServiceAccountExpiryActionUnbind},
+			want:      []string{"spec.serviceAccountLifecycle: Required value"},
+		},
+		{
+			name:      "non-positive ttl",
+			lifecycle: &ServiceAccountLifecycle{TTL: &metav1.Duration{}},
+			want:      []string{"spec.serviceAccountLifecycle.ttl: Invalid value", "must be positive"},
+		},
+		{
+			name:      "idle below minimum",
+			lifecycle: &ServiceAccountLifecycle{IdleRevokeAfter: &metav1.Duration{Duration: time.Minute}},
+			want:      []string{"spec.serviceAccountLifecycle.idleRevokeAfter: Invalid value", "at least 10m0s"},
+		},
+		{
+			name:      "unknown action",
+			lifecycle: &ServiceAccountLifecycle{TTL: hour, Action: "Disable"},
+			want:      []string{"spec.serviceAccountLifecycle.action", "Unsupported value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateServiceAccountLifecycle(tc.lifecycle, field.NewPath("spec", "serviceAccountLifecycle"))
+			if len(tc.want) == 0 {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			got := errs.ToAggregate().Error()
+			for _, want := range tc.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected error to contain %q, got %q", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceAccountLifecycleExpiry(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		lifecycle  *ServiceAccountLifecycle
+		lastUsed   time.Time
+		wantOK     bool
+		wantAt     time.Time
+		wantReason ServiceAccountRevocationReason
+	}{
+		{name: "disabled", lifecycle: nil},
+		{
+			name:       "ttl",
+			lifecycle:  &ServiceAccountLifecycle{TTL: &metav1.Duration{Duration: 24 * time.Hour}},
+			wantOK:     true,
+			wantAt:     created.Add(24 * time.Hour),
+			wantReason: ServiceAccountRevocationReasonTTLExpired,
+		},
+		{
+			name:       "idle from creation when never used",
+			lifecycle:  &ServiceAccountLifecycle{IdleRevokeAfter: &metav1.Duration{Duration: time.Hour}},
+			wantOK:     true,
+			wantAt:     created.Add(time.Hour),
+			wantReason: ServiceAccountRevocationReasonIdle,
+		},
+		{
+			name:       "idle from last use",
+			lifecycle:  &ServiceAccountLifecycle{IdleRevokeAfter: &metav1.Duration{Duration: time.Hour}},
+			lastUsed:   created.Add(3 * time.Hour),
+			wantOK:     true,
+			wantAt:     created.Add(4 * time.Hour),
+			wantReason: ServiceAccountRevocationReasonIdle,
+		},
+		{
+			name: "earliest of ttl and idle wins",
+			lifecycle: &ServiceAccountLifecycle{
+				TTL:             &metav1.Duration{Duration: 2 * time.Hour},
+				IdleRevokeAfter: &metav1.Duration{Duration: time.Hour},
+			},
+			lastUsed:   created.Add(90 * time.Minute),
+			wantOK:     true,
+			wantAt:     created.Add(2 * time.Hour),
+			wantReason: ServiceAccountRevocationReasonTTLExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			at, reason, ok := tc.lifecycle.Expiry(created, tc.lastUsed)
+			if ok != tc.wantOK || !at.Equal(tc.wantAt) || reason != tc.wantReason {
+				t.Errorf("Expiry() = (%v, %q, %v), want (%v, %q, %v)", at, reason, ok, tc.wantAt, tc.wantReason, tc.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ViolationAction selects how restricted resources governed by an RBACPolicy
+// react when they stop complying with it.
+// +kubebuilder:validation:Enum=Revoke;RevokeAfter;Freeze
+type ViolationAction string
+
+// Violation actions for restricted resources.
+const (
+	// ViolationActionRevoke (the default) deprovisions all managed RBAC as soon
+	// as a violation is detected.
+	ViolationActionRevoke ViolationAction = "Revoke"
+
+	// ViolationActionRevokeAfter keeps the last compliant RBAC for the configured
+	// grace period while a RevocationPending condition and warning events give
+	// owners time to fix their spec, then deprovisions like Revoke.
+	ViolationActionRevokeAfter ViolationAction = "RevokeAfter"
+
+	// ViolationActionFreeze keeps the last compliant RBAC indefinitely but applies
+	// no new changes until the resource complies again.
+	ViolationActionFreeze ViolationAction = "Freeze"
+)
+
+// ViolationPolicy controls what happens to the RBAC managed by restricted
+// resources when they violate their RBACPolicy, for example after the policy
+// was tightened.
+// +kubebuilder:validation:XValidation:rule="self.action != 'RevokeAfter' || has(self.gracePeriod)",message="gracePeriod must be set when action is RevokeAfter"
+// +kubebuilder:validation:XValidation:rule="self.action == 'RevokeAfter' || !has(self.gracePeriod)",message="gracePeriod is only supported when action is RevokeAfter"
+type ViolationPolicy struct {
+	// Action selects how violations are handled.
+	// +kubebuilder:validation:Required
+	Action ViolationAction `json:"action"`
+
+	// GracePeriod is how long the last compliant RBAC is kept after a violation
+	// is first detected when Action is RevokeAfter.
+	// +kubebuilder:validation:Optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// ActionOrDefault returns the configured action, or Revoke when no policy is set.
+func (p *ViolationPolicy) ActionOrDefault() ViolationAction {
+	if p == nil || p.Action == "" {
+		return ViolationActionRevoke
+	}
+	return p.Action
+}
+
+// RevocationDelay returns how long after a violation is first detected the
+// managed RBAC is revoked. ok is false when the policy never revokes (Freeze).
+func (p *ViolationPolicy) RevocationDelay() (delay time.Duration, ok bool) {
+	switch p.ActionOrDefault() {
+	case ViolationActionFreeze:
+		return 0, false
+	case ViolationActionRevokeAfter:
+		if p.GracePeriod == nil {
+			return 0, true
+		}
+		return p.GracePeriod.Duration, true
+	default:
+		return 0, true
+	}
+}
+
+// ValidateViolationPolicy performs the semantic validation that CEL cannot
+// express, such as a positive grace period.
+func ValidateViolationPolicy(policy *ViolationPolicy, fldPath *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	switch policy.Action {
+	case ViolationActionRevoke, ViolationActionRevokeAfter, ViolationActionFreeze:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), policy.Action, []string{
+			string(ViolationActionRevoke), string(ViolationActionRevokeAfter), string(ViolationActionFreeze),
+		}))
+	}
+
+	gracePath := fldPath.Child("gracePeriod")
+	switch {
+	case policy.Action == ViolationActionRevokeAfter && policy.GracePeriod == nil:
+		allErrs = append(allErrs, field.Required(gracePath, "gracePeriod must be set when action is RevokeAfter"))
+	case policy.Action != ViolationActionRevokeAfter && policy.GracePeriod != nil:
+		allErrs = append(allErrs, field.Forbidden(gracePath, "gracePeriod is only supported when action is RevokeAfter"))
+	case policy.GracePeriod != nil && policy.GracePeriod.Duration <= 0:
+		allErrs = append(allErrs, field.Invalid(gracePath, policy.GracePeriod.Duration.String(), "gracePeriod must be positive"))
+	}
+
+	return allErrs
+}
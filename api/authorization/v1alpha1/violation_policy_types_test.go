@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateViolationPolicy(t *testing.T) {
+	t.Parallel()
+
+	hour := &metav1.Duration{Duration: time.Hour}
+	testCases := []struct {
+		name   string
+		policy *ViolationPolicy
+		want   []string
+	}{
+		{name: "nil policy", policy: nil},
+		{name: "revoke", policy: &ViolationPolicy{Action: ViolationActionRevoke}},
+		{name: "revoke after", policy: &ViolationPolicy{Action: ViolationActionRevokeAfter, GracePeriod: hour}},
+		{name: "freeze", policy: &ViolationPolicy{Action: ViolationActionFreeze}},
+		{
+			name:   "unknown action",
+			policy: &ViolationPolicy{Action: "Ignore"},
+			want:   []string{"spec.onViolation.action", "Unsupported value"},
+		},
+		{
+			name:   "revoke after without grace period",
+			policy: &ViolationPolicy{Action: ViolationActionRevokeAfter},
+			want:   []string{"spec.onViolation.gracePeriod: Required value"},
+		},
+		{
+			name:   "grace period on freeze",
+			policy: &ViolationPolicy{Action: ViolationActionFreeze, GracePeriod: hour},
+			want:   []string{"spec.onViolation.gracePeriod: Forbidden"},
+		},
+		{
+			name:   "non-positive grace period",
+			policy: &ViolationPolicy{Action: ViolationActionRevokeAfter, GracePeriod: &metav1.Duration{}},
+			want:   []string{"spec.onViolation.gracePeriod: Invalid value", "must be positive"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateViolationPolicy(tc.policy, field.NewPath("spec", "onViolation"))
+			if len(tc.want) == 0 {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			got := errs.ToAggregate().Error()
+			for _, want := range tc.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected error to contain %q, got %q", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestViolationPolicyRevocationDelay(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		policy      *ViolationPolicy
+		wantDelay   time.Duration
+		wantRevokes bool
+	}{
+		{name: "nil defaults to revoke", policy: nil, wantRevokes: true},
+		{name: "revoke", policy: &ViolationPolicy{Action: ViolationActionRevoke}, wantRevokes: true},
+		{
+			name:        "revoke after",
+			policy:      &ViolationPolicy{Action: ViolationActionRevokeAfter, GracePeriod: &metav1.Duration{Duration: time.Hour}},
+			wantDelay:   time.Hour,
+			wantRevokes: true,
+		},
+		{name: "freeze", policy: &ViolationPolicy{Action: ViolationActionFreeze}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			delay, revokes := tc.policy.RevocationDelay()
+			if delay != tc.wantDelay || revokes != tc.wantRevokes {
+				t.Fatalf("RevocationDelay() = (%s, %t), want (%s, %t)", delay, revokes, tc.wantDelay, tc.wantRevokes)
+			}
+		})
+	}
+}
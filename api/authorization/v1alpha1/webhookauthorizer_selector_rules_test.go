@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateWebhookAuthorizerSelectorRules(t *testing.T) {
+	t.Parallel()
+
+	nodePods := func() SelectorResourceRule {
+		return SelectorResourceRule{
+			Verbs:     []string{"list", "watch"},
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			FieldSelector: []SelectorRequirement{{
+				Key:             "spec.nodeName",
+				Operator:        SelectorOperatorIn,
+				ValuesFromExtra: "authentication.kubernetes.io/node-name",
+			}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		mutate  func(r *SelectorResourceRule)
+		wantErr string
+	}{
+		{name: "valid field selector rule", mutate: func(*SelectorResourceRule) {}},
+		{
+			name: "valid label selector rule",
+			mutate: func(r *SelectorResourceRule) {
+				r.FieldSelector = nil
+				r.LabelSelector = []SelectorRequirement{
+					{Key: "team", Operator: SelectorOperatorIn, Values: []string{"x"}},
+					{Key: "quarantined", Operator: SelectorOperatorDoesNotExist},
+				}
+			},
+		},
+		{
+			name:    "missing verbs",
+			mutate:  func(r *SelectorResourceRule) { r.Verbs = nil },
+			wantErr: "spec.selectorRules[0] must have at least one verb",
+		},
+		{
+			name:    "missing resources",
+			mutate:  func(r *SelectorResourceRule) { r.Resources = nil },
+			wantErr: "spec.selectorRules[0] must have at least one resource",
+		},
+		{
+			name:    "no requirements",
+			mutate:  func(r *SelectorResourceRule) { r.FieldSelector = nil },
+			wantErr: "at least one fieldSelector or labelSelector requirement",
+		},
+		{
+			name: "In without values",
+			mutate: func(r *SelectorResourceRule) {
+				r.FieldSelector[0].ValuesFromExtra = ""
+			},
+			wantErr: "spec.selectorRules[0].fieldSelector[0]: operator In requires values or valuesFromExtra",
+		},
+		{
+			name: "Exists with values",
+			mutate: func(r *SelectorResourceRule) {
+				r.LabelSelector = []SelectorRequirement{{Key: "team", Operator: SelectorOperatorExists, Values: []string{"x"}}}
+			},
+			wantErr: "spec.selectorRules[0].labelSelector[0]: operator Exists must not have values",
+		},
+		{
+			name: "unsupported operator",
+			mutate: func(r *SelectorResourceRule) {
+				r.FieldSelector[0].Operator = "Gt"
+			},
+			wantErr: `unsupported operator "Gt"`,
+		},
+		{
+			name:    "empty key",
+			mutate:  func(r *SelectorResourceRule) { r.FieldSelector[0].Key = "" },
+			wantErr: "spec.selectorRules[0].fieldSelector[0].key must not be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule := nodePods()
+			tc.mutate(&rule)
+			wa := &WebhookAuthorizer{Spec: WebhookAuthorizerSpec{SelectorRules: []SelectorResourceRule{rule}}}
+
+			err := validateWebhookAuthorizerRules(wa)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected a selector-only authorizer to be valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
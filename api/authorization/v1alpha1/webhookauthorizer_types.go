@@ -72,8 +72,107 @@ type Principal struct {
 	Extra []PrincipalExtraMatch `json:"extra,omitempty"`
 }
 
+// SelectorOperator is the operator of a SelectorRequirement.
+// +kubebuilder:validation:Enum=In;NotIn;Exists;DoesNotExist
+type SelectorOperator string
+
+// Selector requirement operators. They mirror the operators the API server uses
+// for the parsed selectors in SubjectAccessReview resourceAttributes.
+const (
+	// SelectorOperatorIn requires the request to select only listed values.
+	SelectorOperatorIn SelectorOperator = "In"
+	// SelectorOperatorNotIn requires the request to exclude every listed value.
+	SelectorOperatorNotIn SelectorOperator = "NotIn"
+	// SelectorOperatorExists requires the request to select objects that have the key.
+	SelectorOperatorExists SelectorOperator = "Exists"
+	// SelectorOperatorDoesNotExist requires the request to select objects without the key.
+	SelectorOperatorDoesNotExist SelectorOperator = "DoesNotExist"
+)
+
+// SelectorRequirement is a requirement that the field or label selector of a
+// request must imply for a SelectorResourceRule to match. A request selecting
+// spec.nodeName=node-a implies "spec.nodeName In [node-a, node-b]"; a request
+// without any selector on the key implies nothing.
+// +kubebuilder:validation:XValidation:rule="self.operator in ['In', 'NotIn'] ? (has(self.values) && size(self.values) > 0) || (has(self.valuesFromExtra) && size(self.valuesFromExtra) > 0) : !has(self.values) && !has(self.valuesFromExtra)",message="In and NotIn require values or valuesFromExtra; Exists and DoesNotExist take neither"
+type SelectorRequirement struct {
+	// Key is the field path or label key, e.g. spec.nodeName or team.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	Key string `json:"key"`
+
+	// Operator is In, NotIn, Exists or DoesNotExist.
+	// +kubebuilder:validation:Required
+	Operator SelectorOperator `json:"operator"`
+
+	// Values are the accepted (In) or excluded (NotIn) values.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=253
+	Values []string `json:"values,omitempty"`
+
+	// ValuesFromExtra names a SubjectAccessReview spec.extra key whose values are
+	// added to Values for the request being evaluated. With
+	// authentication.kubernetes.io/node-name a node agent may only select
+	// objects on its own node.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	ValuesFromExtra string `json:"valuesFromExtra,omitempty"`
+}
+
+// SelectorResourceRule is a resource rule that only matches requests whose
+// field and label selectors imply every listed requirement, e.g. "list pods
+// only with spec.nodeName=<own node>".
+//
+// The selectors are only sent by API servers with the AuthorizeWithSelectors
+// feature (Kubernetes 1.31+, on by default from 1.32). Without it requests carry
+// no selectors and these rules never match.
+// +kubebuilder:validation:XValidation:rule="(has(self.fieldSelector) && size(self.fieldSelector) > 0) || (has(self.labelSelector) && size(self.labelSelector) > 0)",message="a selector rule must specify at least one fieldSelector or labelSelector requirement"
+type SelectorResourceRule struct {
+	// Verbs is a list of kubernetes resource API verbs, like get, list, watch.
+	// "*" means all.
+	// +kubebuilder:validation:MinItems=1
+	Verbs []string `json:"verbs"`
+
+	// APIGroups is the name of the APIGroup that contains the resources. "*"
+	// means all.
+	// +kubebuilder:validation:Optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+
+	// Resources is a list of resources this rule applies to. "*" means all in
+	// the specified apiGroups, "*/foo" represents the subresource 'foo' for all
+	// resources in the specified apiGroups.
+	// +kubebuilder:validation:Optional
+	Resources []string `json:"resources,omitempty"`
+
+	// ResourceNames is an optional allow list of names that the rule applies
+	// to. "*" means all.
+	// +kubebuilder:validation:Optional
+	ResourceNames []string `json:"resourceNames,omitempty"`
+
+	// FieldSelector lists requirements the request's field selector must imply.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	FieldSelector []SelectorRequirement `json:"fieldSelector,omitempty"`
+
+	// LabelSelector lists requirements the request's label selector must imply.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	LabelSelector []SelectorRequirement `json:"labelSelector,omitempty"`
+}
+
+// ResourceRule returns the verb, group, resource and name part of the rule.
+func (r *SelectorResourceRule) ResourceRule() authzv1.ResourceRule {
+	return authzv1.ResourceRule{
+		Verbs:         r.Verbs,
+		APIGroups:     r.APIGroups,
+		Resources:     r.Resources,
+		ResourceNames: r.ResourceNames,
+	}
+}
+
 // WebhookAuthorizerSpec defines the desired state of WebhookAuthorizer.
-// +kubebuilder:validation:XValidation:rule="(has(self.resourceRules) && size(self.resourceRules) > 0) || (has(self.nonResourceRules) && size(self.nonResourceRules) > 0)",message="at least one resourceRules or nonResourceRules must be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.resourceRules) && size(self.resourceRules) > 0) || (has(self.nonResourceRules) && size(self.nonResourceRules) > 0) || (has(self.selectorRules) && size(self.selectorRules) > 0)",message="at least one resourceRules, selectorRules or nonResourceRules must be specified"
 // +kubebuilder:validation:XValidation:rule="(has(self.allowedPrincipals) && size(self.allowedPrincipals) > 0) || (has(self.deniedPrincipals) && size(self.deniedPrincipals) > 0)",message="at least one allowedPrincipals or deniedPrincipals must be specified"
 type WebhookAuthorizerSpec struct {
 	// Resources which will be used to evaluate the SubjectAccessReviewSpec.ResourceAttributes
@@ -81,6 +180,13 @@ type WebhookAuthorizerSpec struct {
 	// +kubebuilder:validation:MaxItems=64
 	ResourceRules []authzv1.ResourceRule `json:"resourceRules,omitempty"`
 
+	// SelectorRules are resource rules that additionally constrain the field and
+	// label selectors of list, watch and deletecollection requests. They are
+	// evaluated after ResourceRules.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	SelectorRules []SelectorResourceRule `json:"selectorRules,omitempty"`
+
 	// Resources which will be used to evaluate the SubjectAccessReviewSpec.NonResourceAttributes
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=64
@@ -92,7 +198,7 @@ type WebhookAuthorizerSpec struct {
 	AllowedPrincipals []Principal `json:"allowedPrincipals,omitempty"`
 
 	// DeniedPrincipals is a slice of principals this authorizer should deny
-	// when the request also matches ResourceRules or NonResourceRules.
+	// when the request also matches ResourceRules, SelectorRules or NonResourceRules.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=256
 	DeniedPrincipals []Principal `json:"deniedPrincipals,omitempty"`
@@ -72,10 +72,10 @@ func ValidateWebhookAuthorizer(wa *WebhookAuthorizer) (admission.Warnings, error
 }
 
 func validateWebhookAuthorizerRules(wa *WebhookAuthorizer) error {
-	// At least one of resourceRules or nonResourceRules must be defined.
-	if len(wa.Spec.ResourceRules) == 0 && len(wa.Spec.NonResourceRules) == 0 {
+	// At least one of resourceRules, selectorRules or nonResourceRules must be defined.
+	if len(wa.Spec.ResourceRules) == 0 && len(wa.Spec.SelectorRules) == 0 && len(wa.Spec.NonResourceRules) == 0 {
 		return apierrors.NewBadRequest(
-			"at least one of spec.resourceRules or spec.nonResourceRules must be non-empty")
+			"at least one of spec.resourceRules, spec.selectorRules or spec.nonResourceRules must be non-empty")
 	}
 	if !isLabelSelectorEmpty(&wa.Spec.NamespaceSelector) && len(wa.Spec.NonResourceRules) > 0 {
 		return apierrors.NewBadRequest(
@@ -98,6 +98,12 @@ func validateWebhookAuthorizerRules(wa *WebhookAuthorizer) error {
 		}
 	}
 
+	for i := range wa.Spec.SelectorRules {
+		if err := validateSelectorResourceRule(i, &wa.Spec.SelectorRules[i]); err != nil {
+			return err
+		}
+	}
+
 	// Validate each nonResourceRule has at least one verb and one URL path.
 	for i, rule := range wa.Spec.NonResourceRules {
 		if len(rule.Verbs) == 0 {
@@ -113,6 +119,57 @@ func validateWebhookAuthorizerRules(wa *WebhookAuthorizer) error {
 	return nil
 }
 
+// validateSelectorResourceRule mirrors the resourceRules checks and validates
+// the selector requirements, which the CRD schema only partially covers.
+func validateSelectorResourceRule(i int, rule *SelectorResourceRule) error {
+	if len(rule.Verbs) == 0 {
+		return apierrors.NewBadRequest(
+			fmt.Sprintf("spec.selectorRules[%d] must have at least one verb", i))
+	}
+	if len(rule.APIGroups) == 0 {
+		return apierrors.NewBadRequest(
+			fmt.Sprintf("spec.selectorRules[%d] must have at least one apiGroup (use \"\" for core API group)", i))
+	}
+	if len(rule.Resources) == 0 {
+		return apierrors.NewBadRequest(
+			fmt.Sprintf("spec.selectorRules[%d] must have at least one resource", i))
+	}
+	if len(rule.FieldSelector) == 0 && len(rule.LabelSelector) == 0 {
+		return apierrors.NewBadRequest(
+			fmt.Sprintf("spec.selectorRules[%d] must have at least one fieldSelector or labelSelector requirement", i))
+	}
+	for _, sel := range []struct {
+		field string
+		reqs  []SelectorRequirement
+	}{
+		{"fieldSelector", rule.FieldSelector},
+		{"labelSelector", rule.LabelSelector},
+	} {
+		for j, req := range sel.reqs {
+			path := fmt.Sprintf("spec.selectorRules[%d].%s[%d]", i, sel.field, j)
+			if req.Key == "" {
+				return apierrors.NewBadRequest(path + ".key must not be empty")
+			}
+			switch req.Operator {
+			case SelectorOperatorIn, SelectorOperatorNotIn:
+				if len(req.Values) == 0 && req.ValuesFromExtra == "" {
+					return apierrors.NewBadRequest(
+						fmt.Sprintf("%s: operator %s requires values or valuesFromExtra", path, req.Operator))
+				}
+			case SelectorOperatorExists, SelectorOperatorDoesNotExist:
+				if len(req.Values) > 0 || req.ValuesFromExtra != "" {
+					return apierrors.NewBadRequest(
+						fmt.Sprintf("%s: operator %s must not have values or valuesFromExtra", path, req.Operator))
+				}
+			default:
+				return apierrors.NewBadRequest(
+					fmt.Sprintf("%s: unsupported operator %q", path, req.Operator))
+			}
+		}
+	}
+	return nil
+}
+
 func validateWebhookAuthorizerPrincipals(wa *WebhookAuthorizer) (admission.Warnings, error) {
 	var warnings admission.Warnings
 
@@ -76,7 +76,7 @@ var _ = Describe("WebhookAuthorizer CEL Validation", func() {
 			}
 			err := k8sClient.Create(ctx, wa)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("at least one resourceRules or nonResourceRules must be specified"))
+			Expect(err.Error()).To(ContainSubstring("at least one resourceRules, selectorRules or nonResourceRules must be specified"))
 		})
 
 		It("Should deny a WebhookAuthorizer without allowedPrincipals or deniedPrincipals", func() {
@@ -106,7 +106,7 @@ var _ = Describe("WebhookAuthorizer CEL Validation", func() {
 			}
 			err := k8sClient.Create(ctx, wa)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("at least one resourceRules or nonResourceRules must be specified"))
+			Expect(err.Error()).To(ContainSubstring("at least one resourceRules, selectorRules or nonResourceRules must be specified"))
 		})
 
 		It("Should admit a WebhookAuthorizer with only nonResourceRules", func() {
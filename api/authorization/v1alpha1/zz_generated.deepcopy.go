@@ -27,6 +27,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionEnforcement) DeepCopyInto(out *AdmissionEnforcement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionEnforcement.
+func (in *AdmissionEnforcement) DeepCopy() *AdmissionEnforcement {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionEnforcement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BindDefinition) DeepCopyInto(out *BindDefinition) {
 	*out = *in
@@ -107,6 +122,16 @@ func (in *BindDefinitionSpec) DeepCopyInto(out *BindDefinitionSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NamespaceTermination != nil {
+		in, out := &in.NamespaceTermination, &out.NamespaceTermination
+		*out = new(NamespaceTerminationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountLifecycle != nil {
+		in, out := &in.ServiceAccountLifecycle, &out.ServiceAccountLifecycle
+		*out = new(ServiceAccountLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindDefinitionSpec.
@@ -137,6 +162,20 @@ func (in *BindDefinitionStatus) DeepCopyInto(out *BindDefinitionStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RevokedServiceAccounts != nil {
+		in, out := &in.RevokedServiceAccounts, &out.RevokedServiceAccounts
+		*out = make([]RevokedServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -186,6 +225,21 @@ func (in *BindingLimits) DeepCopy() *BindingLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityStatus) DeepCopyInto(out *CapabilityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityStatus.
+func (in *CapabilityStatus) DeepCopy() *CapabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterBinding) DeepCopyInto(out *ClusterBinding) {
 	*out = *in
@@ -300,6 +354,49 @@ func (in *DefaultPolicyAssignment) DeepCopy() *DefaultPolicyAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DegradedGroupVersion) DeepCopyInto(out *DegradedGroupVersion) {
+	*out = *in
+	if in.FailingSince != nil {
+		in, out := &in.FailingSince, &out.FailingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DegradedGroupVersion.
+func (in *DegradedGroupVersion) DeepCopy() *DegradedGroupVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(DegradedGroupVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldManagerConflict) DeepCopyInto(out *FieldManagerConflict) {
+	*out = *in
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldManagerConflict.
+func (in *FieldManagerConflict) DeepCopy() *FieldManagerConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldManagerConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImpersonationActionRule) DeepCopyInto(out *ImpersonationActionRule) {
 	*out = *in
@@ -367,6 +464,97 @@ func (in *ImpersonationConfig) DeepCopy() *ImpersonationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationExposureReport) DeepCopyInto(out *ImpersonationExposureReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationExposureReport.
+func (in *ImpersonationExposureReport) DeepCopy() *ImpersonationExposureReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationExposureReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImpersonationExposureReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationExposureReportList) DeepCopyInto(out *ImpersonationExposureReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImpersonationExposureReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationExposureReportList.
+func (in *ImpersonationExposureReportList) DeepCopy() *ImpersonationExposureReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationExposureReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImpersonationExposureReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationExposureReportStatus) DeepCopyInto(out *ImpersonationExposureReportStatus) {
+	*out = *in
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]LegacyImpersonationFinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationExposureReportStatus.
+func (in *ImpersonationExposureReportStatus) DeepCopy() *ImpersonationExposureReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationExposureReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImpersonationExtra) DeepCopyInto(out *ImpersonationExtra) {
 	*out = *in
@@ -387,6 +575,47 @@ func (in *ImpersonationExtra) DeepCopy() *ImpersonationExtra {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationGrant) DeepCopyInto(out *ImpersonationGrant) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]rbacv1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	in.Grant.DeepCopyInto(&out.Grant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationGrant.
+func (in *ImpersonationGrant) DeepCopy() *ImpersonationGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationGrantStatus) DeepCopyInto(out *ImpersonationGrantStatus) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationGrantStatus.
+func (in *ImpersonationGrantStatus) DeepCopy() *ImpersonationGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImpersonationIdentityRule) DeepCopyInto(out *ImpersonationIdentityRule) {
 	*out = *in
@@ -407,6 +636,49 @@ func (in *ImpersonationIdentityRule) DeepCopy() *ImpersonationIdentityRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyImpersonationFinding) DeepCopyInto(out *LegacyImpersonationFinding) {
+	*out = *in
+	out.Subject = in.Subject
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]LegacyImpersonationGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyImpersonationFinding.
+func (in *LegacyImpersonationFinding) DeepCopy() *LegacyImpersonationFinding {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyImpersonationFinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyImpersonationGrant) DeepCopyInto(out *LegacyImpersonationGrant) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyImpersonationGrant.
+func (in *LegacyImpersonationGrant) DeepCopy() *LegacyImpersonationGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyImpersonationGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NameMatchLimits) DeepCopyInto(out *NameMatchLimits) {
 	*out = *in
@@ -519,6 +791,127 @@ func (in *NamespaceLimits) DeepCopy() *NamespaceLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTerminationPolicy) DeepCopyInto(out *NamespaceTerminationPolicy) {
+	*out = *in
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTerminationPolicy.
+func (in *NamespaceTerminationPolicy) DeepCopy() *NamespaceTerminationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTerminationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorCapabilities) DeepCopyInto(out *OperatorCapabilities) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorCapabilities.
+func (in *OperatorCapabilities) DeepCopy() *OperatorCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorCapabilities) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorCapabilitiesList) DeepCopyInto(out *OperatorCapabilitiesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorCapabilities, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorCapabilitiesList.
+func (in *OperatorCapabilitiesList) DeepCopy() *OperatorCapabilitiesList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorCapabilitiesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorCapabilitiesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorCapabilitiesStatus) DeepCopyInto(out *OperatorCapabilitiesStatus) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]CapabilityStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DegradedGroupVersions != nil {
+		in, out := &in.DegradedGroupVersions, &out.DegradedGroupVersions
+		*out = make([]DegradedGroupVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorCapabilitiesStatus.
+func (in *OperatorCapabilitiesStatus) DeepCopy() *OperatorCapabilitiesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorCapabilitiesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParsedImpersonationVerb) DeepCopyInto(out *ParsedImpersonationVerb) {
 	*out = *in
@@ -534,6 +927,76 @@ func (in *ParsedImpersonationVerb) DeepCopy() *ParsedImpersonationVerb {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBudgetUsage) DeepCopyInto(out *PolicyBudgetUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBudgetUsage.
+func (in *PolicyBudgetUsage) DeepCopy() *PolicyBudgetUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBudgetUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBudgets) DeepCopyInto(out *PolicyBudgets) {
+	*out = *in
+	if in.MaxRestrictedBindDefinitions != nil {
+		in, out := &in.MaxRestrictedBindDefinitions, &out.MaxRestrictedBindDefinitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRoleBindings != nil {
+		in, out := &in.MaxRoleBindings, &out.MaxRoleBindings
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxServiceAccounts != nil {
+		in, out := &in.MaxServiceAccounts, &out.MaxServiceAccounts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBudgets.
+func (in *PolicyBudgets) DeepCopy() *PolicyBudgets {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBudgets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyDelegation) DeepCopyInto(out *PolicyDelegation) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]SARef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyDelegation.
+func (in *PolicyDelegation) DeepCopy() *PolicyDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyScope) DeepCopyInto(out *PolicyScope) {
 	*out = *in
@@ -709,6 +1172,31 @@ func (in *RBACPolicySpec) DeepCopyInto(out *RBACPolicySpec) {
 		*out = new(ImpersonationConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ParentPolicyRef != nil {
+		in, out := &in.ParentPolicyRef, &out.ParentPolicyRef
+		*out = new(RBACPolicyReference)
+		**out = **in
+	}
+	if in.Delegation != nil {
+		in, out := &in.Delegation, &out.Delegation
+		*out = new(PolicyDelegation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnViolation != nil {
+		in, out := &in.OnViolation, &out.OnViolation
+		*out = new(ViolationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budgets != nil {
+		in, out := &in.Budgets, &out.Budgets
+		*out = new(PolicyBudgets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionEnforcement != nil {
+		in, out := &in.AdmissionEnforcement, &out.AdmissionEnforcement
+		*out = new(AdmissionEnforcement)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACPolicySpec.
@@ -724,6 +1212,16 @@ func (in *RBACPolicySpec) DeepCopy() *RBACPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RBACPolicyStatus) DeepCopyInto(out *RBACPolicyStatus) {
 	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(PolicyBudgetUsage)
+		**out = **in
+	}
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = make([]RoleOffering, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -873,6 +1371,11 @@ func (in *RestrictedBindDefinitionSpec) DeepCopyInto(out *RestrictedBindDefiniti
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ServiceAccountLifecycle != nil {
+		in, out := &in.ServiceAccountLifecycle, &out.ServiceAccountLifecycle
+		*out = new(ServiceAccountLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestrictedBindDefinitionSpec.
@@ -908,11 +1411,29 @@ func (in *RestrictedBindDefinitionStatus) DeepCopyInto(out *RestrictedBindDefini
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RevokedServiceAccounts != nil {
+		in, out := &in.RevokedServiceAccounts, &out.RevokedServiceAccounts
+		*out = make([]RevokedServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PolicyViolations != nil {
 		in, out := &in.PolicyViolations, &out.PolicyViolations
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyViolationSince != nil {
+		in, out := &in.PolicyViolationSince, &out.PolicyViolationSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1039,6 +1560,17 @@ func (in *RestrictedRoleDefinitionStatus) DeepCopyInto(out *RestrictedRoleDefini
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyViolationSince != nil {
+		in, out := &in.PolicyViolationSince, &out.PolicyViolationSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1058,6 +1590,22 @@ func (in *RestrictedRoleDefinitionStatus) DeepCopy() *RestrictedRoleDefinitionSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevokedServiceAccount) DeepCopyInto(out *RevokedServiceAccount) {
+	*out = *in
+	in.RevokedAt.DeepCopyInto(&out.RevokedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevokedServiceAccount.
+func (in *RevokedServiceAccount) DeepCopy() *RevokedServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(RevokedServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleDefinition) DeepCopyInto(out *RoleDefinition) {
 	*out = *in
@@ -1156,6 +1704,13 @@ func (in *RoleDefinitionSpec) DeepCopyInto(out *RoleDefinitionSpec) {
 		*out = new(ConstrainedImpersonationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImpersonationGrants != nil {
+		in, out := &in.ImpersonationGrants, &out.ImpersonationGrants
+		*out = make([]ImpersonationGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleDefinitionSpec.
@@ -1171,6 +1726,20 @@ func (in *RoleDefinitionSpec) DeepCopy() *RoleDefinitionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleDefinitionStatus) DeepCopyInto(out *RoleDefinitionStatus) {
 	*out = *in
+	if in.ImpersonationGrants != nil {
+		in, out := &in.ImpersonationGrants, &out.ImpersonationGrants
+		*out = make([]ImpersonationGrantStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1237,6 +1806,21 @@ func (in *RoleLimits) DeepCopy() *RoleLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleOffering) DeepCopyInto(out *RoleOffering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleOffering.
+func (in *RoleOffering) DeepCopy() *RoleOffering {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleOffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleRefLimits) DeepCopyInto(out *RoleRefLimits) {
 	*out = *in
@@ -1317,6 +1901,100 @@ func (in *SARef) DeepCopy() *SARef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorRequirement) DeepCopyInto(out *SelectorRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorRequirement.
+func (in *SelectorRequirement) DeepCopy() *SelectorRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorResourceRule) DeepCopyInto(out *SelectorResourceRule) {
+	*out = *in
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceNames != nil {
+		in, out := &in.ResourceNames, &out.ResourceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FieldSelector != nil {
+		in, out := &in.FieldSelector, &out.FieldSelector
+		*out = make([]SelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = make([]SelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorResourceRule.
+func (in *SelectorResourceRule) DeepCopy() *SelectorResourceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorResourceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountLifecycle) DeepCopyInto(out *ServiceAccountLifecycle) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IdleRevokeAfter != nil {
+		in, out := &in.IdleRevokeAfter, &out.IdleRevokeAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountLifecycle.
+func (in *ServiceAccountLifecycle) DeepCopy() *ServiceAccountLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountLimits) DeepCopyInto(out *ServiceAccountLimits) {
 	*out = *in
@@ -1392,6 +2070,26 @@ func (in *SubjectLimits) DeepCopy() *SubjectLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ViolationPolicy) DeepCopyInto(out *ViolationPolicy) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViolationPolicy.
+func (in *ViolationPolicy) DeepCopy() *ViolationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ViolationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookAuthorizer) DeepCopyInto(out *WebhookAuthorizer) {
 	*out = *in
@@ -1461,6 +2159,13 @@ func (in *WebhookAuthorizerSpec) DeepCopyInto(out *WebhookAuthorizerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SelectorRules != nil {
+		in, out := &in.SelectorRules, &out.SelectorRules
+		*out = make([]SelectorResourceRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NonResourceRules != nil {
 		in, out := &in.NonResourceRules, &out.NonResourceRules
 		*out = make([]authorizationv1.NonResourceRule, len(*in))
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+// SPDX-License-Identifier: Apache-2.0
+
+package authoperator_test
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestControllerProfileRendering(t *testing.T) {
+	if _, err := exec.LookPath("helm"); err != nil {
+		t.Skipf("helm not installed: %v", err)
+	}
+
+	defaultRender := helmTemplate(t)
+	assertContains(t, defaultRender, "--profile=all")
+	assertContains(t, defaultRender, "- validatingadmissionpolicies")
+	assertContains(t, defaultRender, "- webhookauthorizers/status")
+
+	rolesRender := helmTemplate(t, "--set", "controller.profile=roles-only")
+	assertContains(t, rolesRender, "--profile=roles-only")
+	assertContains(t, rolesRender, "- roledefinitions/status")
+	assertNotContains(t, rolesRender, "- binddefinitions/status")
+	assertNotContains(t, rolesRender, "- validatingadmissionpolicies")
+	assertNotContains(t, rolesRender, "- namespaces/status")
+
+	controllersRender := helmTemplate(t, "--set", "controller.controllers={webhookauthorizer}")
+	assertContains(t, controllersRender, "--controllers=webhookauthorizer")
+	assertNotContains(t, controllersRender, "- escalate")
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+// SPDX-License-Identifier: Apache-2.0
+
+package authoperator_test
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestOperatorConfigRendering(t *testing.T) {
+	if _, err := exec.LookPath("helm"); err != nil {
+		t.Skipf("helm not installed: %v", err)
+	}
+
+	defaultRender := helmTemplate(t)
+	assertNotContains(t, defaultRender, "--config=")
+	assertNotContains(t, defaultRender, "kind: OperatorConfig")
+
+	configRender := helmTemplate(t,
+		"--set", "operatorConfig.enabled=true",
+		"--set", "webhookServer.authorizeRateLimit=50")
+	assertContains(t, configRender, "--config=/etc/auth-operator/config.yaml")
+	assertContains(t, configRender, "kind: OperatorConfig")
+	assertContains(t, configRender, "rateLimit: 50")
+	assertNotContains(t, configRender, "--roledefinition-concurrency=")
+	assertNotContains(t, configRender, "--authorize-rate-limit=")
+	assertNotContains(t, configRender, "--verbosity=")
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+// SPDX-License-Identifier: Apache-2.0
+
+package authoperator_test
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestOTLPMetricsRendering(t *testing.T) {
+	if _, err := exec.LookPath("helm"); err != nil {
+		t.Skipf("helm not installed: %v", err)
+	}
+
+	defaultRender := helmTemplate(t)
+	assertNotContains(t, defaultRender, "--otlp-metrics-enabled")
+
+	flagRender := helmTemplate(t,
+		"--set", "metrics.otlp.enabled=true",
+		"--set", "metrics.otlp.endpoint=otel-collector:4317",
+		"--set", "metrics.otlp.interval=30s")
+	assertContains(t, flagRender, "--otlp-metrics-enabled")
+	assertContains(t, flagRender, "--otlp-metrics-endpoint=otel-collector:4317")
+	assertContains(t, flagRender, "--otlp-metrics-interval=30s")
+	assertNotContains(t, flagRender, "--otlp-metrics-insecure")
+
+	configRender := helmTemplate(t,
+		"--set", "operatorConfig.enabled=true",
+		"--set", "metrics.otlp.enabled=true",
+		"--set", "metrics.otlp.endpoint=otel-collector:4317")
+	assertContains(t, configRender, `endpoint: "otel-collector:4317"`)
+	assertContains(t, configRender, `interval: "1m"`)
+	assertNotContains(t, configRender, "--otlp-metrics-enabled")
+}
@@ -143,3 +143,20 @@ template rendering fails instead of silently allowing broad API-server egress.
 {{- toYaml .Values.networkPolicy.egress.additionalRules | nindent 0 }}
 {{- end }}
 {{- end }}
+
+{{/*
+Reconcilers the controller manager runs, as a comma-separated list resolved
+from controller.controllers or controller.profile. Keep the profiles in sync
+with cmd/controller_profiles.go.
+*/}}
+{{- define "auth-operator.controllers" -}}
+{{- $all := list "roledefinition" "binddefinition" "webhookauthorizer" "rbacpolicy" "restrictedbinddefinition" "restrictedroledefinition" "impersonationexposure" "operatorcapabilities" -}}
+{{- $profiles := dict "all" $all "rbac-only" (list "roledefinition" "binddefinition") "roles-only" (list "roledefinition") "authorizer-only" (list "webhookauthorizer") -}}
+{{- if .Values.controller.controllers -}}
+{{- join "," .Values.controller.controllers -}}
+{{- else if hasKey $profiles .Values.controller.profile -}}
+{{- join "," (get $profiles .Values.controller.profile) -}}
+{{- else -}}
+{{- fail (printf "unknown controller.profile %q" .Values.controller.profile) -}}
+{{- end -}}
+{{- end -}}
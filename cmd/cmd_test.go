@@ -11,10 +11,15 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/telekom/auth-operator/pkg/indexer"
+	"github.com/telekom/auth-operator/pkg/operatorconfig"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func TestSensitivePattern(t *testing.T) {
@@ -175,6 +180,62 @@ func TestValidateTrackerIntervals(t *testing.T) {
 	}
 }
 
+func TestValidateNamespaceTerminationGracePeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		expectError bool
+	}{
+		{"positive (ok)", 30 * time.Minute, false},
+		{"zero disables reporting (ok)", 0, false},
+		{"negative (error)", -1 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNamespaceTerminationGracePeriod(tt.gracePeriod)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateNamespaceTerminationGracePeriod(%v): expected error=%v, got %v",
+					tt.gracePeriod, tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestBuildNamespaceTerminationPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		release       string
+		resourceTypes []string
+		timeout       time.Duration
+		expectError   bool
+	}{
+		{"default wait for all (ok)", "WaitForAll", nil, 0, false},
+		{"wait for listed with types (ok)", "WaitForListed", []string{"pods", "kustomizations.kustomize.toolkit.fluxcd.io"}, 0, false},
+		{"release after timeout (ok)", "ReleaseAfterTimeout", nil, time.Hour, false},
+		{"unknown policy (error)", "Never", nil, 0, true},
+		{"wait for listed without types (error)", "WaitForListed", nil, 0, true},
+		{"types without wait for listed (error)", "WaitForAll", []string{"pods"}, 0, true},
+		{"release after timeout without timeout (error)", "ReleaseAfterTimeout", nil, 0, true},
+		{"negative timeout (error)", "ReleaseAfterTimeout", nil, -time.Minute, true},
+		{"timeout without release after timeout (error)", "WaitForAll", nil, time.Hour, true},
+		{"invalid resource type (error)", "WaitForListed", []string{"Pods/Status"}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := buildNamespaceTerminationPolicy(tt.release, tt.resourceTypes, tt.timeout)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("buildNamespaceTerminationPolicy(%q, %v, %v): expected error=%v, got %v",
+					tt.release, tt.resourceTypes, tt.timeout, tt.expectError, err)
+			}
+			if err == nil && string(policy.FinalizerRelease) != tt.release {
+				t.Errorf("FinalizerRelease = %q, want %q", policy.FinalizerRelease, tt.release)
+			}
+		})
+	}
+}
+
 func TestValidateRateLimitFlags(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -286,6 +347,8 @@ func TestControllerCmdFlags(t *testing.T) {
 
 	expectedFlags := []string{
 		"leader-elect",
+		"profile",
+		"controllers",
 		"binddefinition-concurrency",
 		"roledefinition-concurrency",
 		"webhookauthorizer-concurrency",
@@ -297,6 +360,10 @@ func TestControllerCmdFlags(t *testing.T) {
 		"wait-for-crds",
 		"tracker-sync-interval",
 		"tracker-resync-interval",
+		"namespace-termination-grace-period",
+		"namespace-termination-finalizer-release",
+		"namespace-termination-resource-types",
+		"namespace-termination-release-timeout",
 	}
 
 	for _, name := range expectedFlags {
@@ -327,6 +394,16 @@ func TestTrackerIntervalFlagDefaults(t *testing.T) {
 	}
 }
 
+func TestNamespaceTerminationGracePeriodFlagDefault(t *testing.T) {
+	graceFlag := controllerCmd.Flags().Lookup("namespace-termination-grace-period")
+	if graceFlag == nil {
+		t.Fatal("flag namespace-termination-grace-period not found on controller command")
+	}
+	if graceFlag.DefValue != "30m0s" {
+		t.Errorf("namespace-termination-grace-period default = %q, want %q", graceFlag.DefValue, "30m0s")
+	}
+}
+
 func TestWebhookCmdFlags(t *testing.T) {
 	flags := webhookCmd.Flags()
 
@@ -364,6 +441,10 @@ func TestRootCmdPersistentFlags(t *testing.T) {
 		"health-probe-bind-address",
 		"metrics-bind-address",
 		"metrics-secure",
+		"otlp-metrics-enabled",
+		"otlp-metrics-endpoint",
+		"otlp-metrics-insecure",
+		"otlp-metrics-interval",
 	}
 
 	for _, name := range expectedFlags {
@@ -378,6 +459,50 @@ func TestRootCmdPersistentFlags(t *testing.T) {
 	if f != nil && f.DefValue != "false" {
 		t.Errorf("flag %q default = %q, want %q", "metrics-secure", f.DefValue, "false")
 	}
+
+	f = flags.Lookup("otlp-metrics-interval")
+	if f != nil && f.DefValue != "1m0s" {
+		t.Errorf("flag %q default = %q, want %q", "otlp-metrics-interval", f.DefValue, "1m0s")
+	}
+}
+
+func TestOTLPEndpoint(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4317")
+
+	tests := []struct {
+		name                  string
+		flagValue             string
+		insecure              bool
+		insecureExplicitlySet bool
+		envVars               []string
+		wantEndpoint          string
+		wantInsecure          bool
+	}{
+		{name: "flag value", flagValue: "otel:4317", wantEndpoint: "otel:4317"},
+		{name: "no env fallback", wantEndpoint: ""},
+		{
+			name:         "first non-empty env var with inferred insecure",
+			envVars:      []string{"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT"},
+			wantEndpoint: "collector:4317",
+			wantInsecure: true,
+		},
+		{
+			name:                  "explicit insecure=false wins over http scheme",
+			flagValue:             "http://otel:4317",
+			insecureExplicitlySet: true,
+			wantEndpoint:          "otel:4317",
+		},
+		{name: "https scheme", flagValue: "https://otel:4317", wantEndpoint: "otel:4317"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, insecure := otlpEndpoint(tt.flagValue, tt.insecure, tt.insecureExplicitlySet, tt.envVars...)
+			if endpoint != tt.wantEndpoint || insecure != tt.wantInsecure {
+				t.Errorf("otlpEndpoint() = (%q, %v), want (%q, %v)", endpoint, insecure, tt.wantEndpoint, tt.wantInsecure)
+			}
+		})
+	}
 }
 
 func TestFlagDefaults(t *testing.T) {
@@ -393,6 +518,8 @@ func TestFlagDefaults(t *testing.T) {
 		{"controller", "restrictedbinddefinition-concurrency", "5"},
 		{"controller", "restrictedroledefinition-concurrency", "5"},
 		{"controller", "leader-elect", "true"},
+		{"controller", "profile", "all"},
+		{"controller", "controllers", "[]"},
 		{"controller", "wait-for-crds", "true"},
 		{"controller", "cache-sync-timeout", "2m0s"},
 		{"controller", "graceful-shutdown-timeout", "30s"},
@@ -432,3 +559,232 @@ func TestFlagDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestOperatorConfigFlagValues(t *testing.T) {
+	config, err := operatorconfig.Decode([]byte(`
+apiVersion: config.t-caas.telekom.com/v1alpha1
+kind: OperatorConfig
+verbosity: 4
+tracing:
+  samplingRate: 0.5
+metrics:
+  otlp:
+    enabled: true
+    interval: 30s
+controller:
+  leaderElect: false
+  concurrency:
+    roleDefinition: 3
+  tracker:
+    syncInterval: 2m
+  namespaceTermination:
+    resourceTypes: [pods, secrets]
+webhook:
+  leaderElect: true
+  authorize:
+    rateLimit: 2.5
+`))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	controller := operatorConfigFlagValues(config, "controller")
+	for name, want := range map[string]string{
+		"verbosity":                            "4",
+		"tracing-sampling-rate":                "0.5",
+		"otlp-metrics-enabled":                 "true",
+		"otlp-metrics-interval":                "30s",
+		"leader-elect":                         "false",
+		"roledefinition-concurrency":           "3",
+		"tracker-sync-interval":                "2m0s",
+		"namespace-termination-resource-types": "pods,secrets",
+	} {
+		if got := controller[name]; got != want {
+			t.Errorf("controller value of --%s = %q, want %q", name, got, want)
+		}
+	}
+	if _, ok := controller["authorize-rate-limit"]; ok {
+		t.Error("controller values must not contain webhook settings")
+	}
+
+	webhook := operatorConfigFlagValues(config, "webhook")
+	if got := webhook["leader-elect"]; got != "true" {
+		t.Errorf("webhook value of --leader-elect = %q, want %q", got, "true")
+	}
+	if got := webhook["authorize-rate-limit"]; got != "2.5" {
+		t.Errorf("webhook value of --authorize-rate-limit = %q, want %q", got, "2.5")
+	}
+}
+
+func TestApplyOperatorConfigFlags(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	concurrency := flags.Int("roledefinition-concurrency", 5, "")
+	interval := flags.Duration("tracker-sync-interval", 5*time.Minute, "")
+	if err := flags.Parse([]string{"--tracker-sync-interval=1m"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	err := applyOperatorConfigFlags(flags, map[string]string{
+		"roledefinition-concurrency": "3",
+		"tracker-sync-interval":      "10m0s",
+		"unknown-flag":               "ignored",
+	})
+	if err != nil {
+		t.Fatalf("applyOperatorConfigFlags() error = %v", err)
+	}
+	if *concurrency != 3 {
+		t.Errorf("roledefinition-concurrency = %d, want 3 from the config", *concurrency)
+	}
+	if *interval != time.Minute {
+		t.Errorf("tracker-sync-interval = %v, want the command-line value 1m", *interval)
+	}
+
+	flags.Int("binddefinition-concurrency", 5, "")
+	err = applyOperatorConfigFlags(flags, map[string]string{"binddefinition-concurrency": "three"})
+	if err == nil {
+		t.Error("applyOperatorConfigFlags() expected error for an invalid value")
+	}
+}
+
+func TestOperatorConfigReload(t *testing.T) {
+	savedVerbosity, savedSampling := verbosity, tracingSamplingRate
+	savedLimit, savedBurst := authorizeRateLimit, authorizeRateBurst
+	t.Cleanup(func() {
+		verbosity, tracingSamplingRate = savedVerbosity, savedSampling
+		authorizeRateLimit, authorizeRateBurst = savedLimit, savedBurst
+	})
+	verbosity, tracingSamplingRate = 2, 0.1
+	authorizeRateLimit, authorizeRateBurst = 10, 20
+
+	var gotVerbosity, gotBurst int
+	var gotSampling, gotLimit float64
+	reloader := operatorConfigReloader{
+		setVerbosity:    func(v int) error { gotVerbosity = v; return nil },
+		setSamplingRate: func(r float64) error { gotSampling = r; return nil },
+		setAuthorizeRateLimit: func(limit float64, burst int) error {
+			gotLimit, gotBurst = limit, burst
+			return nil
+		},
+	}
+	state := &operatorConfigState{
+		values:     map[string]string{"verbosity": "2", "port": "9443"},
+		overridden: map[string]bool{"tracing-sampling-rate": true},
+	}
+
+	values := map[string]string{
+		"verbosity":             "5",
+		"tracing-sampling-rate": "1",
+		"authorize-rate-limit":  "50",
+		"authorize-rate-burst":  "100",
+		"port":                  "9444",
+	}
+	if err := state.reload(values, reloader); err != nil {
+		t.Fatalf("reload() error = %v", err)
+	}
+	if gotVerbosity != 5 || verbosity != 5 {
+		t.Errorf("verbosity = %d (applied %d), want 5", verbosity, gotVerbosity)
+	}
+	if gotSampling != 0 || tracingSamplingRate != 0.1 {
+		t.Errorf("sampling rate overridden on the command line was reloaded to %v", tracingSamplingRate)
+	}
+	if gotLimit != 50 || gotBurst != 100 {
+		t.Errorf("rate limit applied = %v/%d, want 50/100", gotLimit, gotBurst)
+	}
+	if restart := state.restartRequired(values); len(restart) != 1 || restart[0] != "port" {
+		t.Errorf("restartRequired() = %v, want [port]", restart)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]string
+	}{
+		{"negative verbosity", map[string]string{"verbosity": "-1"}},
+		{"negative rate limit", map[string]string{"authorize-rate-limit": "-1"}},
+		{"zero burst", map[string]string{"authorize-rate-burst": "0"}},
+		{"disable rate limiting", map[string]string{"authorize-rate-limit": "0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVerbosity, gotLimit = 0, 0
+			if err := state.reload(tt.values, reloader); err == nil {
+				t.Error("reload() expected error")
+			}
+			if gotVerbosity != 0 || gotLimit != 0 {
+				t.Error("reload() applied settings of an invalid config")
+			}
+		})
+	}
+}
+
+func TestComponentCommand(t *testing.T) {
+	if got := componentCommand(renderAuthzConfigCmd).Name(); got != "webhook" {
+		t.Errorf("componentCommand(render-authz-config) = %q, want %q", got, "webhook")
+	}
+	if got := componentCommand(controllerCmd).Name(); got != "controller" {
+		t.Errorf("componentCommand(controller) = %q, want %q", got, "controller")
+	}
+}
+
+func TestResolveControllers(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     string
+		controllers []string
+		want        []string
+		wantErr     bool
+	}{
+		{name: "default profile runs everything", profile: "all", want: allControllers},
+		{name: "rbac-only profile", profile: "rbac-only", want: []string{"roledefinition", "binddefinition"}},
+		{name: "authorizer-only profile", profile: "authorizer-only", want: []string{"webhookauthorizer"}},
+		{name: "unknown profile", profile: "minimal", wantErr: true},
+		{
+			name:        "controllers override the profile",
+			profile:     "authorizer-only",
+			controllers: []string{"RoleDefinition", " binddefinition", "roledefinition"},
+			want:        []string{"roledefinition", "binddefinition"},
+		},
+		{name: "unknown controller", profile: "all", controllers: []string{"rolebinding"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveControllers(tt.profile, tt.controllers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveControllers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("resolveControllers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisableUnselectedControllers(t *testing.T) {
+	saved := []int{roleDefinitionConcurrency, bindDefinitionConcurrency, webhookAuthorizerConcurrency,
+		rbacPolicyConcurrency, restrictedBindDefinitionConcurrency, restrictedRoleDefinitionConcurrency}
+	savedScan, savedProbe := impersonationExposureScanInterval, capabilityProbeInterval
+	t.Cleanup(func() {
+		roleDefinitionConcurrency, bindDefinitionConcurrency, webhookAuthorizerConcurrency = saved[0], saved[1], saved[2]
+		rbacPolicyConcurrency, restrictedBindDefinitionConcurrency, restrictedRoleDefinitionConcurrency = saved[3], saved[4], saved[5]
+		impersonationExposureScanInterval, capabilityProbeInterval = savedScan, savedProbe
+	})
+	roleDefinitionConcurrency, bindDefinitionConcurrency, webhookAuthorizerConcurrency = 5, 5, 1
+	rbacPolicyConcurrency, restrictedBindDefinitionConcurrency, restrictedRoleDefinitionConcurrency = 5, 5, 5
+	impersonationExposureScanInterval, capabilityProbeInterval = time.Minute, time.Minute
+
+	disableUnselectedControllers(controllerProfiles["roles-only"])
+
+	if roleDefinitionConcurrency != 5 {
+		t.Errorf("roledefinition concurrency = %d, want it unchanged", roleDefinitionConcurrency)
+	}
+	if bindDefinitionConcurrency != 0 || webhookAuthorizerConcurrency != 0 || includeRestricted() ||
+		impersonationExposureScanInterval != 0 || capabilityProbeInterval != 0 {
+		t.Error("reconcilers outside the roles-only profile should be disabled")
+	}
+	if got := controllerIndexes(); got != (indexer.ControllerIndexes{RoleDefinition: true}) {
+		t.Errorf("controllerIndexes() = %+v, want only RoleDefinition", got)
+	}
+	crds := requiredCRDs()
+	if len(crds) != 1 || crds[0].Kind != "RoleDefinition" {
+		t.Errorf("requiredCRDs() = %v, want only RoleDefinition", crds)
+	}
+}
@@ -7,10 +7,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgodiscovery "k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 
@@ -45,6 +49,14 @@ var (
 	waitForCRDs                         bool
 	trackerSyncInterval                 time.Duration
 	trackerResyncInterval               time.Duration
+	trackerStaleTTL                     time.Duration
+	namespaceTerminationGracePeriod     time.Duration
+	namespaceTerminationRelease         string
+	namespaceTerminationResourceTypes   []string
+	namespaceTerminationReleaseTimeout  time.Duration
+	impersonationExposureScanInterval   time.Duration
+	capabilityProbeInterval             time.Duration
+	enableImpersonationGrants           bool
 )
 
 // controllerCmd represents the controller command.
@@ -73,10 +85,34 @@ and their status is kept up to date.`,
 		if err := validateTrackerIntervals(trackerSyncInterval, trackerResyncInterval); err != nil {
 			return err
 		}
+		if trackerStaleTTL < 0 {
+			return fmt.Errorf("tracker-stale-ttl must be non-negative, got %s", trackerStaleTTL)
+		}
+		if err := validateNamespaceTerminationGracePeriod(namespaceTerminationGracePeriod); err != nil {
+			return err
+		}
+		if impersonationExposureScanInterval < 0 {
+			return fmt.Errorf("impersonation-exposure-scan-interval must be non-negative, got %s", impersonationExposureScanInterval)
+		}
+		if capabilityProbeInterval < 0 {
+			return fmt.Errorf("capability-probe-interval must be non-negative, got %s", capabilityProbeInterval)
+		}
+		terminationPolicy, err := buildNamespaceTerminationPolicy(
+			namespaceTerminationRelease, namespaceTerminationResourceTypes, namespaceTerminationReleaseTimeout)
+		if err != nil {
+			return err
+		}
+		selectedControllers, err := resolveControllers(controllerProfile, enabledControllers)
+		if err != nil {
+			return err
+		}
+		disableUnselectedControllers(selectedControllers)
 
 		setupLog.Info("starting controller")
 		setupLog.Info("controller configuration",
 			"enableLeaderElection", enableLeaderElection,
+			"profile", controllerProfile,
+			"controllers", selectedControllers,
 			"bindDefinitionConcurrency", bindDefinitionConcurrency,
 			"roleDefinitionConcurrency", roleDefinitionConcurrency,
 			"webhookAuthorizerConcurrency", webhookAuthorizerConcurrency,
@@ -88,6 +124,14 @@ and their status is kept up to date.`,
 			"namespace", namespace,
 			"trackerSyncInterval", trackerSyncInterval,
 			"trackerResyncInterval", trackerResyncInterval,
+			"trackerStaleTTL", trackerStaleTTL,
+			"namespaceTerminationGracePeriod", namespaceTerminationGracePeriod,
+			"namespaceTerminationRelease", namespaceTerminationRelease,
+			"namespaceTerminationResourceTypes", namespaceTerminationResourceTypes,
+			"namespaceTerminationReleaseTimeout", namespaceTerminationReleaseTimeout,
+			"impersonationExposureScanInterval", impersonationExposureScanInterval,
+			"capabilityProbeInterval", capabilityProbeInterval,
+			"enableImpersonationGrants", enableImpersonationGrants,
 		)
 
 		ctx := ctrl.SetupSignalHandler()
@@ -108,6 +152,21 @@ and their status is kept up to date.`,
 				"samplingRate", tracingSamplingRate)
 		}
 
+		otlpMetrics, err := setupOTLPMetrics(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if shutdownErr := otlpMetrics.Shutdown(ctx); shutdownErr != nil {
+				setupLog.Error(shutdownErr, "error shutting down OTLP metrics exporter")
+			}
+		}()
+
+		go watchOperatorConfig(ctx, cmd.Name(), operatorConfigReloader{
+			setVerbosity:    setKlogVerbosity,
+			setSamplingRate: tracingProvider.SetSamplingRate,
+		})
+
 		cfg, err := ctrl.GetConfig()
 		if err != nil {
 			return fmt.Errorf("unable to get kubeconfig: %w", err)
@@ -139,34 +198,49 @@ and their status is kept up to date.`,
 			return fmt.Errorf("unable to start manager: %w", err)
 		}
 
-		resourceTracker := discovery.NewResourceTracker(scheme, mgr.GetConfig())
-		resourceTracker.CollectionInterval = trackerSyncInterval
-		resourceTracker.FullRescanInterval = trackerResyncInterval
-		if err := mgr.Add(resourceTracker); err != nil {
-			return fmt.Errorf("unable to add resource tracker to manager: %w", err)
+		// Build reconciler options (tracing, API server capability detection)
+		reconcilerOpts := []authorizationcontroller.ReconcilerOption{
+			authorizationcontroller.WithNamespaceTerminationGracePeriod(namespaceTerminationGracePeriod),
+			authorizationcontroller.WithNamespaceTerminationPolicy(terminationPolicy),
+			authorizationcontroller.WithImpersonationGrants(enableImpersonationGrants),
 		}
 
-		includeRestricted := rbacPolicyConcurrency > 0 || restrictedBindDefinitionConcurrency > 0 || restrictedRoleDefinitionConcurrency > 0
+		// Only the reconcilers generating or binding roles need API discovery;
+		// the tracker would otherwise watch CRDs for nothing.
+		var resourceTracker *discovery.ResourceTracker
+		if roleDefinitionConcurrency > 0 || bindDefinitionConcurrency > 0 || restrictedRoleDefinitionConcurrency > 0 {
+			resourceTracker = discovery.NewResourceTracker(scheme, mgr.GetConfig())
+			resourceTracker.CollectionInterval = trackerSyncInterval
+			resourceTracker.FullRescanInterval = trackerResyncInterval
+			resourceTracker.StaleGroupVersionTTL = trackerStaleTTL
+			resourceTracker.Tracer = tracingProvider.TracerIfEnabled()
+			if err := mgr.Add(resourceTracker); err != nil {
+				return fmt.Errorf("unable to add resource tracker to manager: %w", err)
+			}
+			// Serve the tracker's view next to /metrics, behind the same
+			// authentication and authorization when --metrics-secure is set.
+			if err := mgr.AddMetricsServerExtraHandler(discovery.SnapshotPath, discovery.SnapshotHandler(resourceTracker)); err != nil {
+				return fmt.Errorf("unable to add discovery snapshot handler: %w", err)
+			}
+			reconcilerOpts = append(reconcilerOpts, authorizationcontroller.WithDiscoveryHealth(resourceTracker))
+		}
 
 		// Wait for CRDs to be available before setting up controllers
 		// This prevents cache sync timeout errors when CRDs are not yet installed
 		if waitForCRDs {
-			includeWA := webhookAuthorizerConcurrency > 0
-			if err := waitForRequiredCRDs(ctx, cfg, cacheSyncTimeout, includeWA, includeRestricted); err != nil {
+			if err := waitForRequiredCRDs(ctx, cfg, cacheSyncTimeout, requiredCRDs()); err != nil {
 				return fmt.Errorf("failed waiting for required CRDs: %w", err)
 			}
 		}
 
 		// Setup field indexes for efficient lookups.
 		// Controller-specific indexes may watch RBAC binding types and therefore
-		// must not be registered in the webhook-only manager.
-		if err := indexer.SetupControllerIndexes(ctx, mgr, includeRestricted); err != nil {
+		// must not be registered in the webhook-only manager. Indexes of
+		// disabled reconcilers are skipped so their types are not watched.
+		if err := indexer.SetupControllerIndexes(ctx, mgr, controllerIndexes()); err != nil {
 			return fmt.Errorf("unable to setup controller field indexes: %w", err)
 		}
 		setupLog.Info("field indexes configured for cached client")
-
-		// Build reconciler options (tracing, API server capability detection)
-		var reconcilerOpts []authorizationcontroller.ReconcilerOption
 		if tracingEnabled {
 			reconcilerOpts = append(reconcilerOpts,
 				authorizationcontroller.WithTracer(tracingProvider.Tracer()))
@@ -179,7 +253,8 @@ and their status is kept up to date.`,
 		// Setup failures are non-fatal by design: the operator must keep working on
 		// clusters where the /metrics endpoint or the version endpoint is unreadable.
 		// In that case the ConstrainedImpersonationEffective condition reports Unknown.
-		if detector := buildCapabilityDetector(cfg); detector != nil {
+		detector := buildCapabilityDetector(cfg)
+		if detector != nil {
 			reconcilerOpts = append(reconcilerOpts, authorizationcontroller.WithCapabilityDetector(detector))
 		}
 
@@ -241,11 +316,15 @@ and their status is kept up to date.`,
 
 		if rbacPolicyConcurrency > 0 {
 			setupLog.Info("creating RBACPolicy reconciler", "concurrency", rbacPolicyConcurrency)
+			// The operator exempts itself from the ValidatingAdmissionPolicies it
+			// generates for spec.admissionEnforcement, so it must know its username.
+			rbacPolicyOpts := append(slices.Clone(reconcilerOpts),
+				authorizationcontroller.WithOperatorUsername(resolveOperatorUsername(ctx, cfg)))
 			rbacPolicyController := authorizationcontroller.NewRBACPolicyReconciler(
 				mgr.GetClient(),
 				mgr.GetScheme(),
 				mgr.GetEventRecorder("RBACPolicyReconciler"),
-				reconcilerOpts...)
+				rbacPolicyOpts...)
 			if err := rbacPolicyController.SetupWithManager(ctx, mgr, rbacPolicyConcurrency); err != nil {
 				return fmt.Errorf("unable to setup controller RBACPolicy with manager: %w", err)
 			}
@@ -288,6 +367,40 @@ and their status is kept up to date.`,
 			setupLog.Info("RestrictedRoleDefinition reconciler is disabled")
 		}
 
+		if impersonationExposureScanInterval > 0 {
+			setupLog.Info("creating ImpersonationExposure reconciler", "scanInterval", impersonationExposureScanInterval)
+			impersonationExposureController := authorizationcontroller.NewImpersonationExposureReconciler(
+				mgr.GetClient(),
+				mgr.GetScheme(),
+				impersonationExposureScanInterval,
+				reconcilerOpts...)
+			if err := impersonationExposureController.SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("unable to setup controller ImpersonationExposure with manager: %w", err)
+			}
+			setupLog.Info("ImpersonationExposure reconciler configured successfully")
+		} else {
+			setupLog.Info("ImpersonationExposure reconciler is disabled")
+		}
+
+		if capabilityProbeInterval > 0 && detector != nil {
+			setupLog.Info("creating OperatorCapabilities reconciler", "probeInterval", capabilityProbeInterval)
+			operatorCapabilitiesController, err := authorizationcontroller.NewOperatorCapabilitiesReconciler(
+				mgr.GetClient(),
+				mgr.GetScheme(),
+				detector,
+				capabilityProbeInterval,
+				reconcilerOpts...)
+			if err != nil {
+				return fmt.Errorf("unable to create OperatorCapabilities reconciler: %w", err)
+			}
+			if err := operatorCapabilitiesController.SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("unable to setup controller OperatorCapabilities with manager: %w", err)
+			}
+			setupLog.Info("OperatorCapabilities reconciler configured successfully")
+		} else {
+			setupLog.Info("OperatorCapabilities reconciler is disabled")
+		}
+
 		setupLog.Info("starting manager - waiting for cache sync", "timeout", cacheSyncTimeout)
 		if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 			return fmt.Errorf("unable to set up health check: %w", err)
@@ -308,6 +421,12 @@ func init() {
 	controllerCmd.Flags().BoolVar(&enableLeaderElection, "leader-elect", true,
 		"Enable leader election for controller manager. "+
 			"Enabled by default for safety. Disable only for single-replica deployments.")
+	controllerCmd.Flags().StringVar(&controllerProfile, "profile", defaultControllerProfile,
+		"Set of reconcilers to run: "+strings.Join(profileNames(), ", ")+". "+
+			"Run only what a cluster needs so the operator can be granted matching, narrower RBAC.")
+	controllerCmd.Flags().StringSliceVar(&enabledControllers, "controllers", nil,
+		"Reconcilers to run, overriding --profile: "+strings.Join(allControllers, ", ")+". "+
+			"A selected reconciler whose concurrency or interval is 0 stays disabled.")
 	controllerCmd.Flags().IntVar(&bindDefinitionConcurrency, "binddefinition-concurrency", 5,
 		"Number of concurrent workers for BindDefinition reconciler. Default is 5. Use 0 to disable the reconciler.")
 	controllerCmd.Flags().IntVar(&roleDefinitionConcurrency, "roledefinition-concurrency", 5,
@@ -336,6 +455,62 @@ func init() {
 		"Interval between full rescans by the ResourceTracker to account for missed events. "+
 			"Refreshes the CRD UUID map and API resources cache. Default is 15 minutes. "+
 			"Use 0 to fall back to the controller's internal default (15 minutes). Negative values are rejected.")
+	controllerCmd.Flags().DurationVar(&trackerStaleTTL, "tracker-stale-ttl", discovery.DefaultStaleGroupVersionTTL,
+		"How long the ResourceTracker keeps serving the last known resources of a GroupVersion whose discovery fails, "+
+			"e.g. an unavailable aggregated API. Afterwards its resources are omitted from generated roles. "+
+			"Default is 30 minutes. Use 0 to fall back to the internal default. Negative values are rejected.")
+	controllerCmd.Flags().DurationVar(&namespaceTerminationGracePeriod, "namespace-termination-grace-period",
+		authorizationcontroller.DefaultNamespaceTerminationGracePeriod,
+		"How long a terminating namespace may be blocked by remaining resources before a warning event is emitted "+
+			"and it is counted in auth_operator_namespace_termination_stuck. Default is 30 minutes. "+
+			"Use 0 to disable the report. Negative values are rejected.")
+	controllerCmd.Flags().StringVar(&namespaceTerminationRelease, "namespace-termination-finalizer-release",
+		string(authorizationv1alpha1.FinalizerReleaseWaitForAll),
+		"Default policy for releasing RoleBinding finalizers in terminating namespaces: "+
+			"WaitForAll, WaitForListed or ReleaseAfterTimeout. BindDefinitions may override it with spec.namespaceTermination.")
+	controllerCmd.Flags().StringSliceVar(&namespaceTerminationResourceTypes, "namespace-termination-resource-types", nil,
+		"Resource types that hold RoleBinding finalizers when --namespace-termination-finalizer-release=WaitForListed, "+
+			"e.g. pods,persistentvolumeclaims,kustomizations.kustomize.toolkit.fluxcd.io.")
+	controllerCmd.Flags().DurationVar(&namespaceTerminationReleaseTimeout, "namespace-termination-release-timeout", 0,
+		"Time after namespace deletion when RoleBinding finalizers are released regardless of remaining resources. "+
+			"Required when --namespace-termination-finalizer-release=ReleaseAfterTimeout.")
+	controllerCmd.Flags().DurationVar(&impersonationExposureScanInterval, "impersonation-exposure-scan-interval",
+		authorizationcontroller.DefaultImpersonationExposureScanInterval,
+		"Interval between periodic cluster-wide scans for the legacy impersonate verb, reported in the "+
+			"ImpersonationExposureReport \"cluster\" and auth_operator_legacy_impersonation_* metrics. "+
+			"RBAC changes also trigger a scan. Default is 10 minutes. Use 0 to disable the scanner.")
+	controllerCmd.Flags().DurationVar(&capabilityProbeInterval, "capability-probe-interval",
+		authorizationcontroller.DefaultCapabilityProbeInterval,
+		"Interval between probes of optional API server capabilities, published in the "+
+			"OperatorCapabilities \"cluster\" status and the auth_operator_api_server_capability metric. "+
+			"Default is 10 minutes. Use 0 to disable publishing; capability-gated features keep probing on demand.")
+	controllerCmd.Flags().BoolVar(&enableImpersonationGrants, "enable-impersonation-grants", false,
+		"Generate the roles and bindings of RoleDefinition spec.impersonationGrants. The bindings name arbitrary "+
+			"subjects, so anyone who may write RoleDefinitions can grant impersonation. Disabled by default.")
+}
+
+// buildNamespaceTerminationPolicy assembles the controller-wide finalizer-release
+// policy from flags and validates it with the same rules the BindDefinition
+// webhook applies to spec.namespaceTermination.
+func buildNamespaceTerminationPolicy(release string, resourceTypes []string, timeout time.Duration) (*authorizationv1alpha1.NamespaceTerminationPolicy, error) {
+	policy := &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleasePolicy(release),
+		ResourceTypes:    resourceTypes,
+	}
+	if timeout != 0 {
+		policy.Timeout = &metav1.Duration{Duration: timeout}
+	}
+	if errs := authorizationv1alpha1.ValidateNamespaceTerminationPolicy(policy, field.NewPath("namespaceTermination")); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid namespace termination flags: %w", errs.ToAggregate())
+	}
+	return policy, nil
+}
+
+func validateNamespaceTerminationGracePeriod(gracePeriod time.Duration) error {
+	if gracePeriod < 0 {
+		return fmt.Errorf("namespace-termination-grace-period must be non-negative, got %s", gracePeriod)
+	}
+	return nil
 }
 
 func validateTrackerIntervals(syncInterval, resyncInterval time.Duration) error {
@@ -348,34 +523,66 @@ func validateTrackerIntervals(syncInterval, resyncInterval time.Duration) error
 	return nil
 }
 
-// waitForRequiredCRDs waits for all required CRDs to be established before starting controllers.
-// This prevents the "timed out waiting for cache to be synced" errors that occur when
-// CRDs are not yet installed or not yet established.
-func waitForRequiredCRDs(ctx context.Context, cfg *rest.Config, timeout time.Duration, includeWebhookAuthorizer, includeRestricted bool) error {
-	setupLog.Info("waiting for required CRDs to be established", "timeout", timeout)
+// includeRestricted reports whether any reconciler of the RBACPolicy
+// family runs; they watch each other's types.
+func includeRestricted() bool {
+	return rbacPolicyConcurrency > 0 || restrictedBindDefinitionConcurrency > 0 || restrictedRoleDefinitionConcurrency > 0
+}
 
-	// Create a client for CRD checking (uses direct API calls, not cached)
-	c, err := client.New(cfg, client.Options{Scheme: scheme})
-	if err != nil {
-		return fmt.Errorf("unable to create client for CRD waiting: %w", err)
+// controllerIndexes selects the field indexes of the enabled reconcilers.
+func controllerIndexes() indexer.ControllerIndexes {
+	return indexer.ControllerIndexes{
+		RoleDefinition:    roleDefinitionConcurrency > 0,
+		BindDefinition:    bindDefinitionConcurrency > 0,
+		WebhookAuthorizer: webhookAuthorizerConcurrency > 0,
+		Restricted:        includeRestricted(),
 	}
+}
 
-	// Define the CRDs we need to wait for
-	requiredGVKs := []schema.GroupVersionKind{
-		authorizationv1alpha1.GroupVersion.WithKind("RoleDefinition"),
-		authorizationv1alpha1.GroupVersion.WithKind("BindDefinition"),
+// requiredCRDs returns the CRDs the enabled reconcilers watch.
+func requiredCRDs() []schema.GroupVersionKind {
+	var requiredGVKs []schema.GroupVersionKind
+	if roleDefinitionConcurrency > 0 {
+		requiredGVKs = append(requiredGVKs,
+			authorizationv1alpha1.GroupVersion.WithKind("RoleDefinition"))
 	}
-	if includeWebhookAuthorizer {
+	if bindDefinitionConcurrency > 0 {
+		requiredGVKs = append(requiredGVKs,
+			authorizationv1alpha1.GroupVersion.WithKind("BindDefinition"))
+	}
+	if webhookAuthorizerConcurrency > 0 {
 		requiredGVKs = append(requiredGVKs,
 			authorizationv1alpha1.GroupVersion.WithKind("WebhookAuthorizer"))
 	}
-	if includeRestricted {
+	if includeRestricted() {
 		requiredGVKs = append(requiredGVKs,
 			authorizationv1alpha1.GroupVersion.WithKind("RBACPolicy"),
 			authorizationv1alpha1.GroupVersion.WithKind("RestrictedBindDefinition"),
 			authorizationv1alpha1.GroupVersion.WithKind("RestrictedRoleDefinition"),
 		)
 	}
+	if impersonationExposureScanInterval > 0 {
+		requiredGVKs = append(requiredGVKs,
+			authorizationv1alpha1.GroupVersion.WithKind("ImpersonationExposureReport"))
+	}
+	if capabilityProbeInterval > 0 {
+		requiredGVKs = append(requiredGVKs,
+			authorizationv1alpha1.GroupVersion.WithKind("OperatorCapabilities"))
+	}
+	return requiredGVKs
+}
+
+// waitForRequiredCRDs waits for all required CRDs to be established before starting controllers.
+// This prevents the "timed out waiting for cache to be synced" errors that occur when
+// CRDs are not yet installed or not yet established.
+func waitForRequiredCRDs(ctx context.Context, cfg *rest.Config, timeout time.Duration, requiredGVKs []schema.GroupVersionKind) error {
+	setupLog.Info("waiting for required CRDs to be established", "timeout", timeout)
+
+	// Create a client for CRD checking (uses direct API calls, not cached)
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("unable to create client for CRD waiting: %w", err)
+	}
 
 	waiter := discovery.NewCRDWaiter(c, setupLog)
 	if err := waiter.WaitForCRDs(ctx, requiredGVKs, timeout); err != nil {
@@ -407,8 +614,30 @@ func validateConcurrency(flags map[string]int) error {
 	return nil
 }
 
+// resolveOperatorUsername asks the API server who the operator authenticates as
+// with a SelfSubjectReview. Failures are non-fatal: RBACPolicies with
+// spec.admissionEnforcement then report OperatorIdentityUnknown instead of
+// generating a policy that could lock the operator out.
+func resolveOperatorUsername(ctx context.Context, cfg *rest.Config) string {
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Info("unable to resolve operator username, admission enforcement is unavailable", "error", err.Error())
+		return ""
+	}
+	reviewCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	review := &authenticationv1.SelfSubjectReview{}
+	if err := c.Create(reviewCtx, review); err != nil {
+		setupLog.Info("unable to resolve operator username, admission enforcement is unavailable", "error", err.Error())
+		return ""
+	}
+	setupLog.V(1).Info("resolved operator username", "username", review.Status.UserInfo.Username)
+	return review.Status.UserInfo.Username
+}
+
 // buildCapabilityDetector assembles the API server capability detector used to
-// decide whether constrained impersonation (KEP-5284) grants are effective.
+// decide, for example, whether constrained impersonation (KEP-5284) grants are
+// effective, and whose results the OperatorCapabilities status publishes.
 //
 // It intentionally never returns an error: every component is optional and a
 // partially configured detector still produces a useful answer (a version-only
@@ -423,7 +652,7 @@ func buildCapabilityDetector(cfg *rest.Config) *capabilities.Detector {
 
 	if fetcher, err := capabilities.NewRESTMetricsFetcher(cfg); err != nil {
 		setupLog.V(1).Info("API server /metrics capability probe unavailable; "+
-			"constrained impersonation support will be inferred from the server version", "error", err.Error())
+			"capability support will be inferred from the server version", "error", err.Error())
 	} else {
 		detector.Metrics = fetcher
 	}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Reconciler names accepted by --controllers.
+const (
+	controllerRoleDefinition           = "roledefinition"
+	controllerBindDefinition           = "binddefinition"
+	controllerWebhookAuthorizer        = "webhookauthorizer"
+	controllerRBACPolicy               = "rbacpolicy"
+	controllerRestrictedBindDefinition = "restrictedbinddefinition"
+	controllerRestrictedRoleDefinition = "restrictedroledefinition"
+	controllerImpersonationExposure    = "impersonationexposure"
+	controllerOperatorCapabilities     = "operatorcapabilities"
+)
+
+// defaultControllerProfile runs every reconciler.
+const defaultControllerProfile = "all"
+
+// allControllers lists every reconciler of the controller command.
+var allControllers = []string{
+	controllerRoleDefinition,
+	controllerBindDefinition,
+	controllerWebhookAuthorizer,
+	controllerRBACPolicy,
+	controllerRestrictedBindDefinition,
+	controllerRestrictedRoleDefinition,
+	controllerImpersonationExposure,
+	controllerOperatorCapabilities,
+}
+
+// controllerProfiles are the reconciler sets selectable with --profile. The
+// Helm chart derives the controller's ClusterRole from the same sets, so keep
+// the "auth-operator.controllers" helper in sync.
+var controllerProfiles = map[string][]string{
+	defaultControllerProfile: allControllers,
+	// rbac-only generates roles and bindings, e.g. on edge clusters.
+	"rbac-only": {controllerRoleDefinition, controllerBindDefinition},
+	// roles-only generates roles from RoleDefinitions.
+	"roles-only": {controllerRoleDefinition},
+	// authorizer-only validates WebhookAuthorizers for the webhook's /authorize endpoint.
+	"authorizer-only": {controllerWebhookAuthorizer},
+}
+
+var (
+	controllerProfile  string
+	enabledControllers []string
+)
+
+// resolveControllers returns the reconcilers selected by --controllers, or by
+// --profile when --controllers is empty.
+func resolveControllers(profile string, controllers []string) ([]string, error) {
+	if len(controllers) == 0 {
+		selected, ok := controllerProfiles[profile]
+		if !ok {
+			return nil, fmt.Errorf("unknown --profile %q, must be one of %s", profile, strings.Join(profileNames(), ", "))
+		}
+		return selected, nil
+	}
+
+	selected := make([]string, 0, len(controllers))
+	for _, name := range controllers {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(allControllers, name) {
+			return nil, fmt.Errorf("unknown controller %q in --controllers, must be one of %s", name, strings.Join(allControllers, ", "))
+		}
+		if !slices.Contains(selected, name) {
+			selected = append(selected, name)
+		}
+	}
+	return selected, nil
+}
+
+// disableUnselectedControllers disables the reconcilers missing from selected
+// the same way a concurrency or interval of 0 does.
+func disableUnselectedControllers(selected []string) {
+	disable := map[string]func(){
+		controllerRoleDefinition:           func() { roleDefinitionConcurrency = 0 },
+		controllerBindDefinition:           func() { bindDefinitionConcurrency = 0 },
+		controllerWebhookAuthorizer:        func() { webhookAuthorizerConcurrency = 0 },
+		controllerRBACPolicy:               func() { rbacPolicyConcurrency = 0 },
+		controllerRestrictedBindDefinition: func() { restrictedBindDefinitionConcurrency = 0 },
+		controllerRestrictedRoleDefinition: func() { restrictedRoleDefinitionConcurrency = 0 },
+		controllerImpersonationExposure:    func() { impersonationExposureScanInterval = 0 },
+		controllerOperatorCapabilities:     func() { capabilityProbeInterval = 0 },
+	}
+	for _, name := range allControllers {
+		if !slices.Contains(selected, name) {
+			disable[name]()
+		}
+	}
+}
+
+func profileNames() []string {
+	names := make([]string, 0, len(controllerProfiles))
+	for name := range controllerProfiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/telekom/auth-operator/pkg/discovery"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var discoveryDumpOutput string
+
+// discoveryCmd groups commands that inspect API discovery.
+var discoveryCmd = &cobra.Command{
+	Use:   "discovery",
+	Short: "Inspect the API resources the operator generates roles from",
+}
+
+// discoveryDumpCmd writes a discovery snapshot of the cluster in the current kubeconfig.
+var discoveryDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Write a snapshot of the cluster's API resources as JSON",
+	Long: `Collect the cluster's API resources the same way the controller's
+ResourceTracker does and write them as a JSON snapshot, with the collection
+timestamp and the UIDs of the known CustomResourceDefinitions.
+
+The output has the format the controller serves on ` + discovery.SnapshotPath + ` of its
+metrics endpoint and is accepted by "auth-operator render roles --discovery".
+The cluster is taken from KUBECONFIG, the in-cluster config or ~/.kube/config.`,
+	Example: `  auth-operator discovery dump -o snapshot.json`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDiscoveryDump(cmd.Context(), cmd.OutOrStdout())
+	},
+}
+
+func runDiscoveryDump(ctx context.Context, stdout io.Writer) error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("unable to get kubeconfig: %w", err)
+	}
+	snapshot, err := discovery.CollectSnapshot(ctx, scheme, cfg)
+	if err != nil {
+		return err
+	}
+	return writeSnapshot(snapshot, discoveryDumpOutput, stdout)
+}
+
+// writeSnapshot writes snapshot as indented JSON to path, or to stdout when
+// path is "-".
+func writeSnapshot(snapshot *discovery.Snapshot, path string, stdout io.Writer) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+	data = append(data, '\n')
+	if path == stdioFileName {
+		_, err = stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	setupLog.Info("wrote discovery snapshot", "path", path,
+		"groupVersions", len(snapshot.Resources), "crds", len(snapshot.CRDUIDs))
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(discoveryCmd)
+	discoveryCmd.AddCommand(discoveryDumpCmd)
+
+	discoveryDumpCmd.Flags().StringVarP(&discoveryDumpOutput, "output", "o", stdioFileName,
+		"File to write the snapshot to; \"-\" writes to standard output.")
+}
@@ -0,0 +1,389 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/telekom/auth-operator/pkg/operatorconfig"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// operatorConfigReloadInterval is how often the OperatorConfig file is checked
+// for changes. Polling, unlike inotify, also follows the symlink swap kubelet
+// performs when a mounted ConfigMap is updated.
+const operatorConfigReloadInterval = 10 * time.Second
+
+// Flags whose OperatorConfig value is applied while the process runs.
+const (
+	verbosityFlag          = "verbosity"
+	tracingSamplingFlag    = "tracing-sampling-rate"
+	authorizeRateLimitFlag = "authorize-rate-limit"
+	authorizeRateBurstFlag = "authorize-rate-burst"
+)
+
+var (
+	operatorConfigFile string
+
+	// loadedOperatorConfig is the OperatorConfig the running command was
+	// started with, or nil without --config.
+	loadedOperatorConfig *operatorConfigState
+)
+
+// operatorConfigState tracks the OperatorConfig file of a running command.
+type operatorConfigState struct {
+	path string
+	data []byte
+	// values are the flag values of the last applied OperatorConfig.
+	values map[string]string
+	// overridden are the flags given on the command line, which the file
+	// never changes.
+	overridden map[string]bool
+}
+
+// operatorConfigReloader applies hot-reloadable settings of a running command.
+type operatorConfigReloader struct {
+	setVerbosity    func(int) error
+	setSamplingRate func(float64) error
+	// setAuthorizeRateLimit is nil for commands without an /authorize endpoint.
+	setAuthorizeRateLimit func(limit float64, burst int) error
+}
+
+// componentCommand returns the subcommand of the root command that cmd
+// belongs to, e.g. webhook for "webhook render-authz-config".
+func componentCommand(cmd *cobra.Command) *cobra.Command {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd
+}
+
+// operatorConfigFlagValues maps the fields of config set for component
+// ("controller" or "webhook") to the values of the flags they replace.
+func operatorConfigFlagValues(config *operatorconfig.OperatorConfig, component string) map[string]string {
+	values := map[string]string{}
+	setInt := func(name string, v *int) {
+		if v != nil {
+			values[name] = strconv.Itoa(*v)
+		}
+	}
+	setFloat := func(name string, v *float64) {
+		if v != nil {
+			values[name] = strconv.FormatFloat(*v, 'g', -1, 64)
+		}
+	}
+	setBool := func(name string, v *bool) {
+		if v != nil {
+			values[name] = strconv.FormatBool(*v)
+		}
+	}
+	setString := func(name string, v *string) {
+		if v != nil {
+			values[name] = *v
+		}
+	}
+	setDuration := func(name string, v *metav1.Duration) {
+		if v != nil {
+			values[name] = v.Duration.String()
+		}
+	}
+	setStrings := func(name string, v []string) {
+		if len(v) > 0 {
+			values[name] = strings.Join(v, ",")
+		}
+	}
+
+	setInt(verbosityFlag, config.Verbosity)
+	if t := config.Tracing; t != nil {
+		setBool("tracing-enabled", t.Enabled)
+		setString("tracing-endpoint", t.Endpoint)
+		setFloat(tracingSamplingFlag, t.SamplingRate)
+		setBool("tracing-insecure", t.Insecure)
+	}
+	if m := config.Metrics; m != nil && m.OTLP != nil {
+		setBool("otlp-metrics-enabled", m.OTLP.Enabled)
+		setString("otlp-metrics-endpoint", m.OTLP.Endpoint)
+		setBool("otlp-metrics-insecure", m.OTLP.Insecure)
+		setDuration("otlp-metrics-interval", m.OTLP.Interval)
+	}
+
+	switch component {
+	case "controller":
+		c := config.Controller
+		if c == nil {
+			break
+		}
+		setBool("leader-elect", c.LeaderElect)
+		setString("profile", c.Profile)
+		setStrings("controllers", c.Controllers)
+		if cc := c.Concurrency; cc != nil {
+			setInt("roledefinition-concurrency", cc.RoleDefinition)
+			setInt("binddefinition-concurrency", cc.BindDefinition)
+			setInt("webhookauthorizer-concurrency", cc.WebhookAuthorizer)
+			setInt("rbacpolicy-concurrency", cc.RBACPolicy)
+			setInt("restrictedbinddefinition-concurrency", cc.RestrictedBindDefinition)
+			setInt("restrictedroledefinition-concurrency", cc.RestrictedRoleDefinition)
+		}
+		setDuration("cache-sync-timeout", c.CacheSyncTimeout)
+		setDuration("graceful-shutdown-timeout", c.GracefulShutdownTimeout)
+		setBool("wait-for-crds", c.WaitForCRDs)
+		if t := c.Tracker; t != nil {
+			setDuration("tracker-sync-interval", t.SyncInterval)
+			setDuration("tracker-resync-interval", t.ResyncInterval)
+			setDuration("tracker-stale-ttl", t.StaleTTL)
+		}
+		if nt := c.NamespaceTermination; nt != nil {
+			setDuration("namespace-termination-grace-period", nt.GracePeriod)
+			setString("namespace-termination-finalizer-release", nt.FinalizerRelease)
+			setStrings("namespace-termination-resource-types", nt.ResourceTypes)
+			setDuration("namespace-termination-release-timeout", nt.ReleaseTimeout)
+		}
+		setDuration("impersonation-exposure-scan-interval", c.ImpersonationExposureScanInterval)
+		setDuration("capability-probe-interval", c.CapabilityProbeInterval)
+		setBool("enable-impersonation-grants", c.EnableImpersonationGrants)
+	case "webhook":
+		w := config.Webhook
+		if w == nil {
+			break
+		}
+		setInt("port", w.Port)
+		setString("certs-dir", w.CertsDir)
+		setBool("enable-http2", w.EnableHTTP2)
+		setBool("leader-elect", w.LeaderElect)
+		if cr := w.CertRotation; cr != nil {
+			setBool("disable-cert-rotation", cr.Disabled)
+			setString("cert-rotation-dns-name", cr.DNSName)
+			setString("cert-rotation-secret-name", cr.SecretName)
+			setStrings("cert-rotation-validating-webhook", cr.ValidatingWebhooks)
+			setStrings("cert-rotation-mutating-webhook", cr.MutatingWebhooks)
+		}
+		setBool("tdg-migration", w.TDGMigration)
+		setBool("capi-operator-update-bypass", w.CAPIOperatorUpdateBypass)
+		if a := w.Authorize; a != nil {
+			setFloat(authorizeRateLimitFlag, a.RateLimit)
+			setInt(authorizeRateBurstFlag, a.RateBurst)
+			setString("authorize-auth-token-file", a.AuthTokenFile)
+			setBool("allow-unauthenticated-authorize", a.AllowUnauthenticated)
+		}
+		setBool("record-service-account-usage", w.RecordServiceAccountUsage)
+	}
+	return values
+}
+
+// applyOperatorConfigFlags sets the flags of values that were not given on the
+// command line. Values for flags the command does not define are ignored.
+func applyOperatorConfigFlags(flags *pflag.FlagSet, values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		f := flags.Lookup(name)
+		if f == nil || f.Changed {
+			continue
+		}
+		if err := flags.Set(name, values[name]); err != nil {
+			return fmt.Errorf("invalid value %q for --%s: %w", values[name], name, err)
+		}
+	}
+	return nil
+}
+
+// loadOperatorConfig applies the OperatorConfig of --config to the flags of
+// cmd. It is a no-op without --config.
+func loadOperatorConfig(cmd *cobra.Command) error {
+	if operatorConfigFile == "" {
+		return nil
+	}
+	config, data, err := operatorconfig.Load(operatorConfigFile)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	overridden := map[string]bool{}
+	flags.Visit(func(f *pflag.Flag) {
+		overridden[f.Name] = true
+	})
+	values := operatorConfigFlagValues(config, componentCommand(cmd).Name())
+	if err := applyOperatorConfigFlags(flags, values); err != nil {
+		return fmt.Errorf("%s: %w", operatorConfigFile, err)
+	}
+
+	loadedOperatorConfig = &operatorConfigState{
+		path:       operatorConfigFile,
+		data:       data,
+		values:     values,
+		overridden: overridden,
+	}
+	return nil
+}
+
+// watchOperatorConfig polls the OperatorConfig file until ctx is done and
+// applies the hot-reloadable settings of every valid change. It is a no-op
+// when the command was started without --config.
+func watchOperatorConfig(ctx context.Context, component string, reloader operatorConfigReloader) {
+	state := loadedOperatorConfig
+	if state == nil {
+		return
+	}
+	log := setupLog.WithValues("config", state.path)
+
+	ticker := time.NewTicker(operatorConfigReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		data, err := os.ReadFile(state.path)
+		if err != nil {
+			log.Error(err, "unable to read OperatorConfig, keeping the current configuration")
+			continue
+		}
+		if bytes.Equal(data, state.data) {
+			continue
+		}
+		state.data = data
+
+		config, err := operatorconfig.Decode(data)
+		if err != nil {
+			log.Error(err, "ignoring invalid OperatorConfig, keeping the current configuration")
+			continue
+		}
+		values := operatorConfigFlagValues(config, component)
+		if err := state.reload(values, reloader); err != nil {
+			log.Error(err, "ignoring invalid OperatorConfig, keeping the current configuration")
+			continue
+		}
+		if restart := state.restartRequired(values); len(restart) > 0 {
+			log.Info("OperatorConfig changed settings that take effect after a restart", "flags", restart)
+		}
+		state.values = values
+		log.Info("reloaded OperatorConfig")
+	}
+}
+
+// reload validates the hot-reloadable settings of values and applies those
+// that changed. Flags given on the command line keep their value, and fields
+// removed from the file keep their current value.
+func (s *operatorConfigState) reload(values map[string]string, reloader operatorConfigReloader) error {
+	changed := func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok && !s.overridden[name] && value != s.values[name]
+	}
+
+	newVerbosity := verbosity
+	verbosityChanged := false
+	if value, ok := changed(verbosityFlag); ok {
+		v, err := strconv.Atoi(value)
+		if err != nil || v < 0 {
+			return fmt.Errorf("verbosity must be a non-negative integer, got %q", value)
+		}
+		newVerbosity, verbosityChanged = v, true
+	}
+
+	newSamplingRate := tracingSamplingRate
+	samplingChanged := false
+	if value, ok := changed(tracingSamplingFlag); ok {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tracing.samplingRate %q: %w", value, err)
+		}
+		newSamplingRate, samplingChanged = rate, true
+	}
+
+	newRateLimit, newRateBurst := authorizeRateLimit, authorizeRateBurst
+	rateLimitChanged := false
+	if reloader.setAuthorizeRateLimit != nil {
+		if value, ok := changed(authorizeRateLimitFlag); ok {
+			limit, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webhook.authorize.rateLimit %q: %w", value, err)
+			}
+			newRateLimit, rateLimitChanged = limit, true
+		}
+		if value, ok := changed(authorizeRateBurstFlag); ok {
+			burst, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid webhook.authorize.rateBurst %q: %w", value, err)
+			}
+			newRateBurst, rateLimitChanged = burst, true
+		}
+		if rateLimitChanged {
+			if err := validateRateLimitFlags(newRateLimit, newRateBurst); err != nil {
+				return err
+			}
+			if (newRateLimit > 0) != (authorizeRateLimit > 0) {
+				return fmt.Errorf("enabling or disabling /authorize rate limiting requires a restart")
+			}
+		}
+	}
+
+	if samplingChanged {
+		if err := reloader.setSamplingRate(newSamplingRate); err != nil {
+			return err
+		}
+		tracingSamplingRate = newSamplingRate
+	}
+	if rateLimitChanged {
+		if err := reloader.setAuthorizeRateLimit(newRateLimit, newRateBurst); err != nil {
+			return err
+		}
+		authorizeRateLimit, authorizeRateBurst = newRateLimit, newRateBurst
+	}
+	if verbosityChanged {
+		if err := reloader.setVerbosity(newVerbosity); err != nil {
+			return err
+		}
+		verbosity = newVerbosity
+	}
+	return nil
+}
+
+// restartRequired returns the flags whose value in values differs from the
+// applied OperatorConfig but cannot be changed while the process runs.
+func (s *operatorConfigState) restartRequired(values map[string]string) []string {
+	var names []string
+	for _, name := range slices.Concat(mapKeys(values), mapKeys(s.values)) {
+		switch name {
+		case verbosityFlag, tracingSamplingFlag, authorizeRateLimitFlag, authorizeRateBurstFlag:
+			continue
+		}
+		if s.overridden[name] || values[name] == s.values[name] || slices.Contains(names, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// setKlogVerbosity sets the verbosity of the klog logger all commands log with.
+func setKlogVerbosity(v int) error {
+	return flag.Set("v", strconv.Itoa(v))
+}
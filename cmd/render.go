@@ -0,0 +1,134 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	authorizationcontroller "github.com/telekom/auth-operator/internal/controller/authorization"
+	"github.com/telekom/auth-operator/pkg/discovery"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// stdioFileName is the file name that stands for standard input or output.
+const stdioFileName = "-"
+
+var (
+	renderDiscoveryFile string
+	renderFilenames     []string
+)
+
+// renderCmd groups commands that render operator output offline.
+var renderCmd = &cobra.Command{
+	Use:   "render",
+	Short: "Render generated resources offline without a cluster",
+}
+
+// renderRolesCmd renders the roles RoleDefinitions generate from an API resource snapshot.
+var renderRolesCmd = &cobra.Command{
+	Use:   "roles",
+	Short: "Render the ClusterRoles and Roles generated from RoleDefinitions",
+	Long: `Render the ClusterRoles and Roles the RoleDefinition controller generates
+for the RoleDefinitions in --filename, using the API resources of a discovery
+snapshot instead of a live cluster.
+
+The snapshot is the output of "auth-operator discovery dump", or a bare
+APIResourcesByGroupVersion JSON document: an object mapping GroupVersion
+strings such as "v1" or "apps/v1" to lists of metav1.APIResource. Documents of other kinds in --filename are ignored. The roles are written to
+standard output as a YAML stream in input order, so they can be diffed in
+review before the RoleDefinitions reach a cluster.`,
+	Example: `  auth-operator render roles --discovery snapshot.json -f roledefinitions.yaml`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRenderRoles(cmd.Context(), cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+func runRenderRoles(ctx context.Context, stdin io.Reader, out io.Writer) error {
+	source, err := discovery.LoadSnapshot(renderDiscoveryFile)
+	if err != nil {
+		return err
+	}
+
+	var roleDefinitions []authorizationv1alpha1.RoleDefinition
+	for _, filename := range renderFilenames {
+		decoded, err := readRoleDefinitions(filename, stdin)
+		if err != nil {
+			return err
+		}
+		roleDefinitions = append(roleDefinitions, decoded...)
+	}
+	if len(roleDefinitions) == 0 {
+		return errors.New("no RoleDefinitions found in --filename")
+	}
+
+	for i := range roleDefinitions {
+		role, err := authorizationcontroller.RenderRoleDefinition(ctx, &roleDefinitions[i], source)
+		if err != nil {
+			return fmt.Errorf("render RoleDefinition %s: %w", roleDefinitions[i].Name, err)
+		}
+		rendered, err := sigsyaml.Marshal(role)
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", role.GetName(), err)
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", rendered); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRoleDefinitions decodes the RoleDefinitions of a YAML or JSON stream.
+// The filename "-" reads from stdin.
+func readRoleDefinitions(filename string, stdin io.Reader) (roleDefinitions []authorizationv1alpha1.RoleDefinition, err error) {
+	in := stdin
+	if filename != stdioFileName {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("open: %w", err)
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("close %s: %w", filename, closeErr)
+			}
+		}()
+		in = f
+	}
+
+	decoder := yaml.NewYAMLOrJSONDecoder(in, 4096)
+	for {
+		var roleDefinition authorizationv1alpha1.RoleDefinition
+		if err := decoder.Decode(&roleDefinition); err != nil {
+			if errors.Is(err, io.EOF) {
+				return roleDefinitions, nil
+			}
+			return nil, fmt.Errorf("decode %s: %w", filename, err)
+		}
+		if roleDefinition.Kind != "RoleDefinition" ||
+			roleDefinition.GroupVersionKind().Group != authorizationv1alpha1.GroupVersion.Group {
+			continue
+		}
+		roleDefinitions = append(roleDefinitions, roleDefinition)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(renderCmd)
+	renderCmd.AddCommand(renderRolesCmd)
+
+	renderRolesCmd.Flags().StringVar(&renderDiscoveryFile, "discovery", "",
+		"Path to a JSON snapshot of the cluster's API resources, as written by \"auth-operator discovery dump\".")
+	renderRolesCmd.Flags().StringArrayVarP(&renderFilenames, "filename", "f", nil,
+		"YAML or JSON file containing RoleDefinitions; \"-\" reads standard input. Can be repeated.")
+	_ = renderRolesCmd.MarkFlagRequired("discovery")
+	_ = renderRolesCmd.MarkFlagRequired("filename")
+}
@@ -4,6 +4,7 @@ Copyright © 2026 Deutsche Telekom AG.
 package cmd
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
@@ -11,8 +12,11 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	pkgmetrics "github.com/telekom/auth-operator/pkg/metrics"
+	"github.com/telekom/auth-operator/pkg/operatorconfig"
 	"github.com/telekom/auth-operator/pkg/system"
 	"github.com/telekom/auth-operator/pkg/tracing"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -25,6 +29,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
@@ -48,6 +53,12 @@ var (
 	tracingEndpoint     string
 	tracingSamplingRate float64
 	tracingInsecure     bool
+
+	// OTLP metrics flags.
+	otlpMetricsEnabled  bool
+	otlpMetricsEndpoint string
+	otlpMetricsInsecure bool
+	otlpMetricsInterval time.Duration
 )
 
 const metricsCertDir = "/tmp/k8s-metrics-server/serving-certs"
@@ -88,9 +99,14 @@ The operator watches for changes in the cluster's API resources and automaticall
 updates the generated roles to reflect the current state of available APIs.
 
 For more information, visit: https://github.com/telekom/auth-operator`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Apply the OperatorConfig before anything reads the flags it sets.
+		if err := loadOperatorConfig(cmd); err != nil {
+			return err
+		}
+
 		// Set the verbosity level for klog
-		if err := flag.Set("v", fmt.Sprintf("%d", verbosity)); err != nil {
+		if err := setKlogVerbosity(verbosity); err != nil {
 			_, _ = fmt.Fprintf(os.Stderr, "warning: failed to set klog verbosity level: %v\n", err)
 		}
 
@@ -106,6 +122,10 @@ For more information, visit: https://github.com/telekom/auth-operator`,
 		for flagName, flagValue := range redactedFlags {
 			log.V(3).Info("flag", "name", flagName, "value", flagValue)
 		}
+		if operatorConfigFile != "" {
+			log.Info("applied OperatorConfig; command-line flags take precedence", "config", operatorConfigFile)
+		}
+		return nil
 	},
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
@@ -127,6 +147,10 @@ func init() {
 	cobra.OnInitialize(initScheme)
 
 	rootCmd.PersistentFlags().StringVar(&namespace, "namespace", os.Getenv("POD_NAMESPACE"), "operator namespace")
+	rootCmd.PersistentFlags().StringVar(&operatorConfigFile, "config", "",
+		"Path to an OperatorConfig file (apiVersion "+operatorconfig.APIVersion+"). "+
+			"Flags given on the command line override its values; verbosity, tracing sampling rate "+
+			"and /authorize rate limits are reloaded when the file changes.")
 	rootCmd.PersistentFlags().IntVarP(&verbosity, "verbosity", "v", 2, "Log level (0-9)")
 	rootCmd.PersistentFlags().StringVar(&probeAddr, "health-probe-bind-address", ":8081",
 		"The address the probe endpoint binds to.")
@@ -147,6 +171,18 @@ func init() {
 		"Trace sampling rate (0.0 to 1.0). Default is 0.1 (10%% sampling).")
 	rootCmd.PersistentFlags().BoolVar(&tracingInsecure, "tracing-insecure", false,
 		"Use insecure (non-TLS) connection to the OTLP collector.")
+
+	// OTLP metrics flags
+	rootCmd.PersistentFlags().BoolVar(&otlpMetricsEnabled, "otlp-metrics-enabled", false,
+		"Push the Prometheus metrics to an OTLP collector in addition to serving /metrics. "+
+			"Requires --otlp-metrics-endpoint (or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT / OTEL_EXPORTER_OTLP_ENDPOINT env) to be set.")
+	rootCmd.PersistentFlags().StringVar(&otlpMetricsEndpoint, "otlp-metrics-endpoint", "",
+		"OTLP collector endpoint for metrics (e.g. otel-collector:4317). "+
+			"Can also be set via OTEL_EXPORTER_OTLP_METRICS_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT environment variables.")
+	rootCmd.PersistentFlags().BoolVar(&otlpMetricsInsecure, "otlp-metrics-insecure", false,
+		"Use insecure (non-TLS) connection to the OTLP metrics collector.")
+	rootCmd.PersistentFlags().DurationVar(&otlpMetricsInterval, "otlp-metrics-interval", pkgmetrics.DefaultOTLPExportInterval,
+		"Interval between OTLP metric exports.")
 }
 
 func initScheme() {
@@ -170,21 +206,69 @@ func metricsFilterProvider() func(c *rest.Config, httpClient *http.Client) (metr
 // tracingConfig returns the tracing configuration derived from CLI flags
 // and environment variables. The flag value takes precedence; the
 // OTEL_EXPORTER_OTLP_ENDPOINT environment variable is used as fallback.
-// If the endpoint contains a scheme (http:// or https://), it is parsed
-// as a URL to extract the host:port. When --tracing-insecure was not
-// explicitly set, the insecure flag is inferred from the scheme
-// (http → insecure, https → secure).
+// See otlpEndpoint for endpoints with a scheme.
 func tracingConfig() tracing.Config {
-	endpoint := strings.TrimSpace(tracingEndpoint)
-	if endpoint == "" {
-		endpoint = strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	endpoint, insecure := otlpEndpoint(tracingEndpoint, tracingInsecure,
+		rootCmd.PersistentFlags().Changed("tracing-insecure"), "OTEL_EXPORTER_OTLP_ENDPOINT")
+
+	return tracing.Config{
+		Enabled:      tracingEnabled,
+		Endpoint:     endpoint,
+		SamplingRate: tracingSamplingRate,
+		Insecure:     insecure,
+	}
+}
+
+// otlpMetricsConfig returns the OTLP metrics configuration derived from CLI
+// flags and environment variables. The flag value takes precedence over
+// OTEL_EXPORTER_OTLP_METRICS_ENDPOINT, then OTEL_EXPORTER_OTLP_ENDPOINT.
+func otlpMetricsConfig() pkgmetrics.OTLPConfig {
+	endpoint, insecure := otlpEndpoint(otlpMetricsEndpoint, otlpMetricsInsecure,
+		rootCmd.PersistentFlags().Changed("otlp-metrics-insecure"),
+		"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT")
+
+	return pkgmetrics.OTLPConfig{
+		Enabled:  otlpMetricsEnabled,
+		Endpoint: endpoint,
+		Insecure: insecure,
+		Interval: otlpMetricsInterval,
 	}
+}
 
-	insecure := tracingInsecure
-	insecureExplicitlySet := rootCmd.PersistentFlags().Changed("tracing-insecure")
+// setupOTLPMetrics starts pushing the controller-runtime metrics registry,
+// which holds all auth-operator metrics, to an OTLP collector when
+// --otlp-metrics-enabled is set. The caller must shut the exporter down.
+func setupOTLPMetrics(ctx context.Context) (*pkgmetrics.OTLPExporter, error) {
+	cfg := otlpMetricsConfig()
+	exporter, err := pkgmetrics.SetupOTLP(ctx, cfg, crmetrics.Registry, system.Version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup OTLP metrics export: %w", err)
+	}
+	if exporter.Enabled() {
+		setupLog.Info("OTLP metrics export enabled",
+			"endpoint", cfg.Endpoint,
+			"interval", cfg.Interval)
+	}
+	return exporter, nil
+}
 
-	// Parse as URL if it contains a scheme — otlptracegrpc.WithEndpoint
-	// expects a bare host:port.
+// otlpEndpoint returns the OTLP endpoint from the flag value or, when it is
+// empty, the first non-empty environment variable of envVars.
+// If the endpoint contains a scheme (http:// or https://), it is parsed
+// as a URL to extract the host:port. When the insecure flag was not
+// explicitly set, insecure is inferred from the scheme
+// (http → insecure, https → secure).
+func otlpEndpoint(flagValue string, insecure, insecureExplicitlySet bool, envVars ...string) (string, bool) {
+	endpoint := strings.TrimSpace(flagValue)
+	for _, envVar := range envVars {
+		if endpoint != "" {
+			break
+		}
+		endpoint = strings.TrimSpace(os.Getenv(envVar))
+	}
+
+	// Parse as URL if it contains a scheme — the OTLP gRPC exporters'
+	// WithEndpoint expects a bare host:port.
 	if strings.Contains(endpoint, "://") {
 		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
 			// Only infer insecure from scheme when the flag wasn't explicitly set.
@@ -194,11 +278,5 @@ func tracingConfig() tracing.Config {
 			endpoint = u.Host
 		}
 	}
-
-	return tracing.Config{
-		Enabled:      tracingEnabled,
-		Endpoint:     endpoint,
-		SamplingRate: tracingSamplingRate,
-		Insecure:     insecure,
-	}
+	return endpoint, insecure
 }
@@ -48,6 +48,7 @@ var (
 	authorizeRateBurst             int
 	authorizeAuthTokenFile         string
 	allowUnauthenticatedAuthorize  bool
+	recordServiceAccountUsage      bool
 	webhookLeaderElect             bool
 )
 
@@ -88,6 +89,16 @@ ensuring authorization policies are enforced at creation time.`,
 				"samplingRate", tracingSamplingRate)
 		}
 
+		otlpMetrics, err := setupOTLPMetrics(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if shutdownErr := otlpMetrics.Shutdown(ctx); shutdownErr != nil {
+				setupLog.Error(shutdownErr, "error shutting down OTLP metrics exporter")
+			}
+		}()
+
 		disableHTTP2 := func(c *tls.Config) {
 			setupLog.Info("disabling http/2")
 			c.NextProtos = []string{"http/1.1"}
@@ -154,11 +165,22 @@ ensuring authorization policies are enforced at creation time.`,
 			setupLog.Info("waiting for certificate rotation to complete before configuring webhooks")
 			<-startListeners
 			setupLog.Info("certificate rotation complete, configuring webhooks")
-			if err := configureWebhooks(mgr, tracingProvider); err != nil {
+			authorizer, err := configureWebhooks(mgr, tracingProvider)
+			if err != nil {
 				setupLog.Error(err, "failed to configure webhooks")
 				cancel(fmt.Errorf("error configuring webhooks: %w", err))
 				return
 			}
+			go watchOperatorConfig(ctx, cmd.Name(), operatorConfigReloader{
+				setVerbosity:    setKlogVerbosity,
+				setSamplingRate: tracingProvider.SetSamplingRate,
+				setAuthorizeRateLimit: func(limit float64, burst int) error {
+					if !authorizer.SetRateLimit(rate.Limit(limit), burst) {
+						return errors.New("enabling /authorize rate limiting requires a restart")
+					}
+					return nil
+				},
+			})
 			setupLog.Info("webhooks configured successfully, server is ready")
 			ready.Store(true)
 		}()
@@ -247,7 +269,9 @@ ensuring authorization policies are enforced at creation time.`,
 	},
 }
 
-func configureWebhooks(mgr manager.Manager, tp *tracing.Provider) error {
+// configureWebhooks registers the webhooks with mgr and returns the
+// /authorize handler so its rate limit can be reloaded.
+func configureWebhooks(mgr manager.Manager, tp *tracing.Provider) (*authorizationwebhook.Authorizer, error) {
 	log := ctrl.Log.WithName("webhook-setup")
 
 	log.Info("registering authorization webhook at /authorize")
@@ -260,11 +284,11 @@ func configureWebhooks(mgr manager.Manager, tp *tracing.Provider) error {
 		Tracer: tp.TracerIfEnabled(),
 	}
 	if err := validateAuthorizeConfig(authorizeRateLimit, authorizeRateBurst, authorizeAuthTokenFile); err != nil {
-		return err
+		return nil, err
 	}
 	token, err := loadAuthorizeAuthToken(authorizeAuthTokenFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	authorizer.BearerToken = token
 	authorizer.BearerTokenFile = authorizeAuthTokenFile
@@ -278,16 +302,27 @@ func configureWebhooks(mgr manager.Manager, tp *tracing.Provider) error {
 			"rateLimit", authorizeRateLimit,
 			"burst", authorizeRateBurst)
 	}
+	if recordServiceAccountUsage {
+		authorizer.UsageRecorder = &authorizationwebhook.ServiceAccountUsageRecorder{
+			Reader: mgr.GetAPIReader(),
+			Writer: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("ServiceAccountUsageRecorder"),
+		}
+		if err := mgr.Add(authorizer.UsageRecorder); err != nil {
+			return nil, fmt.Errorf("unable to add ServiceAccount usage recorder: %w", err)
+		}
+		log.Info("recording last use of generated ServiceAccounts for idle revocation")
+	}
 	mgr.GetWebhookServer().Register("/authorize", authorizer)
 
 	log.Info("setting up RoleDefinition webhook")
 	if err := (&authorizationv1alpha1.RoleDefinition{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for RoleDefinition: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for RoleDefinition: %w", err)
 	}
 
 	log.Info("setting up BindDefinition webhook")
 	if err := (&authorizationv1alpha1.BindDefinition{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for BindDefinition: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for BindDefinition: %w", err)
 	}
 	// Setup Namespace mutator
 	log.Info("setting up Namespace mutator webhook",
@@ -319,29 +354,29 @@ func configureWebhooks(mgr manager.Manager, tp *tracing.Provider) error {
 	// Setup WebhookAuthorizer validator
 	log.Info("setting up WebhookAuthorizer validating webhook")
 	if err := (&authorizationv1alpha1.WebhookAuthorizer{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for WebhookAuthorizer: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for WebhookAuthorizer: %w", err)
 	}
 
 	// Setup RBACPolicy validator
 	log.Info("setting up RBACPolicy validating webhook")
 	if err := (&authorizationv1alpha1.RBACPolicy{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for RBACPolicy: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for RBACPolicy: %w", err)
 	}
 
 	// Setup RestrictedBindDefinition validator
 	log.Info("setting up RestrictedBindDefinition validating webhook")
 	if err := (&authorizationv1alpha1.RestrictedBindDefinition{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for RestrictedBindDefinition: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for RestrictedBindDefinition: %w", err)
 	}
 
 	// Setup RestrictedRoleDefinition validator
 	log.Info("setting up RestrictedRoleDefinition validating webhook")
 	if err := (&authorizationv1alpha1.RestrictedRoleDefinition{}).SetupWebhookWithManager(mgr); err != nil {
-		return fmt.Errorf("unable to create webhook for RestrictedRoleDefinition: %w", err)
+		return nil, fmt.Errorf("unable to create webhook for RestrictedRoleDefinition: %w", err)
 	}
 
 	log.Info("all webhooks configured successfully")
-	return nil
+	return authorizer, nil
 }
 
 func init() {
@@ -385,6 +420,9 @@ func init() {
 	webhookCmd.Flags().BoolVar(&allowUnauthenticatedAuthorize, "allow-unauthenticated-authorize", false,
 		"Allow /authorize requests without a bearer token when no authorize auth token file is configured. "+
 			"Insecure; use only for development or temporary migration.")
+	webhookCmd.Flags().BoolVar(&recordServiceAccountUsage, "record-service-account-usage", false,
+		"Record the last use of operator-generated ServiceAccounts seen by /authorize in the "+
+			"authorization.t-caas.telekom.com/last-used annotation. Required for serviceAccountLifecycle.idleRevokeAfter.")
 
 	webhookCmd.Flags().BoolVar(&webhookLeaderElect, "leader-elect", false,
 		"Enable leader election for the webhook manager. Required when running "+
@@ -0,0 +1,229 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/internal/webhook/authzconfig"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// authzConfigFileName is the file name of the rendered AuthorizationConfiguration.
+	authzConfigFileName = "authorization-config.yaml"
+	// certSecretCAKey is the CA bundle key in the cert rotator Secret.
+	certSecretCAKey = "ca.crt"
+)
+
+var (
+	authzConfigServerURL                 string
+	authzConfigCAFile                    string
+	authzConfigTokenSecretName           string
+	authzConfigTokenSecretKey            string
+	authzConfigAuthorizerName            string
+	authzConfigKubeconfigPath            string
+	authzConfigTimeout                   time.Duration
+	authzConfigAuthorizedTTL             time.Duration
+	authzConfigUnauthorizedTTL           time.Duration
+	authzConfigFailurePolicy             string
+	authzConfigIncludeDefaultAuthorizers bool
+	authzConfigDisableMatchConditions    bool
+	authzConfigOutputDir                 string
+)
+
+// renderAuthzConfigCmd renders the API server wiring for the /authorize endpoint.
+var renderAuthzConfigCmd = &cobra.Command{
+	Use:   "render-authz-config",
+	Short: "Render the API server AuthorizationConfiguration and kubeconfig for /authorize",
+	Long: `Render an apiserver.config.k8s.io/v1 AuthorizationConfiguration and the
+webhook kubeconfig it references, so the API server calls the auth-operator
+/authorize endpoint.
+
+The kubeconfig embeds the CA from the webhook certificate Secret maintained by
+the cert rotator (or --ca-file) and the /authorize bearer token. The webhook
+entry carries a CEL matchCondition built from the union of all WebhookAuthorizer
+rules, so the API server only sends SubjectAccessReviews that some
+WebhookAuthorizer could match. Render again after WebhookAuthorizer rules or the
+webhook CA change.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRenderAuthzConfig(cmd.Context())
+	},
+}
+
+func runRenderAuthzConfig(ctx context.Context) error {
+	serverURL := authzConfigServerURL
+	if serverURL == "" {
+		if certRotationDNSName == "" {
+			return errors.New("either --server-url or --cert-rotation-dns-name is required")
+		}
+		serverURL = authzconfig.ServerURL(certRotationDNSName, webhookPort)
+	}
+
+	token, err := loadAuthorizeAuthToken(authorizeAuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	var c client.Client
+	getClient := func() (client.Client, error) {
+		if c != nil {
+			return c, nil
+		}
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get kubeconfig: %w", err)
+		}
+		c, err = client.New(cfg, client.Options{Scheme: scheme})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create client: %w", err)
+		}
+		return c, nil
+	}
+	readSecretKey := func(name, key string) ([]byte, error) {
+		if namespace == "" {
+			return nil, fmt.Errorf("--namespace is required to read Secret %s", name)
+		}
+		cl, err := getClient()
+		if err != nil {
+			return nil, err
+		}
+		secret := &corev1.Secret{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+			return nil, fmt.Errorf("get Secret %s/%s: %w", namespace, name, err)
+		}
+		value, ok := secret.Data[key]
+		if !ok || len(value) == 0 {
+			return nil, fmt.Errorf("secret %s/%s has no %q key", namespace, name, key)
+		}
+		return value, nil
+	}
+
+	var caData []byte
+	switch {
+	case authzConfigCAFile != "":
+		if caData, err = os.ReadFile(authzConfigCAFile); err != nil {
+			return fmt.Errorf("read --ca-file: %w", err)
+		}
+	case certRotationSecretName != "":
+		if caData, err = readSecretKey(certRotationSecretName, certSecretCAKey); err != nil {
+			return err
+		}
+	default:
+		return errors.New("either --ca-file or --cert-rotation-secret-name is required")
+	}
+
+	if token == "" && authzConfigTokenSecretName != "" {
+		tokenBytes, err := readSecretKey(authzConfigTokenSecretName, authzConfigTokenSecretKey)
+		if err != nil {
+			return err
+		}
+		token = strings.TrimSpace(string(tokenBytes))
+	}
+	if token == "" {
+		setupLog.Info("rendering kubeconfig without a bearer token; /authorize rejects unauthenticated requests " +
+			"unless the webhook runs with --allow-unauthenticated-authorize")
+	}
+
+	var authorizers []authorizationv1alpha1.WebhookAuthorizer
+	if !authzConfigDisableMatchConditions {
+		cl, err := getClient()
+		if err != nil {
+			return err
+		}
+		list := &authorizationv1alpha1.WebhookAuthorizerList{}
+		if err := cl.List(ctx, list); err != nil {
+			return fmt.Errorf("list WebhookAuthorizers: %w", err)
+		}
+		authorizers = list.Items
+	}
+
+	rendered, err := authzconfig.Render(authzconfig.Options{
+		AuthorizerName:            authzConfigAuthorizerName,
+		ServerURL:                 serverURL,
+		CAData:                    caData,
+		Token:                     token,
+		KubeconfigPath:            authzConfigKubeconfigPath,
+		Timeout:                   authzConfigTimeout,
+		AuthorizedTTL:             authzConfigAuthorizedTTL,
+		UnauthorizedTTL:           authzConfigUnauthorizedTTL,
+		FailurePolicy:             authzConfigFailurePolicy,
+		IncludeDefaultAuthorizers: authzConfigIncludeDefaultAuthorizers,
+		DisableMatchConditions:    authzConfigDisableMatchConditions,
+	}, authorizers)
+	if err != nil {
+		return fmt.Errorf("render authorization config: %w", err)
+	}
+
+	authzConfigPath := filepath.Join(authzConfigOutputDir, authzConfigFileName)
+	if err := os.WriteFile(authzConfigPath, rendered.AuthorizationConfiguration, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", authzConfigPath, err)
+	}
+	// The kubeconfig carries the bearer token, so keep it owner-readable only.
+	kubeconfigPath := filepath.Join(authzConfigOutputDir, filepath.Base(authzConfigKubeconfigPath))
+	if err := os.WriteFile(kubeconfigPath, rendered.Kubeconfig, 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", kubeconfigPath, err)
+	}
+	setupLog.Info("rendered authorization config",
+		"authorizationConfiguration", authzConfigPath,
+		"kubeconfig", kubeconfigPath,
+		"server", serverURL,
+		"webhookAuthorizers", len(authorizers))
+	return nil
+}
+
+func init() {
+	webhookCmd.AddCommand(renderAuthzConfigCmd)
+
+	// The connection flags share their names and variables with the webhook
+	// command so the same arguments can be reused.
+	renderAuthzConfigCmd.Flags().IntVar(&webhookPort, "port", 9443,
+		"The port the webhook server binds to.")
+	renderAuthzConfigCmd.Flags().StringVar(&certRotationDNSName, "cert-rotation-dns-name", "",
+		"The DNS name for the webhook service, used to build the /authorize URL.")
+	renderAuthzConfigCmd.Flags().StringVar(&certRotationSecretName, "cert-rotation-secret-name", "",
+		"The name of the webhook certs Secret in --namespace to read ca.crt from.")
+	renderAuthzConfigCmd.Flags().StringVar(&authorizeAuthTokenFile, "authorize-auth-token-file", "",
+		"Path to a file containing the /authorize bearer token.")
+
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigServerURL, "server-url", "",
+		"Full https URL of the /authorize endpoint. Overrides --cert-rotation-dns-name and --port.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigCAFile, "ca-file", "",
+		"Path to the PEM CA bundle for the webhook serving certificate. Overrides --cert-rotation-secret-name.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigTokenSecretName, "authorize-auth-token-secret-name", "",
+		"Name of a Secret in --namespace holding the /authorize bearer token. Ignored when --authorize-auth-token-file is set.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigTokenSecretKey, "authorize-auth-token-secret-key", "token",
+		"Key in the bearer token Secret.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigAuthorizerName, "authorizer-name", authzconfig.DefaultAuthorizerName,
+		"Name of the Webhook authorizer entry and of the kubeconfig cluster, user and context.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigKubeconfigPath, "kubeconfig-path", authzconfig.DefaultKubeconfigPath,
+		"Path on the API server host where the rendered kubeconfig is installed.")
+	renderAuthzConfigCmd.Flags().DurationVar(&authzConfigTimeout, "timeout", 3*time.Second,
+		"Webhook call timeout (at most 30s).")
+	renderAuthzConfigCmd.Flags().DurationVar(&authzConfigAuthorizedTTL, "authorized-ttl", 5*time.Minute,
+		"How long the API server caches allow decisions.")
+	renderAuthzConfigCmd.Flags().DurationVar(&authzConfigUnauthorizedTTL, "unauthorized-ttl", 30*time.Second,
+		"How long the API server caches deny and no-opinion decisions.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigFailurePolicy, "failure-policy", apiserverv1.FailurePolicyNoOpinion,
+		"Decision when the webhook cannot be reached: NoOpinion or Deny.")
+	renderAuthzConfigCmd.Flags().BoolVar(&authzConfigIncludeDefaultAuthorizers, "include-default-authorizers", true,
+		"Place the webhook between the Node and RBAC authorizers so the file can replace --authorization-mode.")
+	renderAuthzConfigCmd.Flags().BoolVar(&authzConfigDisableMatchConditions, "no-match-conditions", false,
+		"Send every SubjectAccessReview to the webhook instead of deriving matchConditions from WebhookAuthorizers.")
+	renderAuthzConfigCmd.Flags().StringVar(&authzConfigOutputDir, "output-dir", ".",
+		"Directory to write "+authzConfigFileName+" and the kubeconfig to.")
+}
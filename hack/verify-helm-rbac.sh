@@ -15,6 +15,7 @@ default_render="${TMP_DIR}/default.yaml"
 namespace_admission_render="${TMP_DIR}/namespace-admission.yaml"
 clusterwide_render="${TMP_DIR}/clusterwide.yaml"
 scoped_render="${TMP_DIR}/scoped.yaml"
+token_request_render="${TMP_DIR}/token-request.yaml"
 production_render="${TMP_DIR}/production.yaml"
 metrics_auth_render="${TMP_DIR}/metrics-auth.yaml"
 egress_render="${TMP_DIR}/egress.yaml"
@@ -49,6 +50,28 @@ if ! grep -Eq '^kind:[[:space:]]*Role$' "${scoped_render}" ||
 	exit 1
 fi
 
+helm template auth-operator "${CHART_DIR}" \
+	--set image.tag=test \
+	--set controller.impersonation.enabled=true \
+	--set controller.impersonation.credentialSource=TokenRequest \
+	--set controller.impersonation.serviceAccounts[0].namespace=team-a \
+	--set controller.impersonation.serviceAccounts[0].name=team-a-rbac-applier >"${token_request_render}"
+go run "${ROOT_DIR}/hack/verify-rendered-rbac.go" --impersonation=scoped --serviceaccount=team-a-rbac-applier "${token_request_render}"
+if ! grep -Eq '^[[:space:]]*-[[:space:]]*serviceaccounts/token$' "${token_request_render}" ||
+	grep -Eq '^[[:space:]]*-[[:space:]]*impersonate$' "${token_request_render}"; then
+	echo "TokenRequest credential source must grant serviceaccounts/token instead of impersonate" >&2
+	exit 1
+fi
+
+if helm template auth-operator "${CHART_DIR}" \
+	--set image.tag=test \
+	--set controller.impersonation.enabled=true \
+	--set controller.impersonation.credentialSource=TokenRequest \
+	--set controller.impersonation.clusterWide=true >/dev/null 2>&1; then
+	echo "TokenRequest credential source with clusterWide should fail Helm rendering" >&2
+	exit 1
+fi
+
 if helm template auth-operator "${CHART_DIR}" \
 	--set image.tag=test \
 	--set controller.impersonation.enabled=true >/dev/null 2>&1; then
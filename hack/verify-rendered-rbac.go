@@ -281,7 +281,7 @@ func verifyImpersonationMode(roles []roleObject, mode, expectedServiceAccount st
 	var scopedRules, clusterWideRules int
 	for _, role := range roles {
 		for _, rule := range role.Rules {
-			if !isServiceAccountImpersonate(rule) {
+			if !isServiceAccountApplyGrant(rule) {
 				continue
 			}
 			if err := verifyImpersonationRule(role, rule, mode, expectedServiceAccount); err != nil {
@@ -339,10 +339,15 @@ func isAdmissionregistrationWrite(rule policyRule) bool {
 		hasAny(rule.Verbs, "patch", "update")
 }
 
-func isServiceAccountImpersonate(rule policyRule) bool {
-	return hasAny(rule.APIGroups, "") &&
-		hasAny(rule.Resources, "serviceaccounts") &&
-		hasAny(rule.Verbs, "impersonate")
+// isServiceAccountApplyGrant reports whether rule lets the controller act as a
+// ServiceAccount, either through serviceaccounts/impersonate or by minting its
+// tokens through serviceaccounts/token (RBACPolicy credentialSource TokenRequest).
+func isServiceAccountApplyGrant(rule policyRule) bool {
+	if !hasAny(rule.APIGroups, "") {
+		return false
+	}
+	return (hasAny(rule.Resources, "serviceaccounts") && hasAny(rule.Verbs, "impersonate")) ||
+		(hasAny(rule.Resources, "serviceaccounts/token") && hasAny(rule.Verbs, "create"))
 }
 
 func hasAny(values []string, needles ...string) bool {
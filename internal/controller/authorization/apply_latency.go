@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/metrics"
+)
+
+// applyLatencyPredicate starts the RBACApplyLatency clock of a definition
+// when the informer sees a new generation. It never filters events.
+//
+// Create events also arrive for every existing object when the cache starts,
+// so a clock is only started for first generations that are not yet Ready,
+// measured from the creation timestamp. The start of a later generation that
+// was pending across an operator restart is unknown and is not measured.
+func applyLatencyPredicate(controller string) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			if e.Object.GetGeneration() == 1 && !readyForGeneration(e.Object) {
+				metrics.StartApplyLatency(controller, e.Object.GetName(), 1, e.Object.GetCreationTimestamp().Time)
+			}
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld != nil && e.ObjectNew != nil && e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				metrics.StartApplyLatency(controller, e.ObjectNew.GetName(), e.ObjectNew.GetGeneration(), time.Now())
+			}
+			return true
+		},
+	}
+}
+
+// readyForGeneration reports whether obj is Ready for its current generation.
+func readyForGeneration(obj any) bool {
+	getter, ok := obj.(conditions.Getter)
+	if !ok {
+		return false
+	}
+	return conditions.IsReady(getter) &&
+		conditions.GetObservedGeneration(getter, conditions.ReadyConditionType) == getter.GetGeneration()
+}
+
+// applyLatencyNamespaceHandler wraps the Namespace event handler of a
+// definition controller. When the labels of an active namespace change, it
+// starts the RBACApplyLatency clock of every definition the event is mapped
+// to, since their selectors may now select a different set of namespaces.
+type applyLatencyNamespaceHandler struct {
+	handler.EventHandler
+	controller string
+}
+
+func newApplyLatencyNamespaceHandler(controller string, mapFn handler.MapFunc) handler.EventHandler {
+	return &applyLatencyNamespaceHandler{
+		EventHandler: handler.EnqueueRequestsFromMapFunc(mapFn),
+		controller:   controller,
+	}
+}
+
+// Update implements handler.EventHandler.
+func (h *applyLatencyNamespaceHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	namespace, ok := e.ObjectNew.(*corev1.Namespace)
+	if !ok || e.ObjectOld == nil || !conditions.IsNamespaceActive(namespace) ||
+		maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+		h.EventHandler.Update(ctx, e, q)
+		return
+	}
+	h.EventHandler.Update(ctx, e, &applyLatencyQueue{
+		TypedRateLimitingInterface: q,
+		controller:                 h.controller,
+		since:                      time.Now(),
+	})
+}
+
+// applyLatencyQueue starts the RBACApplyLatency clock of every request added
+// to the wrapped queue. It hides a priority queue, so requests are added with
+// the default priority, which is what the mapping handler uses for updates
+// that change the resource version anyway.
+type applyLatencyQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	controller string
+	since      time.Time
+}
+
+// Add implements workqueue.TypedInterface.
+func (q *applyLatencyQueue) Add(req reconcile.Request) {
+	metrics.StartApplyLatency(q.controller, req.Name, 0, q.since)
+	q.TypedRateLimitingInterface.Add(req)
+}
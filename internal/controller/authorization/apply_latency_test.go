@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/metrics"
+)
+
+func applyLatencySampleCount(g Gomega, controller string) uint64 {
+	h, ok := metrics.RBACApplyLatency.WithLabelValues(controller, metrics.ApplyOutcomeReady).(prometheus.Histogram)
+	g.Expect(ok).To(BeTrue())
+	var m dto.Metric
+	g.Expect(h.Write(&m)).To(Succeed())
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestApplyLatencyPredicate(t *testing.T) {
+	ctx := context.Background()
+	pred := applyLatencyPredicate(metrics.ControllerRoleDefinition)
+
+	t.Run("starts the clock of a new generation", func(t *testing.T) {
+		g := NewWithT(t)
+		metrics.RBACApplyLatency.Reset()
+		defer metrics.RBACApplyLatency.Reset()
+
+		oldObj := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{Name: "rd-update", Generation: 1}}
+		newObj := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{Name: "rd-update", Generation: 2}}
+		g.Expect(pred.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+
+		metrics.ObserveApplyLatency(ctx, metrics.ControllerRoleDefinition, "rd-update", 2, metrics.ApplyOutcomeReady)
+		g.Expect(applyLatencySampleCount(g, metrics.ControllerRoleDefinition)).To(Equal(uint64(1)))
+	})
+
+	t.Run("ignores updates without a generation change", func(t *testing.T) {
+		g := NewWithT(t)
+		metrics.RBACApplyLatency.Reset()
+		defer metrics.RBACApplyLatency.Reset()
+
+		obj := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{Name: "rd-status", Generation: 3}}
+		g.Expect(pred.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: obj.DeepCopy()})).To(BeTrue())
+
+		metrics.ObserveApplyLatency(ctx, metrics.ControllerRoleDefinition, "rd-status", 3, metrics.ApplyOutcomeReady)
+		g.Expect(applyLatencySampleCount(g, metrics.ControllerRoleDefinition)).To(BeZero())
+	})
+
+	t.Run("create starts the clock only for first generations that are not Ready", func(t *testing.T) {
+		g := NewWithT(t)
+		metrics.RBACApplyLatency.Reset()
+		defer metrics.RBACApplyLatency.Reset()
+
+		created := metav1.NewTime(time.Now().Add(-time.Minute))
+		pending := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{
+			Name: "rd-new", Generation: 1, CreationTimestamp: created,
+		}}
+		ready := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{
+			Name: "rd-ready", Generation: 1, CreationTimestamp: created,
+		}}
+		conditions.MarkReady(ready, 1, authorizationv1alpha1.ReadyReasonReconciled, authorizationv1alpha1.ReadyMessageReconciled)
+		later := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{
+			Name: "rd-later", Generation: 4, CreationTimestamp: created,
+		}}
+		for _, obj := range []client.Object{pending, ready, later} {
+			g.Expect(pred.Create(event.CreateEvent{Object: obj})).To(BeTrue())
+		}
+
+		for _, obj := range []client.Object{ready, later, pending} {
+			metrics.ObserveApplyLatency(ctx, metrics.ControllerRoleDefinition, obj.GetName(), obj.GetGeneration(), metrics.ApplyOutcomeReady)
+		}
+		g.Expect(applyLatencySampleCount(g, metrics.ControllerRoleDefinition)).To(Equal(uint64(1)))
+	})
+}
+
+func TestApplyLatencyNamespaceHandler(t *testing.T) {
+	ctx := context.Background()
+	mapFn := func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "bd-ns"}}}
+	}
+	h := newApplyLatencyNamespaceHandler(metrics.ControllerBindDefinition, mapFn)
+
+	activeNamespace := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: labels},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		oldNS       *corev1.Namespace
+		newNS       *corev1.Namespace
+		wantSamples uint64
+	}{
+		{
+			name:        "label change of an active namespace",
+			oldNS:       activeNamespace(map[string]string{"env": "dev"}),
+			newNS:       activeNamespace(map[string]string{"env": "prod"}),
+			wantSamples: 1,
+		},
+		{
+			name:  "phase change",
+			oldNS: activeNamespace(nil),
+			newNS: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "ns1"},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			metrics.RBACApplyLatency.Reset()
+			defer metrics.RBACApplyLatency.Reset()
+
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer q.ShutDown()
+			h.Update(ctx, event.UpdateEvent{ObjectOld: tt.oldNS, ObjectNew: tt.newNS}, q)
+			g.Expect(q.Len()).To(Equal(1))
+
+			metrics.ObserveApplyLatency(ctx, metrics.ControllerBindDefinition, "bd-ns", 1, metrics.ApplyOutcomeReady)
+			g.Expect(applyLatencySampleCount(g, metrics.ControllerBindDefinition)).To(Equal(tt.wantSamples))
+		})
+	}
+}
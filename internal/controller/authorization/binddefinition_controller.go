@@ -131,6 +131,20 @@ type BindDefinitionReconciler struct {
 // setTracer implements tracerSetter.
 func (r *BindDefinitionReconciler) setTracer(t trace.Tracer) { r.tracer = t }
 
+// setNamespaceTerminationGracePeriod implements namespaceTerminationGracePeriodSetter.
+func (r *BindDefinitionReconciler) setNamespaceTerminationGracePeriod(d time.Duration) {
+	if r.RoleBindingTerminator != nil {
+		r.RoleBindingTerminator.terminationGracePeriod = d
+	}
+}
+
+// setNamespaceTerminationPolicy implements namespaceTerminationPolicySetter.
+func (r *BindDefinitionReconciler) setNamespaceTerminationPolicy(p *authorizationv1alpha1.NamespaceTerminationPolicy) {
+	if r.RoleBindingTerminator != nil {
+		r.RoleBindingTerminator.defaultTerminationPolicy = p
+	}
+}
+
 // NewBindDefinitionReconciler creates a new BindDefinition reconciler.
 // Uses the manager's cached client for improved performance.
 func NewBindDefinitionReconciler(
@@ -174,7 +188,8 @@ func (r *BindDefinitionReconciler) SetupWithManager(mgr ctrl.Manager, concurrenc
 	}
 	return ctrl.NewControllerManagedBy(mgr).
 		// control BindDefinitions
-		For(&authorizationv1alpha1.BindDefinition{}).
+		For(&authorizationv1alpha1.BindDefinition{},
+			builder.WithPredicates(applyLatencyPredicate(metrics.ControllerBindDefinition))).
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			MaxConcurrentReconciles: concurrency,
 		}).
@@ -188,7 +203,7 @@ func (r *BindDefinitionReconciler) SetupWithManager(mgr ctrl.Manager, concurrenc
 		// safety net, although by the time a namespace delete event fires the
 		// object is typically already gone from the API server.
 		Watches(&corev1.Namespace{},
-			handler.EnqueueRequestsFromMapFunc(r.namespaceToBindDefinitionRequests),
+			newApplyLatencyNamespaceHandler(metrics.ControllerBindDefinition, r.namespaceToBindDefinitionRequests),
 			builder.WithPredicates(namespaceLabelOrPhaseChangePredicate()),
 		).
 
@@ -397,7 +412,7 @@ func (r *BindDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// Track reconcile duration on exit
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerBindDefinition).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerBindDefinition, duration)
 		logger.V(1).Info("=== Reconcile END ===",
 			"bindDefinition", req.Name,
 			"duration", duration.String())
@@ -569,6 +584,7 @@ func (r *BindDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// self-heals quickly on first occurrence but doesn't waste API calls when
 	// references stay missing for a long time (e.g. misconfiguration).
 	if missingRoleRefCount > 0 {
+		metrics.ObserveApplyLatency(ctx, metrics.ControllerBindDefinition, bindDefinition.Name, bindDefinition.Generation, metrics.ApplyOutcomeDegraded)
 		backoff := calculateMissingRoleRefBackoff(bindDefinition)
 		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerBindDefinition, metrics.ResultDegraded).Inc()
 		logger.Info("Requeuing with backoff due to missing role references",
@@ -581,6 +597,7 @@ func (r *BindDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	logger.V(1).Info("Reconcile completed successfully",
 		"bindDefinition", bindDefinition.Name,
 		"requeueAfter", DefaultRequeueInterval)
+	metrics.ObserveApplyLatency(ctx, metrics.ControllerBindDefinition, bindDefinition.Name, bindDefinition.Generation, metrics.ApplyOutcomeReady)
 	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerBindDefinition, metrics.ResultSuccess).Inc()
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
@@ -751,6 +768,11 @@ func (r *BindDefinitionReconciler) reconcileResources(
 		"bindDefinition", bindDefinition.Name,
 		"subjectCount", len(bindDefinition.Spec.Subjects))
 	previousGeneratedSAs := append([]rbacv1.Subject(nil), bindDefinition.Status.GeneratedServiceAccounts...)
+	bindDefinition.Status.RevokedServiceAccounts = retainedRevokedServiceAccounts(
+		bindDefinition.Spec.ServiceAccountLifecycle,
+		bindDefinition.Spec.Subjects,
+		bindDefinition.Status.RevokedServiceAccounts,
+	)
 	generatedSAs, externalSAs, err := r.ensureServiceAccounts(ctx, bindDefinition)
 	if err != nil {
 		return 0, fmt.Errorf("ensure ServiceAccounts: %w", err)
@@ -773,25 +795,9 @@ func (r *BindDefinitionReconciler) reconcileResources(
 	// Update metric for external SAs count
 	metrics.ExternalSAsReferenced.WithLabelValues(bindDefinition.Name).Set(float64(len(externalSAs)))
 
-	// Ensure ClusterRoleBindings (uses SSA - handles both create and update)
-	logger.V(2).Info("reconcileResources: Ensuring ClusterRoleBindings",
-		"bindDefinition", bindDefinition.Name,
-		"clusterRoleRefCount", len(bindDefinition.Spec.ClusterRoleBindings.ClusterRoleRefs))
-	if err := r.ensureClusterRoleBindings(ctx, bindDefinition); err != nil {
-		return 0, fmt.Errorf("ensure ClusterRoleBindings: %w", err)
-	}
-	logger.V(2).Info("reconcileResources: ClusterRoleBindings ensured",
-		"bindDefinition", bindDefinition.Name)
-
-	// Ensure RoleBindings (uses SSA - handles both create and update)
-	logger.V(2).Info("reconcileResources: Ensuring RoleBindings",
-		"bindDefinition", bindDefinition.Name,
-		"roleBindingSpecCount", len(bindDefinition.Spec.RoleBindings))
-	if err := r.ensureRoleBindings(ctx, bindDefinition, perRoleBindingNamespaces); err != nil {
-		return 0, fmt.Errorf("ensure RoleBindings: %w", err)
+	if err := r.ensureBindings(ctx, bindDefinition, perRoleBindingNamespaces); err != nil {
+		return 0, err
 	}
-	logger.V(2).Info("reconcileResources: RoleBindings ensured",
-		"bindDefinition", bindDefinition.Name)
 
 	conditions.MarkTrue(bindDefinition, authorizationv1alpha1.CreateCondition, bindDefinition.Generation,
 		authorizationv1alpha1.CreateReason, authorizationv1alpha1.CreateMessage)
@@ -816,6 +822,42 @@ func (r *BindDefinitionReconciler) reconcileResources(
 	return missingCount, nil
 }
 
+// ensureBindings ensures the ClusterRoleBindings and RoleBindings of the
+// BindDefinition. The field manager conflicts of their applies replace
+// status.conflicts and are reported once the applies ran, also on error.
+func (r *BindDefinitionReconciler) ensureBindings(
+	ctx context.Context,
+	bindDefinition *authorizationv1alpha1.BindDefinition,
+	perRoleBindingNamespaces [][]corev1.Namespace,
+) error {
+	logger := log.FromContext(ctx)
+
+	defer reportConflicts(ctx, r.recorder, bindDefinition, metrics.ControllerBindDefinition,
+		bindDefinition.GetConflictPolicy(), bindDefinition.Status.Conflicts, &bindDefinition.Status.Conflicts)
+	bindDefinition.Status.Conflicts = nil
+
+	// Ensure ClusterRoleBindings (uses SSA - handles both create and update)
+	logger.V(2).Info("reconcileResources: Ensuring ClusterRoleBindings",
+		"bindDefinition", bindDefinition.Name,
+		"clusterRoleRefCount", len(bindDefinition.Spec.ClusterRoleBindings.ClusterRoleRefs))
+	if err := r.ensureClusterRoleBindings(ctx, bindDefinition); err != nil {
+		return fmt.Errorf("ensure ClusterRoleBindings: %w", err)
+	}
+	logger.V(2).Info("reconcileResources: ClusterRoleBindings ensured",
+		"bindDefinition", bindDefinition.Name)
+
+	// Ensure RoleBindings (uses SSA - handles both create and update)
+	logger.V(2).Info("reconcileResources: Ensuring RoleBindings",
+		"bindDefinition", bindDefinition.Name,
+		"roleBindingSpecCount", len(bindDefinition.Spec.RoleBindings))
+	if err := r.ensureRoleBindings(ctx, bindDefinition, perRoleBindingNamespaces); err != nil {
+		return fmt.Errorf("ensure RoleBindings: %w", err)
+	}
+	logger.V(2).Info("reconcileResources: RoleBindings ensured",
+		"bindDefinition", bindDefinition.Name)
+	return nil
+}
+
 // ensureClusterRoleBindings ensures all ClusterRoleBindings for the BindDefinition exist and are up-to-date.
 // Uses Server-Side Apply (SSA) to create or update bindings in a single operation.
 // This replaces the separate createClusterRoleBindings and updateClusterRoleBindings functions.
@@ -824,6 +866,8 @@ func (r *BindDefinitionReconciler) ensureClusterRoleBindings(
 	bindDef *authorizationv1alpha1.BindDefinition,
 ) error {
 	logger := log.FromContext(ctx)
+	conflictPolicy := bindDef.GetConflictPolicy()
+	applyOpts := conflictApplyOptions(ctx, conflictPolicy, &bindDef.Status.Conflicts)
 
 	for _, clusterRoleRef := range bindDef.Spec.ClusterRoleBindings.ClusterRoleRefs {
 		crbName := helpers.BuildBindingName(bindDef.Spec.TargetName, clusterRoleRef)
@@ -838,7 +882,7 @@ func (r *BindDefinitionReconciler) ensureClusterRoleBindings(
 		ac := pkgssa.ClusterRoleBindingWithSubjectsAndRoleRef(
 			crbName,
 			helpers.BuildResourceLabels(bindDef.Labels),
-			bindDef.Spec.Subjects,
+			withoutRevokedServiceAccounts(bindDef.Spec.Subjects, bindDef.Status.RevokedServiceAccounts),
 			rbacv1.RoleRef{
 				APIGroup: rbacv1.GroupName,
 				Kind:     "ClusterRole",
@@ -851,7 +895,10 @@ func (r *BindDefinitionReconciler) ensureClusterRoleBindings(
 			WithAnnotations(helpers.BuildResourceAnnotations("BindDefinition", bindDef.Name))
 
 		// Apply using SSA with cache-aware diffing — skip if unchanged.
-		result, err := pkgssa.PatchApplyClusterRoleBinding(ctx, r.client, ac, client.ForceOwnership)
+		result, err := pkgssa.PatchApplyClusterRoleBinding(ctx, r.client, ac, applyOpts...)
+		if conflictReported(conflictPolicy, err) {
+			continue
+		}
 		if err != nil {
 			logger.Error(err, "Failed to ensure ClusterRoleBinding",
 				"bindDefinitionName", bindDef.Name, "clusterRoleBindingName", crbName)
@@ -956,7 +1003,7 @@ func (r *BindDefinitionReconciler) ensureSingleRoleBinding(
 		rbName,
 		namespace,
 		helpers.BuildResourceLabels(bindDef.Labels),
-		bindDef.Spec.Subjects,
+		withoutRevokedServiceAccounts(bindDef.Spec.Subjects, bindDef.Status.RevokedServiceAccounts),
 		desiredRoleRef,
 	)
 
@@ -965,7 +1012,12 @@ func (r *BindDefinitionReconciler) ensureSingleRoleBinding(
 		WithAnnotations(helpers.BuildResourceAnnotations("BindDefinition", bindDef.Name))
 
 	// Apply using SSA with cache-aware diffing — skip if unchanged.
-	result, err := pkgssa.PatchApplyRoleBinding(ctx, r.client, ac, client.ForceOwnership)
+	conflictPolicy := bindDef.GetConflictPolicy()
+	applyOpts := conflictApplyOptions(ctx, conflictPolicy, &bindDef.Status.Conflicts)
+	result, err := pkgssa.PatchApplyRoleBinding(ctx, r.client, ac, applyOpts...)
+	if conflictReported(conflictPolicy, err) {
+		return nil
+	}
 	if err != nil {
 		logger.Error(err, "Failed to ensure RoleBinding",
 			"bindDefinitionName", bindDef.Name, "roleBindingName", rbName, "namespace", namespace)
@@ -1347,6 +1399,8 @@ func (r *BindDefinitionReconciler) applyServiceAccount(
 // SAs already owned by another BindDefinition ARE updated via SSA to add this BD's
 // ownerRef, enabling shared ownership so that the SA survives individual BD deletions.
 // Returns the list of generated/managed SAs and the list of external (pre-existing) SAs.
+// Generated SAs whose serviceAccountLifecycle expired are recorded in
+// status.revokedServiceAccounts and are no longer applied.
 func (r *BindDefinitionReconciler) ensureServiceAccounts(
 	ctx context.Context,
 	bindDef *authorizationv1alpha1.BindDefinition,
@@ -1357,6 +1411,9 @@ func (r *BindDefinitionReconciler) ensureServiceAccounts(
 
 	// Use the configured value from spec, defaulting to true for backward compatibility
 	automountToken := ptr.Deref(bindDef.Spec.AutomountServiceAccountToken, true)
+	lifecycle := bindDef.Spec.ServiceAccountLifecycle
+	revoked := revokedServiceAccountKeys(bindDef.Status.RevokedServiceAccounts)
+	now := time.Now()
 
 	for _, subject := range bindDef.Spec.Subjects {
 		if subject.Kind != authorizationv1alpha1.BindSubjectServiceAccount {
@@ -1372,6 +1429,16 @@ func (r *BindDefinitionReconciler) ensureServiceAccounts(
 			continue // Namespace not found or terminating, logged in validateServiceAccountNamespace
 		}
 
+		// Revoked SAs stay revoked. Unbind keeps them under this BindDefinition's
+		// management so they are still cleaned up when it is deleted.
+		if _, ok := revoked[serviceAccountKey(subject.Namespace, subject.Name)]; ok {
+			if lifecycle.ActionOrDefault() == authorizationv1alpha1.ServiceAccountExpiryActionUnbind &&
+				!helpers.SubjectExists(generatedSAs, subject) {
+				generatedSAs = append(generatedSAs, subject)
+			}
+			continue
+		}
+
 		// Check if the ServiceAccount already exists and whether any BindDefinition owns it.
 		// Pre-existing SAs (created outside of any BindDefinition) must NOT be
 		// adopted — we skip SSA so we never add an OwnerReference to them.
@@ -1414,6 +1481,19 @@ func (r *BindDefinitionReconciler) ensureServiceAccounts(
 			continue
 		}
 
+		if saExists {
+			if entry := expiredServiceAccount(lifecycle, existing, now); entry != nil {
+				keep, err := r.revokeServiceAccount(ctx, bindDef, *entry)
+				if err != nil {
+					return nil, nil, err
+				}
+				if keep && !helpers.SubjectExists(generatedSAs, subject) {
+					generatedSAs = append(generatedSAs, subject)
+				}
+				continue
+			}
+		}
+
 		// If SA exists and is owned by another BD, emit a shared-ownership event
 		if saExists && !hasOwnerRef(existing, bindDef) {
 			logger.V(1).Info("ServiceAccount is owned by another BindDefinition, adding shared ownership",
@@ -1441,6 +1521,35 @@ func (r *BindDefinitionReconciler) ensureServiceAccounts(
 	return generatedSAs, externalSAs, nil
 }
 
+// revokeServiceAccount records an expired generated ServiceAccount in
+// status.revokedServiceAccounts and deletes it when the lifecycle action is
+// Delete. It reports whether the ServiceAccount stays managed by this
+// BindDefinition (true for Unbind).
+func (r *BindDefinitionReconciler) revokeServiceAccount(
+	ctx context.Context,
+	bindDef *authorizationv1alpha1.BindDefinition,
+	entry authorizationv1alpha1.RevokedServiceAccount,
+) (bool, error) {
+	logger := log.FromContext(ctx)
+	action := bindDef.Spec.ServiceAccountLifecycle.ActionOrDefault()
+
+	if action == authorizationv1alpha1.ServiceAccountExpiryActionDelete {
+		if _, err := r.deleteServiceAccount(ctx, bindDef, entry.Name, entry.Namespace); err != nil {
+			return false, fmt.Errorf("delete revoked ServiceAccount %s/%s: %w", entry.Namespace, entry.Name, err)
+		}
+	}
+
+	bindDef.Status.RevokedServiceAccounts = append(bindDef.Status.RevokedServiceAccounts, entry)
+	metrics.ServiceAccountsRevoked.WithLabelValues(metrics.ControllerBindDefinition, string(entry.Reason)).Inc()
+	logger.Info("Revoked generated ServiceAccount",
+		"bindDefinitionName", bindDef.Name, "serviceAccount", entry.Name, "namespace", entry.Namespace,
+		"reason", entry.Reason, "action", action)
+	r.recorder.Eventf(bindDef, nil, corev1.EventTypeNormal,
+		authorizationv1alpha1.EventReasonServiceAccountRevoked, authorizationv1alpha1.EventActionReconcile,
+		"Revoked ServiceAccount %s/%s (%s, action %s)", entry.Namespace, entry.Name, entry.Reason, action)
+	return action == authorizationv1alpha1.ServiceAccountExpiryActionUnbind, nil
+}
+
 // applyStatus applies status updates using Server-Side Apply (SSA).
 // It compares the desired status against the informer cache and skips the
 // API call when nothing has changed, reducing API-server load.
@@ -1520,6 +1629,7 @@ func (r *BindDefinitionReconciler) reconcileDelete(
 }
 
 func deleteBindDefinitionMetricSeries(name string) {
+	metrics.DeleteApplyLatency(metrics.ControllerBindDefinition, name)
 	metrics.DeleteManagedResourceSeries(metrics.ControllerBindDefinition, name)
 	metrics.RoleRefsMissing.DeleteLabelValues(name)
 	metrics.NamespacesActive.DeleteLabelValues(name)
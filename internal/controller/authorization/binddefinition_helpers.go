@@ -128,6 +128,8 @@ func ownerReferenceIdentity(owner metav1.Object) (apiVersion, kind string) {
 		return apiVersion, authorizationv1alpha1.RestrictedBindDefinitionKind
 	case *authorizationv1alpha1.RestrictedRoleDefinition:
 		return apiVersion, authorizationv1alpha1.RestrictedRoleDefinitionKind
+	case *authorizationv1alpha1.RBACPolicy:
+		return apiVersion, "RBACPolicy"
 	}
 	return "", ""
 }
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/metrics"
+	pkgssa "github.com/telekom/auth-operator/pkg/ssa"
+)
+
+// conflictApplyOptions returns the patch helper options that apply a
+// definition's conflictPolicy to its generated RBAC resources. Every conflict
+// with another field manager is added to conflicts, up to
+// MaxFieldManagerConflicts; reportConflicts surfaces them after the applies.
+//
+// Only Force sets client.ForceOwnership. Fail and Report leave the resource
+// unchanged and the patch helper returns the conflict error; callers use
+// conflictReported to continue under Report.
+func conflictApplyOptions(
+	ctx context.Context,
+	policy authorizationv1alpha1.ConflictPolicy,
+	conflicts *[]authorizationv1alpha1.FieldManagerConflict,
+) []client.ApplyOption {
+	logger := log.FromContext(ctx)
+	onConflict := pkgssa.ConflictHandler(func(c pkgssa.Conflict) {
+		conflict := authorizationv1alpha1.FieldManagerConflict{
+			Kind:      c.Kind,
+			Namespace: c.Namespace,
+			Name:      c.Name,
+			Managers:  c.Managers,
+		}
+		if slices.ContainsFunc(*conflicts, func(listed authorizationv1alpha1.FieldManagerConflict) bool {
+			return listed.Kind == conflict.Kind && listed.Namespace == conflict.Namespace && listed.Name == conflict.Name
+		}) {
+			return
+		}
+		if len(*conflicts) >= authorizationv1alpha1.MaxFieldManagerConflicts {
+			logger.V(1).Info("Too many field manager conflicts, not listing conflict in status",
+				"kind", c.Kind, "resource", conflictResourceName(conflict), "managers", c.Managers)
+			return
+		}
+		*conflicts = append(*conflicts, conflict)
+	})
+	if policy == authorizationv1alpha1.ConflictPolicyForce {
+		return []client.ApplyOption{client.ForceOwnership, onConflict}
+	}
+	return []client.ApplyOption{onConflict}
+}
+
+// reportConflicts emits a Warning event and counts SSAConflicts, once per
+// competing manager, for the conflicts collected by the latest applies. Under Force every conflict is
+// reported, as each one takes over fields of another manager. Under Fail and
+// Report a conflict persists across reconciles, so only conflicts missing from
+// previous, the status.conflicts before the applies, are reported.
+//
+// conflicts is a pointer so that callers can defer the call before the applies.
+func reportConflicts(
+	ctx context.Context,
+	recorder events.EventRecorder,
+	obj runtime.Object,
+	controller string,
+	policy authorizationv1alpha1.ConflictPolicy,
+	previous []authorizationv1alpha1.FieldManagerConflict,
+	conflicts *[]authorizationv1alpha1.FieldManagerConflict,
+) {
+	logger := log.FromContext(ctx)
+	for _, conflict := range *conflicts {
+		if policy != authorizationv1alpha1.ConflictPolicyForce && slices.ContainsFunc(previous, func(p authorizationv1alpha1.FieldManagerConflict) bool {
+			return p.Kind == conflict.Kind && p.Namespace == conflict.Namespace && p.Name == conflict.Name &&
+				slices.Equal(p.Managers, conflict.Managers)
+		}) {
+			continue
+		}
+		for _, manager := range conflict.Managers {
+			metrics.IncSSAConflict(controller, conflict.Kind, manager, string(policy))
+		}
+		resource := conflictResourceName(conflict)
+		logger.Info("Generated RBAC resource has fields owned by other field managers",
+			"kind", conflict.Kind, "resource", resource, "managers", conflict.Managers, "conflictPolicy", policy)
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, authorizationv1alpha1.EventReasonFieldManagerConflict, authorizationv1alpha1.EventActionUpdateResource,
+			"%s %s has fields owned by %s (conflictPolicy=%s)", conflict.Kind, resource, strings.Join(conflict.Managers, ", "), policy)
+	}
+}
+
+// conflictResourceName returns "<namespace>/<name>", or the name of a
+// cluster-scoped resource.
+func conflictResourceName(conflict authorizationv1alpha1.FieldManagerConflict) string {
+	if conflict.Namespace == "" {
+		return conflict.Name
+	}
+	return conflict.Namespace + "/" + conflict.Name
+}
+
+// conflictReported reports whether err is a field manager conflict that the
+// Report policy leaves in place, so the caller skips the resource and
+// continues with the remaining ones.
+func conflictReported(policy authorizationv1alpha1.ConflictPolicy, err error) bool {
+	return policy == authorizationv1alpha1.ConflictPolicyReport && pkgssa.IsFieldManagerConflict(err)
+}
@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/metrics"
+	pkgssa "github.com/telekom/auth-operator/pkg/ssa"
+)
+
+func TestBindDefinitionConflictPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	const competingManager = "argocd-controller"
+
+	testCases := []struct {
+		policy      authorizationv1alpha1.ConflictPolicy
+		wantErr     bool
+		wantSubject string
+	}{
+		{policy: "", wantSubject: "team-user"},
+		{policy: authorizationv1alpha1.ConflictPolicyForce, wantSubject: "team-user"},
+		{policy: authorizationv1alpha1.ConflictPolicyFail, wantErr: true, wantSubject: "argo-user"},
+		{policy: authorizationv1alpha1.ConflictPolicyReport, wantSubject: "argo-user"},
+	}
+	for _, tc := range testCases {
+		name := string(tc.policy)
+		if name == "" {
+			name = "Default"
+		}
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			bindDef := &authorizationv1alpha1.BindDefinition{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: authorizationv1alpha1.GroupVersion.String(),
+					Kind:       "BindDefinition",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "conflict-" + name,
+					UID:  types.UID("conflict-uid-" + name),
+				},
+				Spec: authorizationv1alpha1.BindDefinitionSpec{
+					TargetName: "conflict-" + name,
+					Subjects: []rbacv1.Subject{
+						{Kind: "User", Name: "team-user", APIGroup: rbacv1.GroupName},
+					},
+					ClusterRoleBindings: authorizationv1alpha1.ClusterBinding{
+						ClusterRoleRefs: []string{"view"},
+					},
+					ConflictPolicy: tc.policy,
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(bindDef).Build()
+			recorder := events.NewFakeRecorder(10)
+			r := &BindDefinitionReconciler{
+				client:   c,
+				reader:   c,
+				scheme:   scheme,
+				recorder: recorder,
+			}
+
+			// Another tool applies the binding the BindDefinition generates,
+			// with different subjects.
+			crbName := "conflict-" + name + "-view-binding"
+			competingAC := pkgssa.ClusterRoleBindingWithSubjectsAndRoleRef(
+				crbName,
+				nil,
+				[]rbacv1.Subject{{Kind: "User", Name: "argo-user", APIGroup: rbacv1.GroupName}},
+				rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"},
+			).WithOwnerReferences(ownerRefForBindDefinition(bindDef))
+			g.Expect(c.Apply(ctx, competingAC, client.FieldOwner(competingManager))).To(Succeed())
+
+			policyLabel := string(bindDef.GetConflictPolicy())
+			before := testutil.ToFloat64(metrics.SSAConflicts.WithLabelValues(
+				metrics.ControllerBindDefinition, metrics.ResourceClusterRoleBinding, competingManager, policyLabel))
+
+			err := r.ensureBindings(ctx, bindDef, nil)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(pkgssa.IsFieldManagerConflict(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			crb := &rbacv1.ClusterRoleBinding{}
+			g.Expect(c.Get(ctx, types.NamespacedName{Name: crbName}, crb)).To(Succeed())
+			g.Expect(crb.Subjects).To(HaveLen(1))
+			g.Expect(crb.Subjects[0].Name).To(Equal(tc.wantSubject))
+
+			g.Expect(bindDef.Status.Conflicts).To(Equal([]authorizationv1alpha1.FieldManagerConflict{{
+				Kind:     "ClusterRoleBinding",
+				Name:     crbName,
+				Managers: []string{competingManager},
+			}}))
+			g.Expect(testutil.ToFloat64(metrics.SSAConflicts.WithLabelValues(
+				metrics.ControllerBindDefinition, metrics.ResourceClusterRoleBinding, competingManager, policyLabel))).
+				To(Equal(before + 1))
+			g.Expect(recorder.Events).To(Receive(ContainSubstring(authorizationv1alpha1.EventReasonFieldManagerConflict)))
+		})
+	}
+}
+
+func TestBindDefinitionConflictPolicyReportsUnchangedConflictsOnce(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+
+	bindDef := &authorizationv1alpha1.BindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "report-once", UID: "report-once-uid"},
+		Spec: authorizationv1alpha1.BindDefinitionSpec{
+			TargetName: "report-once",
+			Subjects: []rbacv1.Subject{
+				{Kind: "User", Name: "team-user", APIGroup: rbacv1.GroupName},
+			},
+			ClusterRoleBindings: authorizationv1alpha1.ClusterBinding{
+				ClusterRoleRefs: []string{"view", "edit"},
+			},
+			ConflictPolicy: authorizationv1alpha1.ConflictPolicyReport,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(bindDef).Build()
+	recorder := events.NewFakeRecorder(10)
+	r := &BindDefinitionReconciler{
+		client:   c,
+		reader:   c,
+		scheme:   scheme,
+		recorder: recorder,
+	}
+	applyCompeting := func(roleRef string) {
+		competingAC := pkgssa.ClusterRoleBindingWithSubjectsAndRoleRef(
+			"report-once-"+roleRef+"-binding",
+			nil,
+			[]rbacv1.Subject{{Kind: "User", Name: "argo-user", APIGroup: rbacv1.GroupName}},
+			rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: roleRef},
+		).WithOwnerReferences(ownerRefForBindDefinition(bindDef))
+		g.Expect(c.Apply(ctx, competingAC, client.FieldOwner("argocd-controller"), client.ForceOwnership)).To(Succeed())
+	}
+	conflictCount := func() float64 {
+		return testutil.ToFloat64(metrics.SSAConflicts.WithLabelValues(
+			metrics.ControllerBindDefinition, metrics.ResourceClusterRoleBinding, "argocd-controller",
+			string(authorizationv1alpha1.ConflictPolicyReport)))
+	}
+
+	applyCompeting("view")
+	before := conflictCount()
+	g.Expect(r.ensureBindings(ctx, bindDef, nil)).To(Succeed())
+	g.Expect(bindDef.Status.Conflicts).To(HaveLen(1))
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(conflictCount()).To(Equal(before + 1))
+
+	// The same conflict on the next reconcile is neither an event nor counted.
+	g.Expect(r.ensureBindings(ctx, bindDef, nil)).To(Succeed())
+	g.Expect(bindDef.Status.Conflicts).To(HaveLen(1))
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(conflictCount()).To(Equal(before + 1))
+
+	// A new conflict is reported on its own.
+	applyCompeting("edit")
+	g.Expect(r.ensureBindings(ctx, bindDef, nil)).To(Succeed())
+	g.Expect(bindDef.Status.Conflicts).To(HaveLen(2))
+	g.Expect(recorder.Events).To(HaveLen(2))
+	g.Expect(conflictCount()).To(Equal(before + 2))
+}
+
+func TestConflictApplyOptionsTruncatesConflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	var conflicts []authorizationv1alpha1.FieldManagerConflict
+	opts := conflictApplyOptions(context.Background(), authorizationv1alpha1.ConflictPolicyReport, &conflicts)
+	var handler pkgssa.ConflictHandler
+	for _, opt := range opts {
+		if h, ok := opt.(pkgssa.ConflictHandler); ok {
+			handler = h
+		}
+	}
+	g.Expect(handler).NotTo(BeNil())
+
+	for i := range authorizationv1alpha1.MaxFieldManagerConflicts + 1 {
+		handler(pkgssa.Conflict{Kind: "RoleBinding", Namespace: fmt.Sprintf("ns-%d", i), Name: "team", Managers: []string{"helm"}})
+	}
+	handler(pkgssa.Conflict{Kind: "RoleBinding", Namespace: "ns-0", Name: "team", Managers: []string{"helm"}})
+	g.Expect(conflicts).To(HaveLen(authorizationv1alpha1.MaxFieldManagerConflicts))
+}
+
+func TestBindDefinitionConflictPolicyWithoutConflict(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+
+	bindDef := &authorizationv1alpha1.BindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "no-conflict", UID: "no-conflict-uid"},
+		Spec: authorizationv1alpha1.BindDefinitionSpec{
+			TargetName: "no-conflict",
+			Subjects: []rbacv1.Subject{
+				{Kind: "User", Name: "team-user", APIGroup: rbacv1.GroupName},
+			},
+			ClusterRoleBindings: authorizationv1alpha1.ClusterBinding{
+				ClusterRoleRefs: []string{"view"},
+			},
+			ConflictPolicy: authorizationv1alpha1.ConflictPolicyFail,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(bindDef).Build()
+	r := &BindDefinitionReconciler{
+		client:   c,
+		reader:   c,
+		scheme:   scheme,
+		recorder: events.NewFakeRecorder(10),
+	}
+
+	g.Expect(r.ensureClusterRoleBindings(ctx, bindDef)).To(Succeed())
+	// A second pass patches the binding it already owns without conflicts.
+	bindDef.Spec.Subjects = append(bindDef.Spec.Subjects, rbacv1.Subject{Kind: "User", Name: "other-user", APIGroup: rbacv1.GroupName})
+	g.Expect(r.ensureClusterRoleBindings(ctx, bindDef)).To(Succeed())
+	g.Expect(bindDef.Status.Conflicts).To(BeEmpty())
+
+	crb := &rbacv1.ClusterRoleBinding{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "no-conflict-view-binding"}, crb)).To(Succeed())
+	g.Expect(crb.Subjects).To(HaveLen(2))
+}
@@ -10,6 +10,7 @@ import (
 	"slices"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
@@ -21,6 +22,7 @@ import (
 // depend on, so tests can inject a stub without a live API server.
 type capabilityDetector interface {
 	ConstrainedImpersonation(ctx context.Context) capabilities.Result
+	Detect(ctx context.Context, name string) capabilities.Result
 }
 
 // legacyFallbackReachableReason is the machine-readable reason carried by the
@@ -98,15 +100,40 @@ func setConstrainedImpersonationCondition(
 		conditions.Delete(obj, authorizationv1alpha1.ConstrainedImpersonationCondition)
 		return capabilities.Result{}
 	}
+	evaluation := evaluateConstrainedImpersonation(ctx, spec, restrictedVerbs, detector)
+	markConstrainedImpersonationCondition(obj, generation, evaluation, evaluation.detail)
+	return evaluation.result
+}
+
+// constrainedImpersonationEvaluation is the outcome of checking a single
+// constrained-impersonation grant: the condition it yields and the Result
+// callers gate the Warning event on.
+type constrainedImpersonationEvaluation struct {
+	status  metav1.ConditionStatus
+	reason  authorizationv1alpha1.AuthZConditionReason
+	message authorizationv1alpha1.AuthZConditionMessage
+	detail  string
+	result  capabilities.Result
+}
 
+// evaluateConstrainedImpersonation decides whether a non-nil grant is effective,
+// following the precedence documented on setConstrainedImpersonationCondition.
+func evaluateConstrainedImpersonation(
+	ctx context.Context,
+	spec *authorizationv1alpha1.ConstrainedImpersonationSpec,
+	restrictedVerbs []string,
+	detector capabilityDetector,
+) constrainedImpersonationEvaluation {
 	logger := log.FromContext(ctx)
 
 	if detector == nil {
-		conditions.MarkUnknown(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
-			authorizationv1alpha1.ConstrainedImpersonationReasonUnknown,
-			authorizationv1alpha1.ConstrainedImpersonationMessageUnknown,
-			"capability detection is not configured")
-		return capabilities.Result{State: capabilities.StateUnknown, Reason: "DetectorUnavailable"}
+		return constrainedImpersonationEvaluation{
+			status:  metav1.ConditionUnknown,
+			reason:  authorizationv1alpha1.ConstrainedImpersonationReasonUnknown,
+			message: authorizationv1alpha1.ConstrainedImpersonationMessageUnknown,
+			detail:  "capability detection is not configured",
+			result:  capabilities.Result{State: capabilities.StateUnknown, Reason: "DetectorUnavailable"},
+		}
 	}
 
 	result := detector.ConstrainedImpersonation(ctx)
@@ -119,44 +146,77 @@ func setConstrainedImpersonationCondition(
 			"mode", spec.Mode)
 		detail := fmt.Sprintf("add %q to spec.restrictedVerbs so the generated role cannot carry the blanket verb",
 			authorizationv1alpha1.LegacyImpersonateVerb)
-		conditions.MarkFalse(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
-			authorizationv1alpha1.ConstrainedImpersonationReasonLegacyFallback,
-			authorizationv1alpha1.ConstrainedImpersonationMessageLegacyFallback,
-			detail)
 		// Deliberately NOT the detector's own result: a reachable legacy fallback
 		// defeats the grant no matter what the feature gate says, so returning the
 		// detector's StateEnabled here would make callers such as
 		// recordConstrainedImpersonationState skip the Warning event and report a
 		// detail unrelated to the actual problem. The synthetic result carries the
 		// same detail as the condition so event and condition stay consistent.
-		return capabilities.Result{
-			State:         capabilities.StateDisabled,
-			Reason:        legacyFallbackReachableReason,
-			Detail:        detail,
-			ServerVersion: result.ServerVersion,
+		return constrainedImpersonationEvaluation{
+			status:  metav1.ConditionFalse,
+			reason:  authorizationv1alpha1.ConstrainedImpersonationReasonLegacyFallback,
+			message: authorizationv1alpha1.ConstrainedImpersonationMessageLegacyFallback,
+			detail:  detail,
+			result: capabilities.Result{
+				State:         capabilities.StateDisabled,
+				Reason:        legacyFallbackReachableReason,
+				Detail:        detail,
+				ServerVersion: result.ServerVersion,
+			},
 		}
 	}
 
 	switch result.State {
 	case capabilities.StateEnabled:
-		conditions.MarkTrue(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
-			authorizationv1alpha1.ConstrainedImpersonationReasonEffective,
-			authorizationv1alpha1.ConstrainedImpersonationMessageEffective, result.Detail)
+		return constrainedImpersonationEvaluation{
+			status:  metav1.ConditionTrue,
+			reason:  authorizationv1alpha1.ConstrainedImpersonationReasonEffective,
+			message: authorizationv1alpha1.ConstrainedImpersonationMessageEffective,
+			detail:  result.Detail,
+			result:  result,
+		}
 	case capabilities.StateDisabled:
 		logger.Info("constrained impersonation grant is inert on this API server",
 			"mode", spec.Mode, "reason", result.Reason, "serverVersion", result.ServerVersion)
-		conditions.MarkFalse(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
-			authorizationv1alpha1.ConstrainedImpersonationReasonInert,
-			authorizationv1alpha1.ConstrainedImpersonationMessageInert, result.Detail)
+		return constrainedImpersonationEvaluation{
+			status:  metav1.ConditionFalse,
+			reason:  authorizationv1alpha1.ConstrainedImpersonationReasonInert,
+			message: authorizationv1alpha1.ConstrainedImpersonationMessageInert,
+			detail:  result.Detail,
+			result:  result,
+		}
 	default:
 		logger.V(1).Info("constrained impersonation support could not be determined",
 			"mode", spec.Mode, "reason", result.Reason, "serverVersion", result.ServerVersion)
-		conditions.MarkUnknown(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
-			authorizationv1alpha1.ConstrainedImpersonationReasonUnknown,
-			authorizationv1alpha1.ConstrainedImpersonationMessageUnknown, result.Detail)
+		return constrainedImpersonationEvaluation{
+			status:  metav1.ConditionUnknown,
+			reason:  authorizationv1alpha1.ConstrainedImpersonationReasonUnknown,
+			message: authorizationv1alpha1.ConstrainedImpersonationMessageUnknown,
+			detail:  result.Detail,
+			result:  result,
+		}
 	}
+}
 
-	return result
+// markConstrainedImpersonationCondition writes an evaluation to the
+// ConstrainedImpersonationEffective condition with the given detail.
+func markConstrainedImpersonationCondition(
+	obj conditionSetter,
+	generation int64,
+	evaluation constrainedImpersonationEvaluation,
+	detail string,
+) {
+	switch evaluation.status {
+	case metav1.ConditionTrue:
+		conditions.MarkTrue(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
+			evaluation.reason, evaluation.message, detail)
+	case metav1.ConditionFalse:
+		conditions.MarkFalse(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
+			evaluation.reason, evaluation.message, detail)
+	default:
+		conditions.MarkUnknown(obj, authorizationv1alpha1.ConstrainedImpersonationCondition, generation,
+			evaluation.reason, evaluation.message, detail)
+	}
 }
 
 // legacyImpersonateRestricted reports whether the definition's restrictedVerbs
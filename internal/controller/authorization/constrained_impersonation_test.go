@@ -30,6 +30,10 @@ func (s stubDetector) ConstrainedImpersonation(context.Context) capabilities.Res
 	return s.result
 }
 
+func (s stubDetector) Detect(context.Context, string) capabilities.Result {
+	return s.result
+}
+
 func testGrant() *authorizationv1alpha1.ConstrainedImpersonationSpec {
 	return &authorizationv1alpha1.ConstrainedImpersonationSpec{
 		Mode: authorizationv1alpha1.ImpersonationModeUserInfo,
@@ -456,3 +460,76 @@ func TestSetConstrainedImpersonationConditionOnRestrictedRoleDefinition(t *testi
 		t.Errorf("expected a True condition, got %+v", cond)
 	}
 }
+
+// TestRecordConstrainedImpersonationStateEvaluatesEachGrant asserts that the
+// condition aggregates spec.constrainedImpersonation and every
+// spec.impersonationGrants entry instead of only the first grant.
+func TestRecordConstrainedImpersonationStateEvaluatesEachGrant(t *testing.T) {
+	t.Parallel()
+
+	detector := stubDetector{result: capabilities.Result{
+		State:  capabilities.StateEnabled,
+		Reason: capabilities.ReasonFeatureGateEnabled,
+		Detail: "gate on",
+	}}
+	newRD := func() *authorizationv1alpha1.RoleDefinition {
+		rd := impersonationGrantTestRoleDefinition()
+		rd.Spec.ConstrainedImpersonation = testGrant()
+		return rd
+	}
+
+	t.Run("worst outcome names the failing grant", func(t *testing.T) {
+		t.Parallel()
+		r := &RoleDefinitionReconciler{recorder: events.NewFakeRecorder(4), capabilityDetector: detector, impersonationGrantsEnabled: true}
+		rd := newRD()
+		rd.Spec.RestrictedVerbs = nil
+
+		r.recordConstrainedImpersonationState(context.Background(), rd)
+
+		cond := conditions.Get(rd, authorizationv1alpha1.ConstrainedImpersonationCondition)
+		if cond == nil || cond.Status != metav1.ConditionFalse {
+			t.Fatalf("condition = %+v, want False", cond)
+		}
+		if !strings.Contains(cond.Message, "spec.constrainedImpersonation: ") {
+			t.Errorf("message %q does not name spec.constrainedImpersonation", cond.Message)
+		}
+		if strings.Contains(cond.Message, "grant pods") {
+			t.Errorf("message %q names an effective grant", cond.Message)
+		}
+	})
+
+	t.Run("all effective lists every grant", func(t *testing.T) {
+		t.Parallel()
+		recorder := events.NewFakeRecorder(4)
+		r := &RoleDefinitionReconciler{recorder: recorder, capabilityDetector: detector, impersonationGrantsEnabled: true}
+		rd := newRD()
+		rd.Spec.RestrictedVerbs = []string{authorizationv1alpha1.LegacyImpersonateVerb}
+
+		r.recordConstrainedImpersonationState(context.Background(), rd)
+
+		cond := conditions.Get(rd, authorizationv1alpha1.ConstrainedImpersonationCondition)
+		if cond == nil || cond.Status != metav1.ConditionTrue {
+			t.Fatalf("condition = %+v, want True", cond)
+		}
+		for _, label := range []string{"grant pods: ", "grant secrets: "} {
+			if !strings.Contains(cond.Message, label) {
+				t.Errorf("message %q does not name %q", cond.Message, label)
+			}
+		}
+		if len(recorder.Events) != 0 {
+			t.Errorf("unexpected Warning event %q", <-recorder.Events)
+		}
+	})
+
+	t.Run("disabled grants are not evaluated", func(t *testing.T) {
+		t.Parallel()
+		r := &RoleDefinitionReconciler{recorder: events.NewFakeRecorder(4), capabilityDetector: detector}
+		rd := impersonationGrantTestRoleDefinition()
+
+		r.recordConstrainedImpersonationState(context.Background(), rd)
+
+		if cond := conditions.Get(rd, authorizationv1alpha1.ConstrainedImpersonationCondition); cond != nil {
+			t.Errorf("condition = %+v, want none while impersonationGrants are disabled", cond)
+		}
+	})
+}
@@ -155,6 +155,13 @@ func resolvePolicyApplyClient(
 	if baseConfig == nil {
 		return nil, "", fmt.Errorf("impersonation configured by policy %q but controller rest config is unavailable", policy.Name)
 	}
+	if policy.Spec.Impersonation.EffectiveCredentialSource() == authorizationv1alpha1.ImpersonationCredentialSourceTokenRequest {
+		tokenClient, err := resolveServiceAccountTokenClient(baseClient, scheme, baseConfig, policy.Spec.Impersonation.ServiceAccountRef, username, cache)
+		if err != nil {
+			return nil, "", err
+		}
+		return tokenClient, username, nil
+	}
 	if factory == nil {
 		factory = newImpersonatedClient
 	}
@@ -174,3 +181,35 @@ func resolvePolicyApplyClient(
 
 	return impersonatedClient, username, nil
 }
+
+// resolveServiceAccountTokenClient returns a client that applies as the
+// ServiceAccount itself with short-lived TokenRequest tokens instead of
+// impersonating it. The client is cached like impersonated clients; its token
+// source refreshes the token before expiry, so a cached client stays usable.
+func resolveServiceAccountTokenClient(
+	baseClient client.Client,
+	scheme *runtime.Scheme,
+	baseConfig *rest.Config,
+	saRef *authorizationv1alpha1.SARef,
+	username string,
+	cache *impersonatedClientCache,
+) (client.Client, error) {
+	factory := func(cfg *rest.Config, scheme *runtime.Scheme, _ string) (client.Client, error) {
+		source := newServiceAccountTokenSource(saRef.Namespace, saRef.Name, tokenRequester(baseClient))
+		return newServiceAccountTokenClient(cfg, scheme, source)
+	}
+
+	var (
+		tokenClient client.Client
+		err         error
+	)
+	if cache != nil {
+		tokenClient, err = cache.getOrCreate(tokenRequestCacheKeyPrefix+username, baseConfig, scheme, factory)
+	} else {
+		tokenClient, err = factory(baseConfig, scheme, username)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("build token request apply client: %w", err)
+	}
+	return tokenClient, nil
+}
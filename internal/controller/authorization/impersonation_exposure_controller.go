@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/ssa"
+	conditions "github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/exposure"
+	"github.com/telekom/auth-operator/pkg/metrics"
+	"github.com/telekom/auth-operator/pkg/tracing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=impersonationexposurereports,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=impersonationexposurereports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;roles;clusterrolebindings;rolebindings,verbs=get;list;watch
+
+// DefaultImpersonationExposureScanInterval is how often the cluster is rescanned
+// for the legacy impersonate verb in addition to the scans triggered by RBAC
+// changes.
+const DefaultImpersonationExposureScanInterval = 10 * time.Minute
+
+const impersonationExposureListTimeout = 30 * time.Second
+
+// ImpersonationExposureReconciler scans all cluster RBAC for the legacy
+// "impersonate" verb and maintains the singleton ImpersonationExposureReport.
+type ImpersonationExposureReconciler struct {
+	client       client.Client
+	scheme       *runtime.Scheme
+	tracer       trace.Tracer
+	scanInterval time.Duration
+}
+
+// setTracer implements tracerSetter.
+func (r *ImpersonationExposureReconciler) setTracer(t trace.Tracer) { r.tracer = t }
+
+// NewImpersonationExposureReconciler creates a new ImpersonationExposure
+// reconciler. A non-positive scanInterval falls back to
+// DefaultImpersonationExposureScanInterval.
+func NewImpersonationExposureReconciler(
+	cachedClient client.Client,
+	scheme *runtime.Scheme,
+	scanInterval time.Duration,
+	opts ...ReconcilerOption,
+) *ImpersonationExposureReconciler {
+	if scanInterval <= 0 {
+		scanInterval = DefaultImpersonationExposureScanInterval
+	}
+	r := &ImpersonationExposureReconciler{
+		client:       cachedClient,
+		scheme:       scheme,
+		scanInterval: scanInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Every RBAC event maps to the single report, so bursts collapse into one scan.
+func (r *ImpersonationExposureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	toReport := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: authorizationv1alpha1.ImpersonationExposureReportName}}}
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("impersonationexposure").
+		For(&authorizationv1alpha1.ImpersonationExposureReport{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&rbacv1.ClusterRole{}, toReport).
+		Watches(&rbacv1.Role{}, toReport).
+		Watches(&rbacv1.ClusterRoleBinding{}, toReport).
+		Watches(&rbacv1.RoleBinding{}, toReport).
+		WithOptions(controller.TypedOptions[reconcile.Request]{MaxConcurrentReconciles: 1}).
+		Complete(r)
+}
+
+// Reconcile scans the cluster and updates the ImpersonationExposureReport and
+// the exposure metrics. Requests for any other name are ignored.
+func (r *ImpersonationExposureReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, retErr error) {
+	startTime := time.Now()
+	logger := log.FromContext(ctx)
+
+	if req.Name != authorizationv1alpha1.ImpersonationExposureReportName {
+		logger.V(1).Info("Ignoring ImpersonationExposureReport with unexpected name", "name", req.Name,
+			"expected", authorizationv1alpha1.ImpersonationExposureReportName)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerImpersonationExposure, metrics.ResultSkipped).Inc()
+		return ctrl.Result{}, nil
+	}
+
+	if r.tracer != nil {
+		var span trace.Span
+		ctx, span = r.tracer.Start(ctx, "reconcile.ImpersonationExposure",
+			trace.WithAttributes(
+				tracing.AttrController.String("ImpersonationExposure"),
+				tracing.AttrResource.String(req.Name),
+			))
+		defer func() {
+			if retErr != nil {
+				span.RecordError(retErr)
+				span.SetStatus(codes.Error, retErr.Error())
+			}
+			span.End()
+		}()
+	}
+
+	defer func() {
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerImpersonationExposure, time.Since(startTime))
+	}()
+
+	// Step 1: Fetch or create the report.
+	report := &authorizationv1alpha1.ImpersonationExposureReport{}
+	if err := r.client.Get(ctx, req.NamespacedName, report); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.fail(fmt.Errorf("fetch ImpersonationExposureReport %s: %w", req.Name, err))
+		}
+		report = &authorizationv1alpha1.ImpersonationExposureReport{ObjectMeta: metav1.ObjectMeta{Name: req.Name}}
+		if err := r.client.Create(ctx, report); err != nil && !apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{}, r.fail(fmt.Errorf("create ImpersonationExposureReport %s: %w", req.Name, err))
+		}
+		logger.Info("Created ImpersonationExposureReport", "name", req.Name)
+	}
+
+	// Step 2: Scan cluster RBAC.
+	rbac, err := r.listRBAC(ctx)
+	if err != nil {
+		return ctrl.Result{}, r.fail(err)
+	}
+	scan := exposure.ScanLegacyImpersonation(rbac)
+
+	// Step 3: Update metrics and status.
+	exposed := len(scan.Findings)
+	metrics.LegacyImpersonationExposedSubjects.WithLabelValues("true").Set(float64(scan.ConstrainedSubjects))
+	metrics.LegacyImpersonationExposedSubjects.WithLabelValues("false").Set(float64(exposed - scan.ConstrainedSubjects))
+	metrics.LegacyImpersonationRoles.Set(float64(scan.LegacyRoles))
+
+	previous := report.Status.DeepCopy()
+	report.Status.ExposedSubjects = clampInt32(exposed)
+	report.Status.ConstrainedSubjects = clampInt32(scan.ConstrainedSubjects)
+	report.Status.LegacyRoles = clampInt32(scan.LegacyRoles)
+	report.Status.Truncated = exposed > authorizationv1alpha1.MaxLegacyImpersonationFindings
+	report.Status.Findings = scan.Findings[:min(exposed, authorizationv1alpha1.MaxLegacyImpersonationFindings)]
+
+	// Only refresh lastScanTime when the findings changed or a periodic scan
+	// is due, so RBAC churn that does not affect exposure causes no writes.
+	if previous.LastScanTime == nil || time.Since(previous.LastScanTime.Time) >= r.scanInterval ||
+		!equality.Semantic.DeepEqual(previous.Findings, report.Status.Findings) ||
+		previous.ExposedSubjects != report.Status.ExposedSubjects ||
+		previous.LegacyRoles != report.Status.LegacyRoles {
+		now := metav1.Now()
+		report.Status.LastScanTime = &now
+	}
+
+	if exposed > 0 {
+		conditions.MarkTrue(report, authorizationv1alpha1.LegacyImpersonationExposedCondition, report.Generation,
+			authorizationv1alpha1.LegacyImpersonationExposedReasonFound, authorizationv1alpha1.LegacyImpersonationExposedMessageFound,
+			exposed, scan.ConstrainedSubjects)
+	} else {
+		conditions.MarkFalse(report, authorizationv1alpha1.LegacyImpersonationExposedCondition, report.Generation,
+			authorizationv1alpha1.LegacyImpersonationExposedReasonNone, authorizationv1alpha1.LegacyImpersonationExposedMessageNone)
+	}
+	conditions.MarkReady(report, report.Generation,
+		authorizationv1alpha1.ReadyReasonReconciled, authorizationv1alpha1.ReadyMessageReconciled)
+
+	if err := ssa.ApplyImpersonationExposureReportStatus(ctx, r.client, report); err != nil {
+		return ctrl.Result{}, r.fail(fmt.Errorf("apply ImpersonationExposureReport %s status: %w", req.Name, err))
+	}
+
+	logger.V(1).Info("Legacy impersonation scan completed",
+		"exposedSubjects", exposed, "constrainedSubjects", scan.ConstrainedSubjects, "legacyRoles", scan.LegacyRoles)
+	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerImpersonationExposure, metrics.ResultSuccess).Inc()
+	return ctrl.Result{RequeueAfter: r.scanInterval}, nil
+}
+
+// listRBAC lists every ClusterRole, Role and binding from the cache.
+func (r *ImpersonationExposureReconciler) listRBAC(ctx context.Context) (*exposure.RBAC, error) {
+	listCtx, cancel := context.WithTimeout(ctx, impersonationExposureListTimeout)
+	defer cancel()
+
+	clusterRoles := &rbacv1.ClusterRoleList{}
+	if err := r.client.List(listCtx, clusterRoles); err != nil {
+		return nil, fmt.Errorf("list ClusterRoles: %w", err)
+	}
+	roles := &rbacv1.RoleList{}
+	if err := r.client.List(listCtx, roles); err != nil {
+		return nil, fmt.Errorf("list Roles: %w", err)
+	}
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := r.client.List(listCtx, clusterRoleBindings); err != nil {
+		return nil, fmt.Errorf("list ClusterRoleBindings: %w", err)
+	}
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := r.client.List(listCtx, roleBindings); err != nil {
+		return nil, fmt.Errorf("list RoleBindings: %w", err)
+	}
+	return &exposure.RBAC{
+		ClusterRoles:        clusterRoles.Items,
+		Roles:               roles.Items,
+		ClusterRoleBindings: clusterRoleBindings.Items,
+		RoleBindings:        roleBindings.Items,
+	}, nil
+}
+
+// fail records an API error for the reconcile and returns err unchanged.
+func (r *ImpersonationExposureReconciler) fail(err error) error {
+	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerImpersonationExposure, metrics.ResultError).Inc()
+	metrics.ReconcileErrors.WithLabelValues(metrics.ControllerImpersonationExposure, metrics.ErrorTypeAPI).Inc()
+	return err
+}
+
+func clampInt32(n int) int32 {
+	return int32(min(n, math.MaxInt32)) // #nosec G115 -- bounded by min
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/metrics"
+)
+
+func newImpersonationExposureTestReconciler(objs ...client.Object) (*ImpersonationExposureReconciler, client.Client) {
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&authorizationv1alpha1.ImpersonationExposureReport{}).
+		Build()
+	return NewImpersonationExposureReconciler(c, scheme, time.Hour), c
+}
+
+func impersonationExposureRequest() ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Name: authorizationv1alpha1.ImpersonationExposureReportName}}
+}
+
+func TestImpersonationExposure_Reconcile_CreatesReport(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	legacy := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy-impersonator"},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""}, Resources: []string{"users"}, Verbs: []string{"impersonate"},
+		}},
+	}
+	constrained := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "constrained-impersonator"},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"users"}, Verbs: []string{"impersonate:user-info"},
+		}},
+	}
+	jane := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "jane"}
+	bob := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "bob"}
+	bindings := []client.Object{
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: legacy.Name},
+			Subjects:   []rbacv1.Subject{jane, bob},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "constrained"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: constrained.Name},
+			Subjects:   []rbacv1.Subject{jane},
+		},
+	}
+
+	r, c := newImpersonationExposureTestReconciler(append([]client.Object{legacy, constrained}, bindings...)...)
+	result, err := r.Reconcile(rbacPolicyCtx(t), impersonationExposureRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{RequeueAfter: time.Hour}))
+
+	var report authorizationv1alpha1.ImpersonationExposureReport
+	g.Expect(c.Get(rbacPolicyCtx(t), impersonationExposureRequest().NamespacedName, &report)).To(gomega.Succeed())
+	g.Expect(report.Status.ExposedSubjects).To(gomega.Equal(int32(2)))
+	g.Expect(report.Status.ConstrainedSubjects).To(gomega.Equal(int32(1)))
+	g.Expect(report.Status.LegacyRoles).To(gomega.Equal(int32(1)))
+	g.Expect(report.Status.LastScanTime).NotTo(gomega.BeNil())
+	g.Expect(report.Status.Findings).To(gomega.HaveLen(2))
+	g.Expect(report.Status.Findings[0].Subject.Name).To(gomega.Equal("jane"))
+	g.Expect(report.Status.Findings[0].HasConstrainedGrant).To(gomega.BeTrue())
+	g.Expect(conditions.IsTrue(&report, authorizationv1alpha1.LegacyImpersonationExposedCondition)).To(gomega.BeTrue())
+	g.Expect(conditions.IsReady(&report)).To(gomega.BeTrue())
+
+	g.Expect(testutil.ToFloat64(metrics.LegacyImpersonationExposedSubjects.WithLabelValues("true"))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(metrics.LegacyImpersonationExposedSubjects.WithLabelValues("false"))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(metrics.LegacyImpersonationRoles)).To(gomega.Equal(float64(1)))
+}
+
+func TestImpersonationExposure_Reconcile_NoExposureKeepsScanTime(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scanned := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	report := &authorizationv1alpha1.ImpersonationExposureReport{
+		ObjectMeta: metav1.ObjectMeta{Name: authorizationv1alpha1.ImpersonationExposureReportName},
+		Status:     authorizationv1alpha1.ImpersonationExposureReportStatus{LastScanTime: &scanned},
+	}
+
+	r, c := newImpersonationExposureTestReconciler(report)
+	_, err := r.Reconcile(rbacPolicyCtx(t), impersonationExposureRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var updated authorizationv1alpha1.ImpersonationExposureReport
+	g.Expect(c.Get(rbacPolicyCtx(t), impersonationExposureRequest().NamespacedName, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.ExposedSubjects).To(gomega.BeZero())
+	g.Expect(updated.Status.Findings).To(gomega.BeEmpty())
+	g.Expect(updated.Status.LastScanTime.Equal(&scanned)).To(gomega.BeTrue())
+	g.Expect(conditions.IsFalse(&updated, authorizationv1alpha1.LegacyImpersonationExposedCondition)).To(gomega.BeTrue())
+}
+
+func TestImpersonationExposure_Reconcile_IgnoresOtherNames(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	r, c := newImpersonationExposureTestReconciler()
+	result, err := r.Reconcile(rbacPolicyCtx(t), ctrl.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{}))
+
+	var reports authorizationv1alpha1.ImpersonationExposureReportList
+	g.Expect(c.List(rbacPolicyCtx(t), &reports)).To(gomega.Succeed())
+	g.Expect(reports.Items).To(gomega.BeEmpty())
+}
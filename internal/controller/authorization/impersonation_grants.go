@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/helpers"
+	"github.com/telekom/auth-operator/pkg/metrics"
+	pkgssa "github.com/telekom/auth-operator/pkg/ssa"
+)
+
+// ErrImpersonationGrantsDisabled is returned when a RoleDefinition lists
+// spec.impersonationGrants but the controller was not started with
+// --enable-impersonation-grants.
+var ErrImpersonationGrantsDisabled = errors.New(
+	"spec.impersonationGrants is disabled; start the controller with --enable-impersonation-grants to use it")
+
+// ensureImpersonationGrants applies one role and one binding per
+// spec.impersonationGrants entry, prunes those of removed grants and records
+// the generated RBAC and its effective permissions in status.
+//
+// Each grant gets its own role because constrained impersonation rules union
+// within a role: splitting is what keeps identity and action pairs correlated.
+//
+// Unless the feature is enabled, every generated grant is pruned and
+// ErrImpersonationGrantsDisabled is returned for RoleDefinitions that list any.
+func (r *RoleDefinitionReconciler) ensureImpersonationGrants(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+) error {
+	grants := roleDefinition.Spec.ImpersonationGrants
+	if !r.impersonationGrantsEnabled {
+		if err := r.pruneImpersonationGrants(ctx, roleDefinition, nil); err != nil {
+			return err
+		}
+		roleDefinition.Status.ImpersonationGrants = nil
+		if len(grants) > 0 {
+			return ErrImpersonationGrantsDisabled
+		}
+		return nil
+	}
+	keep := make(map[string]struct{}, len(grants))
+	statuses := make([]authorizationv1alpha1.ImpersonationGrantStatus, 0, len(grants))
+	for i := range grants {
+		grant := &grants[i]
+		roleName := authorizationv1alpha1.ImpersonationGrantRoleName(roleDefinition.Spec.TargetName, grant.Name)
+		rules, err := authorizationv1alpha1.BuildConstrainedImpersonationRules(&grant.Grant)
+		if err != nil {
+			return fmt.Errorf("build rules for impersonation grant %s: %w", grant.Name, err)
+		}
+		if err := r.applyImpersonationGrant(ctx, roleDefinition, grant, roleName, rules); err != nil {
+			return err
+		}
+		keep[grant.Name] = struct{}{}
+
+		count, permissions := authorizationv1alpha1.ImpersonationGrantPermissions(&grant.Grant)
+		statuses = append(statuses, authorizationv1alpha1.ImpersonationGrantStatus{
+			Name:            grant.Name,
+			RoleName:        roleName,
+			PermissionCount: int32(min(count, math.MaxInt32)), // #nosec G115 -- bounded by min
+			Permissions:     permissions,
+		})
+	}
+
+	if err := r.pruneImpersonationGrants(ctx, roleDefinition, keep); err != nil {
+		return err
+	}
+	roleDefinition.Status.ImpersonationGrants = statuses
+	return nil
+}
+
+// applyImpersonationGrant applies the role carrying a single grant's rules and
+// the binding that grants it to the grant's subjects.
+func (r *RoleDefinitionReconciler) applyImpersonationGrant(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	grant *authorizationv1alpha1.ImpersonationGrant,
+	roleName string,
+	rules []rbacv1.PolicyRule,
+) error {
+	logger := log.FromContext(ctx)
+	ownerRef := ownerRefForRoleDefinition(roleDefinition)
+	annotations := helpers.BuildResourceAnnotations("RoleDefinition", roleDefinition.Name)
+	labels := buildRoleDefinitionResourceLabels(roleDefinition)
+	labels[authorizationv1alpha1.LabelKeyImpersonationGrant] = grant.Name
+	conflictPolicy := roleDefinition.GetConflictPolicy()
+	applyOpts := conflictApplyOptions(ctx, conflictPolicy, &roleDefinition.Status.Conflicts)
+
+	switch roleDefinition.Spec.TargetRole {
+	case authorizationv1alpha1.DefinitionClusterRole:
+		roleKey := client.ObjectKey{Name: roleName}
+		if err := r.checkImpersonationGrantOwnership(ctx, roleDefinition, &rbacv1.ClusterRole{}, roleKey); err != nil {
+			return err
+		}
+		if err := r.checkImpersonationGrantOwnership(ctx, roleDefinition, &rbacv1.ClusterRoleBinding{}, roleKey); err != nil {
+			return err
+		}
+		roleAC := pkgssa.ClusterRoleWithLabelsAndRules(roleName, labels, rules).
+			WithOwnerReferences(ownerRef).WithAnnotations(annotations)
+		result, err := pkgssa.PatchApplyClusterRole(ctx, r.client, roleAC, applyOpts...)
+		if err != nil && !conflictReported(conflictPolicy, err) {
+			logger.Error(err, "Failed to apply impersonation grant ClusterRole via SSA",
+				"roleDefinitionName", roleDefinition.Name, "grant", grant.Name, "roleName", roleName)
+			return err
+		}
+		recordRBACApply(metrics.ResourceClusterRole, result)
+
+		bindingAC := pkgssa.ClusterRoleBindingWithSubjectsAndRoleRef(roleName, labels, grant.Subjects, rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: roleName,
+		}).WithOwnerReferences(ownerRef).WithAnnotations(annotations)
+		result, err = pkgssa.PatchApplyClusterRoleBinding(ctx, r.client, bindingAC, applyOpts...)
+		if err != nil && !conflictReported(conflictPolicy, err) {
+			logger.Error(err, "Failed to apply impersonation grant ClusterRoleBinding via SSA",
+				"roleDefinitionName", roleDefinition.Name, "grant", grant.Name, "bindingName", roleName)
+			return err
+		}
+		recordRBACApply(metrics.ResourceClusterRoleBinding, result)
+	case authorizationv1alpha1.DefinitionNamespacedRole:
+		namespace := roleDefinition.Spec.TargetNamespace
+		roleKey := client.ObjectKey{Name: roleName, Namespace: namespace}
+		if err := r.checkImpersonationGrantOwnership(ctx, roleDefinition, &rbacv1.Role{}, roleKey); err != nil {
+			return err
+		}
+		if err := r.checkImpersonationGrantOwnership(ctx, roleDefinition, &rbacv1.RoleBinding{}, roleKey); err != nil {
+			return err
+		}
+		roleAC := pkgssa.RoleWithLabelsAndRules(roleName, namespace, labels, rules).
+			WithOwnerReferences(ownerRef).WithAnnotations(annotations)
+		result, err := pkgssa.PatchApplyRole(ctx, r.client, roleAC, applyOpts...)
+		if err != nil && !conflictReported(conflictPolicy, err) {
+			logger.Error(err, "Failed to apply impersonation grant Role via SSA",
+				"roleDefinitionName", roleDefinition.Name, "grant", grant.Name, "roleName", roleName)
+			return err
+		}
+		recordRBACApply(metrics.ResourceRole, result)
+
+		bindingAC := pkgssa.RoleBindingWithSubjectsAndRoleRef(roleName, namespace, labels, grant.Subjects, rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName, Kind: "Role", Name: roleName,
+		}).WithOwnerReferences(ownerRef).WithAnnotations(annotations)
+		result, err = pkgssa.PatchApplyRoleBinding(ctx, r.client, bindingAC, applyOpts...)
+		if err != nil && !conflictReported(conflictPolicy, err) {
+			logger.Error(err, "Failed to apply impersonation grant RoleBinding via SSA",
+				"roleDefinitionName", roleDefinition.Name, "grant", grant.Name, "bindingName", roleName)
+			return err
+		}
+		recordRBACApply(metrics.ResourceRoleBinding, result)
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidTargetRole, roleDefinition.Spec.TargetRole)
+	}
+
+	logger.V(2).Info("Impersonation grant ensured",
+		"roleDefinitionName", roleDefinition.Name, "grant", grant.Name, "roleName", roleName)
+	return nil
+}
+
+// checkImpersonationGrantOwnership refuses to take over an existing object that
+// this RoleDefinition does not control, mirroring checkRoleOwnership.
+func (r *RoleDefinitionReconciler) checkImpersonationGrantOwnership(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	existing client.Object,
+	key client.ObjectKey,
+) error {
+	reader := r.reader
+	if reader == nil {
+		reader = r.client
+	}
+	if err := reader.Get(ctx, key, existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("check existing impersonation grant object %s: %w", key, err)
+	}
+	if hasControllerOwnerRef(existing, roleDefinition) {
+		return nil
+	}
+	kind := fmt.Sprintf("%T", existing)
+	switch existing.(type) {
+	case *rbacv1.ClusterRole:
+		kind = "ClusterRole"
+	case *rbacv1.ClusterRoleBinding:
+		kind = "ClusterRoleBinding"
+	case *rbacv1.Role:
+		kind = "Role"
+	case *rbacv1.RoleBinding:
+		kind = "RoleBinding"
+	}
+	r.recorder.Eventf(roleDefinition, nil, corev1.EventTypeWarning,
+		authorizationv1alpha1.EventReasonOwnership, authorizationv1alpha1.EventActionReconcile,
+		"Impersonation grant %s %s already exists and is not owned by RoleDefinition %s (UID: %s)",
+		kind, key, roleDefinition.Name, roleDefinition.UID)
+	return fmt.Errorf("impersonation grant %s %s already exists and is not owned by RoleDefinition %s (UID: %s)",
+		kind, key, roleDefinition.Name, roleDefinition.UID)
+}
+
+// pruneImpersonationGrants deletes the roles and bindings generated for grants
+// that are no longer listed in keep. A nil keep removes every generated grant.
+//
+// Grant objects are listed cluster-wide for both role kinds, so objects left
+// behind by a changed targetRole or targetNamespace are pruned as well: only
+// those of the current kind in the current target namespace are kept.
+func (r *RoleDefinitionReconciler) pruneImpersonationGrants(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	keep map[string]struct{},
+) error {
+	current := func(client.Object) bool { return false }
+	switch roleDefinition.Spec.TargetRole {
+	case authorizationv1alpha1.DefinitionClusterRole:
+		current = func(obj client.Object) bool {
+			switch obj.(type) {
+			case *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding:
+				return true
+			}
+			return false
+		}
+	case authorizationv1alpha1.DefinitionNamespacedRole:
+		current = func(obj client.Object) bool {
+			switch obj.(type) {
+			case *rbacv1.Role, *rbacv1.RoleBinding:
+				return obj.GetNamespace() == roleDefinition.Spec.TargetNamespace
+			}
+			return false
+		}
+	}
+
+	logger := log.FromContext(ctx)
+	lists := []client.ObjectList{
+		&rbacv1.ClusterRoleBindingList{}, &rbacv1.ClusterRoleList{},
+		&rbacv1.RoleBindingList{}, &rbacv1.RoleList{},
+	}
+	for _, list := range lists {
+		if err := r.client.List(ctx, list, client.HasLabels{authorizationv1alpha1.LabelKeyImpersonationGrant}); err != nil {
+			return fmt.Errorf("list impersonation grant objects: %w", err)
+		}
+		objs, err := metaObjects(list)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			if !hasControllerOwnerRef(obj, roleDefinition) {
+				continue
+			}
+			if _, ok := keep[obj.GetLabels()[authorizationv1alpha1.LabelKeyImpersonationGrant]]; ok && current(obj) {
+				continue
+			}
+			if err := r.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("delete stale impersonation grant object %s: %w", client.ObjectKeyFromObject(obj), err)
+			}
+			logger.V(1).Info("Deleted stale impersonation grant object",
+				"roleDefinitionName", roleDefinition.Name, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		}
+	}
+	return nil
+}
+
+// metaObjects returns the items of a typed RBAC list as client.Objects.
+func metaObjects(list client.ObjectList) ([]client.Object, error) {
+	var objs []client.Object
+	switch l := list.(type) {
+	case *rbacv1.ClusterRoleList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	case *rbacv1.ClusterRoleBindingList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	case *rbacv1.RoleList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	case *rbacv1.RoleBindingList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported list type %T", list)
+	}
+	return objs, nil
+}
+
+func recordRBACApply(resource string, result pkgssa.PatchApplyResult) {
+	if result == pkgssa.PatchApplyResultSkipped {
+		metrics.RBACResourcesSkipped.WithLabelValues(resource).Inc()
+	} else {
+		metrics.RBACResourcesApplied.WithLabelValues(resource).Inc()
+	}
+}
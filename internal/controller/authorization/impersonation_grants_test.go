@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func impersonationGrantTestRoleDefinition() *authorizationv1alpha1.RoleDefinition {
+	oncall := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "oncall"}
+	return &authorizationv1alpha1.RoleDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: authorizationv1alpha1.GroupVersion.String(), Kind: "RoleDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: "oncall", UID: "oncall-uid", Generation: 1},
+		Spec: authorizationv1alpha1.RoleDefinitionSpec{
+			TargetRole: authorizationv1alpha1.DefinitionClusterRole,
+			TargetName: "oncall-impersonation",
+			ImpersonationGrants: []authorizationv1alpha1.ImpersonationGrant{
+				{
+					Name:     "pods",
+					Subjects: []rbacv1.Subject{oncall},
+					Grant: authorizationv1alpha1.ConstrainedImpersonationSpec{
+						Mode: authorizationv1alpha1.ImpersonationModeUserInfo,
+						Identities: []authorizationv1alpha1.ImpersonationIdentityRule{
+							{Resource: authorizationv1alpha1.ImpersonationResourceUsers, Names: []string{"user-a"}},
+						},
+						Actions: []authorizationv1alpha1.ImpersonationActionRule{
+							{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"list"}},
+						},
+					},
+				},
+				{
+					Name:     "secrets",
+					Subjects: []rbacv1.Subject{oncall},
+					Grant: authorizationv1alpha1.ConstrainedImpersonationSpec{
+						Mode: authorizationv1alpha1.ImpersonationModeUserInfo,
+						Identities: []authorizationv1alpha1.ImpersonationIdentityRule{
+							{Resource: authorizationv1alpha1.ImpersonationResourceUsers, Names: []string{"user-b"}},
+						},
+						Actions: []authorizationv1alpha1.ImpersonationActionRule{
+							{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureImpersonationGrants(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
+
+	rd := impersonationGrantTestRoleDefinition()
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(rd).Build()
+	r := &RoleDefinitionReconciler{client: c, scheme: s, recorder: events.NewFakeRecorder(10), impersonationGrantsEnabled: true}
+
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+
+	// Each grant is its own role, so user-a can never be used for secrets.
+	var podsRole rbacv1.ClusterRole
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &podsRole)).To(Succeed())
+	g.Expect(podsRole.Labels).To(HaveKeyWithValue(authorizationv1alpha1.LabelKeyImpersonationGrant, "pods"))
+	g.Expect(podsRole.Rules).To(ContainElement(HaveField("ResourceNames", ConsistOf("user-a"))))
+	g.Expect(podsRole.Rules).NotTo(ContainElement(HaveField("Resources", ContainElement("secrets"))))
+
+	var podsBinding rbacv1.ClusterRoleBinding
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &podsBinding)).To(Succeed())
+	g.Expect(podsBinding.RoleRef.Name).To(Equal("oncall-impersonation-pods"))
+	g.Expect(podsBinding.Subjects).To(ConsistOf(rd.Spec.ImpersonationGrants[0].Subjects))
+
+	g.Expect(rd.Status.ImpersonationGrants).To(HaveLen(2))
+	g.Expect(rd.Status.ImpersonationGrants[1]).To(Equal(authorizationv1alpha1.ImpersonationGrantStatus{
+		Name:            "secrets",
+		RoleName:        "oncall-impersonation-secrets",
+		PermissionCount: 1,
+		Permissions:     []string{"users/user-b: get secrets"},
+	}))
+
+	// Removing a grant prunes its role and binding.
+	rd.Spec.ImpersonationGrants = rd.Spec.ImpersonationGrants[:1]
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+	err := c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-secrets"}, &rbacv1.ClusterRole{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-secrets"}, &rbacv1.ClusterRoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(rd.Status.ImpersonationGrants).To(HaveLen(1))
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &rbacv1.ClusterRole{})).To(Succeed())
+}
+
+func TestEnsureImpersonationGrantsRefusesUnownedRole(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
+
+	rd := impersonationGrantTestRoleDefinition()
+	unowned := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "oncall-impersonation-pods"}}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(rd, unowned).Build()
+	recorder := events.NewFakeRecorder(10)
+	r := &RoleDefinitionReconciler{client: c, scheme: s, recorder: recorder, impersonationGrantsEnabled: true}
+
+	err := r.ensureImpersonationGrants(ctx, rd)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("not owned by RoleDefinition oncall"))
+	g.Expect(recorder.Events).To(HaveLen(1))
+}
+
+func TestEnsureImpersonationGrantsPrunesAfterTargetRoleChange(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
+
+	rd := impersonationGrantTestRoleDefinition()
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(rd).Build()
+	r := &RoleDefinitionReconciler{client: c, scheme: s, recorder: events.NewFakeRecorder(10), impersonationGrantsEnabled: true}
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+
+	// Moving the grants to a namespaced Role removes the cluster-scoped objects.
+	rd.Spec.TargetRole = authorizationv1alpha1.DefinitionNamespacedRole
+	rd.Spec.TargetNamespace = "team-a"
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+	err := c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &rbacv1.ClusterRole{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &rbacv1.ClusterRoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "oncall-impersonation-pods"}, &rbacv1.Role{})).To(Succeed())
+
+	// Changing the target namespace removes the Roles left in the old one.
+	rd.Spec.TargetNamespace = "team-b"
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+	err = c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "oncall-impersonation-pods"}, &rbacv1.Role{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "oncall-impersonation-pods"}, &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(c.Get(ctx, types.NamespacedName{Namespace: "team-b", Name: "oncall-impersonation-pods"}, &rbacv1.RoleBinding{})).To(Succeed())
+}
+
+func TestEnsureImpersonationGrantsDisabled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
+
+	rd := impersonationGrantTestRoleDefinition()
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(rd).Build()
+	r := &RoleDefinitionReconciler{client: c, scheme: s, recorder: events.NewFakeRecorder(10), impersonationGrantsEnabled: true}
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+
+	// Turning the feature off removes the grants generated while it was on.
+	r.impersonationGrantsEnabled = false
+	err := r.ensureImpersonationGrants(ctx, rd)
+	g.Expect(err).To(MatchError(ErrImpersonationGrantsDisabled))
+	err = c.Get(ctx, types.NamespacedName{Name: "oncall-impersonation-pods"}, &rbacv1.ClusterRoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(rd.Status.ImpersonationGrants).To(BeEmpty())
+
+	rd.Spec.ImpersonationGrants = nil
+	g.Expect(r.ensureImpersonationGrants(ctx, rd)).To(Succeed())
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/ssa"
+	"github.com/telekom/auth-operator/pkg/capabilities"
+	conditions "github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/discovery"
+	"github.com/telekom/auth-operator/pkg/metrics"
+	"github.com/telekom/auth-operator/pkg/tracing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=operatorcapabilities,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=operatorcapabilities/status,verbs=get;update;patch
+
+// DefaultCapabilityProbeInterval is how often the API server capabilities are
+// re-probed and the OperatorCapabilities status refreshed.
+const DefaultCapabilityProbeInterval = 10 * time.Minute
+
+// degradedDiscoveryRequeueInterval is how often the status is refreshed while
+// API discovery is degraded, so a recovery is published promptly.
+const degradedDiscoveryRequeueInterval = time.Minute
+
+// capabilityProber is the subset of pkg/capabilities.Detector the
+// OperatorCapabilities reconciler depends on.
+type capabilityProber interface {
+	DetectAll(ctx context.Context) []capabilities.Result
+}
+
+// discoveryHealthSource is the subset of pkg/discovery.ResourceTracker the
+// OperatorCapabilities reconciler depends on.
+type discoveryHealthSource interface {
+	DegradedGroupVersions() []discovery.GroupVersionHealth
+}
+
+// OperatorCapabilitiesReconciler publishes the detected API server
+// capabilities in the singleton OperatorCapabilities status.
+type OperatorCapabilitiesReconciler struct {
+	client          client.Client
+	scheme          *runtime.Scheme
+	tracer          trace.Tracer
+	prober          capabilityProber
+	probeInterval   time.Duration
+	discoveryHealth discoveryHealthSource
+}
+
+// setTracer implements tracerSetter.
+func (r *OperatorCapabilitiesReconciler) setTracer(t trace.Tracer) { r.tracer = t }
+
+// setDiscoveryHealth implements discoveryHealthSetter.
+func (r *OperatorCapabilitiesReconciler) setDiscoveryHealth(src discoveryHealthSource) {
+	r.discoveryHealth = src
+}
+
+// NewOperatorCapabilitiesReconciler creates a new OperatorCapabilities
+// reconciler. A non-positive probeInterval falls back to
+// DefaultCapabilityProbeInterval.
+func NewOperatorCapabilitiesReconciler(
+	cachedClient client.Client,
+	scheme *runtime.Scheme,
+	prober capabilityProber,
+	probeInterval time.Duration,
+	opts ...ReconcilerOption,
+) (*OperatorCapabilitiesReconciler, error) {
+	if prober == nil {
+		return nil, fmt.Errorf("capability prober must not be nil")
+	}
+	if probeInterval <= 0 {
+		probeInterval = DefaultCapabilityProbeInterval
+	}
+	r := &OperatorCapabilitiesReconciler{
+		client:        cachedClient,
+		scheme:        scheme,
+		prober:        prober,
+		probeInterval: probeInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The singleton is enqueued once at start so it is created without any event,
+// and re-probed every probe interval afterwards.
+func (r *OperatorCapabilitiesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	seed := source.Func(func(_ context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: authorizationv1alpha1.OperatorCapabilitiesName}})
+		return nil
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("operatorcapabilities").
+		For(&authorizationv1alpha1.OperatorCapabilities{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WatchesRawSource(seed).
+		WithOptions(controller.TypedOptions[reconcile.Request]{MaxConcurrentReconciles: 1}).
+		Complete(r)
+}
+
+// Reconcile probes the API server capabilities and updates the
+// OperatorCapabilities status and the capability metric. With a discovery
+// health source, the status also lists the failing API GroupVersions.
+// Requests for any other name are ignored.
+func (r *OperatorCapabilitiesReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, retErr error) {
+	startTime := time.Now()
+	logger := log.FromContext(ctx)
+
+	if req.Name != authorizationv1alpha1.OperatorCapabilitiesName {
+		logger.V(1).Info("Ignoring OperatorCapabilities with unexpected name", "name", req.Name,
+			"expected", authorizationv1alpha1.OperatorCapabilitiesName)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerOperatorCapabilities, metrics.ResultSkipped).Inc()
+		return ctrl.Result{}, nil
+	}
+
+	if r.tracer != nil {
+		var span trace.Span
+		ctx, span = r.tracer.Start(ctx, "reconcile.OperatorCapabilities",
+			trace.WithAttributes(
+				tracing.AttrController.String("OperatorCapabilities"),
+				tracing.AttrResource.String(req.Name),
+			))
+		defer func() {
+			if retErr != nil {
+				span.RecordError(retErr)
+				span.SetStatus(codes.Error, retErr.Error())
+			}
+			span.End()
+		}()
+	}
+
+	defer func() {
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerOperatorCapabilities, time.Since(startTime))
+	}()
+
+	// Step 1: Fetch or create the singleton.
+	caps := &authorizationv1alpha1.OperatorCapabilities{}
+	if err := r.client.Get(ctx, req.NamespacedName, caps); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.fail(fmt.Errorf("fetch OperatorCapabilities %s: %w", req.Name, err))
+		}
+		caps = &authorizationv1alpha1.OperatorCapabilities{ObjectMeta: metav1.ObjectMeta{Name: req.Name}}
+		if err := r.client.Create(ctx, caps); err != nil && !apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{}, r.fail(fmt.Errorf("create OperatorCapabilities %s: %w", req.Name, err))
+		}
+		logger.Info("Created OperatorCapabilities", "name", req.Name)
+	}
+
+	// Step 2: Probe. The detector caches results, so this is cheap between
+	// probe intervals.
+	results := r.prober.DetectAll(ctx)
+
+	// Step 3: Update metrics and status.
+	previous := caps.Status.DeepCopy()
+	caps.Status.ServerVersion = ""
+	caps.Status.Capabilities = make([]authorizationv1alpha1.CapabilityStatus, 0, len(results))
+	var missing []string
+	for _, res := range results {
+		if caps.Status.ServerVersion == "" {
+			caps.Status.ServerVersion = res.ServerVersion
+		}
+		state := authorizationv1alpha1.CapabilityState(res.State)
+		caps.Status.Capabilities = append(caps.Status.Capabilities, authorizationv1alpha1.CapabilityStatus{
+			Name:    res.Capability,
+			State:   state,
+			Reason:  res.Reason,
+			Message: res.Detail,
+		})
+		for _, s := range []authorizationv1alpha1.CapabilityState{
+			authorizationv1alpha1.CapabilityStateEnabled,
+			authorizationv1alpha1.CapabilityStateDisabled,
+			authorizationv1alpha1.CapabilityStateUnknown,
+		} {
+			value := 0.0
+			if s == state {
+				value = 1
+			}
+			metrics.APIServerCapability.WithLabelValues(res.Capability, string(s)).Set(value)
+		}
+		if !res.Supported() {
+			missing = append(missing, fmt.Sprintf("%s=%s", res.Capability, res.State))
+		}
+	}
+
+	// Only refresh lastProbeTime when a capability changed or the interval
+	// elapsed, so a resync does not rewrite an unchanged status.
+	if previous.LastProbeTime == nil || time.Since(previous.LastProbeTime.Time) >= r.probeInterval ||
+		previous.ServerVersion != caps.Status.ServerVersion ||
+		!slices.Equal(previous.Capabilities, caps.Status.Capabilities) {
+		now := metav1.Now()
+		caps.Status.LastProbeTime = &now
+	}
+
+	requeueAfter := r.probeInterval
+	if r.discoveryHealth != nil {
+		caps.Status.DegradedGroupVersions = degradedGroupVersionsStatus(r.discoveryHealth.DegradedGroupVersions())
+		if len(caps.Status.DegradedGroupVersions) > 0 {
+			requeueAfter = min(requeueAfter, degradedDiscoveryRequeueInterval)
+		}
+	}
+
+	if len(missing) == 0 {
+		conditions.MarkTrue(caps, authorizationv1alpha1.CapabilitiesAvailableCondition, caps.Generation,
+			authorizationv1alpha1.CapabilitiesAvailableReasonAll, authorizationv1alpha1.CapabilitiesAvailableMessageAll)
+	} else {
+		conditions.MarkFalse(caps, authorizationv1alpha1.CapabilitiesAvailableCondition, caps.Generation,
+			authorizationv1alpha1.CapabilitiesAvailableReasonMissing, authorizationv1alpha1.CapabilitiesAvailableMessageMissing,
+			strings.Join(missing, ", "))
+	}
+	conditions.MarkReady(caps, caps.Generation,
+		authorizationv1alpha1.ReadyReasonReconciled, authorizationv1alpha1.ReadyMessageReconciled)
+
+	if err := ssa.ApplyOperatorCapabilitiesStatus(ctx, r.client, caps); err != nil {
+		return ctrl.Result{}, r.fail(fmt.Errorf("apply OperatorCapabilities %s status: %w", req.Name, err))
+	}
+
+	logger.V(1).Info("API server capabilities probed", "serverVersion", caps.Status.ServerVersion, "missing", missing)
+	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerOperatorCapabilities, metrics.ResultSuccess).Inc()
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// degradedGroupVersionsStatus converts the tracker's failing GroupVersions to
+// their status representation. Times are truncated to the serialized RFC 3339
+// precision so an unchanged status compares equal to the cached one.
+func degradedGroupVersionsStatus(degraded []discovery.GroupVersionHealth) []authorizationv1alpha1.DegradedGroupVersion {
+	if len(degraded) == 0 {
+		return nil
+	}
+	out := make([]authorizationv1alpha1.DegradedGroupVersion, 0, len(degraded))
+	for _, gv := range degraded {
+		entry := authorizationv1alpha1.DegradedGroupVersion{
+			GroupVersion: gv.GroupVersion,
+			Stale:        gv.Stale,
+			Message:      gv.LastError,
+		}
+		if !gv.FailingSince.IsZero() {
+			failingSince := metav1.NewTime(gv.FailingSince).Rfc3339Copy()
+			entry.FailingSince = &failingSince
+		}
+		if !gv.LastSuccess.IsZero() {
+			lastSuccess := metav1.NewTime(gv.LastSuccess).Rfc3339Copy()
+			entry.LastSuccessTime = &lastSuccess
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// fail records an API error for the reconcile and returns err unchanged.
+func (r *OperatorCapabilitiesReconciler) fail(err error) error {
+	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerOperatorCapabilities, metrics.ResultError).Inc()
+	metrics.ReconcileErrors.WithLabelValues(metrics.ControllerOperatorCapabilities, metrics.ErrorTypeAPI).Inc()
+	return err
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/capabilities"
+	"github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/discovery"
+	"github.com/telekom/auth-operator/pkg/metrics"
+)
+
+// stubCapabilityProber returns fixed probe results.
+type stubCapabilityProber struct {
+	results []capabilities.Result
+}
+
+func (s stubCapabilityProber) DetectAll(context.Context) []capabilities.Result {
+	return s.results
+}
+
+// stubDiscoveryHealth returns fixed degraded GroupVersions.
+type stubDiscoveryHealth []discovery.GroupVersionHealth
+
+func (s stubDiscoveryHealth) DegradedGroupVersions() []discovery.GroupVersionHealth {
+	return s
+}
+
+func newOperatorCapabilitiesTestReconciler(
+	t *testing.T, prober capabilityProber, objs ...client.Object,
+) (*OperatorCapabilitiesReconciler, client.Client) {
+	t.Helper()
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&authorizationv1alpha1.OperatorCapabilities{}).
+		Build()
+	r, err := NewOperatorCapabilitiesReconciler(c, scheme, prober, time.Hour)
+	if err != nil {
+		t.Fatalf("NewOperatorCapabilitiesReconciler: %v", err)
+	}
+	return r, c
+}
+
+func operatorCapabilitiesRequest() ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Name: authorizationv1alpha1.OperatorCapabilitiesName}}
+}
+
+func TestOperatorCapabilities_Reconcile_PublishesStatus(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	prober := stubCapabilityProber{results: []capabilities.Result{
+		{
+			Capability: "ConstrainedImpersonation", State: capabilities.StateDisabled,
+			Reason: capabilities.ReasonVersionTooOld, Detail: "too old", ServerVersion: "v1.34.2",
+		},
+		{
+			Capability: "AuthorizeWithSelectors", State: capabilities.StateEnabled,
+			Reason: capabilities.ReasonFeatureGA, Detail: "GA", ServerVersion: "v1.34.2",
+		},
+	}}
+
+	r, c := newOperatorCapabilitiesTestReconciler(t, prober)
+	result, err := r.Reconcile(rbacPolicyCtx(t), operatorCapabilitiesRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{RequeueAfter: time.Hour}))
+
+	var caps authorizationv1alpha1.OperatorCapabilities
+	g.Expect(c.Get(rbacPolicyCtx(t), operatorCapabilitiesRequest().NamespacedName, &caps)).To(gomega.Succeed())
+	g.Expect(caps.Status.ServerVersion).To(gomega.Equal("v1.34.2"))
+	g.Expect(caps.Status.LastProbeTime).NotTo(gomega.BeNil())
+	g.Expect(caps.Status.Capabilities).To(gomega.Equal([]authorizationv1alpha1.CapabilityStatus{
+		{Name: "ConstrainedImpersonation", State: authorizationv1alpha1.CapabilityStateDisabled, Reason: "VersionTooOld", Message: "too old"},
+		{Name: "AuthorizeWithSelectors", State: authorizationv1alpha1.CapabilityStateEnabled, Reason: "FeatureGA", Message: "GA"},
+	}))
+	selectors, ok := caps.Status.Capability("AuthorizeWithSelectors")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(selectors.State).To(gomega.Equal(authorizationv1alpha1.CapabilityStateEnabled))
+	g.Expect(conditions.IsFalse(&caps, authorizationv1alpha1.CapabilitiesAvailableCondition)).To(gomega.BeTrue())
+	g.Expect(conditions.IsReady(&caps)).To(gomega.BeTrue())
+
+	g.Expect(testutil.ToFloat64(metrics.APIServerCapability.WithLabelValues("ConstrainedImpersonation", "Disabled"))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(metrics.APIServerCapability.WithLabelValues("ConstrainedImpersonation", "Enabled"))).To(gomega.Equal(float64(0)))
+	g.Expect(testutil.ToFloat64(metrics.APIServerCapability.WithLabelValues("AuthorizeWithSelectors", "Enabled"))).To(gomega.Equal(float64(1)))
+}
+
+func TestOperatorCapabilities_Reconcile_UnchangedKeepsProbeTime(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	probed := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	existing := &authorizationv1alpha1.OperatorCapabilities{
+		ObjectMeta: metav1.ObjectMeta{Name: authorizationv1alpha1.OperatorCapabilitiesName},
+		Status: authorizationv1alpha1.OperatorCapabilitiesStatus{
+			ServerVersion: "v1.36.0",
+			LastProbeTime: &probed,
+			Capabilities: []authorizationv1alpha1.CapabilityStatus{
+				{Name: "AuthorizeWithSelectors", State: authorizationv1alpha1.CapabilityStateEnabled, Reason: "FeatureGA"},
+			},
+		},
+	}
+	prober := stubCapabilityProber{results: []capabilities.Result{{
+		Capability: "AuthorizeWithSelectors", State: capabilities.StateEnabled,
+		Reason: capabilities.ReasonFeatureGA, ServerVersion: "v1.36.0",
+	}}}
+
+	r, c := newOperatorCapabilitiesTestReconciler(t, prober, existing)
+	_, err := r.Reconcile(rbacPolicyCtx(t), operatorCapabilitiesRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var updated authorizationv1alpha1.OperatorCapabilities
+	g.Expect(c.Get(rbacPolicyCtx(t), operatorCapabilitiesRequest().NamespacedName, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.LastProbeTime.Equal(&probed)).To(gomega.BeTrue())
+	g.Expect(conditions.IsTrue(&updated, authorizationv1alpha1.CapabilitiesAvailableCondition)).To(gomega.BeTrue())
+}
+
+func TestOperatorCapabilities_Reconcile_PublishesDegradedGroupVersions(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	failingSince := time.Date(2026, 5, 4, 10, 0, 0, 0, time.UTC)
+	r, c := newOperatorCapabilitiesTestReconciler(t, stubCapabilityProber{})
+	WithDiscoveryHealth(stubDiscoveryHealth{{
+		GroupVersion:        "metrics.k8s.io/v1beta1",
+		Stale:               true,
+		LastSuccess:         failingSince.Add(-5 * time.Minute),
+		FailingSince:        failingSince,
+		ConsecutiveFailures: 2,
+		LastError:           "the server is currently unable to handle the request",
+	}})(r)
+
+	result, err := r.Reconcile(rbacPolicyCtx(t), operatorCapabilitiesRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{RequeueAfter: degradedDiscoveryRequeueInterval}))
+
+	var caps authorizationv1alpha1.OperatorCapabilities
+	g.Expect(c.Get(rbacPolicyCtx(t), operatorCapabilitiesRequest().NamespacedName, &caps)).To(gomega.Succeed())
+	g.Expect(caps.Status.DegradedGroupVersions).To(gomega.HaveLen(1))
+	degraded := caps.Status.DegradedGroupVersions[0]
+	g.Expect(degraded.GroupVersion).To(gomega.Equal("metrics.k8s.io/v1beta1"))
+	g.Expect(degraded.Stale).To(gomega.BeTrue())
+	g.Expect(degraded.FailingSince.Time.Equal(failingSince)).To(gomega.BeTrue())
+	g.Expect(degraded.LastSuccessTime.Time.Equal(failingSince.Add(-5 * time.Minute))).To(gomega.BeTrue())
+	g.Expect(degraded.Message).To(gomega.Equal("the server is currently unable to handle the request"))
+
+	// Once discovery recovers the list is cleared and the probe interval applies.
+	WithDiscoveryHealth(stubDiscoveryHealth{})(r)
+	result, err = r.Reconcile(rbacPolicyCtx(t), operatorCapabilitiesRequest())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{RequeueAfter: time.Hour}))
+	g.Expect(c.Get(rbacPolicyCtx(t), operatorCapabilitiesRequest().NamespacedName, &caps)).To(gomega.Succeed())
+	g.Expect(caps.Status.DegradedGroupVersions).To(gomega.BeEmpty())
+}
+
+func TestOperatorCapabilities_Reconcile_IgnoresOtherNames(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	r, c := newOperatorCapabilitiesTestReconciler(t, stubCapabilityProber{})
+	result, err := r.Reconcile(rbacPolicyCtx(t), ctrl.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{}))
+
+	var list authorizationv1alpha1.OperatorCapabilitiesList
+	g.Expect(c.List(rbacPolicyCtx(t), &list)).To(gomega.Succeed())
+	g.Expect(list.Items).To(gomega.BeEmpty())
+}
+
+func TestNewOperatorCapabilitiesReconciler_RequiresProber(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	_, err := NewOperatorCapabilitiesReconciler(nil, newTestScheme(), nil, 0)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,513 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	admissionregistrationv1ac "k8s.io/client-go/applyconfigurations/admissionregistration/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	conditions "github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/helpers"
+	pkgssa "github.com/telekom/auth-operator/pkg/ssa"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingadmissionpolicies;validatingadmissionpolicybindings,verbs=get;list;watch;create;update;patch;delete
+
+const (
+	// admissionPolicyNamePrefix prefixes the ValidatingAdmissionPolicy and
+	// bindings generated for an RBACPolicy.
+	admissionPolicyNamePrefix = "auth-operator-rbacpolicy-"
+	// admissionBindingNamespacesSuffix names the binding that covers the
+	// explicit namespaces of the policy scope.
+	admissionBindingNamespacesSuffix = "-namespaces"
+	// admissionBindingSelectorSuffix names the binding that covers the
+	// namespace selector of the policy scope.
+	admissionBindingSelectorSuffix = "-selector"
+	// maxAdmissionPolicyNameLength leaves room for the longest binding suffix
+	// within the 253 character object name limit.
+	maxAdmissionPolicyNameLength = 253 - len(admissionBindingNamespacesSuffix)
+
+	// allNamespacesScope is the PolicyScope entry that makes a policy cluster-wide.
+	allNamespacesScope = "*"
+	// namespaceNameLabel is the label the API server sets on every namespace to
+	// its name, which lets a binding select namespaces by name.
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+// admissionValidation is one CEL validation of a generated
+// ValidatingAdmissionPolicy.
+type admissionValidation struct {
+	expression string
+	message    string
+}
+
+// admissionPolicyBinding describes one generated
+// ValidatingAdmissionPolicyBinding. A nil namespaceSelector matches every
+// namespace.
+type admissionPolicyBinding struct {
+	name              string
+	namespaceSelector *metav1.LabelSelector
+}
+
+// admissionPolicyName returns the name of the ValidatingAdmissionPolicy
+// generated for the RBACPolicy policyName. Long names are truncated and
+// suffixed with a short hash, like generated binding names.
+func admissionPolicyName(policyName string) string {
+	fullName := admissionPolicyNamePrefix + policyName
+	if len(fullName) <= maxAdmissionPolicyNameLength {
+		return fullName
+	}
+	hash := sha256.Sum256([]byte(fullName))
+	hashSuffix := hex.EncodeToString(hash[:4])
+	return fullName[:maxAdmissionPolicyNameLength-1-len(hashSuffix)] + "-" + hashSuffix
+}
+
+// admissionPolicyBindings returns the bindings needed to cover the policy
+// scope. Explicit namespaces and the namespace selector get separate bindings
+// because they are ORed in the scope, while the fields of a binding's
+// matchResources are ANDed. A scope of only "*" gets a single binding without
+// a selector.
+func admissionPolicyBindings(vapName string, scope authorizationv1alpha1.PolicyScope) []admissionPolicyBinding {
+	explicit := slices.DeleteFunc(slices.Clone(scope.Namespaces), func(ns string) bool { return ns == allNamespacesScope })
+	slices.Sort(explicit)
+	explicit = slices.Compact(explicit)
+
+	if len(explicit) == 0 && scope.NamespaceSelector == nil {
+		if !slices.Contains(scope.Namespaces, allNamespacesScope) {
+			return nil
+		}
+		return []admissionPolicyBinding{{name: vapName}}
+	}
+
+	var bindings []admissionPolicyBinding
+	if len(explicit) > 0 {
+		bindings = append(bindings, admissionPolicyBinding{
+			name: vapName + admissionBindingNamespacesSuffix,
+			namespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      namespaceNameLabel,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   explicit,
+			}}},
+		})
+	}
+	if scope.NamespaceSelector != nil {
+		bindings = append(bindings, admissionPolicyBinding{
+			name:              vapName + admissionBindingSelectorSuffix,
+			namespaceSelector: scope.NamespaceSelector,
+		})
+	}
+	return bindings
+}
+
+// admissionPolicyBindingNames returns every binding name that may have been
+// generated for vapName, so stale bindings can be pruned after a scope change.
+func admissionPolicyBindingNames(vapName string) []string {
+	return []string{vapName, vapName + admissionBindingNamespacesSuffix, vapName + admissionBindingSelectorSuffix}
+}
+
+// buildAdmissionValidations translates the limits of an RBACPolicy that are
+// expressible in CEL into ValidatingAdmissionPolicy validations over
+// RoleBinding and ClusterRoleBinding writes.
+//
+// Selector-based limits need namespace or role labels the API server does not
+// provide to the policy, and unset limits are default-deny for restricted
+// resources but would block every direct binding write here. Both stay
+// operator-only.
+func buildAdmissionValidations(policy *authorizationv1alpha1.RBACPolicy) []admissionValidation {
+	var validations []admissionValidation
+	add := func(expression, format string, args ...any) {
+		validations = append(validations, admissionValidation{
+			expression: expression,
+			message:    fmt.Sprintf(format, args...) + " by RBACPolicy " + policy.Name,
+		})
+	}
+
+	if limits := policy.Spec.BindingLimits; limits != nil {
+		if !limits.AllowClusterRoleBindings && scopeAllowsClusterBindings(policy.Spec.AppliesTo) {
+			add("request.kind.kind != 'ClusterRoleBinding'", "ClusterRoleBindings are not allowed")
+		}
+		validations = append(validations, roleRefValidations(policy.Name, "ClusterRole", limits.ClusterRoleBindingLimits)...)
+		validations = append(validations, roleRefValidations(policy.Name, "Role", limits.RoleBindingLimits)...)
+
+		if ns := limits.TargetNamespaceLimits; ns != nil {
+			if len(ns.ForbiddenNamespaces) > 0 {
+				add("request.kind.kind != 'RoleBinding' || !(request.namespace in "+celStringList(ns.ForbiddenNamespaces)+")",
+					"the namespace is forbidden")
+			}
+			if len(ns.ForbiddenNamespacePrefixes) > 0 {
+				add("request.kind.kind != 'RoleBinding' || !"+celStringList(ns.ForbiddenNamespacePrefixes)+
+					".exists(p, request.namespace.startsWith(p))",
+					"the namespace matches a forbidden prefix")
+			}
+		}
+	}
+
+	if limits := policy.Spec.SubjectLimits; limits != nil {
+		if len(limits.ForbiddenKinds) > 0 {
+			add(allSubjects("!(s.kind in "+celStringList(limits.ForbiddenKinds)+")"),
+				"a subject kind is forbidden")
+		}
+		if len(limits.AllowedKinds) > 0 {
+			add(allSubjects("s.kind in "+celStringList(limits.AllowedKinds)),
+				"a subject kind is not allowed")
+		}
+		validations = append(validations, nameMatchValidations(policy.Name, rbacv1.UserKind, limits.UserLimits)...)
+		validations = append(validations, nameMatchValidations(policy.Name, rbacv1.GroupKind, limits.GroupLimits)...)
+
+		if sa := limits.ServiceAccountLimits; sa != nil {
+			// RoleBinding subjects may omit the ServiceAccount namespace, which
+			// then defaults to the namespace of the binding.
+			const saNamespace = "(has(s.namespace) && s.namespace != \"\" ? s.namespace : request.namespace)"
+			if len(sa.ForbiddenNamespaces) > 0 {
+				add(allSubjectsOfKind(rbacv1.ServiceAccountKind, "!("+saNamespace+" in "+celStringList(sa.ForbiddenNamespaces)+")"),
+					"a ServiceAccount subject namespace is forbidden")
+			}
+			if len(sa.ForbiddenNamespacePrefixes) > 0 {
+				add(allSubjectsOfKind(rbacv1.ServiceAccountKind, "!"+celStringList(sa.ForbiddenNamespacePrefixes)+
+					".exists(p, "+saNamespace+".startsWith(p))"),
+					"a ServiceAccount subject namespace matches a forbidden prefix")
+			}
+		}
+	}
+
+	return validations
+}
+
+// roleRefValidations returns the validations for the role references of kind.
+// Allowed names are only enforced when no allowed selector is configured,
+// because a reference matching the selector alone is allowed.
+func roleRefValidations(policyName, kind string, limits *authorizationv1alpha1.RoleRefLimits) []admissionValidation {
+	if limits == nil {
+		return nil
+	}
+	guard := "object.roleRef.kind != " + strconv.Quote(kind) + " || "
+	var validations []admissionValidation
+	if len(limits.ForbiddenRoleRefs) > 0 {
+		validations = append(validations, admissionValidation{
+			expression: guard + "!" + celWildcardMatch("object.roleRef.name", limits.ForbiddenRoleRefs),
+			message:    fmt.Sprintf("the %s reference is forbidden by RBACPolicy %s", kind, policyName),
+		})
+	}
+	if len(limits.AllowedRoleRefs) > 0 && limits.AllowedRoleRefSelector == nil {
+		validations = append(validations, admissionValidation{
+			expression: guard + celWildcardMatch("object.roleRef.name", limits.AllowedRoleRefs),
+			message:    fmt.Sprintf("the %s reference is not allowed by RBACPolicy %s", kind, policyName),
+		})
+	}
+	return validations
+}
+
+// nameMatchValidations returns the validations for the names of subjects of
+// kind. Every configured list is checked independently, as in the evaluator.
+func nameMatchValidations(policyName, kind string, limits *authorizationv1alpha1.NameMatchLimits) []admissionValidation {
+	if limits == nil {
+		return nil
+	}
+	var validations []admissionValidation
+	add := func(values []string, condition, problem string) {
+		if len(values) == 0 {
+			return
+		}
+		validations = append(validations, admissionValidation{
+			expression: allSubjectsOfKind(kind, condition),
+			message:    fmt.Sprintf("a %s subject name %s by RBACPolicy %s", kind, problem, policyName),
+		})
+	}
+	add(limits.ForbiddenNames, "!(s.name in "+celStringList(limits.ForbiddenNames)+")", "is forbidden")
+	add(limits.ForbiddenPrefixes, "!"+celStringList(limits.ForbiddenPrefixes)+".exists(p, s.name.startsWith(p))",
+		"matches a forbidden prefix")
+	add(limits.ForbiddenSuffixes, "!"+celStringList(limits.ForbiddenSuffixes)+".exists(p, s.name.endsWith(p))",
+		"matches a forbidden suffix")
+	add(limits.AllowedNames, "s.name in "+celStringList(limits.AllowedNames), "is not in the allowed list")
+	add(limits.AllowedPrefixes, celStringList(limits.AllowedPrefixes)+".exists(p, s.name.startsWith(p))",
+		"does not match any allowed prefix")
+	add(limits.AllowedSuffixes, celStringList(limits.AllowedSuffixes)+".exists(p, s.name.endsWith(p))",
+		"does not match any allowed suffix")
+	return validations
+}
+
+// allSubjects returns a CEL expression that holds when condition holds for
+// every subject s of the binding.
+func allSubjects(condition string) string {
+	return "!has(object.subjects) || object.subjects.all(s, " + condition + ")"
+}
+
+// allSubjectsOfKind is allSubjects restricted to subjects of kind.
+func allSubjectsOfKind(kind, condition string) string {
+	return allSubjects("s.kind != " + strconv.Quote(kind) + " || " + condition)
+}
+
+// celStringList renders values as a CEL list literal.
+func celStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// celWildcardMatch returns a CEL expression that holds when expr matches any
+// of the patterns, with the wildcard semantics of helpers.MatchesWildcard.
+func celWildcardMatch(expr string, patterns []string) string {
+	var exact, terms []string
+	for _, pattern := range patterns {
+		switch {
+		case pattern == "*":
+			return "true"
+		case strings.Contains(pattern, "*"):
+			parts := strings.Split(pattern, "*")
+			for i := range parts {
+				parts[i] = regexp.QuoteMeta(parts[i])
+			}
+			terms = append(terms, expr+".matches("+strconv.Quote("^"+strings.Join(parts, ".*")+"$")+")")
+		default:
+			exact = append(exact, pattern)
+		}
+	}
+	if len(exact) > 0 {
+		terms = append([]string{expr + " in " + celStringList(exact)}, terms...)
+	}
+	return "(" + strings.Join(terms, " || ") + ")"
+}
+
+// scopeAllowsClusterBindings reports whether the policy scope covers
+// cluster-scoped bindings, mirroring the evaluator.
+func scopeAllowsClusterBindings(scope authorizationv1alpha1.PolicyScope) bool {
+	return slices.Contains(scope.Namespaces, allNamespacesScope)
+}
+
+// admissionExemptUsernames returns the usernames the generated policy never
+// applies to: the operator itself and the apply identity of the policy, which
+// write the RBAC of restricted resources after the operator checked it.
+func admissionExemptUsernames(policy *authorizationv1alpha1.RBACPolicy, operatorUsername string) []string {
+	exempt := []string{operatorUsername}
+	if ic := policy.Spec.Impersonation; ic != nil && ic.Enabled {
+		if username := ic.EffectiveUsername(); username != "" && username != operatorUsername {
+			exempt = append(exempt, username)
+		}
+	}
+	return exempt
+}
+
+// buildValidatingAdmissionPolicy builds the apply configuration of the
+// ValidatingAdmissionPolicy enforcing validations for policy.
+func buildValidatingAdmissionPolicy(
+	policy *authorizationv1alpha1.RBACPolicy,
+	vapName string,
+	validations []admissionValidation,
+	exemptUsernames []string,
+) *admissionregistrationv1ac.ValidatingAdmissionPolicyApplyConfiguration {
+	resources := []string{"rolebindings"}
+	if scopeAllowsClusterBindings(policy.Spec.AppliesTo) {
+		resources = append(resources, "clusterrolebindings")
+	}
+
+	spec := admissionregistrationv1ac.ValidatingAdmissionPolicySpec().
+		WithFailurePolicy(admissionregistrationv1.Fail).
+		WithMatchConstraints(admissionregistrationv1ac.MatchResources().
+			WithResourceRules(admissionregistrationv1ac.NamedRuleWithOperations().
+				WithOperations(admissionregistrationv1.Create, admissionregistrationv1.Update).
+				WithAPIGroups(rbacv1.GroupName).
+				WithAPIVersions("*").
+				WithResources(resources...))).
+		WithMatchConditions(admissionregistrationv1ac.MatchCondition().
+			WithName("exclude-auth-operator").
+			WithExpression("!(request.userInfo.username in " + celStringList(exemptUsernames) + ")"))
+	for _, v := range validations {
+		spec.WithValidations(admissionregistrationv1ac.Validation().
+			WithExpression(v.expression).
+			WithMessage(v.message).
+			WithReason(metav1.StatusReasonForbidden))
+	}
+
+	return admissionregistrationv1ac.ValidatingAdmissionPolicy(vapName).
+		WithLabels(helpers.BuildResourceLabels(nil)).
+		WithAnnotations(helpers.BuildResourceAnnotations("RBACPolicy", policy.Name)).
+		WithOwnerReferences(ownerRefForRBACPolicy(policy)).
+		WithSpec(spec)
+}
+
+// buildValidatingAdmissionPolicyBinding builds the apply configuration of one
+// binding of the generated ValidatingAdmissionPolicy.
+func buildValidatingAdmissionPolicyBinding(
+	policy *authorizationv1alpha1.RBACPolicy,
+	vapName string,
+	binding admissionPolicyBinding,
+) *admissionregistrationv1ac.ValidatingAdmissionPolicyBindingApplyConfiguration {
+	spec := admissionregistrationv1ac.ValidatingAdmissionPolicyBindingSpec().
+		WithPolicyName(vapName).
+		WithValidationActions(admissionValidationAction(policy.Spec.AdmissionEnforcement.ActionOrDefault()))
+	if binding.namespaceSelector != nil {
+		spec.WithMatchResources(admissionregistrationv1ac.MatchResources().
+			WithNamespaceSelector(pkgssa.LabelSelectorFrom(binding.namespaceSelector)))
+	}
+
+	return admissionregistrationv1ac.ValidatingAdmissionPolicyBinding(binding.name).
+		WithLabels(helpers.BuildResourceLabels(nil)).
+		WithAnnotations(helpers.BuildResourceAnnotations("RBACPolicy", policy.Name)).
+		WithOwnerReferences(ownerRefForRBACPolicy(policy)).
+		WithSpec(spec)
+}
+
+// admissionValidationAction maps an enforcement action to the binding action.
+func admissionValidationAction(action authorizationv1alpha1.AdmissionEnforcementAction) admissionregistrationv1.ValidationAction {
+	switch action {
+	case authorizationv1alpha1.AdmissionEnforcementActionWarn:
+		return admissionregistrationv1.Warn
+	case authorizationv1alpha1.AdmissionEnforcementActionAudit:
+		return admissionregistrationv1.Audit
+	default:
+		return admissionregistrationv1.Deny
+	}
+}
+
+func ownerRefForRBACPolicy(policy *authorizationv1alpha1.RBACPolicy) *metav1ac.OwnerReferenceApplyConfiguration {
+	return pkgssa.OwnerReference(
+		authorizationv1alpha1.GroupVersion.String(),
+		"RBACPolicy",
+		policy.Name,
+		policy.UID,
+		true, // controller
+		true, // blockOwnerDeletion
+	)
+}
+
+// reconcileAdmissionEnforcement applies the ValidatingAdmissionPolicy and
+// bindings for spec.admissionEnforcement and reports the
+// AdmissionPolicyEnforced condition. When enforcement is disabled, previously
+// generated objects are removed and the condition is dropped.
+//
+// Generated objects are not watched: drift is repaired on the next
+// reconciliation of the policy. This keeps the controller working on clusters
+// that do not serve admissionregistration.k8s.io/v1 ValidatingAdmissionPolicies.
+func (r *RBACPolicyReconciler) reconcileAdmissionEnforcement(ctx context.Context, policy *authorizationv1alpha1.RBACPolicy) error {
+	logger := log.FromContext(ctx)
+	vapName := admissionPolicyName(policy.Name)
+
+	if !policy.Spec.AdmissionEnforcement.IsEnabled() {
+		// Only policies that enforced before have objects to clean up.
+		if !conditions.Has(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition) {
+			return nil
+		}
+		if err := r.pruneAdmissionPolicy(ctx, policy, vapName, nil); err != nil {
+			return err
+		}
+		conditions.Delete(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition)
+		return nil
+	}
+
+	validations := buildAdmissionValidations(policy)
+	bindings := admissionPolicyBindings(vapName, policy.Spec.AppliesTo)
+	switch {
+	case r.operatorUsername == "":
+		conditions.MarkFalse(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition, policy.Generation,
+			authorizationv1alpha1.AdmissionPolicyEnforcedReasonOperatorIdentityUnknown,
+			authorizationv1alpha1.AdmissionPolicyEnforcedMessageOperatorIdentityUnknown)
+		return r.pruneAdmissionPolicy(ctx, policy, vapName, nil)
+	case len(validations) == 0 || len(bindings) == 0:
+		conditions.MarkFalse(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition, policy.Generation,
+			authorizationv1alpha1.AdmissionPolicyEnforcedReasonNothingToEnforce,
+			authorizationv1alpha1.AdmissionPolicyEnforcedMessageNothingToEnforce)
+		return r.pruneAdmissionPolicy(ctx, policy, vapName, nil)
+	}
+
+	vapAC := buildValidatingAdmissionPolicy(policy, vapName, validations, admissionExemptUsernames(policy, r.operatorUsername))
+	if err := r.client.Apply(ctx, vapAC, client.FieldOwner(pkgssa.FieldOwner), client.ForceOwnership); err != nil {
+		return r.markAdmissionApplyFailed(ctx, policy, fmt.Errorf("apply ValidatingAdmissionPolicy %s: %w", vapName, err))
+	}
+	keep := make(map[string]struct{}, len(bindings))
+	for _, binding := range bindings {
+		bindingAC := buildValidatingAdmissionPolicyBinding(policy, vapName, binding)
+		if err := r.client.Apply(ctx, bindingAC, client.FieldOwner(pkgssa.FieldOwner), client.ForceOwnership); err != nil {
+			return r.markAdmissionApplyFailed(ctx, policy,
+				fmt.Errorf("apply ValidatingAdmissionPolicyBinding %s: %w", binding.name, err))
+		}
+		keep[binding.name] = struct{}{}
+	}
+	if err := r.pruneAdmissionPolicy(ctx, policy, vapName, keep); err != nil {
+		return err
+	}
+
+	action := policy.Spec.AdmissionEnforcement.ActionOrDefault()
+	logger.V(2).Info("admission policy ensured",
+		"rbacPolicy", policy.Name, "validatingAdmissionPolicy", vapName,
+		"validations", len(validations), "bindings", len(bindings), "action", action)
+	conditions.MarkTrue(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition, policy.Generation,
+		authorizationv1alpha1.AdmissionPolicyEnforcedReasonEnforced,
+		authorizationv1alpha1.AdmissionPolicyEnforcedMessageEnforced, vapName, len(validations), action)
+	return nil
+}
+
+// markAdmissionApplyFailed reports a failed apply on the condition. A cluster
+// that does not serve ValidatingAdmissionPolicies is a permanent condition and
+// is not retried; other errors are returned so the reconciliation is requeued.
+func (r *RBACPolicyReconciler) markAdmissionApplyFailed(ctx context.Context, policy *authorizationv1alpha1.RBACPolicy, err error) error {
+	conditions.MarkFalse(policy, authorizationv1alpha1.AdmissionPolicyEnforcedCondition, policy.Generation,
+		authorizationv1alpha1.AdmissionPolicyEnforcedReasonApplyFailed,
+		authorizationv1alpha1.AdmissionPolicyEnforcedMessageApplyFailed, err.Error())
+	if meta.IsNoMatchError(err) {
+		log.FromContext(ctx).Info("ValidatingAdmissionPolicies are not served by the API server, admission enforcement is inactive",
+			"rbacPolicy", policy.Name)
+		return nil
+	}
+	return err
+}
+
+// pruneAdmissionPolicy deletes the generated bindings not listed in keep and,
+// when keep is nil, the ValidatingAdmissionPolicy itself. Objects that are not
+// controlled by the policy are left alone.
+func (r *RBACPolicyReconciler) pruneAdmissionPolicy(
+	ctx context.Context,
+	policy *authorizationv1alpha1.RBACPolicy,
+	vapName string,
+	keep map[string]struct{},
+) error {
+	var stale []client.Object
+	for _, name := range admissionPolicyBindingNames(vapName) {
+		if _, ok := keep[name]; !ok {
+			stale = append(stale, &admissionregistrationv1.ValidatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+	}
+	if keep == nil {
+		stale = append(stale, &admissionregistrationv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: vapName}})
+	}
+
+	for _, obj := range stale {
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("get generated admission object %s: %w", obj.GetName(), err)
+		}
+		if !hasControllerOwnerRef(obj, policy) {
+			r.recorder.Eventf(policy, nil, corev1.EventTypeWarning,
+				authorizationv1alpha1.EventReasonOwnership, authorizationv1alpha1.EventActionReconcile,
+				"Admission object %s is not owned by RBACPolicy %s and was not deleted", obj.GetName(), policy.Name)
+			continue
+		}
+		if err := r.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete generated admission object %s: %w", obj.GetName(), err)
+		}
+	}
+	return nil
+}
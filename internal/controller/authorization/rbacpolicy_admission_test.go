@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/conditions"
+)
+
+// evalAdmission evaluates the validations against a binding write like the API
+// server would and returns the messages of the failed validations.
+func evalAdmission(t *testing.T, validations []admissionValidation, kind, namespace string, object map[string]any) []string {
+	t.Helper()
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType), cel.Variable("request", cel.DynType))
+	if err != nil {
+		t.Fatalf("create CEL environment: %v", err)
+	}
+	vars := map[string]any{
+		"object":  object,
+		"request": map[string]any{"kind": map[string]any{"kind": kind}, "namespace": namespace},
+	}
+	var failed []string
+	for _, v := range validations {
+		ast, issues := env.Compile(v.expression)
+		if issues.Err() != nil {
+			t.Fatalf("compile %q: %v", v.expression, issues.Err())
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("program %q: %v", v.expression, err)
+		}
+		out, _, err := prg.Eval(vars)
+		if err != nil {
+			t.Fatalf("evaluate %q: %v", v.expression, err)
+		}
+		if out.Value() != true {
+			failed = append(failed, v.message)
+		}
+	}
+	return failed
+}
+
+func binding(roleKind, roleName string, subjects ...map[string]any) map[string]any {
+	obj := map[string]any{"roleRef": map[string]any{"kind": roleKind, "name": roleName}}
+	if len(subjects) > 0 {
+		list := make([]any, len(subjects))
+		for i, s := range subjects {
+			list[i] = s
+		}
+		obj["subjects"] = list
+	}
+	return obj
+}
+
+func subject(kind, name, namespace string) map[string]any {
+	s := map[string]any{"kind": kind, "name": name}
+	if namespace != "" {
+		s["namespace"] = namespace
+	}
+	return s
+}
+
+func admissionTestPolicy() *authorizationv1alpha1.RBACPolicy {
+	return &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", UID: types.UID("policy-uid"), Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"*"}},
+			BindingLimits: &authorizationv1alpha1.BindingLimits{
+				ClusterRoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{
+					AllowedRoleRefs:   []string{"view", "edit", "team-*"},
+					ForbiddenRoleRefs: []string{"cluster-admin", "system:*"},
+				},
+				TargetNamespaceLimits: &authorizationv1alpha1.NamespaceLimits{
+					ForbiddenNamespaces:        []string{"kube-system"},
+					ForbiddenNamespacePrefixes: []string{"openshift-"},
+				},
+			},
+			SubjectLimits: &authorizationv1alpha1.SubjectLimits{
+				AllowedKinds:   []string{"Group", "ServiceAccount"},
+				ForbiddenKinds: []string{"User"},
+				GroupLimits:    &authorizationv1alpha1.NameMatchLimits{ForbiddenPrefixes: []string{"system:"}},
+				ServiceAccountLimits: &authorizationv1alpha1.ServiceAccountLimits{
+					ForbiddenNamespaces: []string{"kube-system"},
+				},
+			},
+			AdmissionEnforcement: &authorizationv1alpha1.AdmissionEnforcement{Enabled: true},
+		},
+	}
+}
+
+func TestBuildAdmissionValidations(t *testing.T) {
+	validations := buildAdmissionValidations(admissionTestPolicy())
+	devs := subject("Group", "devs", "")
+
+	tests := []struct {
+		name      string
+		kind      string
+		namespace string
+		object    map[string]any
+		want      []string
+	}{
+		{name: "compliant", kind: "RoleBinding", namespace: "team-a", object: binding("ClusterRole", "view", devs)},
+		{name: "no subjects", kind: "RoleBinding", namespace: "team-a", object: binding("ClusterRole", "edit")},
+		{name: "allowed wildcard", kind: "RoleBinding", namespace: "team-a", object: binding("ClusterRole", "team-reader", devs)},
+		{name: "role refs are not limited", kind: "RoleBinding", namespace: "team-a", object: binding("Role", "cluster-admin", devs)},
+		{
+			name: "forbidden cluster role", kind: "RoleBinding", namespace: "team-a",
+			object: binding("ClusterRole", "cluster-admin", devs),
+			want:   []string{"ClusterRole reference is forbidden", "ClusterRole reference is not allowed"},
+		},
+		{
+			name: "forbidden wildcard", kind: "RoleBinding", namespace: "team-a",
+			object: binding("ClusterRole", "system:auth-delegator", devs),
+			want:   []string{"ClusterRole reference is forbidden", "ClusterRole reference is not allowed"},
+		},
+		{
+			name: "cluster role binding", kind: "ClusterRoleBinding",
+			object: binding("ClusterRole", "view", devs),
+			want:   []string{"ClusterRoleBindings are not allowed"},
+		},
+		{
+			name: "forbidden namespace", kind: "RoleBinding", namespace: "kube-system",
+			object: binding("ClusterRole", "view", devs),
+			want:   []string{"the namespace is forbidden"},
+		},
+		{
+			name: "forbidden namespace prefix", kind: "RoleBinding", namespace: "openshift-monitoring",
+			object: binding("ClusterRole", "view", devs),
+			want:   []string{"the namespace matches a forbidden prefix"},
+		},
+		{
+			name: "forbidden subject kind", kind: "RoleBinding", namespace: "team-a",
+			object: binding("ClusterRole", "view", devs, subject("User", "alice", "")),
+			want:   []string{"a subject kind is forbidden", "a subject kind is not allowed"},
+		},
+		{
+			name: "forbidden group prefix", kind: "RoleBinding", namespace: "team-a",
+			object: binding("ClusterRole", "view", subject("Group", "system:masters", "")),
+			want:   []string{"a Group subject name matches a forbidden prefix"},
+		},
+		{
+			name: "forbidden service account namespace", kind: "RoleBinding", namespace: "team-a",
+			object: binding("ClusterRole", "view", subject("ServiceAccount", "default", "kube-system")),
+			want:   []string{"a ServiceAccount subject namespace is forbidden"},
+		},
+		{
+			name: "service account namespace defaults to the binding", kind: "RoleBinding", namespace: "kube-system",
+			object: binding("ClusterRole", "view", subject("ServiceAccount", "default", "")),
+			want:   []string{"the namespace is forbidden", "a ServiceAccount subject namespace is forbidden"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failed := evalAdmission(t, validations, tt.kind, tt.namespace, tt.object)
+			if len(failed) != len(tt.want) {
+				t.Fatalf("failed validations = %q, want %d matching %q", failed, len(tt.want), tt.want)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(failed[i], want) || !strings.HasSuffix(failed[i], "by RBACPolicy tenant") {
+					t.Errorf("failed[%d] = %q, want it to contain %q", i, failed[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildAdmissionValidations_OperatorOnlyLimits(t *testing.T) {
+	policy := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "selectors"},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"team-a"}},
+			BindingLimits: &authorizationv1alpha1.BindingLimits{
+				RoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{
+					AllowedRoleRefs:        []string{"reader"},
+					AllowedRoleRefSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "tenant"}},
+				},
+			},
+		},
+	}
+	if got := buildAdmissionValidations(policy); len(got) != 0 {
+		t.Errorf("allowed names combined with a selector are operator-only, got %+v", got)
+	}
+}
+
+func TestCELWildcardMatch(t *testing.T) {
+	validations := []admissionValidation{{
+		expression: celWildcardMatch("object.roleRef.name", []string{"exact", "a.b*", "*-admin"}),
+		message:    "match",
+	}}
+	for name, want := range map[string]bool{
+		"exact": true, "a.b-reader": true, "tenant-admin": true,
+		"aXb-reader": false, "exact-not": false, "admin": false,
+	} {
+		matched := len(evalAdmission(t, validations, "RoleBinding", "", binding("ClusterRole", name))) == 0
+		if matched != want {
+			t.Errorf("%q matched = %v, want %v", name, matched, want)
+		}
+	}
+	if got := celWildcardMatch("x", []string{"a", "*"}); got != "true" {
+		t.Errorf("a bare wildcard must match everything, got %q", got)
+	}
+}
+
+func TestAdmissionPolicyBindings(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+	tests := []struct {
+		name  string
+		scope authorizationv1alpha1.PolicyScope
+		want  []string
+	}{
+		{name: "all namespaces", scope: authorizationv1alpha1.PolicyScope{Namespaces: []string{"*"}}, want: []string{"vap"}},
+		{name: "explicit", scope: authorizationv1alpha1.PolicyScope{Namespaces: []string{"b", "a", "*"}}, want: []string{"vap-namespaces"}},
+		{name: "selector", scope: authorizationv1alpha1.PolicyScope{NamespaceSelector: selector}, want: []string{"vap-selector"}},
+		{
+			name:  "explicit and selector",
+			scope: authorizationv1alpha1.PolicyScope{Namespaces: []string{"a"}, NamespaceSelector: selector},
+			want:  []string{"vap-namespaces", "vap-selector"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bindings := admissionPolicyBindings("vap", tt.scope)
+			var names []string
+			for _, b := range bindings {
+				names = append(names, b.name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("bindings = %v, want %v", names, tt.want)
+			}
+		})
+	}
+
+	explicit := admissionPolicyBindings("vap", authorizationv1alpha1.PolicyScope{Namespaces: []string{"b", "a", "*", "a"}})
+	if got := explicit[0].namespaceSelector.MatchExpressions[0].Values; strings.Join(got, ",") != "a,b" {
+		t.Errorf("explicit namespaces = %v, want [a b]", got)
+	}
+}
+
+func TestAdmissionPolicyName(t *testing.T) {
+	if got := admissionPolicyName("tenant"); got != "auth-operator-rbacpolicy-tenant" {
+		t.Errorf("admissionPolicyName = %q", got)
+	}
+	long := admissionPolicyName(strings.Repeat("p", 253))
+	if len(long)+len(admissionBindingNamespacesSuffix) > 253 {
+		t.Errorf("binding names derived from %q exceed 253 characters", long)
+	}
+}
+
+func TestRBACPolicy_Reconcile_AdmissionEnforcement(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := rbacPolicyCtx(t)
+
+	policy := admissionTestPolicy()
+	policy.Spec.AdmissionEnforcement.Action = authorizationv1alpha1.AdmissionEnforcementActionWarn
+	policy.Spec.Impersonation = &authorizationv1alpha1.ImpersonationConfig{
+		Enabled:           true,
+		ServiceAccountRef: &authorizationv1alpha1.SARef{Namespace: "tenant-system", Name: "applier"},
+	}
+	r, c := newRBACPolicyTestReconciler(policy)
+	WithOperatorUsername("system:serviceaccount:auth-operator-system:auth-operator")(r)
+
+	_, err := r.Reconcile(ctx, rbacPolicyRequest("tenant"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var vap admissionregistrationv1.ValidatingAdmissionPolicy
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "auth-operator-rbacpolicy-tenant"}, &vap)).To(gomega.Succeed())
+	g.Expect(vap.Spec.Validations).To(gomega.HaveLen(len(buildAdmissionValidations(policy))))
+	g.Expect(vap.Spec.MatchConstraints.ResourceRules[0].Resources).To(gomega.ConsistOf("rolebindings", "clusterrolebindings"))
+	g.Expect(vap.Spec.MatchConditions).To(gomega.HaveLen(1))
+	g.Expect(vap.Spec.MatchConditions[0].Expression).To(gomega.ContainSubstring("system:serviceaccount:auth-operator-system:auth-operator"))
+	g.Expect(vap.Spec.MatchConditions[0].Expression).To(gomega.ContainSubstring("system:serviceaccount:tenant-system:applier"))
+	g.Expect(hasControllerOwnerRef(&vap, policy)).To(gomega.BeTrue())
+
+	var vapBinding admissionregistrationv1.ValidatingAdmissionPolicyBinding
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "auth-operator-rbacpolicy-tenant"}, &vapBinding)).To(gomega.Succeed())
+	g.Expect(vapBinding.Spec.ValidationActions).To(gomega.Equal([]admissionregistrationv1.ValidationAction{admissionregistrationv1.Warn}))
+	g.Expect(vapBinding.Spec.MatchResources).To(gomega.BeNil())
+
+	var updated authorizationv1alpha1.RBACPolicy
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tenant"}, &updated)).To(gomega.Succeed())
+	g.Expect(conditions.IsTrue(&updated, authorizationv1alpha1.AdmissionPolicyEnforcedCondition)).To(gomega.BeTrue())
+
+	// Disabling enforcement removes the generated objects and the condition.
+	updated.Spec.AdmissionEnforcement.Enabled = false
+	updated.Generation++
+	g.Expect(c.Update(ctx, &updated)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, rbacPolicyRequest("tenant"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "auth-operator-rbacpolicy-tenant"}, &vap)).NotTo(gomega.Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "auth-operator-rbacpolicy-tenant"}, &vapBinding)).NotTo(gomega.Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tenant"}, &updated)).To(gomega.Succeed())
+	g.Expect(conditions.Has(&updated, authorizationv1alpha1.AdmissionPolicyEnforcedCondition)).To(gomega.BeFalse())
+}
+
+func TestRBACPolicy_Reconcile_AdmissionEnforcementOperatorUnknown(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := rbacPolicyCtx(t)
+
+	r, c := newRBACPolicyTestReconciler(admissionTestPolicy())
+	_, err := r.Reconcile(ctx, rbacPolicyRequest("tenant"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var vap admissionregistrationv1.ValidatingAdmissionPolicy
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "auth-operator-rbacpolicy-tenant"}, &vap)).NotTo(gomega.Succeed())
+
+	var updated authorizationv1alpha1.RBACPolicy
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tenant"}, &updated)).To(gomega.Succeed())
+	g.Expect(conditions.GetReason(&updated, authorizationv1alpha1.AdmissionPolicyEnforcedCondition)).To(
+		gomega.Equal(string(authorizationv1alpha1.AdmissionPolicyEnforcedReasonOperatorIdentityUnknown)))
+	g.Expect(conditions.IsReady(&updated)).To(gomega.BeTrue())
+}
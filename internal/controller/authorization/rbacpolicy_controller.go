@@ -8,8 +8,12 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -28,6 +32,7 @@ import (
 	conditions "github.com/telekom/auth-operator/pkg/conditions"
 	"github.com/telekom/auth-operator/pkg/indexer"
 	"github.com/telekom/auth-operator/pkg/metrics"
+	pkgpolicy "github.com/telekom/auth-operator/pkg/policy"
 	"github.com/telekom/auth-operator/pkg/tracing"
 
 	"go.opentelemetry.io/otel/codes"
@@ -39,6 +44,8 @@ import (
 // +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=rbacpolicies/finalizers,verbs=update
 // +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=restrictedbinddefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=restrictedroledefinitions,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch
 
 // RBACPolicyReconciler reconciles an RBACPolicy object.
 type RBACPolicyReconciler struct {
@@ -46,6 +53,9 @@ type RBACPolicyReconciler struct {
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
 	tracer   trace.Tracer
+
+	// operatorUsername is exempted from generated ValidatingAdmissionPolicies.
+	operatorUsername string
 }
 
 const rbacPolicyListTimeout = 10 * time.Second
@@ -53,6 +63,9 @@ const rbacPolicyListTimeout = 10 * time.Second
 // setTracer implements tracerSetter.
 func (r *RBACPolicyReconciler) setTracer(t trace.Tracer) { r.tracer = t }
 
+// setOperatorUsername implements operatorUsernameSetter.
+func (r *RBACPolicyReconciler) setOperatorUsername(username string) { r.operatorUsername = username }
+
 // NewRBACPolicyReconciler creates a new RBACPolicy reconciler.
 func NewRBACPolicyReconciler(
 	cachedClient client.Client,
@@ -85,26 +98,128 @@ func (r *RBACPolicyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 			handler.EnqueueRequestsFromMapFunc(r.restrictedResourceToPolicyRequests),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		// Refresh status.catalog when ClusterRoles appear, disappear or change labels.
+		Watches(&rbacv1.ClusterRole{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterRoleToCatalogPolicyRequests),
+		).
+		// Refresh the budget usage of ancestors when a child policy is created,
+		// deleted or moved to another parent.
+		Watches(&authorizationv1alpha1.RBACPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.policyToAncestorPolicyRequests),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Refresh status.usage when namespaces start or stop matching the
+		// namespace selectors of bound RestrictedBindDefinitions.
+		Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.namespaceToBudgetPolicyRequests),
+			builder.WithPredicates(namespaceLabelOrPhaseChangePredicate()),
+		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{MaxConcurrentReconciles: concurrency}).
 		Complete(r)
 }
 
 // restrictedResourceToPolicyRequests maps a RestrictedBindDefinition or
-// RestrictedRoleDefinition event to a reconcile request for its referenced RBACPolicy.
-func (r *RBACPolicyReconciler) restrictedResourceToPolicyRequests(_ context.Context, obj client.Object) []reconcile.Request {
-	var policyName string
+// RestrictedRoleDefinition event to a reconcile request for its referenced
+// RBACPolicy. RestrictedBindDefinitions also count against the budgets of the
+// ancestors of that policy, which are enqueued as well.
+func (r *RBACPolicyReconciler) restrictedResourceToPolicyRequests(ctx context.Context, obj client.Object) []reconcile.Request {
 	switch v := obj.(type) {
 	case *authorizationv1alpha1.RestrictedBindDefinition:
-		policyName = v.Spec.PolicyRef.Name
+		if v.Spec.PolicyRef.Name == "" {
+			return nil
+		}
+		return r.policyLineageRequests(ctx, v.Spec.PolicyRef.Name)
 	case *authorizationv1alpha1.RestrictedRoleDefinition:
-		policyName = v.Spec.PolicyRef.Name
+		if v.Spec.PolicyRef.Name == "" {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: v.Spec.PolicyRef.Name}}}
 	default:
 		return nil
 	}
-	if policyName == "" {
+}
+
+// policyToAncestorPolicyRequests maps an RBACPolicy event to reconcile requests
+// for its ancestors, whose budget usage includes the policy's definitions.
+func (r *RBACPolicyReconciler) policyToAncestorPolicyRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	policy, ok := obj.(*authorizationv1alpha1.RBACPolicy)
+	if !ok || policy.Spec.ParentPolicyRef == nil || policy.Spec.ParentPolicyRef.Name == "" {
+		return nil
+	}
+	return r.policyLineageRequests(ctx, policy.Spec.ParentPolicyRef.Name)
+}
+
+// policyLineageRequests returns reconcile requests for the named policies and
+// all of their ancestors. When the policies cannot be listed, only the named
+// policies are enqueued.
+func (r *RBACPolicyReconciler) policyLineageRequests(ctx context.Context, policyNames ...string) []reconcile.Request {
+	policies := &authorizationv1alpha1.RBACPolicyList{}
+	if err := r.client.List(ctx, policies); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list RBACPolicies to resolve policy ancestors")
+	}
+	seen := make(map[string]struct{})
+	var requests []reconcile.Request
+	enqueue := func(name string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	}
+	for _, name := range policyNames {
+		enqueue(name)
+		idx := slices.IndexFunc(policies.Items, func(p authorizationv1alpha1.RBACPolicy) bool { return p.Name == name })
+		if idx < 0 {
+			continue
+		}
+		for _, ancestor := range authorizationv1alpha1.PolicyLineage(policies.Items, &policies.Items[idx]) {
+			enqueue(ancestor.Name)
+		}
+	}
+	return requests
+}
+
+// clusterRoleToCatalogPolicyRequests maps a ClusterRole event to reconcile
+// requests for every RBACPolicy that publishes a catalog of bindable ClusterRoles.
+func (r *RBACPolicyReconciler) clusterRoleToCatalogPolicyRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	policies := &authorizationv1alpha1.RBACPolicyList{}
+	if err := r.client.List(ctx, policies); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list RBACPolicies for ClusterRole event")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range policies.Items {
+		limits := policies.Items[i].Spec.BindingLimits
+		if limits == nil || limits.ClusterRoleBindingLimits == nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: policies.Items[i].Name}})
+	}
+	return requests
+}
+
+// namespaceToBudgetPolicyRequests maps a Namespace event to reconcile requests
+// for every RBACPolicy referenced by a RestrictedBindDefinition with namespace
+// selectors, and for its ancestors, whose budget usage depends on the
+// matching namespaces.
+func (r *RBACPolicyReconciler) namespaceToBudgetPolicyRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	rbds := &authorizationv1alpha1.RestrictedBindDefinitionList{}
+	if err := r.client.List(ctx, rbds); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list RestrictedBindDefinitions for Namespace event")
+		return nil
+	}
+	var policyNames []string
+	for i := range rbds.Items {
+		policyName := rbds.Items[i].Spec.PolicyRef.Name
+		if policyName == "" || !rbds.Items[i].UsesNamespaceSelectors() || slices.Contains(policyNames, policyName) {
+			continue
+		}
+		policyNames = append(policyNames, policyName)
+	}
+	if len(policyNames) == 0 {
 		return nil
 	}
-	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: policyName}}}
+	return r.policyLineageRequests(ctx, policyNames...)
 }
 
 // Reconcile handles the reconciliation loop for RBACPolicy resources.
@@ -133,7 +248,7 @@ func (r *RBACPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerRBACPolicy).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerRBACPolicy, duration)
 		logger.V(1).Info("=== Reconcile END ===", "rbacPolicy", req.Name, "duration", duration.String())
 	}()
 
@@ -203,7 +318,36 @@ func (r *RBACPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		"restrictedRoleDefinitions", len(rrdList.Items),
 		"totalBound", boundCount)
 
-	// Step 6: Mark Ready and apply status.
+	// Step 6: Compute budget usage. Like BoundResourceCount, usage is eventually
+	// consistent and is refreshed whenever a bound definition, a definition of a
+	// descendant policy or a namespace matched by their selectors changes.
+	if err := r.updateBudgetUsage(ctx, policy); err != nil {
+		logger.Error(err, "failed to compute budget usage", "rbacPolicy", policy.Name)
+		r.markStalled(ctx, policy, err)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, fmt.Errorf("compute budget usage for policy %s: %w", policy.Name, err)
+	}
+
+	// Step 7: Publish the catalog of ClusterRoles tenants may bind.
+	if err := r.updateCatalog(ctx, policy); err != nil {
+		logger.Error(err, "failed to compute role catalog", "rbacPolicy", policy.Name)
+		r.markStalled(ctx, policy, err)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, fmt.Errorf("compute role catalog for policy %s: %w", policy.Name, err)
+	}
+
+	// Step 8: Generate the ValidatingAdmissionPolicy for spec.admissionEnforcement.
+	if err := r.reconcileAdmissionEnforcement(ctx, policy); err != nil {
+		logger.Error(err, "failed to reconcile admission enforcement", "rbacPolicy", policy.Name)
+		r.markStalled(ctx, policy, err)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRBACPolicy, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, fmt.Errorf("reconcile admission enforcement for policy %s: %w", policy.Name, err)
+	}
+
+	// Step 9: Mark Ready and apply status.
 	conditions.MarkReady(policy, policy.Generation,
 		authorizationv1alpha1.ReadyReasonReconciled, authorizationv1alpha1.ReadyMessageReconciled)
 
@@ -219,6 +363,120 @@ func (r *RBACPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
+// updateBudgetUsage sets status.usage from the RestrictedBindDefinitions bound
+// to the policy or to any of its descendant policies, and reports the
+// WithinBudget condition when the policy declares budgets.
+func (r *RBACPolicyReconciler) updateBudgetUsage(ctx context.Context, policy *authorizationv1alpha1.RBACPolicy) error {
+	policies := &authorizationv1alpha1.RBACPolicyList{}
+	listCtx, cancel := context.WithTimeout(ctx, rbacPolicyListTimeout)
+	defer cancel()
+	if err := r.client.List(listCtx, policies); err != nil {
+		return fmt.Errorf("list RBACPolicies: %w", err)
+	}
+	rbds, err := listBudgetRestrictedBindDefinitions(listCtx, r.client, policies.Items, policy.Name)
+	if err != nil {
+		return err
+	}
+
+	var namespaces []corev1.Namespace
+	if restrictedBindDefinitionsUseNamespaceSelectors(rbds) {
+		nsList := &corev1.NamespaceList{}
+		if err := r.client.List(listCtx, nsList); err != nil {
+			return fmt.Errorf("list namespaces: %w", err)
+		}
+		namespaces = nsList.Items
+	}
+
+	usage, err := authorizationv1alpha1.PolicyBudgetUsageFor(rbds, namespaces)
+	if err != nil {
+		return err
+	}
+	policy.Status.Usage = &usage
+
+	if policy.Spec.Budgets == nil {
+		conditions.Delete(policy, authorizationv1alpha1.WithinBudgetCondition)
+		return nil
+	}
+	if exceeded := exceededBudgets(policy.Spec.Budgets, usage); len(exceeded) > 0 {
+		log.FromContext(ctx).V(1).Info("RBACPolicy budgets exceeded", "rbacPolicy", policy.Name, "budgets", exceeded)
+		conditions.MarkFalse(policy, authorizationv1alpha1.WithinBudgetCondition, policy.Generation,
+			authorizationv1alpha1.WithinBudgetReasonExceeded, authorizationv1alpha1.WithinBudgetMessageExceeded,
+			strings.Join(exceeded, "; "))
+		return nil
+	}
+	conditions.MarkTrue(policy, authorizationv1alpha1.WithinBudgetCondition, policy.Generation,
+		authorizationv1alpha1.WithinBudgetReasonWithinLimits, authorizationv1alpha1.WithinBudgetMessageWithinLimits)
+	return nil
+}
+
+// updateCatalog sets status.catalog to the ClusterRoles that satisfy the
+// policy's clusterRoleBindingLimits.
+func (r *RBACPolicyReconciler) updateCatalog(ctx context.Context, policy *authorizationv1alpha1.RBACPolicy) error {
+	limits := policy.Spec.BindingLimits
+	if limits == nil || limits.ClusterRoleBindingLimits == nil {
+		policy.Status.Catalog = nil
+		policy.Status.CatalogOmitted = 0
+		return nil
+	}
+
+	clusterRoles := &rbacv1.ClusterRoleList{}
+	listCtx, cancel := context.WithTimeout(ctx, rbacPolicyListTimeout)
+	defer cancel()
+	if err := r.client.List(listCtx, clusterRoles); err != nil {
+		return fmt.Errorf("list ClusterRoles: %w", err)
+	}
+	policy.Status.Catalog, policy.Status.CatalogOmitted = pkgpolicy.RoleCatalog(policy, clusterRoles.Items)
+	log.FromContext(ctx).V(2).Info("role catalog updated",
+		"rbacPolicy", policy.Name,
+		"offerings", len(policy.Status.Catalog),
+		"omitted", policy.Status.CatalogOmitted)
+	return nil
+}
+
+// exceededBudgets describes every budget that usage exceeds.
+func exceededBudgets(budgets *authorizationv1alpha1.PolicyBudgets, usage authorizationv1alpha1.PolicyBudgetUsage) []string {
+	var exceeded []string
+	check := func(name string, limit *int32, used int32) {
+		if limit != nil && used > *limit {
+			exceeded = append(exceeded, fmt.Sprintf("%s %d/%d", name, used, *limit))
+		}
+	}
+	check("restrictedBindDefinitions", budgets.MaxRestrictedBindDefinitions, usage.RestrictedBindDefinitions)
+	check("roleBindings", budgets.MaxRoleBindings, usage.RoleBindings)
+	check("serviceAccounts", budgets.MaxServiceAccounts, usage.ServiceAccounts)
+	return exceeded
+}
+
+// listBudgetRestrictedBindDefinitions lists the RestrictedBindDefinitions that
+// count against the budgets of policyName: those referencing it or any policy
+// that descends from it through spec.parentPolicyRef.
+func listBudgetRestrictedBindDefinitions(
+	ctx context.Context,
+	c client.Reader,
+	policies []authorizationv1alpha1.RBACPolicy,
+	policyName string,
+) ([]authorizationv1alpha1.RestrictedBindDefinition, error) {
+	var rbds []authorizationv1alpha1.RestrictedBindDefinition
+	for _, name := range authorizationv1alpha1.PolicySubtree(policies, policyName) {
+		list := &authorizationv1alpha1.RestrictedBindDefinitionList{}
+		if err := c.List(ctx, list,
+			client.MatchingFields{indexer.RestrictedBindDefinitionPolicyRefField: name}); err != nil {
+			return nil, fmt.Errorf("list RestrictedBindDefinitions for budgets of policy %s: %w", name, err)
+		}
+		rbds = append(rbds, list.Items...)
+	}
+	return rbds, nil
+}
+
+func restrictedBindDefinitionsUseNamespaceSelectors(rbds []authorizationv1alpha1.RestrictedBindDefinition) bool {
+	for i := range rbds {
+		if rbds[i].UsesNamespaceSelectors() {
+			return true
+		}
+	}
+	return false
+}
+
 // markStalled marks the RBACPolicy as stalled.
 func (r *RBACPolicyReconciler) markStalled(ctx context.Context, policy *authorizationv1alpha1.RBACPolicy, err error) {
 	logger := log.FromContext(ctx)
@@ -12,10 +12,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/onsi/gomega"
 	"go.opentelemetry.io/otel/trace/noop"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -154,6 +156,106 @@ func TestRBACPolicy_Reconcile_WithBoundResources(t *testing.T) {
 	g.Expect(conditions.IsReady(&updated)).To(gomega.BeTrue())
 }
 
+func TestRBACPolicy_Reconcile_BudgetUsage(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	pol := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-policy", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"*"}},
+			Budgets: &authorizationv1alpha1.PolicyBudgets{
+				MaxRestrictedBindDefinitions: ptr.To[int32](5),
+				MaxRoleBindings:              ptr.To[int32](2),
+			},
+		},
+	}
+	saSubject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "team-a"}
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a-bind"},
+		Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+			PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "team-policy"},
+			TargetName: "team-a",
+			Subjects:   []rbacv1.Subject{saSubject},
+			RoleBindings: []authorizationv1alpha1.NamespaceBinding{{
+				ClusterRoleRefs:   []string{"view", "edit"},
+				NamespaceSelector: []metav1.LabelSelector{{MatchLabels: map[string]string{"team": "a"}}},
+			}},
+		},
+	}
+	other := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a-ci"},
+		Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+			PolicyRef:    authorizationv1alpha1.RBACPolicyReference{Name: "team-policy"},
+			TargetName:   "team-a-ci",
+			Subjects:     []rbacv1.Subject{saSubject},
+			RoleBindings: []authorizationv1alpha1.NamespaceBinding{{Namespace: "team-a-ci", RoleRefs: []string{"ci"}}},
+		},
+	}
+	nsA := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}}
+	nsB := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}}
+
+	r, c := newRBACPolicyTestReconciler(pol, rbd, other, nsA, nsB)
+	_, err := r.Reconcile(rbacPolicyCtx(t), rbacPolicyRequest("team-policy"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var updated authorizationv1alpha1.RBACPolicy
+	g.Expect(c.Get(rbacPolicyCtx(t), types.NamespacedName{Name: "team-policy"}, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.Usage).To(gomega.Equal(&authorizationv1alpha1.PolicyBudgetUsage{
+		RestrictedBindDefinitions: 2,
+		RoleBindings:              3,
+		ServiceAccounts:           1,
+	}))
+	budgetCond := conditions.Get(&updated, authorizationv1alpha1.WithinBudgetCondition)
+	g.Expect(budgetCond).NotTo(gomega.BeNil())
+	g.Expect(budgetCond.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(budgetCond.Reason).To(gomega.Equal(string(authorizationv1alpha1.WithinBudgetReasonExceeded)))
+	g.Expect(budgetCond.Message).To(gomega.ContainSubstring("roleBindings 3/2"))
+	// Exceeding a budget is reported but does not make the policy itself unready.
+	g.Expect(conditions.IsReady(&updated)).To(gomega.BeTrue())
+}
+
+func TestRBACPolicy_Reconcile_Catalog(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	pol := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-policy", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"*"}},
+			BindingLimits: &authorizationv1alpha1.BindingLimits{
+				ClusterRoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{
+					AllowedRoleRefs: []string{"tenant-*"},
+				},
+			},
+		},
+	}
+	viewer := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tenant-viewer",
+			Annotations: map[string]string{authorizationv1alpha1.AnnotationKeyDescription: "Read-only access"},
+		},
+		Rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+	}
+	admin := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}}
+
+	r, c := newRBACPolicyTestReconciler(pol, viewer, admin)
+	_, err := r.Reconcile(rbacPolicyCtx(t), rbacPolicyRequest("team-policy"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var updated authorizationv1alpha1.RBACPolicy
+	g.Expect(c.Get(rbacPolicyCtx(t), types.NamespacedName{Name: "team-policy"}, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.Catalog).To(gomega.Equal([]authorizationv1alpha1.RoleOffering{
+		{Name: "tenant-viewer", Description: "Read-only access", RuleCount: 1},
+	}))
+	g.Expect(updated.Status.CatalogOmitted).To(gomega.BeZero())
+
+	// ClusterRole events only enqueue policies that publish a catalog.
+	unrelated := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "no-bindings"}}
+	g.Expect(c.Create(rbacPolicyCtx(t), unrelated)).To(gomega.Succeed())
+	requests := r.clusterRoleToCatalogPolicyRequests(rbacPolicyCtx(t), viewer)
+	g.Expect(requests).To(gomega.HaveLen(1))
+	g.Expect(requests[0].Name).To(gomega.Equal("team-policy"))
+}
+
 func TestRBACPolicy_Reconcile_ObservesGeneration(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -309,6 +411,113 @@ func TestRBACPolicy_RestrictedResourceToPolicyRequests(t *testing.T) {
 	g.Expect(requests).To(gomega.BeNil())
 }
 
+func TestRBACPolicy_NamespaceToBudgetPolicyRequests(t *testing.T) {
+	g := gomega.NewWithT(t)
+	selectorBinding := []authorizationv1alpha1.NamespaceBinding{{
+		NamespaceSelector: []metav1.LabelSelector{{MatchLabels: map[string]string{"team": "a"}}},
+		ClusterRoleRefs:   []string{"view"},
+	}}
+	r, _ := newRBACPolicyTestReconciler(
+		&authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "selector-1"},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef:    authorizationv1alpha1.RBACPolicyReference{Name: "selector-policy"},
+				RoleBindings: selectorBinding,
+			},
+		},
+		&authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "selector-2"},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef:    authorizationv1alpha1.RBACPolicyReference{Name: "selector-policy"},
+				RoleBindings: selectorBinding,
+			},
+		},
+		&authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "static"},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef: authorizationv1alpha1.RBACPolicyReference{Name: "static-policy"},
+				RoleBindings: []authorizationv1alpha1.NamespaceBinding{{
+					Namespace: "team-a", ClusterRoleRefs: []string{"view"},
+				}},
+			},
+		},
+	)
+
+	requests := r.namespaceToBudgetPolicyRequests(rbacPolicyCtx(t), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}})
+	g.Expect(requests).To(gomega.ConsistOf(rbacPolicyRequest("selector-policy")))
+}
+
+func TestRBACPolicy_Reconcile_BudgetUsageIncludesDescendantPolicies(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	parent := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"*"}},
+			Budgets:   &authorizationv1alpha1.PolicyBudgets{MaxRestrictedBindDefinitions: ptr.To[int32](1)},
+		},
+	}
+	child := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo:       authorizationv1alpha1.PolicyScope{Namespaces: []string{"team-a"}},
+			ParentPolicyRef: &authorizationv1alpha1.RBACPolicyReference{Name: "tenant-a"},
+			Budgets:         &authorizationv1alpha1.PolicyBudgets{MaxRestrictedBindDefinitions: ptr.To[int32](1)},
+		},
+	}
+	rbdFor := func(name, policyName string) *authorizationv1alpha1.RestrictedBindDefinition {
+		return &authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef:    authorizationv1alpha1.RBACPolicyReference{Name: policyName},
+				TargetName:   name,
+				RoleBindings: []authorizationv1alpha1.NamespaceBinding{{Namespace: "team-a", ClusterRoleRefs: []string{"view"}}},
+			},
+		}
+	}
+
+	r, c := newRBACPolicyTestReconciler(parent, child, rbdFor("parent-bind", "tenant-a"), rbdFor("dev-bind", "tenant-a-dev"))
+	for _, name := range []string{"tenant-a", "tenant-a-dev"} {
+		_, err := r.Reconcile(rbacPolicyCtx(t), rbacPolicyRequest(name))
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	var updatedParent, updatedChild authorizationv1alpha1.RBACPolicy
+	g.Expect(c.Get(rbacPolicyCtx(t), types.NamespacedName{Name: "tenant-a"}, &updatedParent)).To(gomega.Succeed())
+	g.Expect(updatedParent.Status.BoundResourceCount).To(gomega.Equal(int32(1)))
+	g.Expect(updatedParent.Status.Usage.RestrictedBindDefinitions).To(gomega.Equal(int32(2)))
+	g.Expect(conditions.Get(&updatedParent, authorizationv1alpha1.WithinBudgetCondition).Status).To(gomega.Equal(metav1.ConditionFalse))
+
+	g.Expect(c.Get(rbacPolicyCtx(t), types.NamespacedName{Name: "tenant-a-dev"}, &updatedChild)).To(gomega.Succeed())
+	g.Expect(updatedChild.Status.Usage.RestrictedBindDefinitions).To(gomega.Equal(int32(1)))
+	g.Expect(conditions.Get(&updatedChild, authorizationv1alpha1.WithinBudgetCondition).Status).To(gomega.Equal(metav1.ConditionTrue))
+}
+
+func TestRBACPolicy_BudgetRequestsIncludeAncestors(t *testing.T) {
+	g := gomega.NewWithT(t)
+	policyFor := func(name, parent string) *authorizationv1alpha1.RBACPolicy {
+		p := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if parent != "" {
+			p.Spec.ParentPolicyRef = &authorizationv1alpha1.RBACPolicyReference{Name: parent}
+		}
+		return p
+	}
+	child := policyFor("tenant-a-dev", "tenant-a")
+	r, _ := newRBACPolicyTestReconciler(policyFor("platform", ""), policyFor("tenant-a", "platform"), child)
+
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+			PolicyRef: authorizationv1alpha1.RBACPolicyReference{Name: "tenant-a-dev"},
+		},
+	}
+	g.Expect(r.restrictedResourceToPolicyRequests(rbacPolicyCtx(t), rbd)).To(gomega.ConsistOf(
+		rbacPolicyRequest("tenant-a-dev"), rbacPolicyRequest("tenant-a"), rbacPolicyRequest("platform")))
+
+	g.Expect(r.policyToAncestorPolicyRequests(rbacPolicyCtx(t), child)).To(gomega.ConsistOf(
+		rbacPolicyRequest("tenant-a"), rbacPolicyRequest("platform")))
+	g.Expect(r.policyToAncestorPolicyRequests(rbacPolicyCtx(t), policyFor("platform", ""))).To(gomega.BeEmpty())
+}
+
 func TestRBACPolicy_MarkStalled(t *testing.T) {
 	g := gomega.NewWithT(t)
 
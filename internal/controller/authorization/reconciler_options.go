@@ -5,7 +5,11 @@
 package authorization
 
 import (
+	"time"
+
 	"go.opentelemetry.io/otel/trace"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 )
 
 // tracerSetter is implemented by reconcilers that support OpenTelemetry tracing.
@@ -19,6 +23,36 @@ type capabilityDetectorSetter interface {
 	setCapabilityDetector(capabilityDetector)
 }
 
+// namespaceTerminationGracePeriodSetter is implemented by reconcilers that own a
+// RoleBindingTerminator (currently BindDefinition).
+type namespaceTerminationGracePeriodSetter interface {
+	setNamespaceTerminationGracePeriod(time.Duration)
+}
+
+// namespaceTerminationPolicySetter is implemented by reconcilers that own a
+// RoleBindingTerminator (currently BindDefinition).
+type namespaceTerminationPolicySetter interface {
+	setNamespaceTerminationPolicy(*authorizationv1alpha1.NamespaceTerminationPolicy)
+}
+
+// operatorUsernameSetter is implemented by reconcilers that need the username
+// the operator authenticates as (currently RBACPolicy).
+type operatorUsernameSetter interface {
+	setOperatorUsername(string)
+}
+
+// discoveryHealthSetter is implemented by reconcilers that publish the API
+// discovery health (currently OperatorCapabilities).
+type discoveryHealthSetter interface {
+	setDiscoveryHealth(discoveryHealthSource)
+}
+
+// impersonationGrantsSetter is implemented by reconcilers that generate
+// RoleDefinition impersonation grants (currently RoleDefinition).
+type impersonationGrantsSetter interface {
+	setImpersonationGrantsEnabled(bool)
+}
+
 // ReconcilerOption is a type-safe functional option for configuring reconcilers.
 type ReconcilerOption func(tracerSetter)
 
@@ -51,3 +85,75 @@ func WithCapabilityDetector(d capabilityDetector) ReconcilerOption {
 		setter.setCapabilityDetector(d)
 	}
 }
+
+// WithNamespaceTerminationGracePeriod returns a ReconcilerOption that sets how
+// long a terminating namespace may be blocked by remaining resources before the
+// RoleBinding terminator emits a warning event and counts it as stuck. A zero
+// duration disables the report. Reconcilers without a terminator ignore it.
+func WithNamespaceTerminationGracePeriod(d time.Duration) ReconcilerOption {
+	return func(r tracerSetter) {
+		setter, ok := r.(namespaceTerminationGracePeriodSetter)
+		if !ok {
+			return
+		}
+		setter.setNamespaceTerminationGracePeriod(d)
+	}
+}
+
+// WithNamespaceTerminationPolicy returns a ReconcilerOption that sets the
+// controller-wide finalizer-release policy for RoleBindings in terminating
+// namespaces. BindDefinitions with their own spec.namespaceTermination override
+// it. A nil policy keeps the WaitForAll default. Reconcilers without a
+// terminator ignore it.
+func WithNamespaceTerminationPolicy(p *authorizationv1alpha1.NamespaceTerminationPolicy) ReconcilerOption {
+	return func(r tracerSetter) {
+		setter, ok := r.(namespaceTerminationPolicySetter)
+		if !ok || p == nil {
+			return
+		}
+		setter.setNamespaceTerminationPolicy(p)
+	}
+}
+
+// WithOperatorUsername returns a ReconcilerOption that sets the username the
+// operator authenticates as. The RBACPolicy reconciler exempts it from the
+// ValidatingAdmissionPolicies generated for spec.admissionEnforcement; without
+// it no policy is generated. Reconcilers that do not need it ignore the option.
+func WithOperatorUsername(username string) ReconcilerOption {
+	return func(r tracerSetter) {
+		setter, ok := r.(operatorUsernameSetter)
+		if !ok || username == "" {
+			return
+		}
+		setter.setOperatorUsername(username)
+	}
+}
+
+// WithDiscoveryHealth returns a ReconcilerOption that wires the API discovery
+// health of the ResourceTracker into reconcilers that publish it. The
+// OperatorCapabilities reconciler lists the failing GroupVersions in its
+// status. Reconcilers that do not publish it ignore the option.
+func WithDiscoveryHealth(src discoveryHealthSource) ReconcilerOption {
+	return func(r tracerSetter) {
+		setter, ok := r.(discoveryHealthSetter)
+		if !ok || src == nil {
+			return
+		}
+		setter.setDiscoveryHealth(src)
+	}
+}
+
+// WithImpersonationGrants returns a ReconcilerOption that opts in to
+// RoleDefinition spec.impersonationGrants. The grants bind arbitrary subjects,
+// so anyone allowed to write RoleDefinitions can grant impersonation; without
+// the option RoleDefinitions listing grants are reported as Stalled and any
+// previously generated grant RBAC is removed. Other reconcilers ignore it.
+func WithImpersonationGrants(enabled bool) ReconcilerOption {
+	return func(r tracerSetter) {
+		setter, ok := r.(impersonationGrantsSetter)
+		if !ok {
+			return
+		}
+		setter.setImpersonationGrantsEnabled(enabled)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -55,6 +56,15 @@ type ViolationHandlerConfig struct {
 	SetReconciled func(bool)
 	// ApplyStatus applies the current status via SSA.
 	ApplyStatus func(context.Context) error
+	// OnViolation is the violation response of the governing RBACPolicy.
+	// Nil means Revoke.
+	OnViolation *authorizationv1alpha1.ViolationPolicy
+	// ViolationSince returns when the current run of violations was first
+	// detected, or nil. Optional; without it a RevokeAfter grace period restarts
+	// on every reconcile.
+	ViolationSince func() *metav1.Time
+	// SetViolationSince records when the current run of violations was first detected.
+	SetViolationSince func(*metav1.Time)
 }
 
 // maxViolationsInMessage is the maximum number of violation strings included in
@@ -85,7 +95,9 @@ func stalledErrorDetail(err error) string {
 
 // handlePolicyViolations processes detected policy violations for a restricted resource.
 // It marks the resource as non-compliant via conditions and events, deprovisions
-// RBAC resources, and returns a requeue result. The caller is responsible for
+// RBAC resources once the RBACPolicy onViolation action allows it, and returns a
+// requeue result. Until then the last compliant RBAC is kept and no new changes
+// are applied. The caller is responsible for
 // persisting violations into status.policyViolations before calling this helper.
 // Returns (result, nil) on success, or (result, error) if deprovisioning fails.
 func handlePolicyViolations(
@@ -122,6 +134,17 @@ func handlePolicyViolations(
 		authorizationv1alpha1.EventReasonPolicyViolation, authorizationv1alpha1.EventActionReconcile,
 		"Policy violations detected: %s", strings.Join(msgStrings, "; "))
 
+	now := time.Now()
+	since, firstDetected := violationSince(cfg, now)
+	delay, revokes := cfg.OnViolation.RevocationDelay()
+	if !revokes {
+		return keepFrozenRBAC(ctx, obj, generation, recorder, runtimeObj, cfg, firstDetected)
+	}
+	if deadline := since.Add(delay); now.Before(deadline) {
+		return keepRBACUntilRevocation(ctx, obj, generation, recorder, runtimeObj, cfg, deadline, deadline.Sub(now))
+	}
+	conditions.Delete(obj, authorizationv1alpha1.RevocationPendingCondition)
+
 	// Deprovision: delete all owned RBAC resources.
 	if err := cfg.Deprovision(ctx); err != nil {
 		cfg.MarkStalled(ctx, err)
@@ -143,6 +166,108 @@ func handlePolicyViolations(
 		metrics.ReconcileErrors.WithLabelValues(cfg.ControllerLabel, metrics.ErrorTypeAPI).Inc()
 		return ctrl.Result{}, fmt.Errorf("apply status after deprovisioning %s %s: %w", cfg.ResourceKind, runtimeObj.GetName(), err)
 	}
+	metrics.ObserveApplyLatency(ctx, cfg.ControllerLabel, runtimeObj.GetName(), generation, metrics.ApplyOutcomePolicyViolation)
+	metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultDegraded).Inc()
+	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+}
+
+// violationSince returns when the current run of violations was first detected,
+// recording now as the start when no earlier detection is known. The boolean
+// reports whether the run starts with this detection.
+func violationSince(cfg ViolationHandlerConfig, now time.Time) (time.Time, bool) {
+	if cfg.ViolationSince != nil {
+		if since := cfg.ViolationSince(); since != nil {
+			return since.Time, false
+		}
+	}
+	// metav1.Time serializes with second precision; truncate so the deadline
+	// computed on later reconciles matches the one reported now.
+	since := metav1.NewTime(now.UTC().Truncate(time.Second))
+	if cfg.SetViolationSince != nil {
+		cfg.SetViolationSince(&since)
+	}
+	return since.Time, true
+}
+
+// keepRBACUntilRevocation keeps the last compliant RBAC during an onViolation
+// RevokeAfter grace period and requeues no later than the revocation deadline.
+func keepRBACUntilRevocation(
+	ctx context.Context,
+	obj conditions.Setter,
+	generation int64,
+	recorder events.EventRecorder,
+	runtimeObj client.Object,
+	cfg ViolationHandlerConfig,
+	deadline time.Time,
+	remaining time.Duration,
+) (ctrl.Result, error) {
+	deadlineStr := deadline.UTC().Format(time.RFC3339)
+	log.FromContext(ctx).Info("keeping last compliant RBAC until revocation deadline",
+		"name", runtimeObj.GetName(), "deadline", deadlineStr)
+
+	// The grace period requeues at least every DefaultRequeueInterval; announce
+	// the pending revocation only when the condition becomes True.
+	alreadyPending := conditions.IsTrue(obj, authorizationv1alpha1.RevocationPendingCondition)
+	conditions.MarkTrue(obj, authorizationv1alpha1.RevocationPendingCondition, generation,
+		authorizationv1alpha1.RevocationPendingReasonGracePeriod, authorizationv1alpha1.RevocationPendingMessageGracePeriod, deadlineStr)
+	conditions.MarkFalse(obj, conditions.ReadyConditionType, generation,
+		authorizationv1alpha1.ViolationGracePeriodReason, "policy violations detected; last compliant RBAC is kept until %s", deadlineStr)
+	conditions.Delete(obj, conditions.ReconcilingConditionType)
+
+	if !alreadyPending {
+		recorder.Eventf(runtimeObj, nil, corev1.EventTypeWarning,
+			authorizationv1alpha1.EventReasonRevocationPending, authorizationv1alpha1.EventActionReconcile,
+			"Managed RBAC will be revoked in %s (at %s) unless the policy violations are resolved",
+			remaining.Round(time.Second), deadlineStr)
+	}
+
+	if err := cfg.ApplyStatus(ctx); err != nil {
+		metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(cfg.ControllerLabel, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, fmt.Errorf("apply status during violation grace period for %s %s: %w", cfg.ResourceKind, runtimeObj.GetName(), err)
+	}
+	metrics.ObserveApplyLatency(ctx, cfg.ControllerLabel, runtimeObj.GetName(), generation, metrics.ApplyOutcomePolicyViolation)
+	metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultDegraded).Inc()
+	return ctrl.Result{RequeueAfter: min(remaining, DefaultRequeueInterval)}, nil
+}
+
+// keepFrozenRBAC keeps the last compliant RBAC under an onViolation Freeze
+// action. Nothing is applied or deleted until the resource complies again.
+// firstDetected reports whether the current run of violations started with
+// this reconcile.
+func keepFrozenRBAC(
+	ctx context.Context,
+	obj conditions.Setter,
+	generation int64,
+	recorder events.EventRecorder,
+	runtimeObj client.Object,
+	cfg ViolationHandlerConfig,
+	firstDetected bool,
+) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("keeping last compliant RBAC frozen", "name", runtimeObj.GetName())
+
+	// Freeze takes effect when the violations are first detected, or when the
+	// action changes from a pending revocation. Ready is reset on every
+	// reconcile, so the violation run is what marks the transition; requeues
+	// while frozen do not repeat the Frozen event.
+	becameFrozen := firstDetected || conditions.Has(obj, authorizationv1alpha1.RevocationPendingCondition)
+	conditions.Delete(obj, authorizationv1alpha1.RevocationPendingCondition)
+	conditions.MarkFalse(obj, conditions.ReadyConditionType, generation,
+		authorizationv1alpha1.FrozenReason, "policy violations detected; last compliant RBAC is kept and new changes are withheld")
+	conditions.Delete(obj, conditions.ReconcilingConditionType)
+
+	if becameFrozen {
+		recorder.Eventf(runtimeObj, nil, corev1.EventTypeWarning,
+			authorizationv1alpha1.EventReasonFrozen, authorizationv1alpha1.EventActionReconcile,
+			"Managed RBAC is frozen at the last compliant state until the policy violations are resolved")
+	}
+
+	if err := cfg.ApplyStatus(ctx); err != nil {
+		metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(cfg.ControllerLabel, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, fmt.Errorf("apply status while frozen for %s %s: %w", cfg.ResourceKind, runtimeObj.GetName(), err)
+	}
+	metrics.ObserveApplyLatency(ctx, cfg.ControllerLabel, runtimeObj.GetName(), generation, metrics.ApplyOutcomePolicyViolation)
 	metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultDegraded).Inc()
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/onsi/gomega"
@@ -138,6 +139,175 @@ func TestHandlePolicyViolations_DeprovisionError(t *testing.T) {
 	g.Expect(stalledCalled).To(gomega.BeTrue())
 }
 
+func TestHandlePolicyViolations_RevokeAfterWithinGracePeriod(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "grace-rbd", Generation: 2},
+	}
+	violations := []policy.Violation{{Field: "spec.subjects", Message: "too many subjects"}}
+
+	deprovisionCalled := false
+	recorder := events.NewFakeRecorder(10)
+	cfg := ViolationHandlerConfig{
+		ControllerLabel:   metrics.ControllerRestrictedBindDefinition,
+		ResourceKind:      "RestrictedBindDefinition",
+		Deprovision:       func(ctx context.Context) error { deprovisionCalled = true; return nil },
+		MarkStalled:       func(ctx context.Context, err error) {},
+		SetReconciled:     func(v bool) {},
+		ApplyStatus:       func(ctx context.Context) error { return nil },
+		OnViolation:       &authorizationv1alpha1.ViolationPolicy{Action: authorizationv1alpha1.ViolationActionRevokeAfter, GracePeriod: &metav1.Duration{Duration: 2 * time.Minute}},
+		ViolationSince:    func() *metav1.Time { return rbd.Status.PolicyViolationSince },
+		SetViolationSince: func(t *metav1.Time) { rbd.Status.PolicyViolationSince = t },
+	}
+	result, err := handlePolicyViolations(helperCtx(), rbd, rbd.Generation, violations,
+		recorder, rbd, cfg)
+
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deprovisionCalled).To(gomega.BeFalse())
+	g.Expect(rbd.Status.PolicyViolationSince).NotTo(gomega.BeNil())
+	g.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+	g.Expect(result.RequeueAfter).To(gomega.BeNumerically("<=", 2*time.Minute))
+
+	pending := conditions.Get(rbd, authorizationv1alpha1.RevocationPendingCondition)
+	g.Expect(pending).NotTo(gomega.BeNil())
+	g.Expect(pending.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(pending.Reason).To(gomega.Equal(string(authorizationv1alpha1.RevocationPendingReasonGracePeriod)))
+	g.Expect(conditions.GetReason(rbd, conditions.ReadyConditionType)).To(gomega.Equal(string(authorizationv1alpha1.ViolationGracePeriodReason)))
+	g.Expect(recorder.Events).To(gomega.HaveLen(2))
+	<-recorder.Events
+	g.Expect(<-recorder.Events).To(gomega.ContainSubstring(authorizationv1alpha1.EventReasonRevocationPending))
+
+	// A requeue within the grace period keeps the condition without repeating
+	// the RevocationPending event.
+	_, err = handlePolicyViolations(helperCtx(), rbd, rbd.Generation, violations,
+		recorder, rbd, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(conditions.IsTrue(rbd, authorizationv1alpha1.RevocationPendingCondition)).To(gomega.BeTrue())
+	g.Expect(recorder.Events).To(gomega.HaveLen(1))
+	g.Expect(<-recorder.Events).NotTo(gomega.ContainSubstring(authorizationv1alpha1.EventReasonRevocationPending))
+}
+
+func TestHandlePolicyViolations_RevokeAfterGracePeriodExpired(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "expired-rbd", Generation: 2},
+		Status:     authorizationv1alpha1.RestrictedBindDefinitionStatus{PolicyViolationSince: &since},
+	}
+	conditions.MarkTrue(rbd, authorizationv1alpha1.RevocationPendingCondition, rbd.Generation,
+		authorizationv1alpha1.RevocationPendingReasonGracePeriod, authorizationv1alpha1.RevocationPendingMessageGracePeriod, "earlier")
+	violations := []policy.Violation{{Field: "spec.subjects", Message: "too many subjects"}}
+
+	deprovisionCalled := false
+	result, err := handlePolicyViolations(helperCtx(), rbd, rbd.Generation, violations,
+		events.NewFakeRecorder(10), rbd, ViolationHandlerConfig{
+			ControllerLabel:   metrics.ControllerRestrictedBindDefinition,
+			ResourceKind:      "RestrictedBindDefinition",
+			Deprovision:       func(ctx context.Context) error { deprovisionCalled = true; return nil },
+			MarkStalled:       func(ctx context.Context, err error) {},
+			SetReconciled:     func(v bool) {},
+			ApplyStatus:       func(ctx context.Context) error { return nil },
+			OnViolation:       &authorizationv1alpha1.ViolationPolicy{Action: authorizationv1alpha1.ViolationActionRevokeAfter, GracePeriod: &metav1.Duration{Duration: 30 * time.Minute}},
+			ViolationSince:    func() *metav1.Time { return rbd.Status.PolicyViolationSince },
+			SetViolationSince: func(t *metav1.Time) { rbd.Status.PolicyViolationSince = t },
+		})
+
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deprovisionCalled).To(gomega.BeTrue())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(DefaultRequeueInterval))
+	g.Expect(rbd.Status.PolicyViolationSince.Equal(&since)).To(gomega.BeTrue())
+	g.Expect(conditions.Has(rbd, authorizationv1alpha1.RevocationPendingCondition)).To(gomega.BeFalse())
+	g.Expect(conditions.GetReason(rbd, conditions.ReadyConditionType)).To(gomega.Equal(string(authorizationv1alpha1.DeprovisionedReason)))
+}
+
+func TestHandlePolicyViolations_Freeze(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	rrd := &authorizationv1alpha1.RestrictedRoleDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "frozen-rrd", Generation: 4},
+	}
+	violations := []policy.Violation{{Field: "spec.restrictedVerbs", Message: "verb forbidden"}}
+
+	deprovisionCalled := false
+	statusApplied := false
+	recorder := events.NewFakeRecorder(10)
+	cfg := ViolationHandlerConfig{
+		ControllerLabel:   metrics.ControllerRestrictedRoleDefinition,
+		ResourceKind:      "RestrictedRoleDefinition",
+		Deprovision:       func(ctx context.Context) error { deprovisionCalled = true; return nil },
+		MarkStalled:       func(ctx context.Context, err error) {},
+		SetReconciled:     func(v bool) {},
+		ApplyStatus:       func(ctx context.Context) error { statusApplied = true; return nil },
+		OnViolation:       &authorizationv1alpha1.ViolationPolicy{Action: authorizationv1alpha1.ViolationActionFreeze},
+		ViolationSince:    func() *metav1.Time { return rrd.Status.PolicyViolationSince },
+		SetViolationSince: func(t *metav1.Time) { rrd.Status.PolicyViolationSince = t },
+	}
+	result, err := handlePolicyViolations(helperCtx(), rrd, rrd.Generation, violations,
+		recorder, rrd, cfg)
+
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deprovisionCalled).To(gomega.BeFalse())
+	g.Expect(statusApplied).To(gomega.BeTrue())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(DefaultRequeueInterval))
+	g.Expect(conditions.IsFalse(rrd, authorizationv1alpha1.PolicyCompliantCondition)).To(gomega.BeTrue())
+	g.Expect(conditions.GetReason(rrd, conditions.ReadyConditionType)).To(gomega.Equal(string(authorizationv1alpha1.FrozenReason)))
+	g.Expect(conditions.Has(rrd, authorizationv1alpha1.RevocationPendingCondition)).To(gomega.BeFalse())
+	g.Expect(rrd.Status.PolicyViolationSince).NotTo(gomega.BeNil())
+	g.Expect(recorder.Events).To(gomega.HaveLen(2))
+	<-recorder.Events
+	g.Expect(<-recorder.Events).To(gomega.ContainSubstring(authorizationv1alpha1.EventReasonFrozen))
+
+	// A requeue while frozen keeps the RBAC without repeating the Frozen event,
+	// even after Ready was reset by the reconcile.
+	conditions.MarkReconciling(rrd, rrd.Generation,
+		authorizationv1alpha1.ReconcilingReasonProgressing, authorizationv1alpha1.ReconcilingMessageProgressing)
+	result, err = handlePolicyViolations(helperCtx(), rrd, rrd.Generation, violations,
+		recorder, rrd, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deprovisionCalled).To(gomega.BeFalse())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(DefaultRequeueInterval))
+	g.Expect(conditions.GetReason(rrd, conditions.ReadyConditionType)).To(gomega.Equal(string(authorizationv1alpha1.FrozenReason)))
+	g.Expect(recorder.Events).To(gomega.HaveLen(1))
+	g.Expect(<-recorder.Events).NotTo(gomega.ContainSubstring(authorizationv1alpha1.EventReasonFrozen))
+}
+
+func TestHandlePolicyViolations_FreezeAfterRevocationPending(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	since := metav1.NewTime(time.Now().Add(-24 * time.Hour))
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "refrozen-rbd", Generation: 2},
+		Status:     authorizationv1alpha1.RestrictedBindDefinitionStatus{PolicyViolationSince: &since},
+	}
+	conditions.MarkTrue(rbd, authorizationv1alpha1.RevocationPendingCondition, rbd.Generation,
+		authorizationv1alpha1.RevocationPendingReasonGracePeriod, authorizationv1alpha1.RevocationPendingMessageGracePeriod, "earlier")
+	violations := []policy.Violation{{Field: "spec.subjects", Message: "too many subjects"}}
+
+	recorder := events.NewFakeRecorder(10)
+	_, err := handlePolicyViolations(helperCtx(), rbd, rbd.Generation, violations,
+		recorder, rbd, ViolationHandlerConfig{
+			ControllerLabel:   metrics.ControllerRestrictedBindDefinition,
+			ResourceKind:      "RestrictedBindDefinition",
+			Deprovision:       func(ctx context.Context) error { return nil },
+			MarkStalled:       func(ctx context.Context, err error) {},
+			SetReconciled:     func(v bool) {},
+			ApplyStatus:       func(ctx context.Context) error { return nil },
+			OnViolation:       &authorizationv1alpha1.ViolationPolicy{Action: authorizationv1alpha1.ViolationActionFreeze},
+			ViolationSince:    func() *metav1.Time { return rbd.Status.PolicyViolationSince },
+			SetViolationSince: func(t *metav1.Time) { rbd.Status.PolicyViolationSince = t },
+		})
+
+	// Switching a pending revocation to Freeze is a transition to frozen.
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(rbd.Status.PolicyViolationSince.Equal(&since)).To(gomega.BeTrue())
+	g.Expect(conditions.Has(rbd, authorizationv1alpha1.RevocationPendingCondition)).To(gomega.BeFalse())
+	g.Expect(recorder.Events).To(gomega.HaveLen(2))
+	<-recorder.Events
+	g.Expect(<-recorder.Events).To(gomega.ContainSubstring(authorizationv1alpha1.EventReasonFrozen))
+}
+
 func TestMarkPolicyCompliant(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -103,6 +103,7 @@ func evaluateRestrictedPolicy(
 			err = errors.Join(err, fmt.Errorf("deprovision after policy selector evaluation failure: %w", deprovisionErr))
 		}
 		markPolicyEvaluationError(obj, obj.GetGeneration(), err)
+		conditions.Delete(obj, authorizationv1alpha1.RevocationPendingCondition)
 		cfg.MarkStalled(ctx, err)
 		metrics.ReconcileTotal.WithLabelValues(cfg.ControllerLabel, metrics.ResultError).Inc()
 		metrics.ReconcileErrors.WithLabelValues(cfg.ControllerLabel, metrics.ErrorTypeAPI).Inc()
@@ -120,11 +121,13 @@ func evaluateRestrictedPolicy(
 func handleMissingRestrictedPolicy(
 	ctx context.Context,
 	cfg restrictedPolicyLifecycleConfig,
-	obj client.Object,
+	obj RestrictedPolicyObject,
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("referenced RBACPolicy not found", "name", cfg.ResourceName, "policyRef", cfg.PolicyRefName)
 
+	// Without a policy there is no onViolation grace to count down.
+	conditions.Delete(obj, authorizationv1alpha1.RevocationPendingCondition)
 	cfg.MarkPolicyCompliantFalse(
 		authorizationv1alpha1.PolicyCompliantReasonPolicyNotFound,
 		authorizationv1alpha1.PolicyCompliantMessagePolicyNotFound,
@@ -157,11 +160,13 @@ func handleMissingRestrictedPolicy(
 func handleDeletingRestrictedPolicy(
 	ctx context.Context,
 	cfg restrictedPolicyLifecycleConfig,
-	obj client.Object,
+	obj RestrictedPolicyObject,
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("referenced RBACPolicy is deleting", "name", cfg.ResourceName, "policyRef", cfg.PolicyRefName)
 
+	// Without a policy there is no onViolation grace to count down.
+	conditions.Delete(obj, authorizationv1alpha1.RevocationPendingCondition)
 	cfg.MarkPolicyCompliantFalse(
 		authorizationv1alpha1.PolicyCompliantReasonPolicyDeleting,
 		authorizationv1alpha1.PolicyCompliantMessagePolicyDeleting,
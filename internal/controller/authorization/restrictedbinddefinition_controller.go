@@ -106,7 +106,8 @@ func (r *RestrictedBindDefinitionReconciler) SetupWithManager(mgr ctrl.Manager,
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&authorizationv1alpha1.RestrictedBindDefinition{},
-			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			builder.WithPredicates(predicate.GenerationChangedPredicate{},
+				applyLatencyPredicate(metrics.ControllerRestrictedBindDefinition))).
 		Owns(&rbacv1.ClusterRoleBinding{}).
 		Owns(&corev1.ServiceAccount{}).
 		// Re-reconcile when external ServiceAccount subjects are created,
@@ -135,7 +136,7 @@ func (r *RestrictedBindDefinitionReconciler) SetupWithManager(mgr ctrl.Manager,
 		).
 		// Re-reconcile when namespaces change (label changes affect namespace selectors).
 		Watches(&corev1.Namespace{},
-			handler.EnqueueRequestsFromMapFunc(r.namespaceToRestrictedBindDefinitions),
+			newApplyLatencyNamespaceHandler(metrics.ControllerRestrictedBindDefinition, r.namespaceToRestrictedBindDefinitions),
 			builder.WithPredicates(namespaceLabelOrPhaseChangePredicate()),
 		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{MaxConcurrentReconciles: concurrency}).
@@ -175,12 +176,15 @@ func (r *RestrictedBindDefinitionReconciler) rbdEvaluatePolicy(
 	}
 
 	result, err = handlePolicyViolations(ctx, rbd, rbd.Generation, violations, r.recorder, rbd, ViolationHandlerConfig{
-		ControllerLabel: metrics.ControllerRestrictedBindDefinition,
-		ResourceKind:    "RestrictedBindDefinition",
-		Deprovision:     cfg.Deprovision,
-		MarkStalled:     cfg.MarkStalled,
-		SetReconciled:   func(v bool) { rbd.Status.BindReconciled = v },
-		ApplyStatus:     func(ctx context.Context) error { return ssa.ApplyRestrictedBindDefinitionStatus(ctx, r.client, rbd) },
+		ControllerLabel:   metrics.ControllerRestrictedBindDefinition,
+		ResourceKind:      "RestrictedBindDefinition",
+		Deprovision:       cfg.Deprovision,
+		MarkStalled:       cfg.MarkStalled,
+		SetReconciled:     func(v bool) { rbd.Status.BindReconciled = v },
+		ApplyStatus:       func(ctx context.Context) error { return ssa.ApplyRestrictedBindDefinitionStatus(ctx, r.client, rbd) },
+		OnViolation:       rbacPolicy.Spec.OnViolation,
+		ViolationSince:    func() *metav1.Time { return rbd.Status.PolicyViolationSince },
+		SetViolationSince: func(t *metav1.Time) { rbd.Status.PolicyViolationSince = t },
 	})
 	return result, true, err
 }
@@ -195,7 +199,11 @@ func (r *RestrictedBindDefinitionReconciler) rbdPolicyLifecycleConfig(rbd *autho
 		Evaluate: func(ctx context.Context, p *authorizationv1alpha1.RBACPolicy) ([]policy.Violation, error) {
 			labelGetter := newLabelGetter(r.ownershipReader())
 			violations := policy.EvaluateBindDefinition(ctx, p, rbd, labelGetter)
-			return violations, labelGetter.Err()
+			if err := labelGetter.Err(); err != nil {
+				return violations, err
+			}
+			budgetViolations, err := r.rbdEvaluateBudgets(ctx, p, rbd)
+			return append(violations, budgetViolations...), err
 		},
 		Deprovision: func(ctx context.Context) error {
 			if err := r.rbdDeprovision(ctx, rbd, r.client); err != nil {
@@ -215,9 +223,76 @@ func (r *RestrictedBindDefinitionReconciler) rbdPolicyLifecycleConfig(rbd *autho
 	}
 }
 
+// rbdEvaluateBudgets checks the RestrictedBindDefinition against the budgets of
+// its RBACPolicy and of every ancestor policy, whose usage includes the
+// definitions of their descendants. Admission only checks creates and updates,
+// so namespaces that later match a selector, or a lowered budget, are caught here.
+func (r *RestrictedBindDefinitionReconciler) rbdEvaluateBudgets(
+	ctx context.Context,
+	rbacPolicy *authorizationv1alpha1.RBACPolicy,
+	rbd *authorizationv1alpha1.RestrictedBindDefinition,
+) ([]policy.Violation, error) {
+	if rbacPolicy.Spec.Budgets == nil && rbacPolicy.Spec.ParentPolicyRef == nil {
+		return nil, nil
+	}
+	policies := &authorizationv1alpha1.RBACPolicyList{}
+	if err := r.client.List(ctx, policies); err != nil {
+		return nil, fmt.Errorf("list RBACPolicies for budgets of policy %s: %w", rbacPolicy.Name, err)
+	}
+
+	var violations []policy.Violation
+	var namespaces []corev1.Namespace
+	namespacesListed := false
+	for _, budgetPolicy := range authorizationv1alpha1.PolicyLineage(policies.Items, rbacPolicy) {
+		if budgetPolicy.Spec.Budgets == nil {
+			continue
+		}
+		siblings, err := listBudgetRestrictedBindDefinitions(ctx, r.client, policies.Items, budgetPolicy.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !namespacesListed && (rbd.UsesNamespaceSelectors() || restrictedBindDefinitionsUseNamespaceSelectors(siblings)) {
+			nsList := &corev1.NamespaceList{}
+			if err := r.client.List(ctx, nsList); err != nil {
+				return nil, fmt.Errorf("list namespaces for budgets of policy %s: %w", budgetPolicy.Name, err)
+			}
+			namespaces = nsList.Items
+			namespacesListed = true
+		}
+		budgetViolations, err := policy.EvaluateBudgets(&budgetPolicy, rbd, siblings, namespaces)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, budgetViolations...)
+	}
+	return violations, nil
+}
+
 // policyToRestrictedBindDefinitions maps an RBACPolicy event to reconcile requests
-// for all RestrictedBindDefinitions referencing that policy.
+// for all RestrictedBindDefinitions referencing that policy. When the policy
+// declares budgets, the definitions of its descendant policies count against
+// them and are enqueued as well.
 func (r *RestrictedBindDefinitionReconciler) policyToRestrictedBindDefinitions(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := r.policyRefToRestrictedBindDefinitions(ctx, obj)
+	rbacPolicy, ok := obj.(*authorizationv1alpha1.RBACPolicy)
+	if !ok || rbacPolicy.Spec.Budgets == nil {
+		return requests
+	}
+	policies := &authorizationv1alpha1.RBACPolicyList{}
+	if err := r.client.List(ctx, policies); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list RBACPolicies to resolve descendant policies", "policy", rbacPolicy.Name)
+		return requests
+	}
+	for _, name := range authorizationv1alpha1.PolicySubtree(policies.Items, rbacPolicy.Name)[1:] {
+		descendant := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		requests = append(requests, r.policyRefToRestrictedBindDefinitions(ctx, descendant)...)
+	}
+	return requests
+}
+
+// policyRefToRestrictedBindDefinitions returns reconcile requests for the
+// RestrictedBindDefinitions whose policyRef names obj.
+func (r *RestrictedBindDefinitionReconciler) policyRefToRestrictedBindDefinitions(ctx context.Context, obj client.Object) []reconcile.Request {
 	rbdList := &authorizationv1alpha1.RestrictedBindDefinitionList{}
 	return mapPolicyToRestrictedRequests(
 		ctx, r.client, obj, rbdList,
@@ -522,7 +597,7 @@ func (r *RestrictedBindDefinitionReconciler) Reconcile(ctx context.Context, req
 
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerRestrictedBindDefinition).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerRestrictedBindDefinition, duration)
 		logger.V(1).Info("=== Reconcile END ===", "restrictedBindDefinition", req.Name, "duration", duration.String())
 	}()
 
@@ -532,6 +607,7 @@ func (r *RestrictedBindDefinitionReconciler) Reconcile(ctx context.Context, req
 		if apierrors.IsNotFound(err) {
 			logger.V(1).Info("RestrictedBindDefinition not found (deleted), skipping", "name", req.Name)
 			metrics.DeletePolicyViolationContribution(metrics.ControllerRestrictedBindDefinition, req.Name)
+			metrics.DeleteApplyLatency(metrics.ControllerRestrictedBindDefinition, req.Name)
 			metrics.RoleRefsMissing.DeleteLabelValues(req.Name)
 			metrics.NamespacesActive.DeleteLabelValues(req.Name)
 			metrics.DeleteManagedResourceSeries(metrics.ControllerRestrictedBindDefinition, req.Name)
@@ -598,6 +674,8 @@ func (r *RestrictedBindDefinitionReconciler) Reconcile(ctx context.Context, req
 	// Policy compliant.
 	markPolicyCompliant(rbd, rbd.Generation, r.recorder, rbd, rbacPolicy.Name, metrics.ControllerRestrictedBindDefinition)
 	rbd.Status.PolicyViolations = nil
+	rbd.Status.PolicyViolationSince = nil
+	conditions.Delete(rbd, authorizationv1alpha1.RevocationPendingCondition)
 
 	// Step 7: Validate role references before applying RBAC resources. A
 	// restricted binding must not pre-create bindings to roles that may be
@@ -663,6 +741,7 @@ func (r *RestrictedBindDefinitionReconciler) Reconcile(ctx context.Context, req
 		return ctrl.Result{}, fmt.Errorf("apply RestrictedBindDefinition %s status: %w", rbd.Name, err)
 	}
 
+	metrics.ObserveApplyLatency(ctx, metrics.ControllerRestrictedBindDefinition, rbd.Name, rbd.Generation, metrics.ApplyOutcomeReady)
 	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRestrictedBindDefinition, metrics.ResultSuccess).Inc()
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
@@ -862,6 +941,9 @@ func (r *RestrictedBindDefinitionReconciler) rbdReconcileBindings(
 	if err := r.rbdValidateRoleBindingNameCollisions(ctx, rbd); err != nil {
 		return err
 	}
+	defer reportConflicts(ctx, r.recorder, rbd, metrics.ControllerRestrictedBindDefinition,
+		rbd.GetConflictPolicy(), rbd.Status.Conflicts, &rbd.Status.Conflicts)
+	rbd.Status.Conflicts = nil
 
 	for _, clusterRoleRef := range restrictedClusterRoleRefs(rbd.Spec.ClusterRoleBindings) {
 		crbName := helpers.BuildBindingName(rbd.Spec.TargetName, clusterRoleRef)
@@ -878,9 +960,14 @@ func (r *RestrictedBindDefinitionReconciler) rbdReconcileBindings(
 		ac.WithOwnerReferences(ownerRefForRestricted(rbd, authorizationv1alpha1.RestrictedBindDefinitionKind)).
 			WithAnnotations(helpers.BuildResourceAnnotations("RestrictedBindDefinition", rbd.Name))
 
-		if result, err := pkgssa.PatchApplyClusterRoleBindingAlways(ctx, applyClient, ac, client.FieldOwner(pkgssa.FieldOwnerFor(rbd.Name)), client.ForceOwnership); err != nil {
+		result, err := pkgssa.PatchApplyClusterRoleBindingAlways(ctx, applyClient, ac, rbdApplyOptions(ctx, rbd)...)
+		if conflictReported(rbd.GetConflictPolicy(), err) {
+			continue
+		}
+		if err != nil {
 			return fmt.Errorf("ensure ClusterRoleBinding %s: %w", crbName, err)
-		} else if result == pkgssa.PatchApplyResultSkipped {
+		}
+		if result == pkgssa.PatchApplyResultSkipped {
 			metrics.RBACResourcesSkipped.WithLabelValues(metrics.ResourceClusterRoleBinding).Inc()
 		} else {
 			metrics.RBACResourcesApplied.WithLabelValues(metrics.ResourceClusterRoleBinding).Inc()
@@ -1205,6 +1292,8 @@ func (r *RestrictedBindDefinitionReconciler) rbdPruneStaleServiceAccounts(
 // rbdEnsureServiceAccounts ensures all ServiceAccount subjects exist, tracking
 // which were generated vs pre-existing (external).
 // AllowAutoCreate and DisableAdoption from saCreationConfig are enforced here.
+// Generated ServiceAccounts whose serviceAccountLifecycle expired are recorded
+// in status.revokedServiceAccounts and left out of the effective subjects.
 func (r *RestrictedBindDefinitionReconciler) rbdEnsureServiceAccounts(
 	ctx context.Context,
 	rbd *authorizationv1alpha1.RestrictedBindDefinition,
@@ -1217,12 +1306,25 @@ func (r *RestrictedBindDefinitionReconciler) rbdEnsureServiceAccounts(
 	var generatedSAs []rbacv1.Subject
 	var externalSAs []string
 	var skippedSAs []string
+	lifecycle := rbd.Spec.ServiceAccountLifecycle
+	rbd.Status.RevokedServiceAccounts = retainedRevokedServiceAccounts(lifecycle, rbd.Spec.Subjects, rbd.Status.RevokedServiceAccounts)
+	revoked := revokedServiceAccountKeys(rbd.Status.RevokedServiceAccounts)
+	now := time.Now()
 
 	for _, subject := range rbd.Spec.Subjects {
 		if subject.Kind != authorizationv1alpha1.BindSubjectServiceAccount {
 			effectiveSubjects = append(effectiveSubjects, subject)
 			continue
 		}
+		// Revoked SAs stay revoked. Unbind keeps them recorded as generated so
+		// they are still cleaned up with the RestrictedBindDefinition.
+		if _, ok := revoked[serviceAccountKey(subject.Namespace, subject.Name)]; ok {
+			if lifecycle.ActionOrDefault() == authorizationv1alpha1.ServiceAccountExpiryActionUnbind &&
+				!helpers.SubjectExists(generatedSAs, subject) {
+				generatedSAs = append(generatedSAs, subject)
+			}
+			continue
+		}
 		ns := &corev1.Namespace{}
 		if err := r.ownershipReader().Get(ctx, types.NamespacedName{Name: subject.Namespace}, ns); err != nil {
 			if apierrors.IsNotFound(err) {
@@ -1263,6 +1365,12 @@ func (r *RestrictedBindDefinitionReconciler) rbdEnsureServiceAccounts(
 				skippedSAs = append(skippedSAs, rbdSkippedServiceAccount(subject, skipReason))
 				continue
 			}
+			if entry := expiredServiceAccount(lifecycle, existing, now); entry != nil {
+				if r.rbdRevokeServiceAccount(ctx, rbd, *entry) && !helpers.SubjectExists(generatedSAs, subject) {
+					generatedSAs = append(generatedSAs, subject)
+				}
+				continue
+			}
 		} else {
 			createSA, skipReason, err := r.rbdCanCreateMissingServiceAccount(ctx, subject, ns, saCreationConfig)
 			if err != nil {
@@ -1300,6 +1408,27 @@ func (r *RestrictedBindDefinitionReconciler) rbdEnsureServiceAccounts(
 	return effectiveSubjects, nil
 }
 
+// rbdRevokeServiceAccount records an expired generated ServiceAccount in
+// status.revokedServiceAccounts. It reports whether the ServiceAccount stays
+// recorded as generated (true for Unbind); with Delete it is left to the stale
+// ServiceAccount pruning that follows.
+func (r *RestrictedBindDefinitionReconciler) rbdRevokeServiceAccount(
+	ctx context.Context,
+	rbd *authorizationv1alpha1.RestrictedBindDefinition,
+	entry authorizationv1alpha1.RevokedServiceAccount,
+) bool {
+	action := rbd.Spec.ServiceAccountLifecycle.ActionOrDefault()
+	rbd.Status.RevokedServiceAccounts = append(rbd.Status.RevokedServiceAccounts, entry)
+	metrics.ServiceAccountsRevoked.WithLabelValues(metrics.ControllerRestrictedBindDefinition, string(entry.Reason)).Inc()
+	log.FromContext(ctx).Info("revoked generated ServiceAccount",
+		"name", rbd.Name, "serviceAccount", entry.Name, "namespace", entry.Namespace,
+		"reason", entry.Reason, "action", action)
+	r.recorder.Eventf(rbd, nil, corev1.EventTypeNormal,
+		authorizationv1alpha1.EventReasonServiceAccountRevoked, authorizationv1alpha1.EventActionReconcile,
+		"Revoked ServiceAccount %s/%s (%s, action %s)", entry.Namespace, entry.Name, entry.Reason, action)
+	return action == authorizationv1alpha1.ServiceAccountExpiryActionUnbind
+}
+
 func (r *RestrictedBindDefinitionReconciler) rbdClassifyExistingServiceAccount(
 	ctx context.Context,
 	rbd *authorizationv1alpha1.RestrictedBindDefinition,
@@ -1462,6 +1591,14 @@ func rbdSkippedServiceAccount(subject rbacv1.Subject, reason string) string {
 	return fmt.Sprintf("%s/%s: %s", subject.Namespace, subject.Name, reason)
 }
 
+// rbdApplyOptions returns the apply options for the generated bindings of a
+// RestrictedBindDefinition: its own field owner plus its conflictPolicy, with
+// conflicts collected in status.conflicts.
+func rbdApplyOptions(ctx context.Context, rbd *authorizationv1alpha1.RestrictedBindDefinition) []client.ApplyOption {
+	return append([]client.ApplyOption{client.FieldOwner(pkgssa.FieldOwnerFor(rbd.Name))},
+		conflictApplyOptions(ctx, rbd.GetConflictPolicy(), &rbd.Status.Conflicts)...)
+}
+
 // rbdEnsureRoleBinding ensures a single RoleBinding exists.
 func (r *RestrictedBindDefinitionReconciler) rbdEnsureRoleBinding(
 	ctx context.Context,
@@ -1487,9 +1624,14 @@ func (r *RestrictedBindDefinitionReconciler) rbdEnsureRoleBinding(
 	ac.WithOwnerReferences(ownerRefForRestricted(rbd, authorizationv1alpha1.RestrictedBindDefinitionKind)).
 		WithAnnotations(helpers.BuildResourceAnnotations("RestrictedBindDefinition", rbd.Name))
 
-	if result, err := pkgssa.PatchApplyRoleBindingAlways(ctx, applyClient, ac, client.FieldOwner(pkgssa.FieldOwnerFor(rbd.Name)), client.ForceOwnership); err != nil {
+	result, err := pkgssa.PatchApplyRoleBindingAlways(ctx, applyClient, ac, rbdApplyOptions(ctx, rbd)...)
+	if conflictReported(rbd.GetConflictPolicy(), err) {
+		return nil
+	}
+	if err != nil {
 		return fmt.Errorf("ensure RoleBinding %s/%s: %w", namespace, rbName, err)
-	} else if result == pkgssa.PatchApplyResultSkipped {
+	}
+	if result == pkgssa.PatchApplyResultSkipped {
 		metrics.RBACResourcesSkipped.WithLabelValues(metrics.ResourceRoleBinding).Inc()
 	} else {
 		metrics.RBACResourcesApplied.WithLabelValues(metrics.ResourceRoleBinding).Inc()
@@ -1693,6 +1835,7 @@ func (r *RestrictedBindDefinitionReconciler) reconcileDelete(
 
 	// Clean up metric series.
 	metrics.DeletePolicyViolationContribution(metrics.ControllerRestrictedBindDefinition, rbd.Name)
+	metrics.DeleteApplyLatency(metrics.ControllerRestrictedBindDefinition, rbd.Name)
 	metrics.RoleRefsMissing.DeleteLabelValues(rbd.Name)
 	metrics.NamespacesActive.DeleteLabelValues(rbd.Name)
 	metrics.DeleteManagedResourceSeries(metrics.ControllerRestrictedBindDefinition, rbd.Name)
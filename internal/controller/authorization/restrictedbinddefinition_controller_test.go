@@ -10,6 +10,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/onsi/gomega"
@@ -24,11 +25,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"go.opentelemetry.io/otel/trace/noop"
 
@@ -147,6 +150,11 @@ func newRBDTestReconciler(objs ...client.Object) (*RestrictedBindDefinitionRecon
 	c := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(objs...).
+		WithIndex(
+			&authorizationv1alpha1.RestrictedBindDefinition{},
+			indexer.RestrictedBindDefinitionPolicyRefField,
+			indexer.RestrictedBindDefinitionPolicyRefFunc,
+		).
 		WithStatusSubresource(
 			&authorizationv1alpha1.RestrictedBindDefinition{},
 			&authorizationv1alpha1.RBACPolicy{},
@@ -1064,6 +1072,48 @@ func TestRBD_ReconcileResources_UnownedClusterRoleBindingIsPreserved(t *testing.
 	g.Expect(kept.OwnerReferences).To(gomega.BeEmpty())
 }
 
+func TestRBD_ReconcileResources_ConflictPolicyFail(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-rbd", UID: "conflict-rbd-uid"},
+		Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+			TargetName: "conflict-target",
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.UserKind, Name: "alice", APIGroup: rbacv1.GroupName},
+			},
+			ClusterRoleBindings: &authorizationv1alpha1.ClusterBinding{
+				ClusterRoleRefs: []string{"view"},
+			},
+			ConflictPolicy: authorizationv1alpha1.ConflictPolicyFail,
+		},
+	}
+	r, c := newRBDTestReconciler(rbd)
+
+	// Another tool applies the generated binding with different subjects.
+	competingAC := pkgssa.ClusterRoleBindingWithSubjectsAndRoleRef(
+		"conflict-target-view-binding",
+		nil,
+		[]rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "mallory", APIGroup: rbacv1.GroupName}},
+		rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"},
+	).WithOwnerReferences(ownerRefForRestricted(rbd, authorizationv1alpha1.RestrictedBindDefinitionKind))
+	g.Expect(c.Apply(rbdCtx(), competingAC, client.FieldOwner("argocd-controller"))).To(gomega.Succeed())
+
+	err := r.rbdReconcileResources(rbdCtx(), rbd, c, nil)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(pkgssa.IsFieldManagerConflict(err)).To(gomega.BeTrue())
+	g.Expect(rbd.Status.Conflicts).To(gomega.Equal([]authorizationv1alpha1.FieldManagerConflict{{
+		Kind:     "ClusterRoleBinding",
+		Name:     "conflict-target-view-binding",
+		Managers: []string{"argocd-controller"},
+	}}))
+
+	var kept rbacv1.ClusterRoleBinding
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Name: "conflict-target-view-binding"}, &kept)).To(gomega.Succeed())
+	g.Expect(kept.Subjects).To(gomega.HaveLen(1))
+	g.Expect(kept.Subjects[0].Name).To(gomega.Equal("mallory"))
+}
+
 func TestRBD_Reconcile_UnownedClusterRoleBindingStallsAndPreservesObject(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -1173,6 +1223,119 @@ func TestRBD_Reconcile_PolicyCompliant_CreatesRoleBindings(t *testing.T) {
 	g.Expect(rb.RoleRef.Name).To(gomega.Equal("edit"))
 }
 
+func TestRBD_Reconcile_BudgetExceeded_NewestDefinitionInViolation(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	pol := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "budget-policy", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"team-a", "team-b"}},
+			BindingLimits: &authorizationv1alpha1.BindingLimits{
+				AllowClusterRoleBindings: false,
+			},
+			Budgets: &authorizationv1alpha1.PolicyBudgets{MaxRoleBindings: ptr.To[int32](1)},
+		},
+	}
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rbdFor := func(name, namespace string, createdAt time.Time) *authorizationv1alpha1.RestrictedBindDefinition {
+		return &authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1, CreationTimestamp: metav1.NewTime(createdAt)},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: "budget-policy"},
+				TargetName: name,
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, Name: "testuser", APIGroup: rbacv1.GroupName},
+				},
+				RoleBindings: []authorizationv1alpha1.NamespaceBinding{
+					{Namespace: namespace, ClusterRoleRefs: []string{"edit"}},
+				},
+			},
+		}
+	}
+	older := rbdFor("older", "team-a", created)
+	newer := rbdFor("newer", "team-b", created.Add(time.Hour))
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "edit"}}
+	nsA := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	nsB := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+
+	r, c := newRBDTestReconciler(rbdPolicyWithDefaultAllowances(pol), older, newer, clusterRole, nsA, nsB)
+	for _, name := range []string{"older", "newer"} {
+		_, err := r.Reconcile(rbdCtx(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	var updatedOlder, updatedNewer authorizationv1alpha1.RestrictedBindDefinition
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Name: "older"}, &updatedOlder)).To(gomega.Succeed())
+	g.Expect(updatedOlder.Status.PolicyViolations).To(gomega.BeEmpty())
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Name: "newer"}, &updatedNewer)).To(gomega.Succeed())
+	g.Expect(updatedNewer.Status.PolicyViolations).To(gomega.ContainElement(gomega.ContainSubstring("exceeding its budget of 1")))
+
+	var rb rbacv1.RoleBinding
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Namespace: "team-a", Name: "older-edit-binding"}, &rb)).To(gomega.Succeed())
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Namespace: "team-b", Name: "newer-edit-binding"}, &rb)).NotTo(gomega.Succeed())
+}
+
+func TestRBD_Reconcile_ParentBudgetSharedByChildPolicies(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	parent := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Generation: 1},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			AppliesTo: authorizationv1alpha1.PolicyScope{Namespaces: []string{"team-a", "team-b"}},
+			Budgets:   &authorizationv1alpha1.PolicyBudgets{MaxRoleBindings: ptr.To[int32](1)},
+		},
+	}
+	childFor := func(name, namespace string) *authorizationv1alpha1.RBACPolicy {
+		return rbdPolicyWithDefaultAllowances(&authorizationv1alpha1.RBACPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec: authorizationv1alpha1.RBACPolicySpec{
+				AppliesTo:       authorizationv1alpha1.PolicyScope{Namespaces: []string{namespace}},
+				ParentPolicyRef: &authorizationv1alpha1.RBACPolicyReference{Name: "tenant-a"},
+				Budgets:         &authorizationv1alpha1.PolicyBudgets{MaxRoleBindings: ptr.To[int32](1)},
+			},
+		})
+	}
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rbdFor := func(name, policyName, namespace string, createdAt time.Time) *authorizationv1alpha1.RestrictedBindDefinition {
+		return &authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1, CreationTimestamp: metav1.NewTime(createdAt)},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef:  authorizationv1alpha1.RBACPolicyReference{Name: policyName},
+				TargetName: name,
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, Name: "testuser", APIGroup: rbacv1.GroupName},
+				},
+				RoleBindings: []authorizationv1alpha1.NamespaceBinding{
+					{Namespace: namespace, ClusterRoleRefs: []string{"edit"}},
+				},
+			},
+		}
+	}
+	older := rbdFor("older", "tenant-a-dev", "team-a", created)
+	newer := rbdFor("newer", "tenant-a-ops", "team-b", created.Add(time.Hour))
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "edit"}}
+	nsA := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	nsB := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+
+	r, c := newRBDTestReconciler(parent, childFor("tenant-a-dev", "team-a"), childFor("tenant-a-ops", "team-b"),
+		older, newer, clusterRole, nsA, nsB)
+	for _, name := range []string{"older", "newer"} {
+		_, err := r.Reconcile(rbdCtx(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	var updatedOlder, updatedNewer authorizationv1alpha1.RestrictedBindDefinition
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Name: "older"}, &updatedOlder)).To(gomega.Succeed())
+	g.Expect(updatedOlder.Status.PolicyViolations).To(gomega.BeEmpty())
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Name: "newer"}, &updatedNewer)).To(gomega.Succeed())
+	g.Expect(updatedNewer.Status.PolicyViolations).To(gomega.ConsistOf(
+		gomega.ContainSubstring(`RBACPolicy "tenant-a" to 2, exceeding its budget of 1`)))
+
+	var rb rbacv1.RoleBinding
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Namespace: "team-a", Name: "older-edit-binding"}, &rb)).To(gomega.Succeed())
+	g.Expect(c.Get(rbdCtx(), types.NamespacedName{Namespace: "team-b", Name: "newer-edit-binding"}, &rb)).NotTo(gomega.Succeed())
+}
+
 func TestRBD_ReconcileResources_UnownedRoleBindingIsPreserved(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -2932,6 +3095,48 @@ func TestRBD_PolicyToRestrictedBindDefinitions(t *testing.T) {
 	g.Expect(requests[0].Name).To(gomega.Equal("mapped-rbd"))
 }
 
+func TestRBD_PolicyToRestrictedBindDefinitions_BudgetsIncludeDescendants(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	rbdFor := func(name, policyName string) *authorizationv1alpha1.RestrictedBindDefinition {
+		return &authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				PolicyRef: authorizationv1alpha1.RBACPolicyReference{Name: policyName},
+			},
+		}
+	}
+	parent := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a"}}
+	child := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-dev"},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			ParentPolicyRef: &authorizationv1alpha1.RBACPolicyReference{Name: "tenant-a"},
+		},
+	}
+
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(parent, child, rbdFor("parent-rbd", "tenant-a"), rbdFor("child-rbd", "tenant-a-dev")).
+		WithIndex(&authorizationv1alpha1.RestrictedBindDefinition{}, ".spec.policyRef.name", func(obj client.Object) []string {
+			return []string{obj.(*authorizationv1alpha1.RestrictedBindDefinition).Spec.PolicyRef.Name}
+		}).
+		Build()
+	r := NewRestrictedBindDefinitionReconciler(c, scheme, events.NewFakeRecorder(10))
+
+	names := func(requests []reconcile.Request) []string {
+		var out []string
+		for _, req := range requests {
+			out = append(out, req.Name)
+		}
+		return out
+	}
+	g.Expect(names(r.policyToRestrictedBindDefinitions(rbdCtx(), parent))).To(gomega.ConsistOf("parent-rbd"))
+
+	parent.Spec.Budgets = &authorizationv1alpha1.PolicyBudgets{MaxRestrictedBindDefinitions: ptr.To[int32](1)}
+	g.Expect(names(r.policyToRestrictedBindDefinitions(rbdCtx(), parent))).To(gomega.ConsistOf("parent-rbd", "child-rbd"))
+}
+
 func TestRBD_PolicyToRestrictedBindDefinitions_ListError(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -133,7 +133,8 @@ func (r *RestrictedRoleDefinitionReconciler) SetupWithManager(mgr ctrl.Manager,
 	trackerChannel := source.Channel(r.trackerEvents, handler.EnqueueRequestsFromMapFunc(r.queueAll()))
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&authorizationv1alpha1.RestrictedRoleDefinition{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&authorizationv1alpha1.RestrictedRoleDefinition{}, builder.WithPredicates(predicate.GenerationChangedPredicate{},
+			applyLatencyPredicate(metrics.ControllerRestrictedRoleDefinition))).
 		Owns(&rbacv1.ClusterRole{}).
 		Owns(&rbacv1.Role{}).
 		// Re-reconcile when the referenced RBACPolicy changes.
@@ -144,7 +145,7 @@ func (r *RestrictedRoleDefinitionReconciler) SetupWithManager(mgr ctrl.Manager,
 		// change. Policy appliesTo namespace selectors are evaluated against
 		// spec.targetNamespace.
 		Watches(&corev1.Namespace{},
-			handler.EnqueueRequestsFromMapFunc(r.namespaceToRestrictedRoleDefinitions),
+			newApplyLatencyNamespaceHandler(metrics.ControllerRestrictedRoleDefinition, r.namespaceToRestrictedRoleDefinitions),
 			builder.WithPredicates(namespaceLabelOrPhaseChangePredicate()),
 		).
 		WatchesRawSource(trackerChannel).
@@ -185,12 +186,15 @@ func (r *RestrictedRoleDefinitionReconciler) rrdEvaluatePolicy(
 	}
 
 	result, err = handlePolicyViolations(ctx, rrd, rrd.Generation, violations, r.recorder, rrd, ViolationHandlerConfig{
-		ControllerLabel: metrics.ControllerRestrictedRoleDefinition,
-		ResourceKind:    "RestrictedRoleDefinition",
-		Deprovision:     cfg.Deprovision,
-		MarkStalled:     cfg.MarkStalled,
-		SetReconciled:   func(v bool) { rrd.Status.RoleReconciled = v },
-		ApplyStatus:     func(ctx context.Context) error { return ssa.ApplyRestrictedRoleDefinitionStatus(ctx, r.client, rrd) },
+		ControllerLabel:   metrics.ControllerRestrictedRoleDefinition,
+		ResourceKind:      "RestrictedRoleDefinition",
+		Deprovision:       cfg.Deprovision,
+		MarkStalled:       cfg.MarkStalled,
+		SetReconciled:     func(v bool) { rrd.Status.RoleReconciled = v },
+		ApplyStatus:       func(ctx context.Context) error { return ssa.ApplyRestrictedRoleDefinitionStatus(ctx, r.client, rrd) },
+		OnViolation:       rbacPolicy.Spec.OnViolation,
+		ViolationSince:    func() *metav1.Time { return rrd.Status.PolicyViolationSince },
+		SetViolationSince: func(t *metav1.Time) { rrd.Status.PolicyViolationSince = t },
 	})
 	return result, true, err
 }
@@ -325,7 +329,7 @@ func (r *RestrictedRoleDefinitionReconciler) Reconcile(ctx context.Context, req
 
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerRestrictedRoleDefinition).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerRestrictedRoleDefinition, duration)
 		logger.V(1).Info("=== Reconcile END ===", "restrictedRoleDefinition", req.Name, "duration", duration.String())
 	}()
 
@@ -335,6 +339,7 @@ func (r *RestrictedRoleDefinitionReconciler) Reconcile(ctx context.Context, req
 		if apierrors.IsNotFound(err) {
 			logger.V(1).Info("RestrictedRoleDefinition not found (deleted), skipping", "name", req.Name)
 			metrics.DeletePolicyViolationContribution(metrics.ControllerRestrictedRoleDefinition, req.Name)
+			metrics.DeleteApplyLatency(metrics.ControllerRestrictedRoleDefinition, req.Name)
 			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRestrictedRoleDefinition, metrics.ResultSkipped).Inc()
 			return ctrl.Result{}, nil
 		}
@@ -438,15 +443,20 @@ func (r *RestrictedRoleDefinitionReconciler) Reconcile(ctx context.Context, req
 	if v := policy.CheckMaxRulesPerRole(rbacPolicy.Spec.RoleLimits, len(finalRules)); v != nil {
 		rrd.Status.PolicyViolations = []string{v.String()}
 		result, err := handlePolicyViolations(ctx, rrd, rrd.Generation, []policy.Violation{*v}, r.recorder, rrd, ViolationHandlerConfig{
-			ControllerLabel: metrics.ControllerRestrictedRoleDefinition,
-			ResourceKind:    "RestrictedRoleDefinition",
-			Deprovision:     func(ctx context.Context) error { return r.rrdDeprovision(ctx, rrd, r.client) },
-			MarkStalled:     func(ctx context.Context, err error) { r.rrdMarkStalled(ctx, rrd, err) },
-			SetReconciled:   func(v bool) { rrd.Status.RoleReconciled = v },
-			ApplyStatus:     func(ctx context.Context) error { return ssa.ApplyRestrictedRoleDefinitionStatus(ctx, r.client, rrd) },
+			ControllerLabel:   metrics.ControllerRestrictedRoleDefinition,
+			ResourceKind:      "RestrictedRoleDefinition",
+			Deprovision:       func(ctx context.Context) error { return r.rrdDeprovision(ctx, rrd, r.client) },
+			MarkStalled:       func(ctx context.Context, err error) { r.rrdMarkStalled(ctx, rrd, err) },
+			SetReconciled:     func(v bool) { rrd.Status.RoleReconciled = v },
+			ApplyStatus:       func(ctx context.Context) error { return ssa.ApplyRestrictedRoleDefinitionStatus(ctx, r.client, rrd) },
+			OnViolation:       rbacPolicy.Spec.OnViolation,
+			ViolationSince:    func() *metav1.Time { return rrd.Status.PolicyViolationSince },
+			SetViolationSince: func(t *metav1.Time) { rrd.Status.PolicyViolationSince = t },
 		})
 		return result, err
 	}
+	rrd.Status.PolicyViolationSince = nil
+	conditions.Delete(rrd, authorizationv1alpha1.RevocationPendingCondition)
 
 	// Step 8: Ensure the target role exists.
 	applyClient, impersonatedUser, err := r.rrdResolveApplyClient(rbacPolicy)
@@ -476,6 +486,7 @@ func (r *RestrictedRoleDefinitionReconciler) Reconcile(ctx context.Context, req
 		return ctrl.Result{}, fmt.Errorf("apply RestrictedRoleDefinition %s status: %w", rrd.Name, err)
 	}
 
+	metrics.ObserveApplyLatency(ctx, metrics.ControllerRestrictedRoleDefinition, rrd.Name, rrd.Generation, metrics.ApplyOutcomeReady)
 	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRestrictedRoleDefinition, metrics.ResultSuccess).Inc()
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
@@ -674,6 +685,11 @@ func (r *RestrictedRoleDefinitionReconciler) rrdEnsureRole(
 
 	ownerRef := ownerRefForRestricted(rrd, authorizationv1alpha1.RestrictedRoleDefinitionKind)
 	annotations := helpers.BuildResourceAnnotations("RestrictedRoleDefinition", rrd.Name)
+	conflictPolicy := rrd.GetConflictPolicy()
+	defer reportConflicts(ctx, r.recorder, rrd, metrics.ControllerRestrictedRoleDefinition,
+		conflictPolicy, rrd.Status.Conflicts, &rrd.Status.Conflicts)
+	rrd.Status.Conflicts = nil
+	applyOpts := conflictApplyOptions(ctx, conflictPolicy, &rrd.Status.Conflicts)
 
 	switch rrd.Spec.TargetRole {
 	case authorizationv1alpha1.DefinitionClusterRole:
@@ -688,7 +704,10 @@ func (r *RestrictedRoleDefinitionReconciler) rrdEnsureRole(
 			rrd.Spec.TargetName, labelsMap, finalRules,
 		).WithOwnerReferences(ownerRef).WithAnnotations(annotations)
 
-		result, err := pkgssa.PatchApplyClusterRolePruningLabelsAlways(ctx, applyClient, ac, helpers.IsProtectedRestrictedLabel, client.ForceOwnership)
+		result, err := pkgssa.PatchApplyClusterRolePruningLabelsAlways(ctx, applyClient, ac, helpers.IsProtectedRestrictedLabel, applyOpts...)
+		if conflictReported(conflictPolicy, err) {
+			break
+		}
 		if err != nil {
 			return fmt.Errorf("apply ClusterRole %s: %w", rrd.Spec.TargetName, err)
 		}
@@ -706,7 +725,10 @@ func (r *RestrictedRoleDefinitionReconciler) rrdEnsureRole(
 			rrd.Spec.TargetName, rrd.Spec.TargetNamespace, labelsMap, finalRules,
 		).WithOwnerReferences(ownerRef).WithAnnotations(annotations)
 
-		result, err := pkgssa.PatchApplyRoleAlways(ctx, applyClient, ac, client.ForceOwnership)
+		result, err := pkgssa.PatchApplyRoleAlways(ctx, applyClient, ac, applyOpts...)
+		if conflictReported(conflictPolicy, err) {
+			break
+		}
 		if err != nil {
 			return fmt.Errorf("apply Role %s/%s: %w", rrd.Spec.TargetNamespace, rrd.Spec.TargetName, err)
 		}
@@ -894,6 +916,7 @@ func (r *RestrictedRoleDefinitionReconciler) rrdHandleDeletion(
 
 	// Clean up metric series.
 	metrics.DeletePolicyViolationContribution(metrics.ControllerRestrictedRoleDefinition, rrd.Name)
+	metrics.DeleteApplyLatency(metrics.ControllerRestrictedRoleDefinition, rrd.Name)
 
 	// Remove finalizer.
 	old := rrd.DeepCopy()
@@ -38,6 +38,7 @@ import (
 	"github.com/telekom/auth-operator/pkg/helpers"
 	"github.com/telekom/auth-operator/pkg/indexer"
 	"github.com/telekom/auth-operator/pkg/metrics"
+	pkgssa "github.com/telekom/auth-operator/pkg/ssa"
 )
 
 // --- Standard Go tests (no envtest) ---
@@ -1160,6 +1161,52 @@ func TestRRD_EnsureRole_ClusterRole(t *testing.T) {
 	g.Expect(cr.Rules[0].Resources).To(ContainElement("pods"))
 }
 
+func TestRRD_EnsureRole_ConflictPolicy(t *testing.T) {
+	testCases := []struct {
+		policy    authorizationv1alpha1.ConflictPolicy
+		wantVerbs []string
+	}{
+		{policy: authorizationv1alpha1.ConflictPolicyForce, wantVerbs: []string{"get", "list"}},
+		{policy: authorizationv1alpha1.ConflictPolicyReport, wantVerbs: []string{"get"}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			g := NewWithT(t)
+
+			rrd := &authorizationv1alpha1.RestrictedRoleDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "conflict-rrd", UID: "uid-conflict"},
+				Spec: authorizationv1alpha1.RestrictedRoleDefinitionSpec{
+					TargetName:     "conflict-cluster-role",
+					TargetRole:     authorizationv1alpha1.DefinitionClusterRole,
+					ConflictPolicy: tc.policy,
+				},
+			}
+			r, c := newRRDTestReconcilerFake(rrd)
+
+			// Another tool applies the generated ClusterRole with different rules.
+			competingAC := pkgssa.ClusterRoleWithLabelsAndRules("conflict-cluster-role", nil, []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+			}).WithOwnerReferences(ownerRefForRestricted(rrd, authorizationv1alpha1.RestrictedRoleDefinitionKind))
+			g.Expect(c.Apply(rrdCtx(), competingAC, client.FieldOwner("helm"))).To(Succeed())
+
+			err := r.rrdEnsureRole(rrdCtx(), rrd, []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			}, c)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			var cr rbacv1.ClusterRole
+			g.Expect(c.Get(rrdCtx(), types.NamespacedName{Name: "conflict-cluster-role"}, &cr)).To(Succeed())
+			g.Expect(cr.Rules).To(HaveLen(1))
+			g.Expect(cr.Rules[0].Verbs).To(Equal(tc.wantVerbs))
+			g.Expect(rrd.Status.Conflicts).To(Equal([]authorizationv1alpha1.FieldManagerConflict{{
+				Kind:     "ClusterRole",
+				Name:     "conflict-cluster-role",
+				Managers: []string{"helm"},
+			}}))
+		})
+	}
+}
+
 func TestRRD_EnsureRole_DoesNotPropagateSourceLabels(t *testing.T) {
 	g := NewWithT(t)
 
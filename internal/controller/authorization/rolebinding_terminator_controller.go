@@ -54,8 +54,16 @@ const (
 	// Sized for the typical number of distinct API resource types in a cluster with CRDs.
 	// If the buffer is full, resource check goroutines will block until the collector drains it.
 	terminationResourceChannelSize = 100
+	// maxBlockingResourceNamesPerType bounds how many object names are kept per
+	// blocking resource type, so logs and events stay readable for namespaces
+	// holding thousands of objects.
+	maxBlockingResourceNamesPerType = 5
 )
 
+// DefaultNamespaceTerminationGracePeriod is how long a terminating namespace may
+// be blocked by remaining resources before the terminator reports it as stuck.
+const DefaultNamespaceTerminationGracePeriod = 30 * time.Minute
+
 var (
 	errNoControllingBindDefinitionOwner = errors.New("no controlling BindDefinition owner reference found")
 	errBindDefinitionOwnerUIDMismatch   = errors.New("BindDefinition owner reference UID mismatch")
@@ -66,6 +74,8 @@ type namespaceDeletionResourceBlocking struct {
 	ResourceType string // e.g., "pods", "persistentvolumeclaims"
 	APIGroup     string // e.g., "", "apps"
 	Count        int
+	// Names holds up to maxBlockingResourceNamesPerType sorted object names.
+	Names []string
 }
 
 // namespaceTerminationStatus caches the blocking resources for a namespace and manages access with a mutex.
@@ -76,6 +86,9 @@ type namespaceTerminationStatus struct {
 	lastError         error
 	fetchedAt         time.Time
 	rateLimiter       rate.Sometimes
+	// stuckReported is set once the grace-period warning has been emitted for
+	// the current termination, so sibling RoleBindings do not repeat it.
+	stuckReported bool
 }
 
 func newNamespaceTerminationStatus() *namespaceTerminationStatus {
@@ -100,6 +113,12 @@ type RoleBindingTerminator struct {
 	resourceTracker                    apiResourceProvider
 	recorder                           events.EventRecorder
 	namespaceTerminationResourcesCache sync.Map // map[string]*namespaceTerminationStatus
+	// terminationGracePeriod is how long a namespace may stay blocked before a
+	// warning event is emitted and it is counted as stuck. Zero disables reporting.
+	terminationGracePeriod time.Duration
+	// defaultTerminationPolicy applies to BindDefinitions without their own
+	// namespaceTermination policy. Nil means WaitForAll.
+	defaultTerminationPolicy *authorizationv1alpha1.NamespaceTerminationPolicy
 }
 
 // NewRoleBindingTerminator creates a new RoleBinding terminator.
@@ -124,6 +143,7 @@ func NewRoleBindingTerminator(
 		recorder:                           recorder,
 		resourceTracker:                    resourceTracker,
 		namespaceTerminationResourcesCache: sync.Map{},
+		terminationGracePeriod:             DefaultNamespaceTerminationGracePeriod,
 	}, nil
 }
 
@@ -287,11 +307,21 @@ func namespaceHasResources(ctx context.Context, resourceTracker apiResourceProvi
 
 				logger.V(2).Info("found resources in namespace - will NOT remove finalizers", "namespace", namespace, "gvr", gvr.String(), "itemCount", len(list.Items))
 
+				names := make([]string, 0, len(list.Items))
+				for i := range list.Items {
+					names = append(names, list.Items[i].GetName())
+				}
+				slices.Sort(names)
+				if len(names) > maxBlockingResourceNamesPerType {
+					names = names[:maxBlockingResourceNamesPerType]
+				}
+
 				// Add to blocking resources list
 				blockingResourcesChannel <- namespaceDeletionResourceBlocking{
 					ResourceType: resource.Name,
 					APIGroup:     gv.Group,
 					Count:        len(list.Items),
+					Names:        names,
 				}
 				return nil
 			})
@@ -326,7 +356,19 @@ func supportsList(verbs []string) bool {
 
 // formatBlockingResourcesMessage creates a count-only message about resources blocking namespace deletion.
 func formatBlockingResourcesMessage(blockingResources []namespaceDeletionResourceBlocking) string {
-	// Sort for deterministic event messages across reconciliation runs.
+	sortBlockingResources(blockingResources)
+
+	resourceDetails := make([]string, 0, len(blockingResources))
+
+	for _, rb := range blockingResources {
+		resourceDetails = append(resourceDetails, fmt.Sprintf("%s=%d", blockingResourceType(rb), rb.Count))
+	}
+
+	return strings.Join(resourceDetails, "; ")
+}
+
+// sortBlockingResources sorts for deterministic messages across reconciliation runs.
+func sortBlockingResources(blockingResources []namespaceDeletionResourceBlocking) {
 	slices.SortFunc(blockingResources, func(a, b namespaceDeletionResourceBlocking) int {
 		if a.ResourceType != b.ResourceType {
 			if a.ResourceType < b.ResourceType {
@@ -342,11 +384,25 @@ func formatBlockingResourcesMessage(blockingResources []namespaceDeletionResourc
 		}
 		return 0
 	})
+}
 
-	resourceDetails := make([]string, 0, len(blockingResources))
+// formatBlockingResourcesDetail extends formatBlockingResourcesMessage with a
+// sample of the blocking object names. It is used for logs and events only; the
+// namespace condition stays count-only to keep status writes small and stable.
+func formatBlockingResourcesDetail(blockingResources []namespaceDeletionResourceBlocking) string {
+	sortBlockingResources(blockingResources)
 
+	resourceDetails := make([]string, 0, len(blockingResources))
 	for _, rb := range blockingResources {
-		resourceDetails = append(resourceDetails, fmt.Sprintf("%s=%d", blockingResourceType(rb), rb.Count))
+		detail := fmt.Sprintf("%s=%d", blockingResourceType(rb), rb.Count)
+		if len(rb.Names) > 0 {
+			names := strings.Join(rb.Names, ", ")
+			if rb.Count > len(rb.Names) {
+				names = fmt.Sprintf("%s, ... +%d more", names, rb.Count-len(rb.Names))
+			}
+			detail = fmt.Sprintf("%s [%s]", detail, names)
+		}
+		resourceDetails = append(resourceDetails, detail)
 	}
 
 	return strings.Join(resourceDetails, "; ")
@@ -444,6 +500,58 @@ func extractNamespaceCondition(ns *corev1.Namespace, condType authorizationv1alp
 	return nil
 }
 
+// namespaceTerminationBlockedFor returns how long the namespace has been
+// terminating. It is derived from the deletion timestamp rather than the
+// condition transition time so it survives operator restarts.
+func namespaceTerminationBlockedFor(namespace *corev1.Namespace, now time.Time) time.Duration {
+	if namespace.DeletionTimestamp == nil {
+		return 0
+	}
+	return now.Sub(namespace.DeletionTimestamp.Time)
+}
+
+// namespaceTerminationWasBlocked reports whether the namespace currently carries
+// NamespaceTerminationBlockedCondition with status True.
+func namespaceTerminationWasBlocked(namespace *corev1.Namespace) bool {
+	for _, c := range namespace.Status.Conditions {
+		if string(c.Type) == string(authorizationv1alpha1.NamespaceTerminationBlockedCondition) {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// reportTerminationStuck emits a warning event on the namespace and marks it as
+// stuck once the blocked duration exceeds the configured grace period. The event
+// is emitted once per termination; the metric is kept up to date on every call.
+func (r *RoleBindingTerminator) reportTerminationStuck(ctx context.Context, namespace *corev1.Namespace, blockingResources []namespaceDeletionResourceBlocking, blockedFor time.Duration) {
+	if r.terminationGracePeriod <= 0 || blockedFor < r.terminationGracePeriod {
+		return
+	}
+	metrics.SetNamespaceTerminationStuck(namespace.Name, true)
+
+	v, _ := r.namespaceTerminationResourcesCache.LoadOrStore(namespace.Name, newNamespaceTerminationStatus())
+	nsTermStatus := v.(*namespaceTerminationStatus)
+	nsTermStatus.mutex.Lock()
+	alreadyReported := nsTermStatus.stuckReported
+	nsTermStatus.stuckReported = true
+	nsTermStatus.mutex.Unlock()
+	if alreadyReported {
+		return
+	}
+
+	detail := formatBlockingResourcesDetail(blockingResources)
+	log.FromContext(ctx).Info("namespace termination blocked longer than grace period",
+		"namespace", namespace.Name,
+		"blockedFor", blockedFor.Round(time.Second),
+		"gracePeriod", r.terminationGracePeriod,
+		"blockingResources", detail,
+	)
+	r.recorder.Eventf(namespace, nil, corev1.EventTypeWarning, authorizationv1alpha1.EventReasonNamespaceTerminationStuck, authorizationv1alpha1.EventActionReconcile,
+		"Namespace has been terminating for %s (grace period %s); RoleBinding finalizers are held until remaining resources are removed: %s",
+		blockedFor.Round(time.Second), r.terminationGracePeriod, detail)
+}
+
 func (r *RoleBindingTerminator) applyNamespaceTerminationStatus(ctx context.Context, namespace *corev1.Namespace) error {
 	condAC := extractNamespaceCondition(namespace, authorizationv1alpha1.NamespaceTerminationBlockedCondition)
 	if condAC == nil {
@@ -456,6 +564,64 @@ func (r *RoleBindingTerminator) applyNamespaceTerminationStatus(ctx context.Cont
 	return nil
 }
 
+// updateNamespaceTerminationState sets NamespaceTerminationBlockedCondition and
+// the termination metrics from the namespace-wide hold. The blocked duration is
+// observed once, when the last held RoleBinding is released and the condition
+// flips to False; sibling RoleBindings then see it already False.
+func (r *RoleBindingTerminator) updateNamespaceTerminationState(
+	ctx context.Context,
+	namespace *corev1.Namespace,
+	hold namespaceTerminationHold,
+	remainingResources []namespaceDeletionResourceBlocking,
+	blockedFor time.Duration,
+) error {
+	nsWrapper := conditions.NewNamespaceWrapper(namespace)
+	switch {
+	case len(hold.blocking) > 0:
+		r.reportTerminationStuck(ctx, namespace, hold.blocking, blockedFor)
+		conditions.MarkTrue(
+			nsWrapper,
+			authorizationv1alpha1.NamespaceTerminationBlockedCondition,
+			0,
+			authorizationv1alpha1.NamespaceTerminationBlockedReason,
+			conditions.ConditionMessage(fmt.Sprintf("%s: %s", authorizationv1alpha1.NamespaceTerminationBlockedMessage, formatBlockingResourcesMessage(hold.blocking))),
+		)
+	case len(remainingResources) > 0:
+		if namespaceTerminationWasBlocked(namespace) {
+			metrics.NamespaceTerminationBlockedDuration.Observe(blockedFor.Seconds())
+		}
+		metrics.SetNamespaceTerminationStuck(namespace.Name, false)
+		message := string(authorizationv1alpha1.NamespaceTerminationReleasedByPolicyMessage)
+		if len(hold.releasedBy) > 0 {
+			policies := make([]string, 0, len(hold.releasedBy))
+			for _, p := range hold.releasedBy {
+				policies = append(policies, string(p))
+			}
+			message += " (" + strings.Join(policies, ", ") + ")"
+		}
+		conditions.MarkFalse(
+			nsWrapper,
+			authorizationv1alpha1.NamespaceTerminationBlockedCondition,
+			0,
+			authorizationv1alpha1.NamespaceTerminationReleasedByPolicyReason,
+			conditions.ConditionMessage(fmt.Sprintf("%s: %s", message, formatBlockingResourcesMessage(remainingResources))),
+		)
+	default:
+		if namespaceTerminationWasBlocked(namespace) {
+			metrics.NamespaceTerminationBlockedDuration.Observe(blockedFor.Seconds())
+		}
+		metrics.SetNamespaceTerminationStuck(namespace.Name, false)
+		conditions.MarkFalse(
+			nsWrapper,
+			authorizationv1alpha1.NamespaceTerminationBlockedCondition,
+			0,
+			authorizationv1alpha1.NamespaceTerminationAllowedReason,
+			authorizationv1alpha1.NamespaceTerminationAllowedMessage,
+		)
+	}
+	return r.applyNamespaceTerminationStatus(ctx, namespace)
+}
+
 // Reconcile handles the reconciliation loop for RoleBinding resources owned by BindDefinitions.
 // It manages finalizer cleanup during namespace termination.
 func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -465,7 +631,7 @@ func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Track reconcile duration on exit
 	defer func() {
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerRoleBindingTerminator).Observe(time.Since(startTime).Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerRoleBindingTerminator, time.Since(startTime))
 	}()
 
 	// Fetching the RoleBinding from Kubernetes API
@@ -525,6 +691,7 @@ func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			// namespace is already deleted, nothing to do
+			metrics.SetNamespaceTerminationStuck(roleBinding.Namespace, false)
 			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultSkipped).Inc()
 			return ctrl.Result{}, nil
 		}
@@ -545,6 +712,7 @@ func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request)
 			logger.V(1).Info("removed finalizer from RoleBinding")
 			// Evict the cache entry — no longer needed for a non-terminating namespace.
 			r.namespaceTerminationResourcesCache.Delete(roleBinding.Namespace)
+			metrics.SetNamespaceTerminationStuck(roleBinding.Namespace, false)
 		}
 		// Namespace is not terminating — no blocking-resource check needed.
 		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultSuccess).Inc()
@@ -562,48 +730,50 @@ func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request)
 		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ErrorTypeAPI).Inc()
 		return ctrl.Result{}, err
 	}
+	blockedFor := namespaceTerminationBlockedFor(&namespace, time.Now())
+
+	// The namespace-wide blocking set is shared by all RoleBindings; the owning
+	// BindDefinition's policy decides which of those resources hold this one.
+	policy := r.terminationPolicyFor(bindDefinition)
+	remainingResources := blockingResources
+	blockingResources, releasedAfterTimeout := blockingResourcesForPolicy(policy, remainingResources, blockedFor)
 	terminationAllowed := len(blockingResources) == 0
 
+	// The namespace condition and termination metrics describe the namespace,
+	// so they follow the policies of all RoleBindings that are still held.
+	hold, err := r.namespaceTerminationHoldFor(ctx, namespace.Name, remainingResources, blockedFor)
+	if err != nil {
+		logger.Error(err, "failed to evaluate namespace termination policies", "namespace", namespace.Name)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, err
+	}
+	if err := r.updateNamespaceTerminationState(ctx, &namespace, hold, remainingResources, blockedFor); err != nil {
+		logger.Error(err, "failed to update Namespace termination status", "namespace", namespace.Name)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, err
+	}
+
 	if !terminationAllowed {
-		logger.V(1).Info("terminating namespace still has resources - NOT removing RoleBinding finalizer", "namespace", namespace.Name)
+		logger.V(1).Info("terminating namespace still has resources - NOT removing RoleBinding finalizer", "namespace", namespace.Name, "blockedFor", blockedFor.Round(time.Second))
 		for _, br := range blockingResources {
-			logger.V(1).Info("blocking resource found", "namespace", namespace.Name, "resourceType", blockingResourceType(br), "count", br.Count)
+			logger.V(1).Info("blocking resource found", "namespace", namespace.Name, "resourceType", blockingResourceType(br), "count", br.Count, "names", br.Names)
 		}
-
-		conditions.MarkTrue(
-			conditions.NewNamespaceWrapper(&namespace),
-			authorizationv1alpha1.NamespaceTerminationBlockedCondition,
-			0,
-			authorizationv1alpha1.NamespaceTerminationBlockedReason,
-			conditions.ConditionMessage(fmt.Sprintf("%s: %s", authorizationv1alpha1.NamespaceTerminationBlockedMessage, formatBlockingResourcesMessage(blockingResources))),
-		)
-		if err := r.applyNamespaceTerminationStatus(ctx, &namespace); err != nil {
-			logger.Error(err, "failed to update Namespace status with blocking resources information", "namespace", namespace.Name)
-			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultError).Inc()
-			metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ErrorTypeAPI).Inc()
-			return ctrl.Result{}, err
-		}
-
 		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultRequeue).Inc()
 		return ctrl.Result{RequeueAfter: terminatingNamespaceRequeueInterval}, nil
 	}
 
-	// No resources found - safe to remove finalizer from RoleBinding
+	// No resources hold this RoleBinding - safe to remove its finalizer
 	logger = logger.WithValues("bindDefinitionName", bindDefinition.Name)
 
-	logger.V(1).Info("terminating namespace has no more resources - proceeding to remove RoleBinding finalizers")
-	conditions.MarkFalse(
-		conditions.NewNamespaceWrapper(&namespace),
-		authorizationv1alpha1.NamespaceTerminationBlockedCondition,
-		0,
-		authorizationv1alpha1.NamespaceTerminationAllowedReason,
-		authorizationv1alpha1.NamespaceTerminationAllowedMessage,
-	)
-	if err := r.applyNamespaceTerminationStatus(ctx, &namespace); err != nil {
-		logger.Error(err, "failed to clear Namespace termination blocked status", "namespace", namespace.Name)
-		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ResultError).Inc()
-		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleBindingTerminator, metrics.ErrorTypeAPI).Inc()
-		return ctrl.Result{}, err
+	releasedByPolicy := len(remainingResources) > 0
+	if releasedByPolicy {
+		logger.V(1).Info("namespace termination policy releases RoleBinding finalizers despite remaining resources",
+			"finalizerRelease", policy.FinalizerRelease,
+			"remainingResources", formatBlockingResourcesMessage(remainingResources))
+	} else {
+		logger.V(1).Info("terminating namespace has no more resources - proceeding to remove RoleBinding finalizers")
 	}
 
 	if removed, err := r.removeRoleBindingFinalizer(ctx, &roleBinding); err != nil {
@@ -613,12 +783,25 @@ func (r *RoleBindingTerminator) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	} else if removed {
 		logger.V(2).Info("removing finalizer from RoleBinding in terminating namespace")
-		r.recorder.Eventf(bindDefinition, nil, corev1.EventTypeNormal, authorizationv1alpha1.EventReasonFinalizerRemoved, authorizationv1alpha1.EventActionFinalizerRemove, "Removed finalizer from RoleBinding %s in terminating namespace %s", roleBinding.Name, namespace.Name)
-		// Evict the cache entry — this RoleBinding's finalizer removal means
-		// termination cleanup is complete (all blocking resources already gone).
-		// The cache is retained WHILE blocking resources exist to avoid redundant
-		// API calls for sibling RoleBindings in the same namespace.
-		r.namespaceTerminationResourcesCache.Delete(namespace.Name)
+		if releasedByPolicy {
+			eventType := corev1.EventTypeNormal
+			if releasedAfterTimeout {
+				eventType = corev1.EventTypeWarning
+			}
+			metrics.RoleBindingFinalizerReleasedByPolicy.WithLabelValues(string(policy.FinalizerRelease)).Inc()
+			r.recorder.Eventf(bindDefinition, nil, eventType, authorizationv1alpha1.EventReasonFinalizerReleasedByPolicy, authorizationv1alpha1.EventActionFinalizerRemove,
+				"Released finalizer from RoleBinding %s in terminating namespace %s per %s policy; remaining resources: %s",
+				roleBinding.Name, namespace.Name, policy.FinalizerRelease, formatBlockingResourcesDetail(remainingResources))
+		} else {
+			r.recorder.Eventf(bindDefinition, nil, corev1.EventTypeNormal, authorizationv1alpha1.EventReasonFinalizerRemoved, authorizationv1alpha1.EventActionFinalizerRemove, "Removed finalizer from RoleBinding %s in terminating namespace %s", roleBinding.Name, namespace.Name)
+		}
+		// Evict the cache entry once no RoleBinding in the namespace is held.
+		// After a policy release the remaining resources may still exist, so the
+		// entry is retained while sibling RoleBindings are held to avoid redundant
+		// API calls and a repeated stuck report for the same termination.
+		if len(hold.blocking) == 0 {
+			r.namespaceTerminationResourcesCache.Delete(namespace.Name)
+		}
 		logger.V(1).Info("successfully removed finalizer from RoleBinding in terminating namespace")
 	} else {
 		logger.V(3).Info("RoleBinding does not have finalizer", "roleBindingName", roleBinding.Name)
@@ -11,7 +11,10 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"golang.org/x/time/rate"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +33,7 @@ import (
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	"github.com/telekom/auth-operator/pkg/discovery"
+	"github.com/telekom/auth-operator/pkg/metrics"
 )
 
 func newTestScheme() *runtime.Scheme {
@@ -37,6 +41,7 @@ func newTestScheme() *runtime.Scheme {
 	_ = authorizationv1alpha1.AddToScheme(s)
 	_ = rbacv1.AddToScheme(s)
 	_ = corev1.AddToScheme(s)
+	_ = admissionregistrationv1.AddToScheme(s)
 	return s
 }
 
@@ -215,6 +220,36 @@ func TestFormatBlockingResourcesMessage(t *testing.T) {
 	})
 }
 
+func TestFormatBlockingResourcesDetail(t *testing.T) {
+	t.Run("names are listed per type", func(t *testing.T) {
+		g := NewWithT(t)
+		resources := []namespaceDeletionResourceBlocking{
+			{ResourceType: "services", APIGroup: "", Count: 1, Names: []string{"svc-a"}},
+			{ResourceType: "pods", APIGroup: "", Count: 2, Names: []string{"pod-a", "pod-b"}},
+		}
+		msg := formatBlockingResourcesDetail(resources)
+		g.Expect(msg).To(Equal("pods=2 [pod-a, pod-b]; services=1 [svc-a]"))
+	})
+
+	t.Run("truncated names report the remainder", func(t *testing.T) {
+		g := NewWithT(t)
+		resources := []namespaceDeletionResourceBlocking{
+			{ResourceType: "deployments", APIGroup: "apps", Count: 12, Names: []string{"a", "b", "c", "d", "e"}},
+		}
+		msg := formatBlockingResourcesDetail(resources)
+		g.Expect(msg).To(Equal("deployments.apps=12 [a, b, c, d, e, ... +7 more]"))
+	})
+
+	t.Run("missing names fall back to counts", func(t *testing.T) {
+		g := NewWithT(t)
+		resources := []namespaceDeletionResourceBlocking{
+			{ResourceType: "configmaps", APIGroup: "", Count: 3},
+		}
+		msg := formatBlockingResourcesDetail(resources)
+		g.Expect(msg).To(Equal("configmaps=3"))
+	})
+}
+
 func TestGetOwningBindDefinition(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
@@ -1316,3 +1351,196 @@ func TestRBTerminator_NamespaceHasResources_NetworkRoleBindings(t *testing.T) {
 	g.Expect(condition.Message).To(ContainSubstring("networkrolebindings.network.example.com=1"))
 	g.Expect(condition.Message).NotTo(ContainSubstring("test-networkrb"))
 }
+
+func TestRBTerminator_NamespaceHasResources_CollectsSortedBoundedNames(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	podsGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podsGVR: "PodList"},
+	)
+
+	podList := &unstructured.UnstructuredList{}
+	podList.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "PodList"})
+	for _, name := range []string{"pod-g", "pod-c", "pod-a", "pod-f", "pod-b", "pod-e", "pod-d"} {
+		podList.Items = append(podList.Items, unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": "names-ns",
+				},
+			},
+		})
+	}
+	dynamicClient.PrependReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, podList, nil
+	})
+
+	resourceTracker := fakeAPIResourceProvider{
+		resources: discovery.APIResourcesByGroupVersion{
+			"v1": {{Name: "pods", Kind: "Pod", Verbs: []string{"list"}, Namespaced: true}},
+		},
+	}
+
+	blocking, err := namespaceHasResources(ctx, resourceTracker, dynamicClient, "names-ns")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(blocking).To(HaveLen(1))
+	g.Expect(blocking[0].Count).To(Equal(7))
+	g.Expect(blocking[0].Names).To(Equal([]string{"pod-a", "pod-b", "pod-c", "pod-d", "pod-e"}))
+}
+
+func blockedDurationSampleCount(t *testing.T) uint64 {
+	t.Helper()
+	m := &dto.Metric{}
+	if err := metrics.NamespaceTerminationBlockedDuration.Write(m); err != nil {
+		t.Fatalf("failed to read histogram: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+// stuckTerminationFixture builds a terminator for a namespace that has been
+// terminating for the given duration with a pre-populated blocking cache entry.
+func stuckTerminationFixture(t *testing.T, nsName string, terminatingFor time.Duration, blocking []namespaceDeletionResourceBlocking) (*RoleBindingTerminator, client.Client, *events.FakeRecorder) {
+	t.Helper()
+	scheme := newTestScheme()
+
+	now := metav1.Now()
+	deletedAt := metav1.NewTime(now.Add(-terminatingFor))
+	bdOwnerRef := testBindDefinitionControllerOwnerRef("test-bd", "bd-uid")
+	objs := []client.Object{
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              nsName,
+				DeletionTimestamp: &deletedAt,
+				Finalizers:        []string{"kubernetes"},
+			},
+			Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+		},
+		testBindDefinition(),
+	}
+	for _, name := range []string{"rb-1", "rb-2"} {
+		objs = append(objs, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         nsName,
+				OwnerReferences:   []metav1.OwnerReference{bdOwnerRef},
+				DeletionTimestamp: &now,
+				Finalizers:        []string{authorizationv1alpha1.RoleBindingFinalizer},
+			},
+			RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"},
+		})
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(objs[0]).
+		Build()
+	recorder := events.NewFakeRecorder(10)
+	r := &RoleBindingTerminator{
+		client:                 c,
+		scheme:                 scheme,
+		recorder:               recorder,
+		terminationGracePeriod: 30 * time.Minute,
+	}
+
+	cacheEntry := &namespaceTerminationStatus{blockingResources: blocking, rateLimiter: rate.Sometimes{}}
+	cacheEntry.rateLimiter.Do(func() {}) // burn first call
+	r.namespaceTerminationResourcesCache.Store(nsName, cacheEntry)
+	return r, c, recorder
+}
+
+func TestRBTerminatorReconcileStuckNamespaceEmitsWarningOnce(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, _, recorder := stuckTerminationFixture(t, "stuck-ns", time.Hour, []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", APIGroup: "", Count: 2, Names: []string{"pod-a", "pod-b"}},
+	})
+
+	for _, name := range []string{"rb-1", "rb-2"} {
+		result, err := r.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name, Namespace: "stuck-ns"},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(terminatingNamespaceRequeueInterval))
+	}
+
+	g.Expect(recorder.Events).To(HaveLen(1), "sibling RoleBindings must not repeat the warning")
+	event := <-recorder.Events
+	g.Expect(event).To(ContainSubstring(corev1.EventTypeWarning))
+	g.Expect(event).To(ContainSubstring(authorizationv1alpha1.EventReasonNamespaceTerminationStuck))
+	g.Expect(event).To(ContainSubstring("pods=2 [pod-a, pod-b]"))
+	g.Expect(testutil.ToFloat64(metrics.NamespaceTerminationStuck)).To(BeNumerically(">=", 1))
+
+	metrics.SetNamespaceTerminationStuck("stuck-ns", false)
+}
+
+func TestRBTerminatorReconcileWithinGracePeriodDoesNotWarn(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, _, recorder := stuckTerminationFixture(t, "recent-ns", time.Minute, []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", APIGroup: "", Count: 1, Names: []string{"pod-a"}},
+	})
+
+	_, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "recent-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recorder.Events).To(BeEmpty())
+}
+
+func TestRBTerminatorReconcileZeroGracePeriodDisablesWarning(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, _, recorder := stuckTerminationFixture(t, "disabled-ns", 24*time.Hour, []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", APIGroup: "", Count: 1, Names: []string{"pod-a"}},
+	})
+	r.terminationGracePeriod = 0
+
+	_, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "disabled-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recorder.Events).To(BeEmpty())
+}
+
+func TestRBTerminatorReconcileUnblockedNamespaceClearsStuckState(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, c, _ := stuckTerminationFixture(t, "recovering-ns", time.Hour, []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", APIGroup: "", Count: 1, Names: []string{"pod-a"}},
+	})
+	stuckBefore := testutil.ToFloat64(metrics.NamespaceTerminationStuck)
+
+	_, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "recovering-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(testutil.ToFloat64(metrics.NamespaceTerminationStuck)).To(Equal(stuckBefore + 1))
+
+	// Remaining resources are gone: replace the cache entry with an empty result.
+	cacheEntry := &namespaceTerminationStatus{rateLimiter: rate.Sometimes{}}
+	cacheEntry.rateLimiter.Do(func() {})
+	r.namespaceTerminationResourcesCache.Store("recovering-ns", cacheEntry)
+	observedBefore := blockedDurationSampleCount(t)
+
+	_, err = r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "recovering-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(testutil.ToFloat64(metrics.NamespaceTerminationStuck)).To(Equal(stuckBefore))
+	g.Expect(blockedDurationSampleCount(t)).To(Equal(observedBefore + 1))
+
+	updatedNS := &corev1.Namespace{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "recovering-ns"}, updatedNS)).To(Succeed())
+	condition := namespaceCondition(updatedNS, authorizationv1alpha1.NamespaceTerminationBlockedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// defaultNamespaceTerminationPolicy preserves the original terminator behavior:
+// keep the RoleBinding finalizer until no other namespaced resource is left.
+var defaultNamespaceTerminationPolicy = authorizationv1alpha1.NamespaceTerminationPolicy{
+	FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForAll,
+}
+
+// terminationPolicyFor returns the policy governing RoleBindings owned by the
+// given BindDefinition. A policy on the BindDefinition takes precedence over the
+// controller-wide default.
+func (r *RoleBindingTerminator) terminationPolicyFor(bindDefinition *authorizationv1alpha1.BindDefinition) authorizationv1alpha1.NamespaceTerminationPolicy {
+	if bindDefinition != nil && bindDefinition.Spec.NamespaceTermination != nil {
+		return *bindDefinition.Spec.NamespaceTermination
+	}
+	if r.defaultTerminationPolicy != nil {
+		return *r.defaultTerminationPolicy
+	}
+	return defaultNamespaceTerminationPolicy
+}
+
+// blockingResourcesForPolicy returns the subset of blocking resources that still
+// hold the RoleBinding finalizer under the given policy. timedOut reports that a
+// ReleaseAfterTimeout policy released the finalizer while resources remained.
+func blockingResourcesForPolicy(
+	policy authorizationv1alpha1.NamespaceTerminationPolicy,
+	blockingResources []namespaceDeletionResourceBlocking,
+	blockedFor time.Duration,
+) (held []namespaceDeletionResourceBlocking, timedOut bool) {
+	switch policy.FinalizerRelease {
+	case authorizationv1alpha1.FinalizerReleaseWaitForListed:
+		for _, br := range blockingResources {
+			for _, resourceType := range policy.ResourceTypes {
+				if blockingResourceType(br) == resourceType {
+					held = append(held, br)
+					break
+				}
+			}
+		}
+		return held, false
+	case authorizationv1alpha1.FinalizerReleaseAfterTimeout:
+		if len(blockingResources) > 0 && policy.Timeout != nil && blockedFor >= policy.Timeout.Duration {
+			return nil, true
+		}
+		return blockingResources, false
+	default:
+		return blockingResources, false
+	}
+}
+
+// namespaceTerminationHold is the namespace-wide outcome of the termination
+// policies of every BindDefinition that still holds a RoleBinding finalizer in a
+// terminating namespace. The namespace condition and termination metrics are
+// derived from it, so mixed policies in one namespace cannot flip them.
+type namespaceTerminationHold struct {
+	// blocking lists the resources that still hold at least one RoleBinding.
+	blocking []namespaceDeletionResourceBlocking
+	// releasedBy lists the policies that released RoleBindings while
+	// resources remained.
+	releasedBy []authorizationv1alpha1.FinalizerReleasePolicy
+}
+
+// namespaceTerminationHoldFor evaluates the termination policy of every
+// RoleBinding in the namespace that still carries the finalizer against the
+// remaining resources. RoleBindings without a valid BindDefinition owner are
+// skipped; their own reconcile removes the finalizer.
+func (r *RoleBindingTerminator) namespaceTerminationHoldFor(
+	ctx context.Context,
+	namespace string,
+	remainingResources []namespaceDeletionResourceBlocking,
+	blockedFor time.Duration,
+) (namespaceTerminationHold, error) {
+	var hold namespaceTerminationHold
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := r.client.List(ctx, roleBindings, client.InNamespace(namespace)); err != nil {
+		return hold, fmt.Errorf("list RoleBindings in namespace %s: %w", namespace, err)
+	}
+
+	heldTypes := map[string]bool{}
+	releasedPolicies := map[authorizationv1alpha1.FinalizerReleasePolicy]bool{}
+	policies := map[types.UID]authorizationv1alpha1.NamespaceTerminationPolicy{}
+	for i := range roleBindings.Items {
+		roleBinding := &roleBindings.Items[i]
+		if !controllerutil.ContainsFinalizer(roleBinding, authorizationv1alpha1.RoleBindingFinalizer) {
+			continue
+		}
+		ownerRef := metav1.GetControllerOf(roleBinding)
+		if ownerRef == nil {
+			continue
+		}
+		policy, ok := policies[ownerRef.UID]
+		if !ok {
+			bindDefinition, err := r.getOwningBindDefinition(ctx, roleBinding.OwnerReferences)
+			if err != nil {
+				if isInvalidBindDefinitionOwnerError(err) {
+					continue
+				}
+				return hold, err
+			}
+			policy = r.terminationPolicyFor(bindDefinition)
+			policies[ownerRef.UID] = policy
+		}
+
+		held, _ := blockingResourcesForPolicy(policy, remainingResources, blockedFor)
+		if len(held) == 0 {
+			if len(remainingResources) > 0 && !releasedPolicies[policy.FinalizerRelease] {
+				releasedPolicies[policy.FinalizerRelease] = true
+				hold.releasedBy = append(hold.releasedBy, policy.FinalizerRelease)
+			}
+			continue
+		}
+		for _, br := range held {
+			if resourceType := blockingResourceType(br); !heldTypes[resourceType] {
+				heldTypes[resourceType] = true
+				hold.blocking = append(hold.blocking, br)
+			}
+		}
+	}
+	sortBlockingResources(hold.blocking)
+	slices.Sort(hold.releasedBy)
+	return hold, nil
+}
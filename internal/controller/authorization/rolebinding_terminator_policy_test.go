@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func TestTerminationPolicyFor(t *testing.T) {
+	g := NewWithT(t)
+
+	global := &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseAfterTimeout,
+		Timeout:          &metav1.Duration{Duration: time.Hour},
+	}
+	own := &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForListed,
+		ResourceTypes:    []string{"pods"},
+	}
+	withPolicy := testBindDefinition()
+	withPolicy.Spec.NamespaceTermination = own
+
+	r := &RoleBindingTerminator{}
+	g.Expect(r.terminationPolicyFor(testBindDefinition()).FinalizerRelease).To(Equal(authorizationv1alpha1.FinalizerReleaseWaitForAll))
+
+	r.defaultTerminationPolicy = global
+	g.Expect(r.terminationPolicyFor(testBindDefinition())).To(Equal(*global))
+	g.Expect(r.terminationPolicyFor(withPolicy)).To(Equal(*own), "BindDefinition policy overrides the global default")
+}
+
+func TestBlockingResourcesForPolicy(t *testing.T) {
+	blocking := []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", Count: 1},
+		{ResourceType: "widgets", APIGroup: "example.com", Count: 2},
+	}
+
+	t.Run("wait for all holds every resource", func(t *testing.T) {
+		g := NewWithT(t)
+		held, timedOut := blockingResourcesForPolicy(defaultNamespaceTerminationPolicy, blocking, 24*time.Hour)
+		g.Expect(held).To(Equal(blocking))
+		g.Expect(timedOut).To(BeFalse())
+	})
+
+	t.Run("wait for listed holds only listed types", func(t *testing.T) {
+		g := NewWithT(t)
+		policy := authorizationv1alpha1.NamespaceTerminationPolicy{
+			FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForListed,
+			ResourceTypes:    []string{"widgets.example.com", "persistentvolumeclaims"},
+		}
+		held, timedOut := blockingResourcesForPolicy(policy, blocking, 0)
+		g.Expect(held).To(ConsistOf(blocking[1]))
+		g.Expect(timedOut).To(BeFalse())
+	})
+
+	t.Run("release after timeout holds until the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		policy := authorizationv1alpha1.NamespaceTerminationPolicy{
+			FinalizerRelease: authorizationv1alpha1.FinalizerReleaseAfterTimeout,
+			Timeout:          &metav1.Duration{Duration: time.Hour},
+		}
+		held, timedOut := blockingResourcesForPolicy(policy, blocking, 30*time.Minute)
+		g.Expect(held).To(Equal(blocking))
+		g.Expect(timedOut).To(BeFalse())
+
+		held, timedOut = blockingResourcesForPolicy(policy, blocking, 2*time.Hour)
+		g.Expect(held).To(BeEmpty())
+		g.Expect(timedOut).To(BeTrue())
+	})
+
+	t.Run("release after timeout without leftovers is not a timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		policy := authorizationv1alpha1.NamespaceTerminationPolicy{
+			FinalizerRelease: authorizationv1alpha1.FinalizerReleaseAfterTimeout,
+			Timeout:          &metav1.Duration{Duration: time.Hour},
+		}
+		held, timedOut := blockingResourcesForPolicy(policy, nil, 2*time.Hour)
+		g.Expect(held).To(BeEmpty())
+		g.Expect(timedOut).To(BeFalse())
+	})
+}
+
+func TestRBTerminatorReconcileWaitForListedReleasesUnlistedLeftovers(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, c, recorder := stuckTerminationFixture(t, "listed-ns", time.Minute, []namespaceDeletionResourceBlocking{
+		{ResourceType: "widgets", APIGroup: "example.com", Count: 1, Names: []string{"stuck-widget"}},
+	})
+	bd := &authorizationv1alpha1.BindDefinition{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "test-bd"}, bd)).To(Succeed())
+	bd.Spec.NamespaceTermination = &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForListed,
+		ResourceTypes:    []string{"pods", "persistentvolumeclaims"},
+	}
+	g.Expect(c.Update(ctx, bd)).To(Succeed())
+
+	result, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "listed-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(reconcile.Result{}))
+
+	err = c.Get(ctx, types.NamespacedName{Name: "rb-1", Namespace: "listed-ns"}, &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "RoleBinding should be released despite the unlisted widget")
+
+	updatedNS := &corev1.Namespace{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "listed-ns"}, updatedNS)).To(Succeed())
+	condition := namespaceCondition(updatedNS, authorizationv1alpha1.NamespaceTerminationBlockedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(string(authorizationv1alpha1.NamespaceTerminationReleasedByPolicyReason)))
+	g.Expect(condition.Message).To(ContainSubstring("widgets.example.com=1"))
+
+	g.Expect(recorder.Events).To(HaveLen(1))
+	event := <-recorder.Events
+	g.Expect(event).To(ContainSubstring(corev1.EventTypeNormal))
+	g.Expect(event).To(ContainSubstring(authorizationv1alpha1.EventReasonFinalizerReleasedByPolicy))
+	g.Expect(event).To(ContainSubstring("stuck-widget"))
+}
+
+func TestRBTerminatorReconcileWaitForListedKeepsListedResources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, c, _ := stuckTerminationFixture(t, "listed-held-ns", time.Minute, []namespaceDeletionResourceBlocking{
+		{ResourceType: "pods", Count: 2, Names: []string{"pod-a", "pod-b"}},
+		{ResourceType: "widgets", APIGroup: "example.com", Count: 1},
+	})
+	r.defaultTerminationPolicy = &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForListed,
+		ResourceTypes:    []string{"pods"},
+	}
+
+	result, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "listed-held-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(terminatingNamespaceRequeueInterval))
+
+	updatedNS := &corev1.Namespace{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "listed-held-ns"}, updatedNS)).To(Succeed())
+	condition := namespaceCondition(updatedNS, authorizationv1alpha1.NamespaceTerminationBlockedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Message).To(ContainSubstring("pods=2"))
+	g.Expect(condition.Message).NotTo(ContainSubstring("widgets"), "unlisted types do not hold the finalizer")
+}
+
+func TestRBTerminatorReconcileReleaseAfterTimeoutWarns(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, c, recorder := stuckTerminationFixture(t, "timeout-ns", 3*time.Hour, []namespaceDeletionResourceBlocking{
+		{ResourceType: "widgets", APIGroup: "example.com", Count: 1, Names: []string{"stuck-widget"}},
+	})
+	// Disable the stuck report so only the release event is recorded.
+	r.terminationGracePeriod = 0
+	r.defaultTerminationPolicy = &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseAfterTimeout,
+		Timeout:          &metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	_, err := r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "timeout-ns"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	err = c.Get(ctx, types.NamespacedName{Name: "rb-1", Namespace: "timeout-ns"}, &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	g.Expect(recorder.Events).To(HaveLen(1))
+	event := <-recorder.Events
+	g.Expect(event).To(ContainSubstring(corev1.EventTypeWarning))
+	g.Expect(event).To(ContainSubstring(authorizationv1alpha1.EventReasonFinalizerReleasedByPolicy))
+	g.Expect(event).To(ContainSubstring(string(authorizationv1alpha1.FinalizerReleaseAfterTimeout)))
+}
+
+func TestRBTerminatorReconcileMixedPoliciesKeepNamespaceBlocked(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r, c, _ := stuckTerminationFixture(t, "mixed-ns", time.Minute, []namespaceDeletionResourceBlocking{
+		{ResourceType: "widgets", APIGroup: "example.com", Count: 1, Names: []string{"stuck-widget"}},
+	})
+	// rb-2 belongs to a BindDefinition that releases unlisted leftovers, while
+	// rb-1 keeps the default WaitForAll policy.
+	listedBD := testBindDefinition()
+	listedBD.Name = "listed-bd"
+	listedBD.UID = "listed-bd-uid"
+	listedBD.Spec.NamespaceTermination = &authorizationv1alpha1.NamespaceTerminationPolicy{
+		FinalizerRelease: authorizationv1alpha1.FinalizerReleaseWaitForListed,
+		ResourceTypes:    []string{"pods"},
+	}
+	g.Expect(c.Create(ctx, listedBD)).To(Succeed())
+	rb2 := &rbacv1.RoleBinding{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "rb-2", Namespace: "mixed-ns"}, rb2)).To(Succeed())
+	rb2.OwnerReferences = []metav1.OwnerReference{testBindDefinitionControllerOwnerRef("listed-bd", "listed-bd-uid")}
+	g.Expect(c.Update(ctx, rb2)).To(Succeed())
+
+	blockedCondition := func() *corev1.NamespaceCondition {
+		ns := &corev1.Namespace{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "mixed-ns"}, ns)).To(Succeed())
+		return namespaceCondition(ns, authorizationv1alpha1.NamespaceTerminationBlockedCondition)
+	}
+
+	result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "rb-1", Namespace: "mixed-ns"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(terminatingNamespaceRequeueInterval))
+	g.Expect(blockedCondition().Status).To(Equal(corev1.ConditionTrue))
+
+	samplesBefore := blockedDurationSampleCount(t)
+	_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "rb-2", Namespace: "mixed-ns"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	err = c.Get(ctx, types.NamespacedName{Name: "rb-2", Namespace: "mixed-ns"}, &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "WaitForListed should release rb-2")
+
+	condition := blockedCondition()
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue), "rb-1 still holds the namespace")
+	g.Expect(condition.Message).To(ContainSubstring("widgets.example.com=1"))
+	g.Expect(blockedDurationSampleCount(t)).To(Equal(samplesBefore))
+	_, cached := r.namespaceTerminationResourcesCache.Load("mixed-ns")
+	g.Expect(cached).To(BeTrue(), "the blocking cache is kept while rb-1 is held")
+}
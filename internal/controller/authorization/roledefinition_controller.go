@@ -43,6 +43,8 @@ import (
 // +kubebuilder:rbac:groups=authorization.t-caas.telekom.com,resources=roledefinitions/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;patch;delete;escalate;bind
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;patch;delete;escalate;bind
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // Note: The controller requires broad object read access for dynamic role generation
 // and namespace-termination cleanup checks. This exposes object metadata/specs to
@@ -68,7 +70,7 @@ type RoleDefinitionReconciler struct {
 	reader          client.Reader
 	scheme          *runtime.Scheme
 	recorder        events.EventRecorder
-	resourceTracker *discovery.ResourceTracker
+	resourceTracker discovery.APIResourceSource
 	trackerEvents   chan event.TypedGenericEvent[client.Object]
 	tracer          trace.Tracer
 
@@ -76,6 +78,11 @@ type RoleDefinitionReconciler struct {
 	// support so an inert grant is surfaced rather than silently reported as
 	// reconciled. Optional: when nil the condition is set to Unknown.
 	capabilityDetector capabilityDetector
+
+	// impersonationGrantsEnabled opts in to spec.impersonationGrants. The
+	// generated bindings name arbitrary subjects, so whoever may write a
+	// RoleDefinition could otherwise grant impersonation to anyone.
+	impersonationGrantsEnabled bool
 }
 
 // setCapabilityDetector implements capabilityDetectorSetter.
@@ -83,6 +90,11 @@ func (r *RoleDefinitionReconciler) setCapabilityDetector(d capabilityDetector) {
 	r.capabilityDetector = d
 }
 
+// setImpersonationGrantsEnabled implements impersonationGrantsSetter.
+func (r *RoleDefinitionReconciler) setImpersonationGrantsEnabled(enabled bool) {
+	r.impersonationGrantsEnabled = enabled
+}
+
 type apiResourceAccess struct {
 	apiGroup string
 	name     string
@@ -93,8 +105,9 @@ type apiResourceAccess struct {
 func (r *RoleDefinitionReconciler) setTracer(t trace.Tracer) { r.tracer = t }
 
 // NewRoleDefinitionReconciler creates a new RoleDefinition reconciler.
-// Uses the manager's cached client for improved performance.
-func NewRoleDefinitionReconciler(cachedClient client.Client, scheme *runtime.Scheme, recorder events.EventRecorder, resourceTracker *discovery.ResourceTracker, opts ...ReconcilerOption) (*RoleDefinitionReconciler, error) {
+// Uses the manager's cached client for improved performance. The
+// resourceTracker is usually the live *discovery.ResourceTracker.
+func NewRoleDefinitionReconciler(cachedClient client.Client, scheme *runtime.Scheme, recorder events.EventRecorder, resourceTracker discovery.APIResourceSource, opts ...ReconcilerOption) (*RoleDefinitionReconciler, error) {
 	if resourceTracker == nil {
 		return nil, fmt.Errorf("resourceTracker cannot be nil")
 	}
@@ -134,12 +147,16 @@ func (r *RoleDefinitionReconciler) SetupWithManager(ctx context.Context, mgr ctr
 
 	return ctrl.NewControllerManagedBy(mgr).
 		// Watch RoleDefinitions with generation predicate (skip status-only updates)
-		For(&authorizationv1alpha1.RoleDefinition{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&authorizationv1alpha1.RoleDefinition{}, builder.WithPredicates(predicate.GenerationChangedPredicate{},
+			applyLatencyPredicate(metrics.ControllerRoleDefinition))).
 		// Watch owned ClusterRoles and Roles to detect external drift.
 		// Note: GenerationChangedPredicate is NOT applied here because RBAC
 		// resources do not increment metadata.generation on spec changes.
 		Owns(&rbacv1.ClusterRole{}).
 		Owns(&rbacv1.Role{}).
+		// Bindings are only owned for spec.impersonationGrants.
+		Owns(&rbacv1.ClusterRoleBinding{}).
+		Owns(&rbacv1.RoleBinding{}).
 		WatchesRawSource(crdTrackerChannel).
 		WithOptions(controller.TypedOptions[reconcile.Request]{MaxConcurrentReconciles: concurrency}).
 		Complete(r)
@@ -217,7 +234,7 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// Track reconcile duration on exit
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerRoleDefinition).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerRoleDefinition, duration)
 		logger.V(1).Info("=== Reconcile END ===",
 			"roleDefinition", req.Name,
 			"duration", duration.String())
@@ -236,6 +253,7 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		} else {
 			logger.V(1).Info("RoleDefinition not found (deleted), skipping reconcile",
 				"roleDefinition", req.Name)
+			metrics.DeleteApplyLatency(metrics.ControllerRoleDefinition, req.Name)
 			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ResultSkipped).Inc()
 		}
 		return ctrl.Result{}, err
@@ -279,6 +297,7 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		} else {
 			logger.V(1).Info("Delete reconcile completed successfully",
 				"roleDefinition", roleDefinition.Name)
+			metrics.DeleteApplyLatency(metrics.ControllerRoleDefinition, roleDefinition.Name)
 			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ResultFinalized).Inc()
 		}
 		return result, err
@@ -352,7 +371,12 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 	r.recordConstrainedImpersonationState(ctx, roleDefinition)
 
-	// Step 5: Ensure the target role exists with computed rules (or aggregation rule)
+	// Step 5: Ensure the target role exists with computed rules (or aggregation rule).
+	// Conflicts are collected by the role and impersonation grant applies and
+	// reported once the reconcile returns.
+	defer reportConflicts(ctx, r.recorder, roleDefinition, metrics.ControllerRoleDefinition,
+		roleDefinition.GetConflictPolicy(), roleDefinition.Status.Conflicts, &roleDefinition.Status.Conflicts)
+	roleDefinition.Status.Conflicts = nil
 	logger.V(2).Info("Ensuring role",
 		"roleDefinition", roleDefinition.Name,
 		"ruleCount", len(finalRules),
@@ -368,6 +392,24 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	logger.V(2).Info("Role ensured successfully",
 		"roleDefinition", roleDefinition.Name)
 
+	// Step 5.5: Generate one role and binding per correlated impersonation grant.
+	if err := r.ensureImpersonationGrants(ctx, roleDefinition); err != nil {
+		if errors.Is(err, ErrImpersonationGrantsDisabled) {
+			logger.Info("impersonationGrants are disabled on this controller",
+				"roleDefinition", roleDefinition.Name)
+			r.markStalled(ctx, roleDefinition, err)
+			metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ResultError).Inc()
+			metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ErrorTypeValidation).Inc()
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to ensure impersonation grants",
+			"roleDefinition", roleDefinition.Name)
+		r.markStalled(ctx, roleDefinition, err)
+		metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ResultError).Inc()
+		metrics.ReconcileErrors.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ErrorTypeAPI).Inc()
+		return ctrl.Result{}, err
+	}
+
 	// Step 6: Apply final status
 	logger.V(2).Info("Applying final status",
 		"roleDefinition", roleDefinition.Name,
@@ -383,6 +425,7 @@ func (r *RoleDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	logger.V(1).Info("Reconcile completed successfully",
 		"roleDefinition", roleDefinition.Name,
 		"requeueAfter", DefaultRequeueInterval)
+	metrics.ObserveApplyLatency(ctx, metrics.ControllerRoleDefinition, roleDefinition.Name, roleDefinition.Generation, metrics.ApplyOutcomeReady)
 	metrics.ReconcileTotal.WithLabelValues(metrics.ControllerRoleDefinition, metrics.ResultSuccess).Inc()
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
@@ -450,6 +493,8 @@ func (r *RoleDefinitionReconciler) discoverAndFilterResources(
 		return nil, ctrl.Result{}, err
 	}
 
+	setAPIDiscoveryDegradedCondition(roleDefinition, r.resourceTracker.DegradedGroupVersions())
+
 	// Filter API resources based on RoleDefinition spec
 	rulesByAPIGroupAndVerbs, err := r.filterAPIResourcesForRoleDefinition(ctx, roleDefinition, apiResources)
 	if err != nil {
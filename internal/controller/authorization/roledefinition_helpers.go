@@ -12,6 +12,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
 	rbacv1ac "k8s.io/client-go/applyconfigurations/rbac/v1"
@@ -24,6 +25,7 @@ import (
 	"github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/ssa"
 	"github.com/telekom/auth-operator/pkg/capabilities"
 	"github.com/telekom/auth-operator/pkg/conditions"
+	"github.com/telekom/auth-operator/pkg/discovery"
 	"github.com/telekom/auth-operator/pkg/helpers"
 	"github.com/telekom/auth-operator/pkg/metrics"
 	"github.com/telekom/auth-operator/pkg/policy"
@@ -201,6 +203,11 @@ func (r *RoleDefinitionReconciler) handleDeletion(
 		}
 	}
 
+	if err := r.pruneImpersonationGrants(ctx, roleDefinition, nil); err != nil {
+		logger.Error(err, "Failed to delete impersonation grant RBAC", "roleDefinitionName", roleDefinition.Name)
+		return r.markDeletionFailed(ctx, roleDefinition, err)
+	}
+
 	return r.removeRoleDefinitionFinalizer(ctx, roleDefinition)
 }
 
@@ -304,30 +311,8 @@ func (r *RoleDefinitionReconciler) buildFinalRules(
 	return finalRules
 }
 
-// ensureRole ensures the role (ClusterRole or Role) exists and is up-to-date using Server-Side Apply (SSA).
-// This unified function replaces the separate createRole and updateRole functions.
-// SSA handles both creation (if not exists) and update (if different) in a single operation.
-// Before applying, it checks whether the target role is already controlled by a different owner
-// to avoid silently taking over roles managed by other controllers.
-func (r *RoleDefinitionReconciler) ensureRole(
-	ctx context.Context,
-	roleDefinition *authorizationv1alpha1.RoleDefinition,
-	finalRules []rbacv1.PolicyRule,
-) error {
-	logger := log.FromContext(ctx)
-
-	// Pre-flight ownership check: verify the target role is not already controlled
-	// by a different owner. Kubernetes rejects multiple controller ownerReferences,
-	// and this check produces a clearer error/event than the raw API rejection.
-	if err := r.checkRoleOwnership(ctx, roleDefinition); err != nil {
-		conditions.MarkFalse(roleDefinition, authorizationv1alpha1.OwnerRefCondition, roleDefinition.Generation,
-			authorizationv1alpha1.OwnerRefReason, "ownership conflict (check operator logs for details)")
-		return err
-	}
-
-	ownerRef := ownerRefForRoleDefinition(roleDefinition)
-	annotations := helpers.BuildResourceAnnotations("RoleDefinition", roleDefinition.Name)
-
+// buildTargetRoleLabels returns the labels applied to the generated role.
+func buildTargetRoleLabels(roleDefinition *authorizationv1alpha1.RoleDefinition) map[string]string {
 	// Build labels: start with metadata + operator identification labels,
 	// then apply aggregation labels (ClusterRole-only) on top, and finally
 	// re-set operator identification labels so aggregationLabels can never
@@ -355,6 +340,36 @@ func (r *RoleDefinitionReconciler) ensureRole(
 			mergedLabels[authorizationv1alpha1.BreakglassCompatibleLabel] = "false"
 		}
 	}
+	return mergedLabels
+}
+
+// ensureRole ensures the role (ClusterRole or Role) exists and is up-to-date using Server-Side Apply (SSA).
+// This unified function replaces the separate createRole and updateRole functions.
+// SSA handles both creation (if not exists) and update (if different) in a single operation.
+// Before applying, it checks whether the target role is already controlled by a different owner
+// to avoid silently taking over roles managed by other controllers.
+func (r *RoleDefinitionReconciler) ensureRole(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	finalRules []rbacv1.PolicyRule,
+) error {
+	logger := log.FromContext(ctx)
+
+	// Pre-flight ownership check: verify the target role is not already controlled
+	// by a different owner. Kubernetes rejects multiple controller ownerReferences,
+	// and this check produces a clearer error/event than the raw API rejection.
+	if err := r.checkRoleOwnership(ctx, roleDefinition); err != nil {
+		conditions.MarkFalse(roleDefinition, authorizationv1alpha1.OwnerRefCondition, roleDefinition.Generation,
+			authorizationv1alpha1.OwnerRefReason, "ownership conflict (check operator logs for details)")
+		return err
+	}
+
+	ownerRef := ownerRefForRoleDefinition(roleDefinition)
+	annotations := helpers.BuildResourceAnnotations("RoleDefinition", roleDefinition.Name)
+
+	mergedLabels := buildTargetRoleLabels(roleDefinition)
+	conflictPolicy := roleDefinition.GetConflictPolicy()
+	applyOpts := conflictApplyOptions(ctx, conflictPolicy, &roleDefinition.Status.Conflicts)
 
 	// Apply the role using SSA with cache-aware diffing — skip if unchanged.
 	switch roleDefinition.Spec.TargetRole {
@@ -392,10 +407,14 @@ func (r *RoleDefinitionReconciler) ensureRole(
 				return err
 			}
 		}
-		// RoleDefinitions own their generated RBAC resources end-to-end. Force
-		// ownership here so drift correction can reclaim fields modified by
-		// external managers while shared SSA helpers remain non-forcing by default.
-		result, err := pkgssa.PatchApplyClusterRolePruningLabels(ctx, r.client, ac, isForbiddenRoleDefinitionAggregationLabel, client.ForceOwnership)
+		// RoleDefinitions own their generated RBAC resources end-to-end. Unless
+		// spec.conflictPolicy says otherwise, force ownership here so drift
+		// correction can reclaim fields modified by external managers while
+		// shared SSA helpers remain non-forcing by default.
+		result, err := pkgssa.PatchApplyClusterRolePruningLabels(ctx, r.client, ac, isForbiddenRoleDefinitionAggregationLabel, applyOpts...)
+		if conflictReported(conflictPolicy, err) {
+			break
+		}
 		if err != nil {
 			logger.Error(err, "Failed to apply ClusterRole via SSA",
 				"roleDefinitionName", roleDefinition.Name, "roleName", roleDefinition.Spec.TargetName)
@@ -416,10 +435,14 @@ func (r *RoleDefinitionReconciler) ensureRole(
 		if err := r.clearRoleRulesIfEmpty(ctx, roleDefinition, finalRules); err != nil {
 			return err
 		}
-		// RoleDefinitions own their generated RBAC resources end-to-end. Force
-		// ownership here so drift correction can reclaim fields modified by
-		// external managers while shared SSA helpers remain non-forcing by default.
-		result, err := pkgssa.PatchApplyRole(ctx, r.client, ac, client.ForceOwnership)
+		// RoleDefinitions own their generated RBAC resources end-to-end. Unless
+		// spec.conflictPolicy says otherwise, force ownership here so drift
+		// correction can reclaim fields modified by external managers while
+		// shared SSA helpers remain non-forcing by default.
+		result, err := pkgssa.PatchApplyRole(ctx, r.client, ac, applyOpts...)
+		if conflictReported(conflictPolicy, err) {
+			break
+		}
 		if err != nil {
 			logger.Error(err, "Failed to apply Role via SSA",
 				"roleDefinitionName", roleDefinition.Name, "roleName", roleDefinition.Spec.TargetName)
@@ -668,28 +691,114 @@ func (r *RoleDefinitionReconciler) clearRoleRulesIfEmpty(
 }
 
 // recordConstrainedImpersonationState sets the ConstrainedImpersonationEffective
-// condition and emits a Warning event when the generated grant would be inert.
+// condition and emits a Warning event when a generated grant would be inert.
 //
 // Backwards compatibility: a ClusterRole carrying impersonate:<mode> verbs is
 // accepted by every API server version, but only an API server with the
 // ConstrainedImpersonation feature gate ever matches it. Without this signal the
 // RoleDefinition would report Ready=true while granting nothing at all.
+//
+// spec.constrainedImpersonation and every spec.impersonationGrants entry are
+// evaluated separately. The condition takes the worst outcome (False, then
+// Unknown, then True) and its detail names each grant that reached it.
 func (r *RoleDefinitionReconciler) recordConstrainedImpersonationState(
 	ctx context.Context,
 	roleDefinition *authorizationv1alpha1.RoleDefinition,
 ) {
-	result := setConstrainedImpersonationCondition(
-		ctx,
-		roleDefinition,
-		roleDefinition.Generation,
-		roleDefinition.Spec.ConstrainedImpersonation,
-		roleDefinition.Spec.RestrictedVerbs,
-		r.capabilityDetector,
-	)
-	if roleDefinition.Spec.ConstrainedImpersonation == nil || result.State == capabilities.StateEnabled {
+	type labeledEvaluation struct {
+		label      string
+		evaluation constrainedImpersonationEvaluation
+	}
+	var evaluations []labeledEvaluation
+	if spec := roleDefinition.Spec.ConstrainedImpersonation; spec != nil {
+		evaluations = append(evaluations, labeledEvaluation{
+			label:      "spec.constrainedImpersonation",
+			evaluation: evaluateConstrainedImpersonation(ctx, spec, roleDefinition.Spec.RestrictedVerbs, r.capabilityDetector),
+		})
+	}
+	grants := roleDefinition.Spec.ImpersonationGrants
+	if !r.impersonationGrantsEnabled {
+		// Disabled grants are never generated, so there is nothing to evaluate.
+		grants = nil
+	}
+	for i := range grants {
+		grant := &grants[i]
+		// Grant roles carry only the generated constrained rules, so the legacy
+		// fallback is never reachable through them; only the feature gate matters.
+		evaluations = append(evaluations, labeledEvaluation{
+			label: fmt.Sprintf("grant %s", grant.Name),
+			evaluation: evaluateConstrainedImpersonation(ctx, &grant.Grant,
+				[]string{authorizationv1alpha1.LegacyImpersonateVerb}, r.capabilityDetector),
+		})
+	}
+	if len(evaluations) == 0 {
+		conditions.Delete(roleDefinition, authorizationv1alpha1.ConstrainedImpersonationCondition)
+		return
+	}
+
+	severity := func(status metav1.ConditionStatus) int {
+		switch status {
+		case metav1.ConditionFalse:
+			return 2
+		case metav1.ConditionUnknown:
+			return 1
+		default:
+			return 0
+		}
+	}
+	worst := evaluations[0].evaluation
+	for _, e := range evaluations[1:] {
+		if severity(e.evaluation.status) > severity(worst.status) {
+			worst = e.evaluation
+		}
+	}
+	detail := worst.detail
+	if len(evaluations) > 1 {
+		var details []string
+		for _, e := range evaluations {
+			if e.evaluation.status == worst.status {
+				details = append(details, fmt.Sprintf("%s: %s", e.label, e.evaluation.detail))
+			}
+		}
+		detail = strings.Join(details, "; ")
+	}
+	markConstrainedImpersonationCondition(roleDefinition, roleDefinition.Generation, worst, detail)
+	if worst.result.State == capabilities.StateEnabled {
 		return
 	}
 	r.recorder.Eventf(roleDefinition, nil, corev1.EventTypeWarning,
 		authorizationv1alpha1.EventReasonCreation, authorizationv1alpha1.EventActionReconcile,
-		"Constrained impersonation grant may not be effective: %s", result.Detail)
+		"Constrained impersonation grant may not be effective: %s", detail)
+}
+
+// setAPIDiscoveryDegradedCondition sets the APIDiscoveryDegraded condition
+// naming the failing GroupVersions the RoleDefinition resolves rules from, or
+// removes it once none of them fails. GroupVersions excluded entirely by
+// spec.restrictedApis cannot affect the generated role and are ignored. Stale
+// GroupVersions still contribute their last known good resources; omitted
+// ones contribute none.
+func setAPIDiscoveryDegradedCondition(
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	degraded []discovery.GroupVersionHealth,
+) {
+	groupVersions := make([]string, 0, len(degraded))
+	for _, gv := range degraded {
+		if groupVersion, err := schema.ParseGroupVersion(gv.GroupVersion); err == nil {
+			if verbs, restricted := restrictedVerbsForGroupVersion(roleDefinition, groupVersion); restricted && len(verbs) == 0 {
+				continue
+			}
+		}
+		state := "omitted"
+		if gv.Stale {
+			state = "stale"
+		}
+		groupVersions = append(groupVersions, fmt.Sprintf("%s (%s)", gv.GroupVersion, state))
+	}
+	if len(groupVersions) == 0 {
+		conditions.Delete(roleDefinition, authorizationv1alpha1.APIDiscoveryDegradedCondition)
+		return
+	}
+	conditions.MarkTrue(roleDefinition, authorizationv1alpha1.APIDiscoveryDegradedCondition, roleDefinition.Generation,
+		authorizationv1alpha1.APIDiscoveryDegradedReason, authorizationv1alpha1.APIDiscoveryDegradedMessage,
+		strings.Join(groupVersions, ", "))
 }
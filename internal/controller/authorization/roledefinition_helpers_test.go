@@ -22,6 +22,7 @@ import (
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	authorizationv1alpha1ac "github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/conditions"
 	"github.com/telekom/auth-operator/pkg/discovery"
 )
 
@@ -2193,3 +2194,49 @@ func TestCheckRoleOwnership_StaleCacheUnownedExistingRoleRejected(t *testing.T)
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("controlled by RoleDefinition other-rd"))
 }
+
+func TestSetAPIDiscoveryDegradedCondition(t *testing.T) {
+	g := NewWithT(t)
+	rd := &authorizationv1alpha1.RoleDefinition{ObjectMeta: metav1.ObjectMeta{Name: "rd", Generation: 3}}
+
+	setAPIDiscoveryDegradedCondition(rd, []discovery.GroupVersionHealth{
+		{GroupVersion: "custom.metrics.k8s.io/v1beta2"},
+		{GroupVersion: "metrics.k8s.io/v1beta1", Stale: true},
+	})
+	cond := conditions.Get(rd, authorizationv1alpha1.APIDiscoveryDegradedCondition)
+	g.Expect(cond).NotTo(BeNil())
+	g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(cond.Reason).To(Equal(string(authorizationv1alpha1.APIDiscoveryDegradedReason)))
+	g.Expect(cond.Message).To(Equal("API discovery is failing for group versions: " +
+		"custom.metrics.k8s.io/v1beta2 (omitted), metrics.k8s.io/v1beta1 (stale)"))
+	g.Expect(cond.ObservedGeneration).To(Equal(int64(3)))
+
+	setAPIDiscoveryDegradedCondition(rd, nil)
+	g.Expect(conditions.Has(rd, authorizationv1alpha1.APIDiscoveryDegradedCondition)).To(BeFalse())
+}
+
+func TestSetAPIDiscoveryDegradedConditionIgnoresRestrictedGroupVersions(t *testing.T) {
+	g := NewWithT(t)
+	rd := &authorizationv1alpha1.RoleDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "rd", Generation: 1},
+		Spec: authorizationv1alpha1.RoleDefinitionSpec{
+			RestrictedAPIs: []authorizationv1alpha1.RestrictedAPIGroup{
+				{Name: "metrics.k8s.io"},
+				{Name: "custom.metrics.k8s.io", Verbs: []string{"delete"}},
+			},
+		},
+	}
+
+	setAPIDiscoveryDegradedCondition(rd, []discovery.GroupVersionHealth{
+		{GroupVersion: "metrics.k8s.io/v1beta1", Stale: true},
+	})
+	g.Expect(conditions.Has(rd, authorizationv1alpha1.APIDiscoveryDegradedCondition)).To(BeFalse())
+
+	setAPIDiscoveryDegradedCondition(rd, []discovery.GroupVersionHealth{
+		{GroupVersion: "custom.metrics.k8s.io/v1beta2"},
+		{GroupVersion: "metrics.k8s.io/v1beta1", Stale: true},
+	})
+	cond := conditions.Get(rd, authorizationv1alpha1.APIDiscoveryDegradedCondition)
+	g.Expect(cond).NotTo(BeNil())
+	g.Expect(cond.Message).To(Equal("API discovery is failing for group versions: custom.metrics.k8s.io/v1beta2 (omitted)"))
+}
@@ -0,0 +1,69 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/discovery"
+	"github.com/telekom/auth-operator/pkg/helpers"
+)
+
+// RenderRoleDefinition returns the ClusterRole or Role the RoleDefinition
+// reconciler generates for roleDefinition from the API resources of source,
+// without contacting an API server. The result carries the same name, labels,
+// annotations, rules and aggregation rule the reconciler applies; owner
+// references are omitted because the RoleDefinition has no UID yet.
+func RenderRoleDefinition(
+	ctx context.Context,
+	roleDefinition *authorizationv1alpha1.RoleDefinition,
+	source discovery.APIResourceSource,
+) (client.Object, error) {
+	if roleDefinition.Spec.AggregateFrom != nil {
+		if err := authorizationv1alpha1.ValidateRoleDefinitionAggregateFrom(roleDefinition); err != nil {
+			return nil, err
+		}
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:        roleDefinition.Spec.TargetName,
+		Labels:      buildTargetRoleLabels(roleDefinition),
+		Annotations: helpers.BuildResourceAnnotations("RoleDefinition", roleDefinition.Name),
+	}
+
+	var finalRules []rbacv1.PolicyRule
+	if roleDefinition.Spec.AggregateFrom == nil {
+		apiResources, err := source.GetAPIResources()
+		if err != nil {
+			return nil, fmt.Errorf("get API resources: %w", err)
+		}
+		r := &RoleDefinitionReconciler{resourceTracker: source}
+		rulesByAPIGroupAndVerbs, err := r.filterAPIResourcesForRoleDefinition(ctx, roleDefinition, apiResources)
+		if err != nil {
+			return nil, err
+		}
+		finalRules = r.buildFinalRules(roleDefinition, rulesByAPIGroupAndVerbs)
+	}
+
+	switch roleDefinition.Spec.TargetRole {
+	case authorizationv1alpha1.DefinitionClusterRole:
+		return &rbacv1.ClusterRole{
+			TypeMeta:        metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+			ObjectMeta:      objectMeta,
+			Rules:           finalRules,
+			AggregationRule: roleDefinition.Spec.AggregateFrom,
+		}, nil
+	case authorizationv1alpha1.DefinitionNamespacedRole:
+		objectMeta.Namespace = roleDefinition.Spec.TargetNamespace
+		return &rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+			ObjectMeta: objectMeta,
+			Rules:      finalRules,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: got %q", ErrInvalidTargetRole, roleDefinition.Spec.TargetRole)
+	}
+}
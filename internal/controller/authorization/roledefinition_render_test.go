@@ -0,0 +1,107 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/discovery"
+)
+
+func TestRenderRoleDefinition(t *testing.T) {
+	source := discovery.NewSnapshotSource(discovery.APIResourcesByGroupVersion{
+		"v1": {
+			{Name: "pods", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "delete"}},
+			{Name: "nodes", Verbs: metav1.Verbs{"get", "list"}},
+		},
+		"apps/v1": {
+			{Name: "deployments", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+
+	t.Run("ClusterRole from snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		roleDefinition := &authorizationv1alpha1.RoleDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-reader"},
+			Spec: authorizationv1alpha1.RoleDefinitionSpec{
+				TargetRole:        authorizationv1alpha1.DefinitionClusterRole,
+				TargetName:        "tenant-reader",
+				ScopeNamespaced:   true,
+				RestrictedVerbs:   []string{"delete"},
+				AggregationLabels: map[string]string{"example.com/aggregate-to-view": "true"},
+			},
+		}
+
+		rendered, err := RenderRoleDefinition(context.Background(), roleDefinition, source)
+		g.Expect(err).NotTo(HaveOccurred())
+		clusterRole, ok := rendered.(*rbacv1.ClusterRole)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(clusterRole.Kind).To(Equal("ClusterRole"))
+		g.Expect(clusterRole.Name).To(Equal("tenant-reader"))
+		g.Expect(clusterRole.Labels).To(HaveKeyWithValue("example.com/aggregate-to-view", "true"))
+		g.Expect(clusterRole.Labels).To(HaveKeyWithValue(authorizationv1alpha1.BreakglassCompatibleLabel, "false"))
+		g.Expect(clusterRole.Rules).To(Equal([]rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "list"}},
+		}))
+	})
+
+	t.Run("namespaced Role", func(t *testing.T) {
+		g := NewWithT(t)
+		roleDefinition := &authorizationv1alpha1.RoleDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: authorizationv1alpha1.RoleDefinitionSpec{
+				TargetRole:          authorizationv1alpha1.DefinitionNamespacedRole,
+				TargetName:          "team-a",
+				TargetNamespace:     "team-a",
+				ScopeNamespaced:     true,
+				RestrictedResources: []metav1.APIResource{{Name: "pods"}},
+			},
+		}
+
+		rendered, err := RenderRoleDefinition(context.Background(), roleDefinition, source)
+		g.Expect(err).NotTo(HaveOccurred())
+		role, ok := rendered.(*rbacv1.Role)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(role.Namespace).To(Equal("team-a"))
+		g.Expect(role.Rules).To(Equal([]rbacv1.PolicyRule{
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "list"}},
+		}))
+	})
+
+	t.Run("aggregating ClusterRole skips discovery", func(t *testing.T) {
+		g := NewWithT(t)
+		aggregateFrom := &rbacv1.AggregationRule{ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"t-caas.telekom.com/rbac-fragment": "true", "t-caas.telekom.com/aggregate-scope": "view"}},
+		}}
+		roleDefinition := &authorizationv1alpha1.RoleDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "view"},
+			Spec: authorizationv1alpha1.RoleDefinitionSpec{
+				TargetRole:    authorizationv1alpha1.DefinitionClusterRole,
+				TargetName:    "view",
+				AggregateFrom: aggregateFrom,
+			},
+		}
+
+		rendered, err := RenderRoleDefinition(context.Background(), roleDefinition, source)
+		g.Expect(err).NotTo(HaveOccurred())
+		clusterRole := rendered.(*rbacv1.ClusterRole)
+		g.Expect(clusterRole.Rules).To(BeEmpty())
+		g.Expect(clusterRole.AggregationRule).To(Equal(aggregateFrom))
+	})
+
+	t.Run("invalid target role", func(t *testing.T) {
+		g := NewWithT(t)
+		roleDefinition := &authorizationv1alpha1.RoleDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken"},
+			Spec:       authorizationv1alpha1.RoleDefinitionSpec{TargetRole: "Invalid", TargetName: "broken"},
+		}
+
+		_, err := RenderRoleDefinition(context.Background(), roleDefinition, source)
+		g.Expect(err).To(MatchError(ErrInvalidTargetRole))
+	})
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// serviceAccountKey returns the "<namespace>/<name>" key of a ServiceAccount subject.
+func serviceAccountKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// serviceAccountLastUsed returns the last-used timestamp recorded on the
+// ServiceAccount, or the zero time when the annotation is missing or invalid.
+func serviceAccountLastUsed(sa *corev1.ServiceAccount) time.Time {
+	value, ok := sa.Annotations[authorizationv1alpha1.AnnotationKeyLastUsed]
+	if !ok {
+		return time.Time{}
+	}
+	lastUsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return lastUsed
+}
+
+// expiredServiceAccount returns the revocation record for a generated
+// ServiceAccount whose lifecycle expired at or before now, or nil when the
+// ServiceAccount is still within its lifecycle.
+func expiredServiceAccount(
+	lifecycle *authorizationv1alpha1.ServiceAccountLifecycle,
+	sa *corev1.ServiceAccount,
+	now time.Time,
+) *authorizationv1alpha1.RevokedServiceAccount {
+	expiresAt, reason, ok := lifecycle.Expiry(sa.CreationTimestamp.Time, serviceAccountLastUsed(sa))
+	if !ok || now.Before(expiresAt) {
+		return nil
+	}
+	return &authorizationv1alpha1.RevokedServiceAccount{
+		Namespace: sa.Namespace,
+		Name:      sa.Name,
+		Reason:    reason,
+		// metav1.Time serializes with second precision; truncate so the
+		// status comparison on later reconciles is stable.
+		RevokedAt: metav1.NewTime(now.UTC().Truncate(time.Second)),
+	}
+}
+
+// retainedRevokedServiceAccounts returns the revocation records that still
+// apply: the lifecycle is enabled and the ServiceAccount is still a subject.
+// Removing the lifecycle or the subject lifts the revocation.
+func retainedRevokedServiceAccounts(
+	lifecycle *authorizationv1alpha1.ServiceAccountLifecycle,
+	subjects []rbacv1.Subject,
+	revoked []authorizationv1alpha1.RevokedServiceAccount,
+) []authorizationv1alpha1.RevokedServiceAccount {
+	if !lifecycle.IsEnabled() || len(revoked) == 0 {
+		return nil
+	}
+	desired := make(map[string]struct{}, len(subjects))
+	for _, subject := range subjects {
+		if subject.Kind == authorizationv1alpha1.BindSubjectServiceAccount {
+			desired[serviceAccountKey(subject.Namespace, subject.Name)] = struct{}{}
+		}
+	}
+	var retained []authorizationv1alpha1.RevokedServiceAccount
+	for _, entry := range revoked {
+		if _, ok := desired[serviceAccountKey(entry.Namespace, entry.Name)]; ok {
+			retained = append(retained, entry)
+		}
+	}
+	return retained
+}
+
+// revokedServiceAccountKeys indexes revocation records by "<namespace>/<name>".
+func revokedServiceAccountKeys(revoked []authorizationv1alpha1.RevokedServiceAccount) map[string]struct{} {
+	keys := make(map[string]struct{}, len(revoked))
+	for _, entry := range revoked {
+		keys[serviceAccountKey(entry.Namespace, entry.Name)] = struct{}{}
+	}
+	return keys
+}
+
+// withoutRevokedServiceAccounts returns subjects without the revoked
+// ServiceAccounts. The input slice is not modified.
+func withoutRevokedServiceAccounts(
+	subjects []rbacv1.Subject,
+	revoked []authorizationv1alpha1.RevokedServiceAccount,
+) []rbacv1.Subject {
+	if len(revoked) == 0 {
+		return subjects
+	}
+	keys := revokedServiceAccountKeys(revoked)
+	bound := make([]rbacv1.Subject, 0, len(subjects))
+	for _, subject := range subjects {
+		if subject.Kind == authorizationv1alpha1.BindSubjectServiceAccount {
+			if _, ok := keys[serviceAccountKey(subject.Namespace, subject.Name)]; ok {
+				continue
+			}
+		}
+		bound = append(bound, subject)
+	}
+	return bound
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func TestExpiredServiceAccount(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	lifecycle := &authorizationv1alpha1.ServiceAccountLifecycle{
+		TTL:             &metav1.Duration{Duration: 24 * time.Hour},
+		IdleRevokeAfter: &metav1.Duration{Duration: time.Hour},
+	}
+	sa := func(created time.Time, lastUsed string) *corev1.ServiceAccount {
+		obj := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+			Name: "deployer", Namespace: "team-a", CreationTimestamp: metav1.NewTime(created),
+		}}
+		if lastUsed != "" {
+			obj.Annotations = map[string]string{authorizationv1alpha1.AnnotationKeyLastUsed: lastUsed}
+		}
+		return obj
+	}
+
+	t.Run("recently used", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(expiredServiceAccount(lifecycle, sa(now.Add(-2*time.Hour), now.Add(-time.Minute).Format(time.RFC3339)), now)).To(BeNil())
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		g := NewWithT(t)
+		entry := expiredServiceAccount(lifecycle, sa(now.Add(-3*time.Hour), now.Add(-2*time.Hour).Format(time.RFC3339)), now)
+		g.Expect(entry).NotTo(BeNil())
+		g.Expect(entry.Reason).To(Equal(authorizationv1alpha1.ServiceAccountRevocationReasonIdle))
+		g.Expect(entry.Namespace).To(Equal("team-a"))
+		g.Expect(entry.Name).To(Equal("deployer"))
+		g.Expect(entry.RevokedAt.Time).To(Equal(now))
+	})
+
+	t.Run("invalid annotation falls back to creation", func(t *testing.T) {
+		g := NewWithT(t)
+		entry := expiredServiceAccount(lifecycle, sa(now.Add(-2*time.Hour), "yesterday"), now)
+		g.Expect(entry).NotTo(BeNil())
+		g.Expect(entry.Reason).To(Equal(authorizationv1alpha1.ServiceAccountRevocationReasonIdle))
+	})
+
+	t.Run("ttl expired", func(t *testing.T) {
+		g := NewWithT(t)
+		entry := expiredServiceAccount(lifecycle, sa(now.Add(-25*time.Hour), now.Format(time.RFC3339)), now)
+		g.Expect(entry).NotTo(BeNil())
+		g.Expect(entry.Reason).To(Equal(authorizationv1alpha1.ServiceAccountRevocationReasonTTLExpired))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(expiredServiceAccount(nil, sa(now.Add(-100*time.Hour), ""), now)).To(BeNil())
+	})
+}
+
+func TestRetainedRevokedServiceAccounts(t *testing.T) {
+	g := NewWithT(t)
+	lifecycle := &authorizationv1alpha1.ServiceAccountLifecycle{TTL: &metav1.Duration{Duration: time.Hour}}
+	subjects := []rbacv1.Subject{
+		{Kind: authorizationv1alpha1.BindSubjectServiceAccount, Namespace: "team-a", Name: "kept"},
+		{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-a/removed"},
+	}
+	revoked := []authorizationv1alpha1.RevokedServiceAccount{
+		{Namespace: "team-a", Name: "kept", Reason: authorizationv1alpha1.ServiceAccountRevocationReasonTTLExpired},
+		{Namespace: "team-a", Name: "removed", Reason: authorizationv1alpha1.ServiceAccountRevocationReasonIdle},
+	}
+
+	retained := retainedRevokedServiceAccounts(lifecycle, subjects, revoked)
+	g.Expect(retained).To(HaveLen(1))
+	g.Expect(retained[0].Name).To(Equal("kept"))
+
+	g.Expect(retainedRevokedServiceAccounts(nil, subjects, revoked)).To(BeEmpty())
+}
+
+func TestWithoutRevokedServiceAccounts(t *testing.T) {
+	g := NewWithT(t)
+	subjects := []rbacv1.Subject{
+		{Kind: authorizationv1alpha1.BindSubjectServiceAccount, Namespace: "team-a", Name: "revoked"},
+		{Kind: authorizationv1alpha1.BindSubjectServiceAccount, Namespace: "team-b", Name: "revoked"},
+		{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "revoked"},
+	}
+	revoked := []authorizationv1alpha1.RevokedServiceAccount{{Namespace: "team-a", Name: "revoked"}}
+
+	bound := withoutRevokedServiceAccounts(subjects, revoked)
+	g.Expect(bound).To(Equal(subjects[1:]))
+	g.Expect(subjects).To(HaveLen(3))
+	g.Expect(withoutRevokedServiceAccounts(subjects, nil)).To(Equal(subjects))
+}
+
+func TestEnsureServiceAccountsRevokesExpired(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	_ = authorizationv1alpha1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+
+	setup := func(action authorizationv1alpha1.ServiceAccountExpiryAction) (*BindDefinitionReconciler, client.Client, *authorizationv1alpha1.BindDefinition) {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "sa-ns"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		}
+		bindDef := &authorizationv1alpha1.BindDefinition{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: authorizationv1alpha1.GroupVersion.String(),
+				Kind:       "BindDefinition",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "lifecycle-bd", UID: "lifecycle-uid"},
+			Spec: authorizationv1alpha1.BindDefinitionSpec{
+				TargetName: "lifecycle",
+				Subjects: []rbacv1.Subject{
+					{Kind: "ServiceAccount", Name: "old-sa", Namespace: "sa-ns"},
+				},
+				ServiceAccountLifecycle: &authorizationv1alpha1.ServiceAccountLifecycle{
+					TTL:    &metav1.Duration{Duration: time.Hour},
+					Action: action,
+				},
+			},
+			Status: authorizationv1alpha1.BindDefinitionStatus{
+				GeneratedServiceAccounts: []rbacv1.Subject{
+					{Kind: "ServiceAccount", Name: "old-sa", Namespace: "sa-ns"},
+				},
+			},
+		}
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+			Name:              "old-sa",
+			Namespace:         "sa-ns",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: authorizationv1alpha1.GroupVersion.String(),
+				Kind:       "BindDefinition",
+				Name:       bindDef.Name,
+				UID:        bindDef.UID,
+			}},
+		}}
+
+		c := fake.NewClientBuilder().WithScheme(s).
+			WithObjects(bindDef, ns, sa).
+			WithStatusSubresource(bindDef).
+			Build()
+		return &BindDefinitionReconciler{client: c, scheme: s, recorder: events.NewFakeRecorder(10)}, c, bindDef
+	}
+
+	t.Run("Unbind keeps the ServiceAccount", func(t *testing.T) {
+		g := NewWithT(t)
+		r, c, bindDef := setup(authorizationv1alpha1.ServiceAccountExpiryActionUnbind)
+
+		generatedSAs, _, err := r.ensureServiceAccounts(ctx, bindDef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(generatedSAs).To(HaveLen(1))
+		g.Expect(bindDef.Status.RevokedServiceAccounts).To(HaveLen(1))
+		g.Expect(bindDef.Status.RevokedServiceAccounts[0].Reason).To(Equal(authorizationv1alpha1.ServiceAccountRevocationReasonTTLExpired))
+		g.Expect(withoutRevokedServiceAccounts(bindDef.Spec.Subjects, bindDef.Status.RevokedServiceAccounts)).To(BeEmpty())
+		g.Expect(c.Get(ctx, client.ObjectKey{Name: "old-sa", Namespace: "sa-ns"}, &corev1.ServiceAccount{})).To(Succeed())
+
+		// A later reconcile keeps the revocation without recording it again.
+		generatedSAs, _, err = r.ensureServiceAccounts(ctx, bindDef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(generatedSAs).To(HaveLen(1))
+		g.Expect(bindDef.Status.RevokedServiceAccounts).To(HaveLen(1))
+	})
+
+	t.Run("Delete removes the ServiceAccount", func(t *testing.T) {
+		g := NewWithT(t)
+		r, c, bindDef := setup(authorizationv1alpha1.ServiceAccountExpiryActionDelete)
+
+		generatedSAs, _, err := r.ensureServiceAccounts(ctx, bindDef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(generatedSAs).To(BeEmpty())
+		g.Expect(bindDef.Status.RevokedServiceAccounts).To(HaveLen(1))
+		err = c.Get(ctx, client.ObjectKey{Name: "old-sa", Namespace: "sa-ns"}, &corev1.ServiceAccount{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// serviceAccountTokenExpirationSeconds is the lifetime requested for apply
+	// tokens. The API server may shorten it; the returned expiry is honoured.
+	serviceAccountTokenExpirationSeconds int64 = 3600
+	// serviceAccountTokenRefreshFraction is the fraction of a token's lifetime
+	// after which it is replaced, so a request never goes out with a token that
+	// expires in flight.
+	serviceAccountTokenRefreshFraction = 0.8
+	// serviceAccountTokenMinRefreshInterval bounds how often a token is minted
+	// when the API server returns a zero or already past expiry, so such a
+	// token does not trigger a TokenRequest on every request.
+	serviceAccountTokenMinRefreshInterval = 10 * time.Second
+	// tokenRequestCacheKeyPrefix separates TokenRequest clients from
+	// impersonated clients for the same ServiceAccount in the client cache.
+	tokenRequestCacheKeyPrefix = "tokenrequest:"
+)
+
+// tokenRequestFunc mints a token for the ServiceAccount namespace/name and
+// returns it with its expiry.
+type tokenRequestFunc func(ctx context.Context, namespace, name string) (string, time.Time, error)
+
+// serviceAccountTokenSource hands out a cached token for one ServiceAccount
+// and mints a new one through the TokenRequest API once the cached token is
+// past serviceAccountTokenRefreshFraction of its lifetime, or after the
+// token was rejected. It is safe for concurrent use.
+type serviceAccountTokenSource struct {
+	namespace string
+	name      string
+	request   tokenRequestFunc
+	now       func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+func newServiceAccountTokenSource(namespace, name string, request tokenRequestFunc) *serviceAccountTokenSource {
+	return &serviceAccountTokenSource{namespace: namespace, name: name, request: request, now: time.Now}
+}
+
+// Token returns a valid token, minting a new one when needed. The lock is held
+// while minting so concurrent requests share one TokenRequest.
+func (s *serviceAccountTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issuedAt := s.now()
+	if s.token != "" && issuedAt.Before(s.refreshAt) {
+		return s.token, nil
+	}
+	token, expiresAt, err := s.request(ctx, s.namespace, s.name)
+	if err != nil {
+		return "", fmt.Errorf("request token for serviceaccount %s/%s: %w", s.namespace, s.name, err)
+	}
+	if token == "" {
+		return "", fmt.Errorf("request token for serviceaccount %s/%s: empty token returned", s.namespace, s.name)
+	}
+	refreshIn := time.Duration(float64(expiresAt.Sub(issuedAt)) * serviceAccountTokenRefreshFraction)
+	refreshIn = max(refreshIn, serviceAccountTokenMinRefreshInterval)
+	s.token = token
+	s.refreshAt = issuedAt.Add(refreshIn)
+	return token, nil
+}
+
+// Invalidate drops token from the cache so the next call to Token mints a new
+// one. A token that was already replaced is left alone, so concurrent
+// rejections of the same token cause a single TokenRequest.
+func (s *serviceAccountTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+		s.refreshAt = time.Time{}
+	}
+}
+
+// bearerTokenRoundTripper authenticates every request with the current token
+// of its source. A 401 response invalidates the token and the request is sent
+// once more with a freshly minted one, which recovers from a ServiceAccount
+// that was deleted and recreated before the cached token was due for refresh.
+type bearerTokenRoundTripper struct {
+	source *serviceAccountTokenSource
+	next   http.RoundTripper
+}
+
+func (rt *bearerTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := rt.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rt.roundTripWithToken(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	rt.source.Invalidate(token)
+	// The retry needs a fresh copy of the body; without one the 401 is final.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	retryToken, err := rt.source.Token(req.Context())
+	if err != nil {
+		// Keep the original 401 rather than replacing it with the mint error.
+		return resp, nil
+	}
+	retry := req
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
+	}
+	_ = resp.Body.Close()
+	return rt.roundTripWithToken(retry, retryToken)
+}
+
+func (rt *bearerTokenRoundTripper) roundTripWithToken(req *http.Request, token string) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return rt.next.RoundTrip(req)
+}
+
+// newServiceAccountTokenClient builds a client that authenticates as the
+// ServiceAccount with tokens from source. The operator's own credentials are
+// stripped from the config; only the server address and TLS trust are kept.
+func newServiceAccountTokenClient(
+	cfg *rest.Config,
+	scheme *runtime.Scheme,
+	source *serviceAccountTokenSource,
+) (client.Client, error) {
+	tokenConfig := rest.AnonymousClientConfig(cfg)
+	tokenConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &bearerTokenRoundTripper{source: source, next: rt}
+	})
+
+	tokenClient, err := client.New(tokenConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("create token client for serviceaccount %s/%s: %w", source.namespace, source.name, err)
+	}
+	return tokenClient, nil
+}
+
+// tokenRequester returns a tokenRequestFunc that mints tokens with the
+// operator's own client, which needs create on serviceaccounts/token.
+func tokenRequester(c client.Client) tokenRequestFunc {
+	return func(ctx context.Context, namespace, name string) (string, time.Time, error) {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		tokenRequest := &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: ptr.To(serviceAccountTokenExpirationSeconds),
+			},
+		}
+		if err := c.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+			return "", time.Time{}, err
+		}
+		return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+	}
+}
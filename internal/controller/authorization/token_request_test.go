@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func TestServiceAccountTokenSource(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	calls := 0
+	source := newServiceAccountTokenSource("team-a", "applier", func(_ context.Context, namespace, name string) (string, time.Time, error) {
+		g.Expect(namespace).To(gomega.Equal("team-a"))
+		g.Expect(name).To(gomega.Equal("applier"))
+		calls++
+		return "token-" + string(rune('0'+calls)), now.Add(time.Hour), nil
+	})
+	source.now = func() time.Time { return now }
+
+	token, err := source.Token(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(token).To(gomega.Equal("token-1"))
+
+	now = start.Add(40 * time.Minute)
+	token, err = source.Token(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(token).To(gomega.Equal("token-1"), "a token within its refresh window is reused")
+
+	now = start.Add(50 * time.Minute)
+	token, err = source.Token(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(token).To(gomega.Equal("token-2"), "a token past 80% of its lifetime is replaced")
+	g.Expect(calls).To(gomega.Equal(2))
+}
+
+func TestServiceAccountTokenSourcePastExpiry(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	calls := 0
+	source := newServiceAccountTokenSource("team-a", "applier", func(context.Context, string, string) (string, time.Time, error) {
+		calls++
+		// A zero expiry lies in the past and yields a negative lifetime.
+		return "token-" + string(rune('0'+calls)), time.Time{}, nil
+	})
+	source.now = func() time.Time { return now }
+
+	for range 3 {
+		token, err := source.Token(context.Background())
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(token).To(gomega.Equal("token-1"))
+	}
+	g.Expect(calls).To(gomega.Equal(1), "a token without a usable expiry is reused for the minimum refresh interval")
+
+	now = start.Add(serviceAccountTokenMinRefreshInterval)
+	token, err := source.Token(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(token).To(gomega.Equal("token-2"))
+}
+
+func TestBearerTokenRoundTripperRetriesUnauthorized(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	calls := 0
+	source := newServiceAccountTokenSource("team-a", "applier", func(context.Context, string, string) (string, time.Time, error) {
+		calls++
+		return "token-" + string(rune('0'+calls)), time.Now().Add(time.Hour), nil
+	})
+
+	var (
+		authorizations []string
+		bodies         []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt := &bearerTokenRoundTripper{source: source, next: http.DefaultTransport}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	resp, err := rt.RoundTrip(req)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_ = resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(authorizations).To(gomega.Equal([]string{"Bearer token-1", "Bearer token-2"}))
+	g.Expect(bodies).To(gomega.Equal([]string{"payload", "payload"}), "the retry replays the request body")
+	g.Expect(req.Header.Get("Authorization")).To(gomega.BeEmpty(), "the caller's request is not modified")
+
+	// The replacement token stays cached for later requests.
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	resp, err = rt.RoundTrip(req)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_ = resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(calls).To(gomega.Equal(2))
+}
+
+func TestBearerTokenRoundTripperRetriesUnauthorizedOnce(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	calls := 0
+	source := newServiceAccountTokenSource("team-a", "applier", func(context.Context, string, string) (string, time.Time, error) {
+		calls++
+		return "token-" + string(rune('0'+calls)), time.Now().Add(time.Hour), nil
+	})
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	rt := &bearerTokenRoundTripper{source: source, next: http.DefaultTransport}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	resp, err := rt.RoundTrip(req)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_ = resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusUnauthorized))
+	g.Expect(requests).To(gomega.Equal(2), "a rejected request is retried only once")
+	g.Expect(calls).To(gomega.Equal(2))
+}
+
+func TestServiceAccountTokenSourceError(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	source := newServiceAccountTokenSource("team-a", "applier", func(context.Context, string, string) (string, time.Time, error) {
+		return "", time.Time{}, errors.New("serviceaccounts/token is forbidden")
+	})
+
+	_, err := source.Token(context.Background())
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("request token for serviceaccount team-a/applier")))
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("forbidden")))
+}
+
+func TestResolvePolicyApplyClientTokenRequest(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newTestScheme()
+
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "applier"}}
+	baseClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sa).Build()
+
+	var (
+		mu      sync.Mutex
+		headers []http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"]}`))
+		case "/apis":
+			_, _ = w.Write([]byte(`{"kind":"APIGroupList","groups":[]}`))
+		case "/api/v1":
+			_, _ = w.Write([]byte(`{"kind":"APIResourceList","groupVersion":"v1","resources":[` +
+				`{"name":"configmaps","namespaced":true,"kind":"ConfigMap","verbs":["get"]}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"probe","namespace":"team-a"}}`))
+		}
+	}))
+	defer server.Close()
+
+	policy := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy-token-request"},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			Impersonation: &authorizationv1alpha1.ImpersonationConfig{
+				Enabled:           true,
+				ServiceAccountRef: &authorizationv1alpha1.SARef{Namespace: "team-a", Name: "applier"},
+				CredentialSource:  authorizationv1alpha1.ImpersonationCredentialSourceTokenRequest,
+			},
+		},
+	}
+	cfg := &rest.Config{Host: server.URL, BearerToken: "operator-token"}
+	cache := newImpersonatedClientCache()
+
+	applyClient, username, err := resolvePolicyApplyClient(baseClient, scheme, cfg, policy, nil, cache)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(username).To(gomega.Equal("system:serviceaccount:team-a:applier"))
+
+	cached, _, err := resolvePolicyApplyClient(baseClient, scheme, cfg, policy, nil, cache)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(cached).To(gomega.BeIdenticalTo(applyClient), "the token client is cached")
+
+	var cm corev1.ConfigMap
+	g.Expect(applyClient.Get(context.Background(), client.ObjectKey{Namespace: "team-a", Name: "probe"}, &cm)).To(gomega.Succeed())
+
+	mu.Lock()
+	defer mu.Unlock()
+	g.Expect(headers).NotTo(gomega.BeEmpty())
+	for _, h := range headers {
+		// The fake client mints "fake-token" for every TokenRequest.
+		g.Expect(h.Get("Authorization")).To(gomega.Equal("Bearer fake-token"))
+		g.Expect(h.Get("Impersonate-User")).To(gomega.BeEmpty(), "TokenRequest credentials must not impersonate")
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 	"github.com/telekom/auth-operator/api/authorization/v1alpha1/applyconfiguration/ssa"
+	"github.com/telekom/auth-operator/pkg/capabilities"
 	"github.com/telekom/auth-operator/pkg/conditions"
 	"github.com/telekom/auth-operator/pkg/metrics"
 	"github.com/telekom/auth-operator/pkg/tracing"
@@ -64,11 +65,21 @@ type WebhookAuthorizerReconciler struct {
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
 	tracer   trace.Tracer
+
+	// capabilityDetector probes the API server for AuthorizeWithSelectors
+	// support so spec.selectorRules that can never match are surfaced.
+	// Optional: when nil, the SelectorRulesEffective condition is Unknown.
+	capabilityDetector capabilityDetector
 }
 
 // setTracer implements tracerSetter.
 func (r *WebhookAuthorizerReconciler) setTracer(t trace.Tracer) { r.tracer = t }
 
+// setCapabilityDetector implements capabilityDetectorSetter.
+func (r *WebhookAuthorizerReconciler) setCapabilityDetector(d capabilityDetector) {
+	r.capabilityDetector = d
+}
+
 // NewWebhookAuthorizerReconciler creates a new WebhookAuthorizer reconciler.
 func NewWebhookAuthorizerReconciler(
 	c client.Client,
@@ -102,8 +113,9 @@ func (r *WebhookAuthorizerReconciler) SetupWithManager(mgr ctrl.Manager, concurr
 //  2. Validate semantic spec constraints (stall on error)
 //  3. Mark as Reconciling and set status.observedGeneration
 //  4. Validate NamespaceSelector can be parsed (stall on error)
-//  5. Set status.authorizerConfigured = true and mark Ready
-//  6. Apply status via SSA
+//  5. Record whether spec.selectorRules can match on this API server
+//  6. Set status.authorizerConfigured = true and mark Ready
+//  7. Apply status via SSA
 func (r *WebhookAuthorizerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, retErr error) {
 	startTime := time.Now()
 	logger := log.FromContext(ctx)
@@ -130,7 +142,7 @@ func (r *WebhookAuthorizerReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues(metrics.ControllerWebhookAuthorizer).Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, metrics.ControllerWebhookAuthorizer, duration)
 		logger.V(1).Info("=== Reconcile END ===",
 			"webhookAuthorizer", req.Name,
 			"duration", duration.String())
@@ -221,12 +233,16 @@ func (r *WebhookAuthorizerReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, fmt.Errorf("validate namespace selector for %s: %w", wa.Name, err)
 	}
 
-	// Step 5: Mark as configured and ready
+	// Step 5: Selector rules only match when the API server sends parsed
+	// selectors. This is a warning surface and does not affect Ready.
+	r.recordSelectorRulesState(ctx, wa)
+
+	// Step 6: Mark as configured and ready
 	wa.Status.AuthorizerConfigured = true
 	conditions.MarkReady(wa, wa.Generation,
 		authorizationv1alpha1.ReadyReasonReconciled, authorizationv1alpha1.ReadyMessageReconciled)
 
-	// Step 6: Apply status via SSA
+	// Step 7: Apply status via SSA
 	if err := ssa.ApplyWebhookAuthorizerStatus(ctx, r.client, wa); err != nil {
 		logger.Error(err, "failed to apply status via SSA",
 			"webhookAuthorizer", wa.Name)
@@ -248,6 +264,47 @@ func (r *WebhookAuthorizerReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
 }
 
+// recordSelectorRulesState sets the SelectorRulesEffective condition and emits
+// a Warning event when spec.selectorRules can never match because the API
+// server does not send parsed field and label selectors in SubjectAccessReviews.
+// The condition is removed when the authorizer has no selector rules.
+func (r *WebhookAuthorizerReconciler) recordSelectorRulesState(
+	ctx context.Context,
+	wa *authorizationv1alpha1.WebhookAuthorizer,
+) {
+	if len(wa.Spec.SelectorRules) == 0 {
+		conditions.Delete(wa, authorizationv1alpha1.WASelectorRulesEffectiveCondition)
+		return
+	}
+	if r.capabilityDetector == nil {
+		conditions.MarkUnknown(wa, authorizationv1alpha1.WASelectorRulesEffectiveCondition, wa.Generation,
+			authorizationv1alpha1.WASelectorRulesReasonUnknown, authorizationv1alpha1.WASelectorRulesMessageUnknown,
+			"capability detection is not configured")
+		return
+	}
+
+	result := r.capabilityDetector.Detect(ctx, capabilities.CapabilityAuthorizeWithSelectors.Name)
+	switch result.State {
+	case capabilities.StateEnabled:
+		conditions.MarkTrue(wa, authorizationv1alpha1.WASelectorRulesEffectiveCondition, wa.Generation,
+			authorizationv1alpha1.WASelectorRulesReasonEffective, authorizationv1alpha1.WASelectorRulesMessageEffective,
+			result.Detail)
+	case capabilities.StateDisabled:
+		log.FromContext(ctx).Info("selector rules are inert on this API server",
+			"webhookAuthorizer", wa.Name, "reason", result.Reason, "serverVersion", result.ServerVersion)
+		conditions.MarkFalse(wa, authorizationv1alpha1.WASelectorRulesEffectiveCondition, wa.Generation,
+			authorizationv1alpha1.WASelectorRulesReasonInert, authorizationv1alpha1.WASelectorRulesMessageInert,
+			result.Detail)
+		r.recorder.Eventf(wa, nil, corev1.EventTypeWarning,
+			authorizationv1alpha1.EventReasonReconciled, authorizationv1alpha1.EventActionReconcile,
+			"Selector rules never match on this API server: %s", result.Detail)
+	default:
+		conditions.MarkUnknown(wa, authorizationv1alpha1.WASelectorRulesEffectiveCondition, wa.Generation,
+			authorizationv1alpha1.WASelectorRulesReasonUnknown, authorizationv1alpha1.WASelectorRulesMessageUnknown,
+			result.Detail)
+	}
+}
+
 // validateNamespaceSelector validates that the NamespaceSelector can be parsed
 // and lists matching namespaces for diagnostics (logged at V(2)).
 func (r *WebhookAuthorizerReconciler) validateNamespaceSelector(
@@ -299,7 +356,8 @@ func (r *WebhookAuthorizerReconciler) refreshAuthorizerActiveRulesMetric(ctx con
 		if !webhookAuthorizerReadyForActiveRulesMetric(list.Items[i]) {
 			continue
 		}
-		total += len(list.Items[i].Spec.ResourceRules) + len(list.Items[i].Spec.NonResourceRules)
+		total += len(list.Items[i].Spec.ResourceRules) + len(list.Items[i].Spec.SelectorRules) +
+			len(list.Items[i].Spec.NonResourceRules)
 	}
 	metrics.AuthorizerActiveRules.Set(float64(total))
 	return nil
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -26,6 +27,7 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/capabilities"
 	"github.com/telekom/auth-operator/pkg/conditions"
 	"github.com/telekom/auth-operator/pkg/metrics"
 
@@ -575,6 +577,98 @@ func TestReconcile_WithPrincipals_Ready(t *testing.T) {
 	g.Expect(conditions.IsReady(&updated)).To(gomega.BeTrue())
 }
 
+func TestReconcile_SelectorRulesEffectiveCondition(t *testing.T) {
+	selectorSpec := validWebhookAuthorizerSpec()
+	selectorSpec.SelectorRules = []authorizationv1alpha1.SelectorResourceRule{{
+		Verbs:     []string{"list"},
+		APIGroups: []string{""},
+		Resources: []string{"pods"},
+		FieldSelector: []authorizationv1alpha1.SelectorRequirement{{
+			Key: "spec.nodeName", Operator: authorizationv1alpha1.SelectorOperatorIn, Values: []string{"node-a"},
+		}},
+	}}
+
+	tests := []struct {
+		name       string
+		spec       authorizationv1alpha1.WebhookAuthorizerSpec
+		detector   capabilityDetector
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantEvent  bool
+	}{
+		{
+			name:       "enabled",
+			spec:       selectorSpec,
+			detector:   stubDetector{result: capabilities.Result{State: capabilities.StateEnabled, Detail: "GA"}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: "FeatureGateEnabled",
+		},
+		{
+			name:       "disabled",
+			spec:       selectorSpec,
+			detector:   stubDetector{result: capabilities.Result{State: capabilities.StateDisabled, Detail: "too old"}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: "FeatureGateDisabled",
+			wantEvent:  true,
+		},
+		{
+			name:       "no detector",
+			spec:       selectorSpec,
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: "FeatureStateUnknown",
+		},
+		{
+			name:     "no selector rules",
+			spec:     validWebhookAuthorizerSpec(),
+			detector: stubDetector{result: capabilities.Result{State: capabilities.StateDisabled}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			wa := &authorizationv1alpha1.WebhookAuthorizer{
+				ObjectMeta: metav1.ObjectMeta{Name: "selector-authorizer", Generation: 1},
+				Spec:       tt.spec,
+			}
+			scheme := newTestScheme()
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(wa).
+				WithStatusSubresource(&authorizationv1alpha1.WebhookAuthorizer{}).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			var opts []ReconcilerOption
+			if tt.detector != nil {
+				opts = append(opts, WithCapabilityDetector(tt.detector))
+			}
+			r := NewWebhookAuthorizerReconciler(c, scheme, recorder, opts...)
+
+			_, err := r.Reconcile(ctxWithLogger(), reconcileRequest(wa.Name))
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			var updated authorizationv1alpha1.WebhookAuthorizer
+			g.Expect(c.Get(ctxWithLogger(), types.NamespacedName{Name: wa.Name}, &updated)).To(gomega.Succeed())
+			g.Expect(conditions.IsReady(&updated)).To(gomega.BeTrue(), "selector rule support must not affect Ready")
+			cond := conditions.Get(&updated, authorizationv1alpha1.WASelectorRulesEffectiveCondition)
+			if tt.wantStatus == "" {
+				g.Expect(cond).To(gomega.BeNil())
+				return
+			}
+			g.Expect(cond).NotTo(gomega.BeNil())
+			g.Expect(cond.Status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(cond.Reason).To(gomega.Equal(tt.wantReason))
+
+			var warnings int
+			for len(recorder.Events) > 0 {
+				if strings.HasPrefix(<-recorder.Events, corev1.EventTypeWarning) {
+					warnings++
+				}
+			}
+			g.Expect(warnings > 0).To(gomega.Equal(tt.wantEvent))
+		})
+	}
+}
+
 func TestReconcile_NoMatchingNamespaces_StillReady(t *testing.T) {
 	g := gomega.NewWithT(t)
 
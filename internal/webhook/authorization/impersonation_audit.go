@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"fmt"
+	"time"
+
+	authzv1 "k8s.io/api/authorization/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	pkgmetrics "github.com/telekom/auth-operator/pkg/metrics"
+)
+
+// auditIDHeader is the header the API server uses to carry the audit ID of the
+// request being authorized. When present it makes impersonation correlation
+// exact; without it checks are correlated by impersonator and mode alone.
+const auditIDHeader = "Audit-ID"
+
+const (
+	// impersonationCorrelationWindow bounds how long one check of a constrained
+	// impersonation waits for its counterpart. Both checks are issued while the
+	// API server handles a single request, so a few seconds is ample.
+	impersonationCorrelationWindow = 10 * time.Second
+	// maxPendingImpersonations bounds the correlation state.
+	maxPendingImpersonations = 4096
+)
+
+// impersonationCheck is the decoded form of a constrained impersonation
+// (KEP-5284) SubjectAccessReview.
+//
+// For an identity check (impersonate:<mode>) the resource attributes name the
+// impersonated identity, e.g. users/alice. For an action check
+// (impersonate-on:<mode>:<verb>) they name the request performed on behalf of
+// that identity, and verb holds the underlying request verb.
+type impersonationCheck struct {
+	mode      authorizationv1alpha1.ImpersonationMode
+	isAction  bool
+	verb      string
+	group     string
+	resource  string
+	namespace string
+	name      string
+	decision  string
+}
+
+// impersonationKey identifies the checks issued for one impersonated request.
+type impersonationKey struct {
+	auditID string
+	user    string
+	uid     string
+	mode    authorizationv1alpha1.ImpersonationMode
+}
+
+// pendingImpersonation holds the checks seen so far for one impersonated
+// request.
+type pendingImpersonation struct {
+	identity *impersonationCheck
+	action   *impersonationCheck
+	lastSeen time.Time
+}
+
+// decodeImpersonationCheck decodes a constrained impersonation SAR. It returns
+// false for every other request, including the legacy "impersonate" verb.
+func decodeImpersonationCheck(sar *authzv1.SubjectAccessReview) (impersonationCheck, bool) {
+	attr := sar.Spec.ResourceAttributes
+	if attr == nil {
+		return impersonationCheck{}, false
+	}
+	parsed, ok := authorizationv1alpha1.ParseImpersonationVerb(attr.Verb)
+	if !ok {
+		return impersonationCheck{}, false
+	}
+	resource := attr.Resource
+	if attr.Subresource != "" {
+		resource += "/" + attr.Subresource
+	}
+	return impersonationCheck{
+		mode:      parsed.Mode,
+		isAction:  parsed.IsAction,
+		verb:      parsed.Action,
+		group:     attr.Group,
+		resource:  resource,
+		namespace: attr.Namespace,
+		name:      attr.Name,
+	}, true
+}
+
+// checkLabel returns the metric label of the check.
+func (c *impersonationCheck) checkLabel() string {
+	if c.isAction {
+		return pkgmetrics.AuthorizerImpersonationCheckAction
+	}
+	return pkgmetrics.AuthorizerImpersonationCheckIdentity
+}
+
+// logFields returns the structured decision-log fields for the check.
+func (c *impersonationCheck) logFields() []any {
+	fields := []any{
+		"impersonationMode", string(c.mode),
+		"impersonationCheck", c.checkLabel(),
+	}
+	if c.isAction {
+		return append(fields, "impersonatedVerb", c.verb)
+	}
+	fields = append(fields,
+		"impersonatedResource", c.resource,
+		"impersonatedName", c.name,
+	)
+	if c.namespace != "" {
+		fields = append(fields, "impersonatedNamespace", c.namespace)
+	}
+	return fields
+}
+
+// identityString renders the impersonated identity, e.g. "users/alice" or
+// "serviceaccounts/team-a/builder".
+func (c *impersonationCheck) identityString() string {
+	if c.namespace != "" {
+		return c.resource + "/" + c.namespace + "/" + c.name
+	}
+	return c.resource + "/" + c.name
+}
+
+// targetString renders the action target, e.g. "pods in namespace team-a".
+func (c *impersonationCheck) targetString() string {
+	target := c.resource
+	if c.group != "" {
+		target += "." + c.group
+	}
+	if c.name != "" {
+		target += "/" + c.name
+	}
+	if c.namespace != "" {
+		target += " in namespace " + c.namespace
+	}
+	return target
+}
+
+// auditImpersonation records a constrained impersonation check in the
+// metrics and correlates the identity check with the action check of the same
+// impersonated request, so that auditors see "X impersonated Y to list pods"
+// as one event. Requests that are not constrained impersonation checks are
+// ignored.
+//
+// Correlation needs both checks to reach this webhook: a check decided by an
+// earlier authorizer in the API server chain is never seen here. For user-info
+// impersonation each impersonated attribute (user, groups, uid, extras) is a
+// separate identity check and yields its own correlated event.
+func (wa *Authorizer) auditImpersonation(auditID string, sar *authzv1.SubjectAccessReview, res *evaluationResult, now time.Time) {
+	check, ok := decodeImpersonationCheck(sar)
+	if !ok {
+		return
+	}
+	check.decision = res.decision
+	pkgmetrics.AuthorizerImpersonationChecksTotal.WithLabelValues(string(check.mode), check.checkLabel(), check.decision).Inc()
+
+	key := impersonationKey{auditID: auditID, user: sar.Spec.User, uid: sar.Spec.UID, mode: check.mode}
+
+	wa.impersonationMu.Lock()
+	if wa.impersonationPending == nil {
+		wa.impersonationPending = make(map[impersonationKey]*pendingImpersonation)
+	}
+	pending, found := wa.impersonationPending[key]
+	if found && now.Sub(pending.lastSeen) > impersonationCorrelationWindow {
+		found = false
+	}
+	if !found {
+		if len(wa.impersonationPending) >= maxPendingImpersonations {
+			wa.prunePendingImpersonationsLocked(now)
+		}
+		pending = &pendingImpersonation{}
+		wa.impersonationPending[key] = pending
+	}
+	pending.lastSeen = now
+	if check.isAction {
+		pending.action = &check
+	} else {
+		pending.identity = &check
+	}
+	var identity, action impersonationCheck
+	correlated := pending.identity != nil && pending.action != nil
+	if correlated {
+		identity, action = *pending.identity, *pending.action
+	}
+	wa.impersonationMu.Unlock()
+
+	if correlated {
+		wa.logImpersonation(auditID, sar, &identity, &action)
+	}
+}
+
+// prunePendingImpersonationsLocked drops expired correlation state and, if the
+// map is still full, the least recently seen entry.
+func (wa *Authorizer) prunePendingImpersonationsLocked(now time.Time) {
+	var oldestKey impersonationKey
+	var oldest time.Time
+	for key, pending := range wa.impersonationPending {
+		if now.Sub(pending.lastSeen) > impersonationCorrelationWindow {
+			delete(wa.impersonationPending, key)
+			continue
+		}
+		if oldest.IsZero() || pending.lastSeen.Before(oldest) {
+			oldestKey, oldest = key, pending.lastSeen
+		}
+	}
+	if len(wa.impersonationPending) >= maxPendingImpersonations {
+		delete(wa.impersonationPending, oldestKey)
+	}
+}
+
+// logImpersonation emits one audit entry for a correlated impersonation.
+// Impersonation is rare and always audit-relevant, so the entry is logged at
+// V(0) regardless of the decision.
+func (wa *Authorizer) logImpersonation(auditID string, sar *authzv1.SubjectAccessReview, identity, action *impersonationCheck) {
+	decision := combinedImpersonationDecision(identity.decision, action.decision)
+	pkgmetrics.AuthorizerImpersonationsTotal.WithLabelValues(string(action.mode), decision).Inc()
+
+	fields := []any{
+		"summary", fmt.Sprintf("%s impersonated %s to %s %s",
+			sar.Spec.User, identity.identityString(), action.verb, action.targetString()),
+		"decision", decision,
+		"user", sar.Spec.User,
+		"groups", cappedGroups(sar.Spec.Groups),
+		"impersonationMode", string(action.mode),
+		"impersonatedResource", identity.resource,
+		"impersonatedName", identity.name,
+		"identityDecision", identity.decision,
+		"verb", action.verb,
+		"apiGroup", action.group,
+		"resource", action.resource,
+		"namespace", action.namespace,
+		"actionDecision", action.decision,
+	}
+	if identity.namespace != "" {
+		fields = append(fields, "impersonatedNamespace", identity.namespace)
+	}
+	if action.name != "" {
+		fields = append(fields, "name", action.name)
+	}
+	if auditID != "" {
+		fields = append(fields, "auditID", auditID)
+	}
+	wa.Log.Info("impersonation decision", fields...)
+}
+
+// combinedImpersonationDecision combines the decisions of the two checks: an
+// impersonated request is denied if either check was denied and only allowed
+// when both were allowed.
+func combinedImpersonationDecision(identity, action string) string {
+	switch {
+	case identity == pkgmetrics.AuthorizerDecisionDenied || action == pkgmetrics.AuthorizerDecisionDenied:
+		return pkgmetrics.AuthorizerDecisionDenied
+	case identity == pkgmetrics.AuthorizerDecisionAllowed && action == pkgmetrics.AuthorizerDecisionAllowed:
+		return pkgmetrics.AuthorizerDecisionAllowed
+	default:
+		return pkgmetrics.AuthorizerDecisionNoOpinion
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	authzv1 "k8s.io/api/authorization/v1"
+
+	pkgmetrics "github.com/telekom/auth-operator/pkg/metrics"
+)
+
+func impersonationSAR(user string, attr authzv1.ResourceAttributes) *authzv1.SubjectAccessReview {
+	return &authzv1.SubjectAccessReview{Spec: authzv1.SubjectAccessReviewSpec{
+		User:               user,
+		UID:                user + "-uid",
+		ResourceAttributes: &attr,
+	}}
+}
+
+func TestDecodeImpersonationCheck(t *testing.T) {
+	identity, ok := decodeImpersonationCheck(impersonationSAR("deployer", authzv1.ResourceAttributes{
+		Verb: "impersonate:serviceaccount", Group: "", Resource: "serviceaccounts", Namespace: "team-a", Name: "builder",
+	}))
+	if !ok {
+		t.Fatal("expected identity check to decode")
+	}
+	if identity.isAction || identity.mode != "serviceaccount" || identity.identityString() != "serviceaccounts/team-a/builder" {
+		t.Errorf("unexpected identity decode: %+v", identity)
+	}
+
+	action, ok := decodeImpersonationCheck(impersonationSAR("deployer", authzv1.ResourceAttributes{
+		Verb: "impersonate-on:serviceaccount:list", Resource: "pods", Namespace: "team-a",
+	}))
+	if !ok {
+		t.Fatal("expected action check to decode")
+	}
+	if !action.isAction || action.verb != "list" || action.targetString() != "pods in namespace team-a" {
+		t.Errorf("unexpected action decode: %+v", action)
+	}
+
+	for _, verb := range []string{"impersonate", "get", "impersonate:bogus"} {
+		if _, ok := decodeImpersonationCheck(impersonationSAR("deployer", authzv1.ResourceAttributes{Verb: verb, Resource: "users"})); ok {
+			t.Errorf("verb %q must not decode as a constrained impersonation check", verb)
+		}
+	}
+	nonResource := &authzv1.SubjectAccessReview{Spec: authzv1.SubjectAccessReviewSpec{
+		NonResourceAttributes: &authzv1.NonResourceAttributes{Path: "/healthz", Verb: "get"},
+	}}
+	if _, ok := decodeImpersonationCheck(nonResource); ok {
+		t.Error("non-resource requests must not decode")
+	}
+}
+
+func TestCombinedImpersonationDecision(t *testing.T) {
+	allowed, denied, noOpinion := pkgmetrics.AuthorizerDecisionAllowed, pkgmetrics.AuthorizerDecisionDenied, pkgmetrics.AuthorizerDecisionNoOpinion
+	tests := []struct {
+		identity, action, want string
+	}{
+		{allowed, allowed, allowed},
+		{allowed, denied, denied},
+		{denied, noOpinion, denied},
+		{allowed, noOpinion, noOpinion},
+		{noOpinion, noOpinion, noOpinion},
+	}
+	for _, tt := range tests {
+		if got := combinedImpersonationDecision(tt.identity, tt.action); got != tt.want {
+			t.Errorf("combinedImpersonationDecision(%s, %s) = %s, want %s", tt.identity, tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestAuditImpersonation_Correlates(t *testing.T) {
+	handler := &Authorizer{Log: logr.Discard()}
+	now := time.Now()
+	action := impersonationSAR("audit-deployer", authzv1.ResourceAttributes{
+		Verb: "impersonate-on:user-info:list", Resource: "pods", Namespace: "team-a",
+	})
+	identity := impersonationSAR("audit-deployer", authzv1.ResourceAttributes{
+		Verb: "impersonate:user-info", Group: "authentication.k8s.io", Resource: "users", Name: "alice",
+	})
+	allowed := &evaluationResult{decision: pkgmetrics.AuthorizerDecisionAllowed}
+	denied := &evaluationResult{decision: pkgmetrics.AuthorizerDecisionDenied}
+
+	correlated := func(decision string) float64 {
+		return testutil.ToFloat64(pkgmetrics.AuthorizerImpersonationsTotal.WithLabelValues("user-info", decision))
+	}
+	allowedBefore, deniedBefore := correlated(pkgmetrics.AuthorizerDecisionAllowed), correlated(pkgmetrics.AuthorizerDecisionDenied)
+	actionChecksBefore := testutil.ToFloat64(pkgmetrics.AuthorizerImpersonationChecksTotal.WithLabelValues(
+		"user-info", pkgmetrics.AuthorizerImpersonationCheckAction, pkgmetrics.AuthorizerDecisionAllowed))
+
+	// The API server issues the action check first, then the identity check.
+	handler.auditImpersonation("audit-1", action, allowed, now)
+	if got := correlated(pkgmetrics.AuthorizerDecisionAllowed) - allowedBefore; got != 0 {
+		t.Fatalf("a single check must not be reported as an impersonation, got %v", got)
+	}
+	handler.auditImpersonation("audit-1", identity, allowed, now.Add(time.Millisecond))
+	if got := correlated(pkgmetrics.AuthorizerDecisionAllowed) - allowedBefore; got != 1 {
+		t.Errorf("expected one allowed impersonation, got %v", got)
+	}
+	if got := testutil.ToFloat64(pkgmetrics.AuthorizerImpersonationChecksTotal.WithLabelValues(
+		"user-info", pkgmetrics.AuthorizerImpersonationCheckAction, pkgmetrics.AuthorizerDecisionAllowed)) - actionChecksBefore; got != 1 {
+		t.Errorf("expected one allowed action check, got %v", got)
+	}
+
+	// A different audit ID is a different request: its checks do not pair
+	// with the ones above.
+	handler.auditImpersonation("audit-2", identity, denied, now.Add(2*time.Millisecond))
+	if got := correlated(pkgmetrics.AuthorizerDecisionDenied) - deniedBefore; got != 0 {
+		t.Errorf("checks of different requests must not be correlated, got %v", got)
+	}
+	handler.auditImpersonation("audit-2", action, allowed, now.Add(3*time.Millisecond))
+	if got := correlated(pkgmetrics.AuthorizerDecisionDenied) - deniedBefore; got != 1 {
+		t.Errorf("expected one denied impersonation, got %v", got)
+	}
+
+	// A counterpart arriving after the window starts a new correlation.
+	handler.auditImpersonation("audit-3", action, allowed, now)
+	handler.auditImpersonation("audit-3", identity, allowed, now.Add(impersonationCorrelationWindow+time.Second))
+	if got := correlated(pkgmetrics.AuthorizerDecisionAllowed) - allowedBefore; got != 1 {
+		t.Errorf("expired checks must not be correlated, got %v", got)
+	}
+}
+
+func TestAuditImpersonation_BoundedState(t *testing.T) {
+	handler := &Authorizer{Log: logr.Discard()}
+	now := time.Now()
+	allowed := &evaluationResult{decision: pkgmetrics.AuthorizerDecisionAllowed}
+	for i := range maxPendingImpersonations + 10 {
+		sar := impersonationSAR("flood", authzv1.ResourceAttributes{
+			Verb: "impersonate-on:user-info:get", Resource: "pods",
+		})
+		handler.auditImpersonation(time.Duration(i).String(), sar, allowed, now)
+	}
+	if got := len(handler.impersonationPending); got > maxPendingImpersonations {
+		t.Errorf("pending impersonations = %d, want at most %d", got, maxPendingImpersonations)
+	}
+}
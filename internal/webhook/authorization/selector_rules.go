@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"slices"
+
+	authzv1 "k8s.io/api/authorization/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// selectorTerm is one parsed requirement of a request's field or label
+// selector. The metav1 field and label selector operators share the spelling
+// of SelectorOperator, so they convert directly.
+type selectorTerm struct {
+	key      string
+	operator authorizationv1alpha1.SelectorOperator
+	values   []string
+}
+
+// selectorRuleIndex returns the index of the first selector rule whose
+// resource part matches the request and whose selector requirements are all
+// implied by the request's selectors, or -1.
+//
+// Only the parsed requirements sent by the API server (AuthorizeWithSelectors)
+// are considered. rawSelector is deliberately never parsed, as recommended by
+// the SubjectAccessReview API, so that this webhook and the API server cannot
+// disagree on what a query selects. A request without parsed requirements
+// matches no selector rule.
+func (wa *Authorizer) selectorRuleIndex(
+	rules []authorizationv1alpha1.SelectorResourceRule,
+	sar *authzv1.SubjectAccessReview,
+	verbPolicy authorizationv1alpha1.ImpersonationVerbPolicy,
+) int {
+	attr := sar.Spec.ResourceAttributes
+	if attr == nil || len(rules) == 0 {
+		return -1
+	}
+	fieldTerms := fieldSelectorTerms(attr.FieldSelector)
+	labelTerms := labelSelectorTerms(attr.LabelSelector)
+	for i := range rules {
+		rule := &rules[i]
+		resourceRule := rule.ResourceRule()
+		if !resourceRuleMatches(&resourceRule, attr, verbPolicy) {
+			continue
+		}
+		if selectorRequirementsImplied(rule.FieldSelector, fieldTerms, sar.Spec.Extra) &&
+			selectorRequirementsImplied(rule.LabelSelector, labelTerms, sar.Spec.Extra) {
+			return i
+		}
+	}
+	return -1
+}
+
+func fieldSelectorTerms(sel *authzv1.FieldSelectorAttributes) []selectorTerm {
+	if sel == nil {
+		return nil
+	}
+	terms := make([]selectorTerm, 0, len(sel.Requirements))
+	for _, req := range sel.Requirements {
+		terms = append(terms, selectorTerm{
+			key:      req.Key,
+			operator: authorizationv1alpha1.SelectorOperator(req.Operator),
+			values:   req.Values,
+		})
+	}
+	return terms
+}
+
+func labelSelectorTerms(sel *authzv1.LabelSelectorAttributes) []selectorTerm {
+	if sel == nil {
+		return nil
+	}
+	terms := make([]selectorTerm, 0, len(sel.Requirements))
+	for _, req := range sel.Requirements {
+		terms = append(terms, selectorTerm{
+			key:      req.Key,
+			operator: authorizationv1alpha1.SelectorOperator(req.Operator),
+			values:   req.Values,
+		})
+	}
+	return terms
+}
+
+// selectorRequirementsImplied reports whether every requirement is implied by
+// at least one request term on the same key. Request terms are ANDed, so one
+// sufficiently narrow term is enough.
+func selectorRequirementsImplied(
+	reqs []authorizationv1alpha1.SelectorRequirement,
+	terms []selectorTerm,
+	extra map[string]authzv1.ExtraValue,
+) bool {
+	for i := range reqs {
+		req := &reqs[i]
+		values, ok := requirementValues(req, extra)
+		if !ok {
+			return false
+		}
+		if !slices.ContainsFunc(terms, func(term selectorTerm) bool {
+			return term.key == req.Key && termImplies(term, req.Operator, values)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// requirementValues resolves the values of a requirement, adding the
+// request's extra values when ValuesFromExtra is set. A missing or empty extra
+// key fails the requirement: a node agent without a node name must not be
+// treated as allowed on every node.
+func requirementValues(
+	req *authorizationv1alpha1.SelectorRequirement,
+	extra map[string]authzv1.ExtraValue,
+) ([]string, bool) {
+	if req.ValuesFromExtra == "" {
+		return req.Values, true
+	}
+	fromExtra := extra[req.ValuesFromExtra]
+	if len(fromExtra) == 0 {
+		return nil, false
+	}
+	return append(slices.Clone(req.Values), fromExtra...), true
+}
+
+// termImplies reports whether every object selected by term also satisfies
+// the rule requirement "key operator values".
+func termImplies(term selectorTerm, operator authorizationv1alpha1.SelectorOperator, values []string) bool {
+	switch operator {
+	case authorizationv1alpha1.SelectorOperatorIn:
+		return term.operator == authorizationv1alpha1.SelectorOperatorIn &&
+			len(term.values) > 0 && isSubset(term.values, values)
+	case authorizationv1alpha1.SelectorOperatorNotIn:
+		switch term.operator {
+		case authorizationv1alpha1.SelectorOperatorNotIn:
+			return isSubset(values, term.values)
+		case authorizationv1alpha1.SelectorOperatorIn:
+			return len(term.values) > 0 && !intersects(term.values, values)
+		case authorizationv1alpha1.SelectorOperatorDoesNotExist:
+			return true
+		}
+	case authorizationv1alpha1.SelectorOperatorExists:
+		return term.operator == authorizationv1alpha1.SelectorOperatorExists ||
+			(term.operator == authorizationv1alpha1.SelectorOperatorIn && len(term.values) > 0)
+	case authorizationv1alpha1.SelectorOperatorDoesNotExist:
+		return term.operator == authorizationv1alpha1.SelectorOperatorDoesNotExist
+	}
+	return false
+}
+
+func isSubset(subset, set []string) bool {
+	for _, s := range subset {
+		if !slices.Contains(set, s) {
+			return false
+		}
+	}
+	return true
+}
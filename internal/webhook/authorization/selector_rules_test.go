@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authzv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+const nodeNameExtra = "authentication.kubernetes.io/node-name"
+
+func fieldSelectorSAR(verb, nodeName string, reqs ...metav1.FieldSelectorRequirement) *authzv1.SubjectAccessReview {
+	sar := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:               "system:node:" + nodeName,
+			ResourceAttributes: &authzv1.ResourceAttributes{Verb: verb, Resource: "pods"},
+		},
+	}
+	if nodeName != "" {
+		sar.Spec.Extra = map[string]authzv1.ExtraValue{nodeNameExtra: {nodeName}}
+	}
+	if len(reqs) > 0 {
+		sar.Spec.ResourceAttributes.FieldSelector = &authzv1.FieldSelectorAttributes{Requirements: reqs}
+	}
+	return sar
+}
+
+func TestSelectorRuleIndex_FieldSelectorFromExtra(t *testing.T) {
+	handler := &Authorizer{Log: logr.Discard()}
+	rules := []authzv1alpha1.SelectorResourceRule{{
+		Verbs:     []string{"list", "watch"},
+		APIGroups: []string{""},
+		Resources: []string{"pods"},
+		FieldSelector: []authzv1alpha1.SelectorRequirement{{
+			Key:             "spec.nodeName",
+			Operator:        authzv1alpha1.SelectorOperatorIn,
+			ValuesFromExtra: nodeNameExtra,
+		}},
+	}}
+	ownNode := metav1.FieldSelectorRequirement{Key: "spec.nodeName", Operator: metav1.FieldSelectorOpIn, Values: []string{"node-a"}}
+
+	tests := []struct {
+		name string
+		sar  *authzv1.SubjectAccessReview
+		want int
+	}{
+		{"list own node", fieldSelectorSAR("list", "node-a", ownNode), 0},
+		{"watch own node", fieldSelectorSAR("watch", "node-a", ownNode), 0},
+		{"list another node", fieldSelectorSAR("list", "node-b", ownNode), -1},
+		{"list without selector", fieldSelectorSAR("list", "node-a"), -1},
+		{"list without node name extra", fieldSelectorSAR("list", "", ownNode), -1},
+		{"verb not in rule", fieldSelectorSAR("delete", "node-a", ownNode), -1},
+		{"NotIn own node does not narrow", fieldSelectorSAR("list", "node-a",
+			metav1.FieldSelectorRequirement{Key: "spec.nodeName", Operator: metav1.FieldSelectorOpNotIn, Values: []string{"node-b"}}), -1},
+		{"different key does not narrow", fieldSelectorSAR("list", "node-a",
+			metav1.FieldSelectorRequirement{Key: "metadata.name", Operator: metav1.FieldSelectorOpIn, Values: []string{"node-a"}}), -1},
+		{"additional requirements only narrow further", fieldSelectorSAR("list", "node-a", ownNode,
+			metav1.FieldSelectorRequirement{Key: "status.phase", Operator: metav1.FieldSelectorOpIn, Values: []string{"Running"}}), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := handler.selectorRuleIndex(rules, tt.sar, authzv1alpha1.ImpersonationVerbPolicyRequireExplicitVerb)
+			if got != tt.want {
+				t.Errorf("selectorRuleIndex() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectorRuleIndex_RawSelectorIsIgnored(t *testing.T) {
+	handler := &Authorizer{Log: logr.Discard()}
+	rules := []authzv1alpha1.SelectorResourceRule{{
+		Verbs:         []string{"list"},
+		APIGroups:     []string{""},
+		Resources:     []string{"pods"},
+		FieldSelector: []authzv1alpha1.SelectorRequirement{{Key: "spec.nodeName", Operator: authzv1alpha1.SelectorOperatorIn, Values: []string{"node-a"}}},
+	}}
+	sar := fieldSelectorSAR("list", "node-a")
+	sar.Spec.ResourceAttributes.FieldSelector = &authzv1.FieldSelectorAttributes{RawSelector: "spec.nodeName=node-a"}
+
+	if got := handler.selectorRuleIndex(rules, sar, authzv1alpha1.ImpersonationVerbPolicyRequireExplicitVerb); got != -1 {
+		t.Errorf("a rawSelector must never satisfy a selector rule, got rule index %d", got)
+	}
+}
+
+func TestTermImplies(t *testing.T) {
+	in := func(values ...string) selectorTerm {
+		return selectorTerm{key: "team", operator: authzv1alpha1.SelectorOperatorIn, values: values}
+	}
+	notIn := func(values ...string) selectorTerm {
+		return selectorTerm{key: "team", operator: authzv1alpha1.SelectorOperatorNotIn, values: values}
+	}
+	exists := selectorTerm{key: "team", operator: authzv1alpha1.SelectorOperatorExists}
+	doesNotExist := selectorTerm{key: "team", operator: authzv1alpha1.SelectorOperatorDoesNotExist}
+
+	tests := []struct {
+		name     string
+		term     selectorTerm
+		operator authzv1alpha1.SelectorOperator
+		values   []string
+		want     bool
+	}{
+		{"In subset implies In", in("x"), authzv1alpha1.SelectorOperatorIn, []string{"x", "y"}, true},
+		{"In superset does not imply In", in("x", "z"), authzv1alpha1.SelectorOperatorIn, []string{"x", "y"}, false},
+		{"empty In does not imply In", in(), authzv1alpha1.SelectorOperatorIn, []string{"x"}, false},
+		{"Exists does not imply In", exists, authzv1alpha1.SelectorOperatorIn, []string{"x"}, false},
+		{"NotIn superset implies NotIn", notIn("x", "y"), authzv1alpha1.SelectorOperatorNotIn, []string{"x"}, true},
+		{"NotIn subset does not imply NotIn", notIn("x"), authzv1alpha1.SelectorOperatorNotIn, []string{"x", "y"}, false},
+		{"disjoint In implies NotIn", in("z"), authzv1alpha1.SelectorOperatorNotIn, []string{"x"}, true},
+		{"overlapping In does not imply NotIn", in("x", "z"), authzv1alpha1.SelectorOperatorNotIn, []string{"x"}, false},
+		{"DoesNotExist implies NotIn", doesNotExist, authzv1alpha1.SelectorOperatorNotIn, []string{"x"}, true},
+		{"In implies Exists", in("x"), authzv1alpha1.SelectorOperatorExists, nil, true},
+		{"Exists implies Exists", exists, authzv1alpha1.SelectorOperatorExists, nil, true},
+		{"NotIn does not imply Exists", notIn("x"), authzv1alpha1.SelectorOperatorExists, nil, false},
+		{"DoesNotExist implies DoesNotExist", doesNotExist, authzv1alpha1.SelectorOperatorDoesNotExist, nil, true},
+		{"NotIn does not imply DoesNotExist", notIn("x"), authzv1alpha1.SelectorOperatorDoesNotExist, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := termImplies(tt.term, tt.operator, tt.values); got != tt.want {
+				t.Errorf("termImplies() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateSAR_SelectorRuleLabelSelector(t *testing.T) {
+	cl := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+	handler := &Authorizer{AllowUnauthenticatedAuthorize: true, Client: cl, Log: logr.Discard()}
+
+	wa := authzv1alpha1.WebhookAuthorizer{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-x-secrets"},
+		Spec: authzv1alpha1.WebhookAuthorizerSpec{
+			AllowedPrincipals: []authzv1alpha1.Principal{{User: "alice"}},
+			SelectorRules: []authzv1alpha1.SelectorResourceRule{{
+				Verbs:         []string{"watch"},
+				APIGroups:     []string{""},
+				Resources:     []string{"secrets"},
+				LabelSelector: []authzv1alpha1.SelectorRequirement{{Key: "team", Operator: authzv1alpha1.SelectorOperatorIn, Values: []string{"x"}}},
+			}},
+		},
+	}
+	sar := func(reqs ...metav1.LabelSelectorRequirement) *authzv1.SubjectAccessReview {
+		s := &authzv1.SubjectAccessReview{Spec: authzv1.SubjectAccessReviewSpec{
+			User:               "alice",
+			ResourceAttributes: &authzv1.ResourceAttributes{Verb: "watch", Resource: "secrets", Namespace: "team-x"},
+		}}
+		if len(reqs) > 0 {
+			s.Spec.ResourceAttributes.LabelSelector = &authzv1.LabelSelectorAttributes{Requirements: reqs}
+		}
+		return s
+	}
+
+	t.Run("matching label selector is allowed", func(t *testing.T) {
+		res, err := handler.evaluateSAR(context.Background(),
+			sar(metav1.LabelSelectorRequirement{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"x"}}),
+			[]authzv1alpha1.WebhookAuthorizer{wa})
+		if err != nil {
+			t.Fatalf("evaluateSAR returned unexpected error: %v", err)
+		}
+		if !res.allowed {
+			t.Fatal("expected allowed")
+		}
+		if res.matchedField != "selectorRule" || res.matchedRule != 0 {
+			t.Errorf("expected selectorRule[0], got %s[%d]", res.matchedField, res.matchedRule)
+		}
+	})
+
+	t.Run("unrestricted watch is no-opinion", func(t *testing.T) {
+		res, err := handler.evaluateSAR(context.Background(), sar(), []authzv1alpha1.WebhookAuthorizer{wa})
+		if err != nil {
+			t.Fatalf("evaluateSAR returned unexpected error: %v", err)
+		}
+		if res.allowed || res.matchedRule != -1 {
+			t.Errorf("expected no-opinion, got allowed=%t matchedRule=%d", res.allowed, res.matchedRule)
+		}
+	})
+}
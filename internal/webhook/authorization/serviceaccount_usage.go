@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/helpers"
+)
+
+const (
+	// serviceAccountUsageQueueSize bounds the pending last-used updates.
+	// Observations are dropped when the queue is full; the next request of
+	// the ServiceAccount after the record interval retries.
+	serviceAccountUsageQueueSize = 1024
+	// serviceAccountUsageRecordTimeout bounds a single last-used update.
+	serviceAccountUsageRecordTimeout = 5 * time.Second
+)
+
+type serviceAccountUsage struct {
+	key types.NamespacedName
+	at  time.Time
+}
+
+// ServiceAccountUsageRecorder maintains the last-used annotation on
+// operator-generated ServiceAccounts that make authorization requests. The
+// annotation drives idleRevokeAfter of a serviceAccountLifecycle.
+//
+// Observations are throttled per ServiceAccount to the record interval and
+// written asynchronously, so the authorization path never waits on the API
+// server. ServiceAccounts not managed by the operator are left untouched.
+type ServiceAccountUsageRecorder struct {
+	// Reader fetches the ServiceAccount before patching. A live API reader
+	// avoids caching every ServiceAccount in the cluster in the webhook.
+	Reader client.Reader
+	// Writer patches the last-used annotation.
+	Writer client.Writer
+	Log    logr.Logger
+	// Interval is the minimum time between two updates of the same
+	// ServiceAccount. Defaults to ServiceAccountUsageRecordInterval.
+	Interval time.Duration
+
+	mu       sync.Mutex
+	recorded map[types.NamespacedName]time.Time
+	queue    chan serviceAccountUsage
+	initOnce sync.Once
+}
+
+func (u *ServiceAccountUsageRecorder) init() {
+	u.initOnce.Do(func() {
+		if u.Interval <= 0 {
+			u.Interval = authorizationv1alpha1.ServiceAccountUsageRecordInterval
+		}
+		u.recorded = make(map[types.NamespacedName]time.Time)
+		u.queue = make(chan serviceAccountUsage, serviceAccountUsageQueueSize)
+	})
+}
+
+// Observe notes that user made a request at now. It is a no-op for users
+// that are not ServiceAccounts and never blocks.
+func (u *ServiceAccountUsageRecorder) Observe(user string, now time.Time) {
+	namespace, name, ok := parseServiceAccountUsername(user)
+	if !ok {
+		return
+	}
+	u.init()
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+
+	u.mu.Lock()
+	if last, seen := u.recorded[key]; seen && now.Sub(last) < u.Interval {
+		u.mu.Unlock()
+		return
+	}
+	u.recorded[key] = now
+	u.pruneLocked(now)
+	u.mu.Unlock()
+
+	select {
+	case u.queue <- serviceAccountUsage{key: key, at: now}:
+	default:
+		u.forget(key)
+		u.Log.V(1).Info("ServiceAccount usage queue full, dropping observation",
+			"serviceAccount", key.String())
+	}
+}
+
+// pruneLocked drops throttle entries older than the record interval so the
+// map stays bounded by the number of recently active ServiceAccounts.
+func (u *ServiceAccountUsageRecorder) pruneLocked(now time.Time) {
+	if len(u.recorded) < serviceAccountUsageQueueSize {
+		return
+	}
+	for key, last := range u.recorded {
+		if now.Sub(last) >= u.Interval {
+			delete(u.recorded, key)
+		}
+	}
+}
+
+// forget clears the throttle entry so the next observation is recorded.
+func (u *ServiceAccountUsageRecorder) forget(key types.NamespacedName) {
+	u.mu.Lock()
+	delete(u.recorded, key)
+	u.mu.Unlock()
+}
+
+// Start writes queued observations until ctx is cancelled. It implements
+// manager.Runnable.
+func (u *ServiceAccountUsageRecorder) Start(ctx context.Context) error {
+	u.init()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case usage := <-u.queue:
+			recordCtx, cancel := context.WithTimeout(ctx, serviceAccountUsageRecordTimeout)
+			if err := u.record(recordCtx, usage); err != nil {
+				u.forget(usage.key)
+				u.Log.Error(err, "failed to record ServiceAccount usage",
+					"serviceAccount", usage.key.String())
+			}
+			cancel()
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every webhook
+// replica records the usage it observes.
+func (u *ServiceAccountUsageRecorder) NeedLeaderElection() bool {
+	return false
+}
+
+// record patches the last-used annotation of an operator-managed
+// ServiceAccount. The annotation only moves forward in time.
+func (u *ServiceAccountUsageRecorder) record(ctx context.Context, usage serviceAccountUsage) error {
+	sa := &corev1.ServiceAccount{}
+	if err := u.Reader.Get(ctx, usage.key, sa); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if sa.Labels[helpers.ManagedByLabelStandard] != helpers.ManagedByValue {
+		return nil
+	}
+	at := usage.at.UTC().Truncate(time.Second)
+	if value, ok := sa.Annotations[authorizationv1alpha1.AnnotationKeyLastUsed]; ok {
+		if previous, err := time.Parse(time.RFC3339, value); err == nil && !at.After(previous) {
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(sa.DeepCopy())
+	if sa.Annotations == nil {
+		sa.Annotations = make(map[string]string, 1)
+	}
+	sa.Annotations[authorizationv1alpha1.AnnotationKeyLastUsed] = at.Format(time.RFC3339)
+	if err := u.Writer.Patch(ctx, sa, patch); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/helpers"
+)
+
+func newUsageRecorderTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add corev1 to scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func usageTestServiceAccount(name string, managed bool, lastUsed string) *corev1.ServiceAccount {
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"}}
+	if managed {
+		sa.Labels = map[string]string{helpers.ManagedByLabelStandard: helpers.ManagedByValue}
+	}
+	if lastUsed != "" {
+		sa.Annotations = map[string]string{authorizationv1alpha1.AnnotationKeyLastUsed: lastUsed}
+	}
+	return sa
+}
+
+func lastUsedAnnotation(t *testing.T, c client.Client, name string) string {
+	t.Helper()
+	sa := &corev1.ServiceAccount{}
+	if err := c.Get(context.Background(), types.NamespacedName{Namespace: "team-a", Name: name}, sa); err != nil {
+		t.Fatalf("get ServiceAccount %s: %v", name, err)
+	}
+	return sa.Annotations[authorizationv1alpha1.AnnotationKeyLastUsed]
+}
+
+func TestServiceAccountUsageRecorderObserveThrottles(t *testing.T) {
+	u := &ServiceAccountUsageRecorder{Log: logr.Discard()}
+	now := time.Now()
+
+	u.Observe("alice", now)
+	u.Observe("system:serviceaccount:team-a:deployer", now)
+	u.Observe("system:serviceaccount:team-a:deployer", now.Add(time.Minute))
+	if got := len(u.queue); got != 1 {
+		t.Fatalf("queued observations = %d, want 1", got)
+	}
+
+	u.Observe("system:serviceaccount:team-a:deployer", now.Add(authorizationv1alpha1.ServiceAccountUsageRecordInterval))
+	if got := len(u.queue); got != 2 {
+		t.Fatalf("queued observations after interval = %d, want 2", got)
+	}
+}
+
+func TestServiceAccountUsageRecorderRecord(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour).Format(time.RFC3339)
+	c := newUsageRecorderTestClient(t,
+		usageTestServiceAccount("managed", true, ""),
+		usageTestServiceAccount("external", false, ""),
+		usageTestServiceAccount("newer", true, later),
+	)
+	u := &ServiceAccountUsageRecorder{Reader: c, Writer: c, Log: logr.Discard()}
+	ctx := context.Background()
+
+	for _, name := range []string{"managed", "external", "newer", "missing"} {
+		usage := serviceAccountUsage{key: types.NamespacedName{Namespace: "team-a", Name: name}, at: now}
+		if err := u.record(ctx, usage); err != nil {
+			t.Fatalf("record %s: %v", name, err)
+		}
+	}
+
+	if got := lastUsedAnnotation(t, c, "managed"); got != now.Format(time.RFC3339) {
+		t.Errorf("managed last-used = %q, want %q", got, now.Format(time.RFC3339))
+	}
+	if got := lastUsedAnnotation(t, c, "external"); got != "" {
+		t.Errorf("external ServiceAccount must not be annotated, got %q", got)
+	}
+	if got := lastUsedAnnotation(t, c, "newer"); got != later {
+		t.Errorf("last-used must not move backwards, got %q want %q", got, later)
+	}
+}
+
+func TestServiceAccountUsageRecorderStart(t *testing.T) {
+	c := newUsageRecorderTestClient(t, usageTestServiceAccount("deployer", true, ""))
+	u := &ServiceAccountUsageRecorder{Reader: c, Writer: c, Log: logr.Discard()}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- u.Start(ctx) }()
+
+	u.Observe("system:serviceaccount:team-a:deployer", time.Now())
+	deadline := time.Now().Add(5 * time.Second)
+	for lastUsedAnnotation(t, c, "deployer") == "" {
+		if time.Now().After(deadline) {
+			t.Fatal("last-used annotation was not recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Start returned %v", err)
+	}
+}
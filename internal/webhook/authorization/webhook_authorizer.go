@@ -77,7 +77,7 @@ type evaluationResult struct {
 	decision       string
 	authorizerName string
 	matchedRule    int    // -1 when no rule matched
-	matchedField   string // "deniedPrincipal", "resourceRule", "nonResourceRule", or ""
+	matchedField   string // "deniedPrincipal", "resourceRule", "selectorRule", "nonResourceRule", or ""
 	evaluatedCount int    // authorizers that actively participated in evaluation
 	skippedCount   int    // authorizers skipped due to namespace selector mismatch
 }
@@ -101,6 +101,9 @@ type Authorizer struct {
 	// token is configured. This is insecure and intended only for explicit
 	// development or migration opt-outs.
 	AllowUnauthenticatedAuthorize bool
+	// UsageRecorder is optional. When set, requests of operator-generated
+	// ServiceAccounts update their last-used annotation.
+	UsageRecorder *ServiceAccountUsageRecorder
 	// Limiter is used as a per-subject limiter template. Each SAR subject gets
 	// an independent token bucket with this limit and burst, preventing one
 	// identity from consuming another identity's authorization budget.
@@ -109,6 +112,11 @@ type Authorizer struct {
 	subjectLimitersMu       sync.Mutex
 	subjectLimiters         map[string]*subjectLimiterEntry
 	subjectLimiterCleanupAt time.Time
+
+	// impersonationPending correlates the identity and action checks of
+	// constrained impersonation requests; see auditImpersonation.
+	impersonationMu      sync.Mutex
+	impersonationPending map[impersonationKey]*pendingImpersonation
 }
 
 type subjectLimiterEntry struct {
@@ -133,7 +141,7 @@ func (wa *Authorizer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	if !wa.authenticateRequest(w, r) {
-		wa.recordRejectedMetrics(start)
+		wa.recordRejectedMetrics(ctx, start)
 		return
 	}
 
@@ -147,14 +155,14 @@ func (wa *Authorizer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !wa.validateSubjectAccessReview(w, &sar, start) {
+	if !wa.validateSubjectAccessReview(ctx, w, &sar, start) {
 		return
 	}
 
 	wa.annotateSARSpan(ctx, &sar)
 	if wa.Limiter != nil && !wa.allowSubjectRequest(&sar) {
 		pkgmetrics.AuthorizerRateLimitedTotal.Inc()
-		wa.recordRejectedMetrics(start)
+		wa.recordRejectedMetrics(ctx, start)
 		wa.Log.V(1).Info("rate limit exceeded, rejecting request",
 			"user", sar.Spec.User,
 			"groups", cappedGroups(sar.Spec.Groups),
@@ -163,6 +171,9 @@ func (wa *Authorizer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	wa.logReceivedSAR(&sar)
+	if wa.UsageRecorder != nil {
+		wa.UsageRecorder.Observe(sar.Spec.User, start)
+	}
 
 	evalCtx, evalCancel := context.WithTimeout(ctx, authorizationv1alpha1.WebhookCacheTimeout)
 	defer evalCancel()
@@ -223,7 +234,8 @@ func (wa *Authorizer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Record audit log and metrics only after successful serialization.
 	latency := time.Since(start)
 	wa.logDecision(&sar, &result, latency)
-	wa.recordMetrics(&result, latency, allRules)
+	wa.recordMetrics(ctx, &result, latency, allRules)
+	wa.auditImpersonation(r.Header.Get(auditIDHeader), &sar, &result, start)
 
 	// Record decision in the span.
 	if span := trace.SpanFromContext(ctx); span.IsRecording() {
@@ -263,20 +275,20 @@ func (wa *Authorizer) decodeSubjectAccessReview(ctx context.Context, w http.Resp
 			span.RecordError(err)
 			span.SetStatus(codes.Error, "invalid request body")
 		}
-		wa.recordErrorMetrics(start)
+		wa.recordErrorMetrics(ctx, start)
 		wa.writeDeniedResponse(w, "invalid request body")
 		return authzv1.SubjectAccessReview{}, false
 	}
 	return sar, true
 }
 
-func (wa *Authorizer) validateSubjectAccessReview(w http.ResponseWriter, sar *authzv1.SubjectAccessReview, start time.Time) bool {
+func (wa *Authorizer) validateSubjectAccessReview(ctx context.Context, w http.ResponseWriter, sar *authzv1.SubjectAccessReview, start time.Time) bool {
 	if reason := validateSAR(sar); reason != "" {
 		wa.Log.V(1).Info("rejecting malformed SubjectAccessReview",
 			"reason", reason,
 			"user", sar.Spec.User,
 			"latency", time.Since(start).String())
-		wa.recordRejectedMetrics(start)
+		wa.recordRejectedMetrics(ctx, start)
 		wa.writeDeniedResponse(w, reason)
 		return false
 	}
@@ -317,7 +329,7 @@ func (wa *Authorizer) handleAuthorizerEvaluationError(ctx context.Context, w htt
 		span.RecordError(err)
 		span.SetStatus(codes.Error, message)
 	}
-	wa.recordErrorMetrics(start)
+	wa.recordErrorMetrics(ctx, start)
 	wa.writeDeniedResponse(w, reasonInternalEvaluationError)
 }
 
@@ -330,7 +342,7 @@ func (wa *Authorizer) marshalSubjectAccessReviewResponse(ctx context.Context, w
 			span.RecordError(err)
 			span.SetStatus(codes.Error, "failed to encode response")
 		}
-		wa.recordErrorMetrics(start)
+		wa.recordErrorMetrics(ctx, start)
 		http.Error(w, "internal evaluation error", http.StatusInternalServerError)
 		return nil, false
 	}
@@ -348,6 +360,26 @@ func publicAuthorizerReason(result evaluationResult) string {
 	}
 }
 
+// SetRateLimit changes the per-subject limit and burst of the rate limiter,
+// including the buckets of subjects already seen. It returns false and does
+// nothing when the Authorizer was created without a Limiter, because enabling
+// rate limiting requires a restart.
+func (wa *Authorizer) SetRateLimit(limit rate.Limit, burst int) bool {
+	if wa.Limiter == nil {
+		return false
+	}
+	wa.subjectLimitersMu.Lock()
+	defer wa.subjectLimitersMu.Unlock()
+
+	wa.Limiter.SetLimit(limit)
+	wa.Limiter.SetBurst(burst)
+	for _, entry := range wa.subjectLimiters {
+		entry.limiter.SetLimit(limit)
+		entry.limiter.SetBurst(burst)
+	}
+	return true
+}
+
 func (wa *Authorizer) allowSubjectRequest(sar *authzv1.SubjectAccessReview) bool {
 	limiter := wa.subjectLimiter(rateLimitSubjectKey(sar))
 	return limiter.Allow()
@@ -513,6 +545,10 @@ func (wa *Authorizer) logDecision(sar *authzv1.SubjectAccessReview, res *evaluat
 		)
 	}
 
+	if check, ok := decodeImpersonationCheck(sar); ok {
+		fields = append(fields, check.logFields()...)
+	}
+
 	if res.matchedField != "" {
 		fields = append(fields, "matchedField", res.matchedField)
 	}
@@ -529,14 +565,15 @@ func (wa *Authorizer) logDecision(sar *authzv1.SubjectAccessReview, res *evaluat
 	}
 }
 
-// countTotalRules returns the total number of resource and non-resource rules
-// across the provided authorizers. The caller is responsible for passing the
+// countTotalRules returns the total number of resource, selector and
+// non-resource rules across the provided authorizers. The caller is responsible for passing the
 // complete set of authorizers to get a request-independent count suitable for
 // the AuthorizerActiveRules gauge.
 func countTotalRules(authorizers []authorizationv1alpha1.WebhookAuthorizer) int {
 	total := 0
 	for i := range authorizers {
-		total += len(authorizers[i].Spec.ResourceRules) + len(authorizers[i].Spec.NonResourceRules)
+		total += len(authorizers[i].Spec.ResourceRules) + len(authorizers[i].Spec.SelectorRules) +
+			len(authorizers[i].Spec.NonResourceRules)
 	}
 	return total
 }
@@ -883,6 +920,9 @@ func (wa *Authorizer) matchRequestRuleWithField(
 		if ruleIdx := wa.resourceRuleIndex(authorizer.Spec.ResourceRules, sar.Spec.ResourceAttributes, verbPolicy); ruleIdx >= 0 {
 			return ruleIdx, "resourceRule"
 		}
+		if ruleIdx := wa.selectorRuleIndex(authorizer.Spec.SelectorRules, sar, verbPolicy); ruleIdx >= 0 {
+			return ruleIdx, "selectorRule"
+		}
 	}
 	if sar.Spec.NonResourceAttributes != nil {
 		if ruleIdx := wa.nonResourceRuleIndex(authorizer.Spec.NonResourceRules, sar.Spec.NonResourceAttributes); ruleIdx >= 0 {
@@ -920,29 +960,38 @@ func (wa *Authorizer) resourceRuleIndex(
 	attr *authzv1.ResourceAttributes,
 	verbPolicy authorizationv1alpha1.ImpersonationVerbPolicy,
 ) int {
+	for i := range rules {
+		if resourceRuleMatches(&rules[i], attr, verbPolicy) {
+			return i
+		}
+	}
+	return -1
+}
+
+// resourceRuleMatches reports whether a single resource rule matches attr,
+// following the semantics documented on resourceRuleIndex.
+func resourceRuleMatches(
+	rule *authzv1.ResourceRule,
+	attr *authzv1.ResourceAttributes,
+	verbPolicy authorizationv1alpha1.ImpersonationVerbPolicy,
+) bool {
 	// Compose the resource identifier including the subresource, if any.
 	// K8s rule convention: subresources appear as "resource/subresource" in Rules.
 	resourceKey := attr.Resource
 	if attr.Subresource != "" {
 		resourceKey = attr.Resource + "/" + attr.Subresource
 	}
-	for i, rule := range rules {
-		if !matchesVerb(rule.Verbs, attr.Verb, verbPolicy) {
-			continue
-		}
-		if !matchesExactOrAll(rule.APIGroups, attr.Group) {
-			continue
-		}
-		if !matchesResourceRule(rule.Resources, resourceKey, attr.Subresource) {
-			continue
-		}
-		// ResourceNames: non-empty list restricts which resource names are allowed.
-		if len(rule.ResourceNames) > 0 && !matchesExactOrAll(rule.ResourceNames, attr.Name) {
-			continue
-		}
-		return i
+	if !matchesVerb(rule.Verbs, attr.Verb, verbPolicy) {
+		return false
 	}
-	return -1
+	if !matchesExactOrAll(rule.APIGroups, attr.Group) {
+		return false
+	}
+	if !matchesResourceRule(rule.Resources, resourceKey, attr.Subresource) {
+		return false
+	}
+	// ResourceNames: non-empty list restricts which resource names are allowed.
+	return len(rule.ResourceNames) == 0 || matchesExactOrAll(rule.ResourceNames, attr.Name)
 }
 
 // nonResourceRuleIndex returns the index of the first matching non-resource rule, or -1.
@@ -958,10 +1007,11 @@ func (wa *Authorizer) nonResourceRuleIndex(rules []authzv1.NonResourceRule, attr
 
 // recordMetrics records Prometheus counters, histogram, and gauge for a
 // completed SAR evaluation. This is called alongside audit logging to ensure
-// Prometheus metrics stay in sync with the structured audit trail.
-func (wa *Authorizer) recordMetrics(result *evaluationResult, latency time.Duration, activeRuleCount int) {
+// Prometheus metrics stay in sync with the structured audit trail. A sampled
+// request span in ctx becomes the exemplar of the latency observation.
+func (wa *Authorizer) recordMetrics(ctx context.Context, result *evaluationResult, latency time.Duration, activeRuleCount int) {
 	pkgmetrics.AuthorizerRequestsTotal.WithLabelValues(result.decision, result.authorizerName).Inc()
-	pkgmetrics.AuthorizerRequestDuration.WithLabelValues(result.decision).Observe(latency.Seconds())
+	pkgmetrics.ObserveAuthorizerRequestDuration(ctx, result.decision, latency)
 	pkgmetrics.AuthorizerActiveRules.Set(float64(activeRuleCount))
 
 	if result.matchedField == "deniedPrincipal" {
@@ -1083,18 +1133,16 @@ func constantTimeTokenEqual(a, b string) bool {
 // recordErrorMetrics records Prometheus request counter and duration histogram
 // with decision=error for early-return error paths (decode failures, list
 // failures) so error rates and latency remain visible in dashboards.
-func (wa *Authorizer) recordErrorMetrics(start time.Time) {
-	duration := time.Since(start).Seconds()
+func (wa *Authorizer) recordErrorMetrics(ctx context.Context, start time.Time) {
 	pkgmetrics.AuthorizerRequestsTotal.WithLabelValues(pkgmetrics.AuthorizerDecisionError, pkgmetrics.AuthorizerNameNone).Inc()
-	pkgmetrics.AuthorizerRequestDuration.WithLabelValues(pkgmetrics.AuthorizerDecisionError).Observe(duration)
+	pkgmetrics.ObserveAuthorizerRequestDuration(ctx, pkgmetrics.AuthorizerDecisionError, time.Since(start))
 }
 
 // recordRejectedMetrics records Prometheus request counter and duration
 // histogram with decision=denied for early rejection paths.
-func (wa *Authorizer) recordRejectedMetrics(start time.Time) {
-	duration := time.Since(start).Seconds()
+func (wa *Authorizer) recordRejectedMetrics(ctx context.Context, start time.Time) {
 	pkgmetrics.AuthorizerRequestsTotal.WithLabelValues(pkgmetrics.AuthorizerDecisionDenied, pkgmetrics.AuthorizerNameNone).Inc()
-	pkgmetrics.AuthorizerRequestDuration.WithLabelValues(pkgmetrics.AuthorizerDecisionDenied).Observe(duration)
+	pkgmetrics.ObserveAuthorizerRequestDuration(ctx, pkgmetrics.AuthorizerDecisionDenied, time.Since(start))
 }
 
 // matchesVerb matches a request verb against a rule's verb list, applying the
@@ -1212,6 +1212,12 @@ func TestCountTotalRules(t *testing.T) {
 				NonResourceRules: []authzv1.NonResourceRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz"}}},
 			}},
 		}, 3},
+		{"selector rules", []authzv1alpha1.WebhookAuthorizer{
+			{Spec: authzv1alpha1.WebhookAuthorizerSpec{
+				ResourceRules: []authzv1.ResourceRule{{Verbs: []string{"get"}}},
+				SelectorRules: []authzv1alpha1.SelectorResourceRule{{Verbs: []string{"list"}}, {Verbs: []string{"watch"}}},
+			}},
+		}, 3},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1629,6 +1635,29 @@ func TestSubjectLimiterCacheIsBounded(t *testing.T) {
 	}
 }
 
+func TestSetRateLimit(t *testing.T) {
+	handler := &Authorizer{
+		Log:     logr.Discard(),
+		Limiter: rate.NewLimiter(rate.Limit(1), 1),
+	}
+	existing := handler.subjectLimiter("existing-user")
+
+	if !handler.SetRateLimit(rate.Limit(50), 100) {
+		t.Fatal("expected SetRateLimit to apply to an enabled limiter")
+	}
+	if existing.Limit() != rate.Limit(50) || existing.Burst() != 100 {
+		t.Errorf("expected existing subject limiter to be updated, got limit %v burst %d", existing.Limit(), existing.Burst())
+	}
+	if created := handler.subjectLimiter("new-user"); created.Limit() != rate.Limit(50) || created.Burst() != 100 {
+		t.Errorf("expected new subject limiter to use the new limit, got limit %v burst %d", created.Limit(), created.Burst())
+	}
+
+	disabled := &Authorizer{Log: logr.Discard()}
+	if disabled.SetRateLimit(rate.Limit(50), 100) {
+		t.Error("expected SetRateLimit to report false without a limiter")
+	}
+}
+
 func TestServeHTTP_NoRateLimiter(t *testing.T) {
 	var buf strings.Builder
 	logger := capturingLogger(&buf, 0)
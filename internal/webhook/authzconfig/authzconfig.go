@@ -0,0 +1,378 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authzconfig
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+const (
+	// AuthorizePath is the webhook server path that serves SubjectAccessReviews.
+	AuthorizePath = "/authorize"
+
+	// DefaultAuthorizerName is the name of the rendered Webhook authorizer entry.
+	// It also names the cluster, user and context in the rendered kubeconfig.
+	DefaultAuthorizerName = "auth-operator"
+
+	// DefaultKubeconfigPath is where the API server is expected to read the
+	// rendered webhook kubeconfig from.
+	DefaultKubeconfigPath = "/etc/kubernetes/auth-operator-authz.kubeconfig"
+
+	// subjectAccessReviewVersion is the SubjectAccessReview version served by
+	// /authorize and used for matchCondition evaluation.
+	subjectAccessReviewVersion = "v1"
+
+	// maxWebhookTimeout is the API server's upper bound for webhook timeouts.
+	maxWebhookTimeout = 30 * time.Second
+)
+
+// Options configures the rendered AuthorizationConfiguration and kubeconfig.
+type Options struct {
+	// AuthorizerName names the Webhook authorizer entry. Defaults to
+	// DefaultAuthorizerName.
+	AuthorizerName string
+	// ServerURL is the full https URL of the /authorize endpoint.
+	ServerURL string
+	// CAData is the PEM-encoded CA bundle that signs the webhook serving
+	// certificate.
+	CAData []byte
+	// Token is the bearer token the API server presents to /authorize. An
+	// empty token renders a kubeconfig without user credentials.
+	Token string
+	// KubeconfigPath is the path the API server reads the webhook kubeconfig
+	// from. Defaults to DefaultKubeconfigPath.
+	KubeconfigPath string
+	// Timeout bounds each webhook call. Must be between 0 and 30s.
+	Timeout time.Duration
+	// AuthorizedTTL and UnauthorizedTTL set the API server decision cache
+	// durations.
+	AuthorizedTTL   time.Duration
+	UnauthorizedTTL time.Duration
+	// FailurePolicy is NoOpinion or Deny.
+	FailurePolicy string
+	// IncludeDefaultAuthorizers wraps the webhook between the Node and RBAC
+	// authorizers so the rendered file can replace --authorization-mode.
+	IncludeDefaultAuthorizers bool
+	// DisableMatchConditions renders the webhook without matchConditions so
+	// every SubjectAccessReview reaches /authorize.
+	DisableMatchConditions bool
+}
+
+// ServerURL returns the /authorize URL for a webhook service DNS name and port.
+func ServerURL(dnsName string, port int) string {
+	return "https://" + net.JoinHostPort(dnsName, strconv.Itoa(port)) + AuthorizePath
+}
+
+// Validate reports option values the API server would reject.
+func (o *Options) Validate() error {
+	var errs []error
+	if !strings.HasPrefix(o.ServerURL, "https://") {
+		errs = append(errs, fmt.Errorf("server URL %q must use https", o.ServerURL))
+	}
+	if len(o.CAData) == 0 {
+		errs = append(errs, errors.New("CA data is required"))
+	}
+	if o.Timeout <= 0 || o.Timeout > maxWebhookTimeout {
+		errs = append(errs, fmt.Errorf("timeout %s must be greater than 0s and at most %s", o.Timeout, maxWebhookTimeout))
+	}
+	if o.AuthorizedTTL <= 0 {
+		errs = append(errs, fmt.Errorf("authorized TTL %s must be greater than 0s", o.AuthorizedTTL))
+	}
+	if o.UnauthorizedTTL <= 0 {
+		errs = append(errs, fmt.Errorf("unauthorized TTL %s must be greater than 0s", o.UnauthorizedTTL))
+	}
+	switch o.FailurePolicy {
+	case apiserverv1.FailurePolicyNoOpinion, apiserverv1.FailurePolicyDeny:
+	default:
+		errs = append(errs, fmt.Errorf("failure policy %q must be %s or %s",
+			o.FailurePolicy, apiserverv1.FailurePolicyNoOpinion, apiserverv1.FailurePolicyDeny))
+	}
+	return errors.Join(errs...)
+}
+
+func (o *Options) authorizerName() string {
+	if o.AuthorizerName == "" {
+		return DefaultAuthorizerName
+	}
+	return o.AuthorizerName
+}
+
+func (o *Options) kubeconfigPath() string {
+	if o.KubeconfigPath == "" {
+		return DefaultKubeconfigPath
+	}
+	return o.KubeconfigPath
+}
+
+// BuildAuthorizationConfiguration returns the AuthorizationConfiguration for
+// the auth-operator webhook. Unless DisableMatchConditions is set, the webhook
+// carries a single matchCondition that lets through only SubjectAccessReviews
+// some WebhookAuthorizer in authorizers could match.
+func BuildAuthorizationConfiguration(opts Options, authorizers []authorizationv1alpha1.WebhookAuthorizer) *apiserverv1.AuthorizationConfiguration {
+	kubeconfigPath := opts.kubeconfigPath()
+	webhook := apiserverv1.AuthorizerConfiguration{
+		Type: string(apiserverv1.TypeWebhook),
+		Name: opts.authorizerName(),
+		Webhook: &apiserverv1.WebhookConfiguration{
+			AuthorizedTTL:                            metav1.Duration{Duration: opts.AuthorizedTTL},
+			UnauthorizedTTL:                          metav1.Duration{Duration: opts.UnauthorizedTTL},
+			Timeout:                                  metav1.Duration{Duration: opts.Timeout},
+			SubjectAccessReviewVersion:               subjectAccessReviewVersion,
+			MatchConditionSubjectAccessReviewVersion: subjectAccessReviewVersion,
+			FailurePolicy:                            opts.FailurePolicy,
+			MatchConditions:                          []apiserverv1.WebhookMatchCondition{},
+			ConnectionInfo: apiserverv1.WebhookConnectionInfo{
+				Type:           apiserverv1.AuthorizationWebhookConnectionInfoTypeKubeConfigFile,
+				KubeConfigFile: &kubeconfigPath,
+			},
+		},
+	}
+	if !opts.DisableMatchConditions {
+		if expression, ok := MatchConditionExpression(authorizers); ok {
+			webhook.Webhook.MatchConditions = append(webhook.Webhook.MatchConditions, apiserverv1.WebhookMatchCondition{Expression: expression})
+		}
+	}
+
+	authorizerConfigs := []apiserverv1.AuthorizerConfiguration{webhook}
+	if opts.IncludeDefaultAuthorizers {
+		// The webhook sits before RBAC so its explicit deny decisions take effect;
+		// a no-opinion answer falls through to RBAC unchanged.
+		authorizerConfigs = []apiserverv1.AuthorizerConfiguration{
+			{Type: "Node", Name: "node"},
+			webhook,
+			{Type: "RBAC", Name: "rbac"},
+		}
+	}
+	return &apiserverv1.AuthorizationConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiserverv1.SchemeGroupVersion.String(),
+			Kind:       "AuthorizationConfiguration",
+		},
+		Authorizers: authorizerConfigs,
+	}
+}
+
+// BuildKubeconfig returns the kubeconfig the API server uses to call the
+// /authorize endpoint.
+func BuildKubeconfig(opts Options) *clientcmdapi.Config {
+	name := opts.authorizerName()
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   opts.ServerURL,
+		CertificateAuthorityData: opts.CAData,
+	}
+	authInfo := clientcmdapi.NewAuthInfo()
+	authInfo.Token = opts.Token
+	config.AuthInfos[name] = authInfo
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	config.CurrentContext = name
+	return config
+}
+
+// Rendered holds the serialized AuthorizationConfiguration and kubeconfig.
+type Rendered struct {
+	AuthorizationConfiguration []byte
+	Kubeconfig                 []byte
+}
+
+// Render validates opts and serializes the AuthorizationConfiguration and
+// kubeconfig as YAML.
+func Render(opts Options, authorizers []authorizationv1alpha1.WebhookAuthorizer) (*Rendered, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	authzConfig, err := yaml.Marshal(BuildAuthorizationConfiguration(opts, authorizers))
+	if err != nil {
+		return nil, fmt.Errorf("marshal AuthorizationConfiguration: %w", err)
+	}
+	kubeconfig, err := clientcmd.Write(*BuildKubeconfig(opts))
+	if err != nil {
+		return nil, fmt.Errorf("marshal kubeconfig: %w", err)
+	}
+	return &Rendered{AuthorizationConfiguration: authzConfig, Kubeconfig: kubeconfig}, nil
+}
+
+// MatchConditionExpression returns a CEL expression over the SubjectAccessReview
+// spec that is true for every request at least one of authorizers could match,
+// using the union of their resource, selector and non-resource rules. Verbs,
+// principals and namespace selectors are ignored so the expression stays a
+// superset of what /authorize decides on. ok is false when every request may
+// match, in which case no matchCondition is needed.
+//
+// The expression is a snapshot: after WebhookAuthorizer rules change, the
+// configuration must be rendered again or new scopes are never sent to the
+// webhook.
+func MatchConditionExpression(authorizers []authorizationv1alpha1.WebhookAuthorizer) (expression string, ok bool) {
+	resourceAll, nonResourceAll := false, false
+	resourceTerms := map[string]struct{}{}
+	nonResourceTerms := map[string]struct{}{}
+	for i := range authorizers {
+		spec := &authorizers[i].Spec
+		rules := slices.Clone(spec.ResourceRules)
+		for j := range spec.SelectorRules {
+			rules = append(rules, spec.SelectorRules[j].ResourceRule())
+		}
+		for j := range rules {
+			term := resourceRuleTerm(&rules[j])
+			if term == "" {
+				resourceAll = true
+				continue
+			}
+			resourceTerms[term] = struct{}{}
+		}
+		for _, rule := range spec.NonResourceRules {
+			term := nonResourceRuleTerm(rule)
+			if term == "" {
+				nonResourceAll = true
+				continue
+			}
+			nonResourceTerms[term] = struct{}{}
+		}
+	}
+	if resourceAll && nonResourceAll {
+		return "", false
+	}
+
+	var clauses []string
+	if clause := attributesClause("request.resourceAttributes", resourceAll, resourceTerms); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := attributesClause("request.nonResourceAttributes", nonResourceAll, nonResourceTerms); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if len(clauses) == 0 {
+		// No WebhookAuthorizer has any rule, so /authorize can only return
+		// no opinion.
+		return "false", true
+	}
+	return strings.Join(clauses, " || "), true
+}
+
+// attributesClause guards the union of terms with a has() check on field.
+// all means every request carrying field may match.
+func attributesClause(field string, all bool, terms map[string]struct{}) string {
+	if all {
+		return "has(" + field + ")"
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	sorted := make([]string, 0, len(terms))
+	for term := range terms {
+		sorted = append(sorted, term)
+	}
+	slices.Sort(sorted)
+	return "(has(" + field + ") && (" + strings.Join(sorted, " || ") + "))"
+}
+
+// resourceRuleTerm returns the CEL term matching the API groups and resources
+// of rule, following the matching in the /authorize evaluator. It returns ""
+// when the rule matches every resource request.
+func resourceRuleTerm(rule *authzv1.ResourceRule) string {
+	var conditions []string
+	if !slices.Contains(rule.APIGroups, "*") {
+		conditions = append(conditions, "request.resourceAttributes.group in "+celStringList(rule.APIGroups))
+	}
+	if !slices.Contains(rule.Resources, "*") {
+		conditions = append(conditions, resourcesCondition(rule.Resources))
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// resourcesCondition matches plain resources, "resource/subresource" pairs and
+// "*/subresource" wildcards.
+func resourcesCondition(patterns []string) string {
+	var plain, composed, anySubresource []string
+	for _, pattern := range patterns {
+		resource, subresource, found := strings.Cut(pattern, "/")
+		switch {
+		case !found:
+			plain = append(plain, pattern)
+		case resource == "*":
+			anySubresource = append(anySubresource, subresource)
+		default:
+			composed = append(composed, pattern)
+		}
+	}
+
+	var alternatives []string
+	if len(plain) > 0 {
+		alternatives = append(alternatives, "(request.resourceAttributes.subresource == '' && request.resourceAttributes.resource in "+celStringList(plain)+")")
+	}
+	if len(composed) > 0 {
+		alternatives = append(alternatives, "(request.resourceAttributes.subresource != '' && "+
+			"request.resourceAttributes.resource + '/' + request.resourceAttributes.subresource in "+celStringList(composed)+")")
+	}
+	if len(anySubresource) > 0 {
+		alternatives = append(alternatives, "(request.resourceAttributes.subresource in "+celStringList(anySubresource)+")")
+	}
+	switch len(alternatives) {
+	case 0:
+		return "false"
+	case 1:
+		return alternatives[0]
+	default:
+		return "(" + strings.Join(alternatives, " || ") + ")"
+	}
+}
+
+// nonResourceRuleTerm returns the CEL term matching the URLs of rule, or ""
+// when the rule matches every non-resource request.
+func nonResourceRuleTerm(rule authzv1.NonResourceRule) string {
+	var exact, alternatives []string
+	for _, url := range rule.NonResourceURLs {
+		switch {
+		case url == "*":
+			return ""
+		case strings.HasSuffix(url, "/*"):
+			alternatives = append(alternatives, "request.nonResourceAttributes.path.startsWith("+celString(strings.TrimSuffix(url, "*"))+")")
+		default:
+			exact = append(exact, url)
+		}
+	}
+	if len(exact) > 0 {
+		alternatives = append([]string{"request.nonResourceAttributes.path in " + celStringList(exact)}, alternatives...)
+	}
+	switch len(alternatives) {
+	case 0:
+		return "false"
+	case 1:
+		return alternatives[0]
+	default:
+		return "(" + strings.Join(alternatives, " || ") + ")"
+	}
+}
+
+// celStringList renders values as a sorted, de-duplicated CEL list literal.
+func celStringList(values []string) string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	quoted := make([]string, len(sorted))
+	for i, value := range sorted {
+		quoted[i] = celString(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// celString renders value as a single-quoted CEL string literal.
+func celString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authzconfig
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	authzv1 "k8s.io/api/authorization/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func validOptions() Options {
+	return Options{
+		ServerURL:       ServerURL("auth-operator-webhook.auth-operator-system.svc", 9443),
+		CAData:          []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+		Token:           "s3cr3t",
+		Timeout:         3 * time.Second,
+		AuthorizedTTL:   5 * time.Minute,
+		UnauthorizedTTL: 30 * time.Second,
+		FailurePolicy:   apiserverv1.FailurePolicyNoOpinion,
+	}
+}
+
+func webhookAuthorizer(name string, spec authorizationv1alpha1.WebhookAuthorizerSpec) authorizationv1alpha1.WebhookAuthorizer {
+	wa := authorizationv1alpha1.WebhookAuthorizer{Spec: spec}
+	wa.Name = name
+	return wa
+}
+
+// sarRequest mirrors how the API server exposes a SubjectAccessReview spec to
+// matchCondition CEL: every string attribute is present, even when empty.
+func sarRequest(resource *authzv1.ResourceAttributes, nonResource *authzv1.NonResourceAttributes) map[string]any {
+	request := map[string]any{"user": "alice", "groups": []string{}, "uid": "", "extra": map[string][]string{}}
+	if resource != nil {
+		request["resourceAttributes"] = map[string]any{
+			"namespace":   resource.Namespace,
+			"verb":        resource.Verb,
+			"group":       resource.Group,
+			"version":     resource.Version,
+			"resource":    resource.Resource,
+			"subresource": resource.Subresource,
+			"name":        resource.Name,
+		}
+	}
+	if nonResource != nil {
+		request["nonResourceAttributes"] = map[string]any{"verb": nonResource.Verb, "path": nonResource.Path}
+	}
+	return request
+}
+
+func evalMatchCondition(t *testing.T, expression string, request map[string]any) bool {
+	t.Helper()
+	env, err := cel.NewEnv(cel.Variable("request", cel.DynType))
+	if err != nil {
+		t.Fatalf("cel env: %v", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		t.Fatalf("compile %q: %v", expression, issues.Err())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		t.Fatalf("program: %v", err)
+	}
+	out, _, err := program.Eval(map[string]any{"request": request})
+	if err != nil {
+		t.Fatalf("eval %q: %v", expression, err)
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		t.Fatalf("expression %q returned %T, want bool", expression, out.Value())
+	}
+	return matched
+}
+
+func TestMatchConditionExpression(t *testing.T) {
+	t.Parallel()
+
+	authorizers := []authorizationv1alpha1.WebhookAuthorizer{
+		webhookAuthorizer("pods", authorizationv1alpha1.WebhookAuthorizerSpec{
+			ResourceRules: []authzv1.ResourceRule{{
+				Verbs:     []string{"get"},
+				APIGroups: []string{""},
+				Resources: []string{"pods", "pods/log"},
+			}},
+		}),
+		webhookAuthorizer("scale", authorizationv1alpha1.WebhookAuthorizerSpec{
+			ResourceRules: []authzv1.ResourceRule{{
+				Verbs:     []string{"update"},
+				APIGroups: []string{"*"},
+				Resources: []string{"*/scale"},
+			}},
+			SelectorRules: []authorizationv1alpha1.SelectorResourceRule{{
+				Verbs:     []string{"list"},
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+			}},
+		}),
+		webhookAuthorizer("metrics", authorizationv1alpha1.WebhookAuthorizerSpec{
+			NonResourceRules: []authzv1.NonResourceRule{{
+				Verbs:           []string{"get"},
+				NonResourceURLs: []string{"/metrics", "/debug/*"},
+			}},
+		}),
+	}
+	expression, ok := MatchConditionExpression(authorizers)
+	if !ok {
+		t.Fatal("expected a matchCondition for scoped authorizers")
+	}
+
+	testCases := []struct {
+		name        string
+		resource    *authzv1.ResourceAttributes
+		nonResource *authzv1.NonResourceAttributes
+		want        bool
+	}{
+		{name: "core pods", resource: &authzv1.ResourceAttributes{Verb: "list", Resource: "pods"}, want: true},
+		{name: "pods log subresource", resource: &authzv1.ResourceAttributes{Resource: "pods", Subresource: "log"}, want: true},
+		{name: "pods exec subresource", resource: &authzv1.ResourceAttributes{Resource: "pods", Subresource: "exec"}},
+		{name: "pods in other group", resource: &authzv1.ResourceAttributes{Group: "metrics.k8s.io", Resource: "pods"}},
+		{name: "any scale subresource", resource: &authzv1.ResourceAttributes{Group: "apps", Resource: "statefulsets", Subresource: "scale"}, want: true},
+		{name: "selector rule resource", resource: &authzv1.ResourceAttributes{Group: "apps", Resource: "deployments"}, want: true},
+		{name: "unrelated resource", resource: &authzv1.ResourceAttributes{Group: "apps", Resource: "daemonsets"}},
+		{name: "exact path", nonResource: &authzv1.NonResourceAttributes{Verb: "get", Path: "/metrics"}, want: true},
+		{name: "prefix path", nonResource: &authzv1.NonResourceAttributes{Verb: "get", Path: "/debug/pprof"}, want: true},
+		{name: "unrelated path", nonResource: &authzv1.NonResourceAttributes{Verb: "get", Path: "/healthz"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := evalMatchCondition(t, expression, sarRequest(tc.resource, tc.nonResource)); got != tc.want {
+				t.Errorf("match = %v, want %v for expression %s", got, tc.want, expression)
+			}
+		})
+	}
+}
+
+func TestMatchConditionExpressionWildcards(t *testing.T) {
+	t.Parallel()
+
+	allResources := webhookAuthorizer("all-resources", authorizationv1alpha1.WebhookAuthorizerSpec{
+		ResourceRules: []authzv1.ResourceRule{{Verbs: []string{"get"}, APIGroups: []string{"*"}, Resources: []string{"*"}}},
+	})
+	allPaths := webhookAuthorizer("all-paths", authorizationv1alpha1.WebhookAuthorizerSpec{
+		NonResourceRules: []authzv1.NonResourceRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"*"}}},
+	})
+
+	if _, ok := MatchConditionExpression([]authorizationv1alpha1.WebhookAuthorizer{allResources, allPaths}); ok {
+		t.Error("expected no matchCondition when every request may match")
+	}
+
+	expression, ok := MatchConditionExpression([]authorizationv1alpha1.WebhookAuthorizer{allResources})
+	if !ok {
+		t.Fatal("expected a matchCondition when non-resource requests cannot match")
+	}
+	if !evalMatchCondition(t, expression, sarRequest(&authzv1.ResourceAttributes{Group: "x.example.com", Resource: "widgets"}, nil)) {
+		t.Error("expected any resource request to match")
+	}
+	if evalMatchCondition(t, expression, sarRequest(nil, &authzv1.NonResourceAttributes{Path: "/healthz"})) {
+		t.Error("expected non-resource request not to match")
+	}
+
+	expression, ok = MatchConditionExpression(nil)
+	if !ok || expression != "false" {
+		t.Errorf("MatchConditionExpression(nil) = %q, %v; want \"false\", true", expression, ok)
+	}
+}
+
+func TestCELStringEscapes(t *testing.T) {
+	t.Parallel()
+
+	if got := celStringList([]string{"b", `it's`, "a", "b"}); got != `['a', 'b', 'it\'s']` {
+		t.Errorf("celStringList() = %s", got)
+	}
+}
+
+func TestOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		modify func(*Options)
+		want   string
+	}{
+		{name: "valid", modify: func(*Options) {}},
+		{name: "http server", modify: func(o *Options) { o.ServerURL = "http://example.com/authorize" }, want: "must use https"},
+		{name: "missing CA", modify: func(o *Options) { o.CAData = nil }, want: "CA data is required"},
+		{name: "timeout too long", modify: func(o *Options) { o.Timeout = time.Minute }, want: "timeout 1m0s"},
+		{name: "zero authorized TTL", modify: func(o *Options) { o.AuthorizedTTL = 0 }, want: "authorized TTL"},
+		{name: "unknown failure policy", modify: func(o *Options) { o.FailurePolicy = "Allow" }, want: `failure policy "Allow"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			opts := validOptions()
+			tc.modify(&opts)
+			err := opts.Validate()
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	opts := validOptions()
+	opts.IncludeDefaultAuthorizers = true
+	authorizers := []authorizationv1alpha1.WebhookAuthorizer{
+		webhookAuthorizer("pods", authorizationv1alpha1.WebhookAuthorizerSpec{
+			ResourceRules: []authzv1.ResourceRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+		}),
+	}
+	rendered, err := Render(opts, authorizers)
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+
+	var authzConfig apiserverv1.AuthorizationConfiguration
+	if err := yaml.UnmarshalStrict(rendered.AuthorizationConfiguration, &authzConfig); err != nil {
+		t.Fatalf("unmarshal AuthorizationConfiguration: %v\n%s", err, rendered.AuthorizationConfiguration)
+	}
+	if authzConfig.APIVersion != "apiserver.config.k8s.io/v1" || authzConfig.Kind != "AuthorizationConfiguration" {
+		t.Errorf("unexpected type meta %s/%s", authzConfig.APIVersion, authzConfig.Kind)
+	}
+	var names []string
+	for _, a := range authzConfig.Authorizers {
+		names = append(names, a.Name)
+	}
+	if got := strings.Join(names, ","); got != "node,auth-operator,rbac" {
+		t.Fatalf("authorizer order = %s, want node,auth-operator,rbac", got)
+	}
+	webhook := authzConfig.Authorizers[1].Webhook
+	if webhook == nil || webhook.ConnectionInfo.KubeConfigFile == nil || *webhook.ConnectionInfo.KubeConfigFile != DefaultKubeconfigPath {
+		t.Fatalf("unexpected webhook connection info: %+v", webhook)
+	}
+	if len(webhook.MatchConditions) != 1 || !strings.Contains(webhook.MatchConditions[0].Expression, "'pods'") {
+		t.Errorf("unexpected matchConditions: %+v", webhook.MatchConditions)
+	}
+	if webhook.Timeout.Duration != 3*time.Second || webhook.FailurePolicy != apiserverv1.FailurePolicyNoOpinion {
+		t.Errorf("unexpected webhook settings: %+v", webhook)
+	}
+
+	kubeconfig, err := clientcmd.Load(rendered.Kubeconfig)
+	if err != nil {
+		t.Fatalf("load kubeconfig: %v", err)
+	}
+	cluster := kubeconfig.Clusters[DefaultAuthorizerName]
+	if cluster == nil || cluster.Server != "https://auth-operator-webhook.auth-operator-system.svc:9443/authorize" {
+		t.Fatalf("unexpected cluster: %+v", cluster)
+	}
+	if string(cluster.CertificateAuthorityData) != string(opts.CAData) {
+		t.Error("CA data was not embedded")
+	}
+	if kubeconfig.AuthInfos[DefaultAuthorizerName].Token != "s3cr3t" {
+		t.Error("token was not embedded")
+	}
+	if kubeconfig.CurrentContext != DefaultAuthorizerName {
+		t.Errorf("current context = %q", kubeconfig.CurrentContext)
+	}
+}
+
+func TestRenderWithoutMatchConditions(t *testing.T) {
+	t.Parallel()
+
+	opts := validOptions()
+	opts.DisableMatchConditions = true
+	config := BuildAuthorizationConfiguration(opts, []authorizationv1alpha1.WebhookAuthorizer{
+		webhookAuthorizer("pods", authorizationv1alpha1.WebhookAuthorizerSpec{
+			ResourceRules: []authzv1.ResourceRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+		}),
+	})
+	if len(config.Authorizers) != 1 {
+		t.Fatalf("expected only the webhook authorizer, got %d", len(config.Authorizers))
+	}
+	if conditions := config.Authorizers[0].Webhook.MatchConditions; len(conditions) != 0 {
+		t.Errorf("expected no matchConditions, got %+v", conditions)
+	}
+}
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authzconfig renders the API server AuthorizationConfiguration and
+// webhook kubeconfig that wire the auth-operator /authorize endpoint into the
+// Kubernetes authorization chain.
+package authzconfig
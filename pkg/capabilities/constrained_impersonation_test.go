@@ -230,7 +230,7 @@ func TestStateFromVersion(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := stateFromVersion(tt.gitVersion)
+			got := CapabilityConstrainedImpersonation.stateFromVersion(tt.gitVersion)
 			if got.State != tt.wantState {
 				t.Errorf("state = %q, want %q (detail: %s)", got.State, tt.wantState, got.Detail)
 			}
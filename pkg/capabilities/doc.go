@@ -6,7 +6,9 @@
 // runtime so the operator can degrade gracefully instead of generating RBAC that
 // silently grants nothing.
 //
-// The only capability modelled today is Kubernetes constrained impersonation
-// (KEP-5284), which is gated behind the ConstrainedImpersonation kube-apiserver
-// feature gate.
+// Each capability is a kube-apiserver feature gate listed in a small registry
+// (see Registered) together with the releases in which the gate went alpha, beta
+// and GA: constrained impersonation (KEP-5284), selector-aware authorization,
+// structured authorization configuration and CRD validation ratcheting. The
+// Detector probes them together and caches each answer.
 package capabilities
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capabilities
+
+import (
+	"fmt"
+	"slices"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// Capability describes an optional API server feature guarded by a
+// kube-apiserver feature gate, and the releases in which that gate changed
+// stage. The stages drive the version fallback when /metrics is unreadable.
+type Capability struct {
+	// Name is the kube-apiserver feature gate name. It doubles as the capability
+	// name in the OperatorCapabilities status.
+	Name string
+	// Description is a short lower-case summary used inside detail messages,
+	// e.g. "constrained impersonation (KEP-5284)".
+	Description string
+	// AlphaMinor is the first Kubernetes 1.x minor release that knows the gate
+	// (alpha, off by default).
+	AlphaMinor int
+	// BetaMinor is the first minor release in which the gate is on by default.
+	BetaMinor int
+	// GAMinor is the first minor release in which the gate is locked on. Zero
+	// means the gate has not graduated.
+	GAMinor int
+	// DisabledImpact is appended to the detail of a Disabled result and says
+	// what operator behaviour is lost without the capability.
+	DisabledImpact string
+}
+
+// Well-known capabilities. Registered returns them in this order.
+var (
+	// CapabilityConstrainedImpersonation gates the impersonate:<mode> and
+	// impersonate-on:<mode>:<verb> RBAC verbs.
+	CapabilityConstrainedImpersonation = Capability{
+		Name:        authorizationv1alpha1.ConstrainedImpersonationFeatureGate,
+		Description: "constrained impersonation (KEP-5284)",
+		AlphaMinor:  35,
+		BetaMinor:   36,
+		DisabledImpact: "Constrained impersonation grants are inert: the generated impersonate:<mode> and " +
+			"impersonate-on:<mode>:<verb> rules are accepted by RBAC but never matched.",
+	}
+	// CapabilityAuthorizeWithSelectors gates field and label selectors in the
+	// SubjectAccessReviews the API server sends to authorization webhooks.
+	CapabilityAuthorizeWithSelectors = Capability{
+		Name:        "AuthorizeWithSelectors",
+		Description: "selector-aware authorization (KEP-4601)",
+		AlphaMinor:  31,
+		BetaMinor:   32,
+		GAMinor:     34,
+		DisabledImpact: "SubjectAccessReviews carry no field or label selectors, so WebhookAuthorizer " +
+			"rules that depend on them cannot match.",
+	}
+	// CapabilityStructuredAuthorizationConfiguration gates configuring the
+	// authorizer chain from an AuthorizationConfiguration file.
+	CapabilityStructuredAuthorizationConfiguration = Capability{
+		Name:        "StructuredAuthorizationConfiguration",
+		Description: "structured authorization configuration (KEP-3221)",
+		AlphaMinor:  29,
+		BetaMinor:   30,
+		GAMinor:     32,
+		DisabledImpact: "The authorization webhook must be configured with the legacy " +
+			"--authorization-webhook-* flags instead of an AuthorizationConfiguration file.",
+	}
+	// CapabilityCRDValidationRatcheting gates letting updates keep unchanged
+	// fields that no longer pass a tightened CRD schema.
+	CapabilityCRDValidationRatcheting = Capability{
+		Name:        "CRDValidationRatcheting",
+		Description: "CRD validation ratcheting (KEP-4008)",
+		AlphaMinor:  28,
+		BetaMinor:   30,
+		GAMinor:     33,
+		DisabledImpact: "Updates to existing custom resources are rejected when an unchanged field no " +
+			"longer passes a tightened CRD schema.",
+	}
+)
+
+var registry = []Capability{
+	CapabilityConstrainedImpersonation,
+	CapabilityAuthorizeWithSelectors,
+	CapabilityStructuredAuthorizationConfiguration,
+	CapabilityCRDValidationRatcheting,
+}
+
+// Registered returns every capability the Detector knows about.
+func Registered() []Capability {
+	return slices.Clone(registry)
+}
+
+// Lookup returns the registered capability with the given name.
+func Lookup(name string) (Capability, bool) {
+	i := slices.IndexFunc(registry, func(c Capability) bool { return c.Name == name })
+	if i < 0 {
+		return Capability{}, false
+	}
+	return registry[i], true
+}
+
+// stateFromMetricsOrVersion prefers the gate's state from a /metrics body and
+// falls back to the server version when the body is empty or lacks the gate.
+func (c Capability) stateFromMetricsOrVersion(metricsBody []byte, serverVersion string) Result {
+	enabled, found := ParseFeatureGateMetric(metricsBody, c.Name)
+	if !found {
+		return c.stateFromVersion(serverVersion)
+	}
+	if enabled {
+		return Result{
+			Capability:    c.Name,
+			State:         StateEnabled,
+			Reason:        ReasonFeatureGateEnabled,
+			Detail:        fmt.Sprintf("API server reports feature gate %s=true", c.Name),
+			ServerVersion: serverVersion,
+		}
+	}
+	return Result{
+		Capability: c.Name,
+		State:      StateDisabled,
+		Reason:     ReasonFeatureGateDisabled,
+		Detail: fmt.Sprintf(
+			"API server reports feature gate %s=false. %s Enable the gate with "+
+				"--feature-gates=%s=true on kube-apiserver (Kubernetes 1.%d+; on by default from 1.%d).",
+			c.Name, c.DisabledImpact, c.Name, c.AlphaMinor, c.BetaMinor),
+		ServerVersion: serverVersion,
+	}
+}
+
+// stateFromVersion infers the capability state from a Kubernetes version string.
+// This is the single isolated place where a version comparison happens.
+//
+// Unparseable or empty versions yield StateUnknown rather than a guess. An alpha
+// release yields StateUnknown too, because the gate is off by default there but
+// may have been explicitly enabled — only the /metrics probe can tell.
+func (c Capability) stateFromVersion(gitVersion string) Result {
+	result := Result{Capability: c.Name, ServerVersion: gitVersion}
+	major, minor, ok := parseMajorMinor(gitVersion)
+	if !ok {
+		result.State = StateUnknown
+		result.Reason = ReasonVersionUnknown
+		result.Detail = fmt.Sprintf(
+			"could not determine API server version (%q) or read the %s metric, so support for feature gate %s is unknown. "+
+				"It requires Kubernetes 1.%d+ with the gate enabled (1.%d+ has it on by default).",
+			gitVersion, featureGateMetricName, c.Name, c.AlphaMinor, c.BetaMinor)
+		return result
+	}
+
+	switch {
+	case c.GAMinor > 0 && (major > 1 || minor >= c.GAMinor):
+		result.State = StateEnabled
+		result.Reason = ReasonFeatureGA
+		result.Detail = fmt.Sprintf("API server %s has feature gate %s at GA (always on).", gitVersion, c.Name)
+	case major > 1 || minor >= c.BetaMinor:
+		result.State = StateEnabled
+		result.Reason = ReasonVersionSupportsFeature
+		result.Detail = fmt.Sprintf(
+			"API server %s has feature gate %s at beta (on by default). Could not read the %s metric to confirm it was not explicitly disabled.",
+			gitVersion, c.Name, featureGateMetricName)
+	case minor >= c.AlphaMinor:
+		result.State = StateUnknown
+		result.Reason = ReasonFeatureGateAlpha
+		result.Detail = fmt.Sprintf(
+			"API server %s has feature gate %s at alpha (off by default) and the %s metric could not be read, "+
+				"so its effective state is unknown. Verify --feature-gates=%s=true on kube-apiserver.",
+			gitVersion, c.Name, featureGateMetricName, c.Name)
+	default:
+		result.State = StateDisabled
+		result.Reason = ReasonVersionTooOld
+		result.Detail = fmt.Sprintf("API server %s predates %s, added in Kubernetes 1.%d. %s",
+			gitVersion, c.Description, c.AlphaMinor, c.DisabledImpact)
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capabilities
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	for _, capability := range Registered() {
+		got, ok := Lookup(capability.Name)
+		if !ok || got.Name != capability.Name {
+			t.Errorf("Lookup(%q) = %v, %v; want the registered capability", capability.Name, got.Name, ok)
+		}
+	}
+	if _, ok := Lookup("NoSuchGate"); ok {
+		t.Error("Lookup of an unregistered name should fail")
+	}
+}
+
+func TestRegisteredReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	caps := Registered()
+	caps[0].Name = "Mutated"
+	if Registered()[0].Name == "Mutated" {
+		t.Error("Registered must not expose the registry slice")
+	}
+}
+
+func TestCapabilityStateFromVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		capability Capability
+		gitVersion string
+		wantState  State
+		wantReason string
+	}{
+		{"selectors at GA", CapabilityAuthorizeWithSelectors, "v1.34.0", StateEnabled, ReasonFeatureGA},
+		{"selectors at beta", CapabilityAuthorizeWithSelectors, "v1.32.4", StateEnabled, ReasonVersionSupportsFeature},
+		{"selectors at alpha", CapabilityAuthorizeWithSelectors, "v1.31.0", StateUnknown, ReasonFeatureGateAlpha},
+		{"selectors too old", CapabilityAuthorizeWithSelectors, "v1.30.2", StateDisabled, ReasonVersionTooOld},
+		{"structured authz at GA", CapabilityStructuredAuthorizationConfiguration, "v1.35.0", StateEnabled, ReasonFeatureGA},
+		{"ratcheting at GA on a future major", CapabilityCRDValidationRatcheting, "v2.0.0", StateEnabled, ReasonFeatureGA},
+		{"ungraduated gate is never GA", CapabilityConstrainedImpersonation, "v1.40.0", StateEnabled, ReasonVersionSupportsFeature},
+		{"unparseable version", CapabilityCRDValidationRatcheting, "garbage", StateUnknown, ReasonVersionUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.capability.stateFromVersion(tt.gitVersion)
+			if got.State != tt.wantState || got.Reason != tt.wantReason {
+				t.Errorf("stateFromVersion(%q) = %s/%s, want %s/%s",
+					tt.gitVersion, got.State, got.Reason, tt.wantState, tt.wantReason)
+			}
+			if got.Capability != tt.capability.Name {
+				t.Errorf("Capability = %q, want %q", got.Capability, tt.capability.Name)
+			}
+		})
+	}
+}
+
+func TestDetectorDetectAll(t *testing.T) {
+	t.Parallel()
+
+	// Metrics report selectors disabled explicitly; the other gates are absent and
+	// fall back to the version.
+	metrics := &stubMetrics{body: featureMetricsBody(CapabilityAuthorizeWithSelectors.Name, "0")}
+	d := &Detector{Metrics: metrics, Version: &stubVersion{gitVersion: "v1.33.1"}}
+
+	results := d.DetectAll(context.Background())
+	if calls := metrics.calls.Load(); calls != 1 {
+		t.Errorf("expected a single /metrics read for all capabilities, got %d", calls)
+	}
+	registered := Registered()
+	if len(results) != len(registered) {
+		t.Fatalf("DetectAll returned %d results, want %d", len(results), len(registered))
+	}
+	want := map[string]string{
+		CapabilityConstrainedImpersonation.Name:             ReasonVersionTooOld,
+		CapabilityAuthorizeWithSelectors.Name:               ReasonFeatureGateDisabled,
+		CapabilityStructuredAuthorizationConfiguration.Name: ReasonFeatureGA,
+		CapabilityCRDValidationRatcheting.Name:              ReasonFeatureGA,
+	}
+	for i, result := range results {
+		if result.Capability != registered[i].Name {
+			t.Errorf("result %d is %q, want registry order (%q)", i, result.Capability, registered[i].Name)
+		}
+		if result.Reason != want[result.Capability] {
+			t.Errorf("%s: reason %q, want %q", result.Capability, result.Reason, want[result.Capability])
+		}
+		if result.ServerVersion != "v1.33.1" {
+			t.Errorf("%s: server version %q, want v1.33.1", result.Capability, result.ServerVersion)
+		}
+	}
+	if !strings.Contains(results[1].Detail, CapabilityAuthorizeWithSelectors.DisabledImpact) {
+		t.Errorf("disabled detail should explain the impact, got %q", results[1].Detail)
+	}
+
+	// A single capability lookup is served from the shared cache.
+	if got := d.Detect(context.Background(), CapabilityAuthorizeWithSelectors.Name); got.State != StateDisabled {
+		t.Errorf("Detect = %s, want Disabled", got.State)
+	}
+	if calls := metrics.calls.Load(); calls != 1 {
+		t.Errorf("expected Detect to reuse the cached probe, got %d calls", calls)
+	}
+}
+
+func TestDetectorDetectUnregistered(t *testing.T) {
+	t.Parallel()
+
+	metrics := &stubMetrics{}
+	d := &Detector{Metrics: metrics}
+	got := d.Detect(context.Background(), "NoSuchGate")
+	if got.State != StateUnknown || got.Reason != ReasonNotRegistered || got.Capability != "NoSuchGate" {
+		t.Errorf("Detect(unregistered) = %+v", got)
+	}
+	if calls := metrics.calls.Load(); calls != 0 {
+		t.Errorf("an unregistered capability must not probe the API server, got %d calls", calls)
+	}
+}
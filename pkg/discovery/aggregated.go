@@ -0,0 +1,183 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"go.opentelemetry.io/otel/trace"
+	apidiscoveryv2 "k8s.io/api/apidiscovery/v2"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	"github.com/telekom/auth-operator/pkg/tracing"
+)
+
+// Discovery modes reported by the auth_operator_api_discovery_collections_total metric.
+const (
+	// discoveryModeAggregated means the aggregated discovery documents changed
+	// and were downloaded.
+	discoveryModeAggregated = "aggregated"
+	// discoveryModeNotModified means every aggregated discovery document was
+	// answered with 304 Not Modified.
+	discoveryModeNotModified = "not_modified"
+	// discoveryModeLegacy means one request per GroupVersion was made.
+	discoveryModeLegacy = "legacy"
+)
+
+// aggregatedDiscoveryPaths are the endpoints serving aggregated discovery:
+// the core group at /api and every other group at /apis.
+var aggregatedDiscoveryPaths = []string{"/api", "/apis"}
+
+// errAggregatedDiscoveryUnsupported is returned when the API server does not
+// answer in the apidiscovery.k8s.io/v2 format.
+var errAggregatedDiscoveryUnsupported = errors.New("aggregated discovery (apidiscovery.k8s.io/v2) is not supported by the API server")
+
+// aggregatedDocument is the converted content of one aggregated discovery
+// endpoint, kept with its ETag for conditional requests.
+type aggregatedDocument struct {
+	etag      string
+	resources APIResourcesByGroupVersion
+	failures  map[string]error
+}
+
+// collectAggregated fetches the aggregated discovery documents with
+// conditional requests and returns the resources and failing GroupVersions
+// they contain. A document answered with 304 Not Modified is taken from the
+// previous collection. It returns errAggregatedDiscoveryUnsupported if the API
+// server does not serve apidiscovery.k8s.io/v2.
+//
+// The caller must hold collectMu.
+func (r *ResourceTracker) collectAggregated(
+	ctx context.Context,
+	config *rest.Config,
+	discoveryClient *discovery.DiscoveryClient,
+) (APIResourcesByGroupVersion, map[string]error, string, error) {
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("create HTTP client for aggregated discovery: %w", err)
+	}
+
+	if r.aggregated == nil {
+		r.aggregated = make(map[string]*aggregatedDocument, len(aggregatedDiscoveryPaths))
+	}
+	collected := make(APIResourcesByGroupVersion)
+	failures := make(map[string]error)
+	mode := discoveryModeNotModified
+	for _, path := range aggregatedDiscoveryPaths {
+		doc, notModified, err := r.fetchAggregatedDocument(ctx, httpClient, discoveryClient.RESTClient(), path)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		if !notModified {
+			mode = discoveryModeAggregated
+		}
+		for gv, resources := range doc.resources {
+			collected[gv] = resources
+		}
+		for gv, err := range doc.failures {
+			failures[gv] = err
+		}
+	}
+	return collected, failures, mode, nil
+}
+
+// fetchAggregatedDocument fetches one aggregated discovery endpoint. It sends
+// the ETag of the previous response in If-None-Match and reports whether the
+// server answered 304 Not Modified.
+func (r *ResourceTracker) fetchAggregatedDocument(
+	ctx context.Context,
+	httpClient *http.Client,
+	restClient rest.Interface,
+	path string,
+) (doc *aggregatedDocument, notModified bool, retErr error) {
+	ctx, span := tracing.StartSpan(ctx, "discovery.AggregatedDocument", tracing.AttrPath.String(path))
+	defer func() {
+		if doc != nil {
+			recordAggregatedDocument(span, doc, notModified)
+		}
+		tracing.RecordError(span, retErr)
+		span.End()
+	}()
+
+	previous := r.aggregated[path]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, restClient.Get().AbsPath(path).URL().String(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Accept", discovery.AcceptV2)
+	if previous != nil && previous.etag != "" {
+		req.Header.Set("If-None-Match", previous.etag)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && previous != nil:
+		return previous, true, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, false, fmt.Errorf("GET %s: unexpected status %s", path, resp.Status)
+	}
+
+	isV2, err := discovery.ContentTypeIsGVK(resp.Header.Get("Content-Type"),
+		apidiscoveryv2.SchemeGroupVersion.WithKind("APIGroupDiscoveryList"))
+	if err != nil || !isV2 {
+		return nil, false, errAggregatedDiscoveryUnsupported
+	}
+
+	var list apidiscoveryv2.APIGroupDiscoveryList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, false, fmt.Errorf("decode aggregated discovery from %s: %w", path, err)
+	}
+
+	_, resourcesByGV, failedGVs := discovery.SplitGroupsAndResources(list)
+	doc = &aggregatedDocument{
+		etag:      resp.Header.Get("ETag"),
+		resources: make(APIResourcesByGroupVersion, len(resourcesByGV)),
+		failures:  make(map[string]error, len(failedGVs)),
+	}
+	for gv, resourceList := range resourcesByGV {
+		doc.resources[gv.String()] = expandAPIResources(gv.Group, gv.Version, resourceList.APIResources)
+	}
+	for gv, err := range failedGVs {
+		doc.failures[gv.String()] = err
+	}
+	r.aggregated[path] = doc
+	return doc, false, nil
+}
+
+// recordAggregatedDocument adds the GroupVersion counts of doc to span and an
+// event per GroupVersion the aggregated document reports as failing.
+func recordAggregatedDocument(span trace.Span, doc *aggregatedDocument, notModified bool) {
+	if !span.IsRecording() {
+		return
+	}
+	mode := discoveryModeAggregated
+	if notModified {
+		mode = discoveryModeNotModified
+	}
+	span.SetAttributes(
+		tracing.AttrDiscoveryMode.String(mode),
+		tracing.AttrResourceCount.Int(len(doc.resources)),
+		tracing.AttrFailureCount.Int(len(doc.failures)),
+	)
+	failed := make([]string, 0, len(doc.failures))
+	for gv := range doc.failures {
+		failed = append(failed, gv)
+	}
+	slices.Sort(failed)
+	for _, gv := range failed {
+		span.AddEvent("group version discovery failed", trace.WithAttributes(
+			tracing.AttrGroupVersion.String(gv),
+			tracing.AttrReason.String(doc.failures[gv].Error()),
+		))
+	}
+}
@@ -0,0 +1,237 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	apidiscoveryv2 "k8s.io/api/apidiscovery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	"github.com/telekom/auth-operator/pkg/tracing"
+)
+
+// discoveryServer is a fake API server serving discovery endpoints and
+// counting the requests per path and status.
+type discoveryServer struct {
+	mu         sync.Mutex
+	aggregated bool
+	etag       string
+	requests   map[string]int
+	notMod     int
+}
+
+func (s *discoveryServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.requests == nil {
+		s.requests = make(map[string]int)
+	}
+	s.requests[req.URL.Path]++
+
+	if s.aggregated && req.Header.Get("Accept") != "" &&
+		(req.URL.Path == "/api" || req.URL.Path == "/apis") {
+		if req.Header.Get("If-None-Match") == s.etag {
+			s.notMod++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList")
+		w.Header().Set("ETag", s.etag)
+		_ = json.NewEncoder(w).Encode(aggregatedList(req.URL.Path))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	var body any
+	switch req.URL.Path {
+	case "/api":
+		body = metav1.APIVersions{Versions: []string{"v1"}}
+	case "/apis":
+		body = metav1.APIGroupList{}
+	case "/api/v1":
+		body = metav1.APIResourceList{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		}}
+	default:
+		http.NotFound(w, req)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func aggregatedList(path string) apidiscoveryv2.APIGroupDiscoveryList {
+	if path == "/api" {
+		return apidiscoveryv2.APIGroupDiscoveryList{Items: []apidiscoveryv2.APIGroupDiscovery{{
+			Versions: []apidiscoveryv2.APIVersionDiscovery{{
+				Version:   "v1",
+				Freshness: apidiscoveryv2.DiscoveryFreshnessCurrent,
+				Resources: []apidiscoveryv2.APIResourceDiscovery{{
+					Resource:     "pods",
+					ResponseKind: &metav1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+					Scope:        apidiscoveryv2.ScopeNamespace,
+					Verbs:        []string{"get", "list"},
+					Subresources: []apidiscoveryv2.APISubresourceDiscovery{{
+						Subresource:  "status",
+						ResponseKind: &metav1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						Verbs:        []string{"get", "patch", "update"},
+					}},
+				}},
+			}},
+		}}}
+	}
+	return apidiscoveryv2.APIGroupDiscoveryList{Items: []apidiscoveryv2.APIGroupDiscovery{{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics.k8s.io"},
+		Versions: []apidiscoveryv2.APIVersionDiscovery{{
+			Version:   "v1beta1",
+			Freshness: apidiscoveryv2.DiscoveryFreshnessStale,
+		}},
+	}}}
+}
+
+func TestCollectAPIResourcesAggregated(t *testing.T) {
+	srv := &discoveryServer{aggregated: true, etag: `"v1"`}
+	server := httptest.NewServer(srv)
+	defer server.Close()
+
+	r := NewResourceTracker(nil, &rest.Config{Host: server.URL})
+	if _, err := r.collectAPIResources(context.Background()); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	resources := r.cache
+	names := make([]string, 0, len(resources["v1"]))
+	for _, resource := range resources["v1"] {
+		names = append(names, resource.Name)
+	}
+	if !slices.Contains(names, "pods") || !slices.Contains(names, "pods/status") {
+		t.Errorf("expected pods and pods/status, got %v", names)
+	}
+	degraded := r.DegradedGroupVersions()
+	if len(degraded) != 1 || degraded[0].GroupVersion != "metrics.k8s.io/v1beta1" {
+		t.Errorf("expected stale metrics.k8s.io/v1beta1 to be degraded, got %+v", degraded)
+	}
+
+	// An unchanged server is detected with one conditional request per path.
+	if _, err := r.collectAPIResources(context.Background()); err != nil {
+		t.Fatalf("second collect: %v", err)
+	}
+	if srv.notMod != 2 {
+		t.Errorf("expected 2 not modified responses, got %d", srv.notMod)
+	}
+	if srv.requests["/api/v1"] != 0 {
+		t.Errorf("expected no per-GroupVersion requests, got %d", srv.requests["/api/v1"])
+	}
+	if !r.cache.Equals(resources) {
+		t.Error("expected cache to be unchanged after not modified responses")
+	}
+}
+
+func TestCollectAPIResourcesLegacyFallback(t *testing.T) {
+	srv := &discoveryServer{}
+	server := httptest.NewServer(srv)
+	defer server.Close()
+
+	r := NewResourceTracker(nil, &rest.Config{Host: server.URL})
+	if _, err := r.collectAPIResources(context.Background()); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	resources := r.cache
+	if len(resources["v1"]) == 0 {
+		t.Fatal("expected core resources from per-GroupVersion discovery")
+	}
+	if srv.requests["/api/v1"] == 0 {
+		t.Error("expected a per-GroupVersion request after falling back")
+	}
+}
+
+func TestFetchAggregatedDocumentUnsupported(t *testing.T) {
+	server := httptest.NewServer(&discoveryServer{})
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		t.Fatalf("discovery client: %v", err)
+	}
+	r := NewResourceTracker(nil, config)
+	if _, _, _, err := r.collectAggregated(context.Background(), config, discoveryClient); err != errAggregatedDiscoveryUnsupported {
+		t.Errorf("expected errAggregatedDiscoveryUnsupported, got %v", err)
+	}
+}
+
+func TestCollectAPIResourcesTracing(t *testing.T) {
+	collectSpans := func(t *testing.T, srv *discoveryServer) (map[string]sdktrace.ReadOnlySpan, []string) {
+		t.Helper()
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		defer func() { _ = tp.Shutdown(context.Background()) }()
+
+		r := NewResourceTracker(nil, &rest.Config{Host: server.URL})
+		r.Tracer = tp.Tracer("test")
+		if _, err := r.collectAPIResources(context.Background()); err != nil {
+			t.Fatalf("collect: %v", err)
+		}
+
+		byName := make(map[string]sdktrace.ReadOnlySpan)
+		names := make([]string, 0, len(recorder.Ended()))
+		for _, span := range recorder.Ended() {
+			byName[span.Name()] = span
+			names = append(names, span.Name())
+		}
+		return byName, names
+	}
+
+	t.Run("aggregated", func(t *testing.T) {
+		spans, names := collectSpans(t, &discoveryServer{aggregated: true, etag: `"v1"`})
+		if len(names) != 3 {
+			t.Fatalf("expected a collection span and two document spans, got %v", names)
+		}
+		collect, ok := spans["discovery.Collect"]
+		if !ok {
+			t.Fatalf("expected discovery.Collect span, got %v", names)
+		}
+		if !slices.Contains(collect.Attributes(), tracing.AttrDiscoveryMode.String(discoveryModeAggregated)) {
+			t.Errorf("expected aggregated mode attribute, got %v", collect.Attributes())
+		}
+		var failedEvents []string
+		for _, span := range spans {
+			for _, event := range span.Events() {
+				for _, attr := range event.Attributes {
+					if attr.Key == tracing.AttrGroupVersion {
+						failedEvents = append(failedEvents, attr.Value.AsString())
+					}
+				}
+			}
+		}
+		if !slices.Equal(failedEvents, []string{"metrics.k8s.io/v1beta1"}) {
+			t.Errorf("expected a failure event for metrics.k8s.io/v1beta1, got %v", failedEvents)
+		}
+	})
+
+	t.Run("per GroupVersion", func(t *testing.T) {
+		spans, names := collectSpans(t, &discoveryServer{})
+		gv, ok := spans["discovery.GroupVersion"]
+		if !ok {
+			t.Fatalf("expected discovery.GroupVersion span, got %v", names)
+		}
+		if !slices.Contains(gv.Attributes(), tracing.AttrGroupVersion.String("v1")) {
+			t.Errorf("expected group version attribute, got %v", gv.Attributes())
+		}
+		if gv.Parent().SpanID() != spans["discovery.Collect"].SpanContext().SpanID() {
+			t.Error("expected GroupVersion span to be a child of the collection span")
+		}
+	})
+}
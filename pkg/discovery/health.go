@@ -0,0 +1,159 @@
+package discovery
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/telekom/auth-operator/pkg/metrics"
+)
+
+// DefaultStaleGroupVersionTTL is how long the last known good resources of a
+// failing GroupVersion are served before the GroupVersion is dropped from the
+// cache.
+const DefaultStaleGroupVersionTTL = 30 * time.Minute
+
+// GroupVersionHealth describes a GroupVersion whose latest discovery failed.
+type GroupVersionHealth struct {
+	// GroupVersion is the failing GroupVersion, e.g. "metrics.k8s.io/v1beta1".
+	GroupVersion string
+	// Stale is true while the last known good resources are still served.
+	// It is false once the staleness window elapsed, or when the GroupVersion
+	// never succeeded, and its resources are omitted from the cache.
+	Stale bool
+	// LastSuccess is the time of the last successful discovery; zero if the
+	// GroupVersion never succeeded since the tracker started.
+	LastSuccess time.Time
+	// FailingSince is the time of the first failure in the current streak.
+	FailingSince time.Time
+	// ConsecutiveFailures counts the collections that failed in a row.
+	ConsecutiveFailures int
+	// LastError is the error message of the latest failure.
+	LastError string
+}
+
+// groupVersionState is the tracker-internal discovery state of a GroupVersion.
+type groupVersionState struct {
+	lastSuccess         time.Time
+	failingSince        time.Time
+	consecutiveFailures int
+	lastError           string
+	stale               bool
+}
+
+// staleGroupVersionTTL returns the configured staleness window or the default.
+func (r *ResourceTracker) staleGroupVersionTTL() time.Duration {
+	if r.StaleGroupVersionTTL > 0 {
+		return r.StaleGroupVersionTTL
+	}
+	return DefaultStaleGroupVersionTTL
+}
+
+// mergeCollection folds the result of one collection into the cache and the
+// per-GroupVersion health. A failing GroupVersion keeps its last known good
+// resources while its last success is within the staleness window; afterwards,
+// or when it never succeeded, it is omitted. GroupVersions that are neither
+// collected nor failing were removed from discovery and are forgotten.
+//
+// The caller must hold cacheMu. It returns true if the cache changed.
+func (r *ResourceTracker) mergeCollection(collected APIResourcesByGroupVersion, failures map[string]error, now time.Time) bool {
+	if r.health == nil {
+		r.health = make(map[string]*groupVersionState)
+	}
+	ttl := r.staleGroupVersionTTL()
+
+	for gv := range collected {
+		r.health[gv] = &groupVersionState{lastSuccess: now}
+		metrics.APIDiscoveryGroupVersionHealthy.WithLabelValues(gv).Set(1)
+	}
+	for gv, err := range failures {
+		state, ok := r.health[gv]
+		if !ok {
+			state = &groupVersionState{}
+			r.health[gv] = state
+		}
+		if state.consecutiveFailures == 0 {
+			state.failingSince = now
+		}
+		state.consecutiveFailures++
+		state.lastError = err.Error()
+
+		previous, cached := r.cache[gv]
+		state.stale = cached && !state.lastSuccess.IsZero() && now.Sub(state.lastSuccess) < ttl
+		if state.stale {
+			collected[gv] = previous
+		}
+		metrics.APIDiscoveryGroupVersionErrors.WithLabelValues(gv).Inc()
+		metrics.APIDiscoveryGroupVersionHealthy.WithLabelValues(gv).Set(0)
+	}
+	for gv := range r.health {
+		if _, ok := failures[gv]; ok {
+			continue
+		}
+		if _, ok := collected[gv]; ok {
+			continue
+		}
+		delete(r.health, gv)
+		metrics.APIDiscoveryGroupVersionHealthy.DeleteLabelValues(gv)
+		metrics.APIDiscoveryGroupVersionErrors.DeleteLabelValues(gv)
+	}
+
+	if collected.Equals(r.cache) {
+		return false
+	}
+	r.cache = collected
+	return true
+}
+
+// applyCollection merges a collection like mergeCollection and reports whether
+// the cache or the discovery health changed. A health change is a GroupVersion
+// that starts or stops failing, or whose failure turns from stale to omitted;
+// consumers surface it (e.g. the APIDiscoveryDegraded condition) even when the
+// cached resources stay the same. Repeated failures alone are not a change.
+//
+// The caller must hold cacheMu.
+func (r *ResourceTracker) applyCollection(collected APIResourcesByGroupVersion, failures map[string]error, now time.Time) bool {
+	before := r.degradedStates()
+	cacheChanged := r.mergeCollection(collected, failures, now)
+	return cacheChanged || !maps.Equal(before, r.degradedStates())
+}
+
+// degradedStates maps every failing GroupVersion to whether it is stale.
+// The caller must hold cacheMu.
+func (r *ResourceTracker) degradedStates() map[string]bool {
+	states := make(map[string]bool)
+	for gv, state := range r.health {
+		if state.consecutiveFailures > 0 {
+			states[gv] = state.stale
+		}
+	}
+	return states
+}
+
+// DegradedGroupVersions returns the GroupVersions whose latest discovery
+// failed, sorted by GroupVersion. It returns nil while every GroupVersion is
+// healthy.
+func (r *ResourceTracker) DegradedGroupVersions() []GroupVersionHealth {
+	r.cacheMu.RLock()
+	defer r.cacheMu.RUnlock()
+
+	var degraded []GroupVersionHealth
+	for gv, state := range r.health {
+		if state.consecutiveFailures == 0 {
+			continue
+		}
+		degraded = append(degraded, GroupVersionHealth{
+			GroupVersion:        gv,
+			Stale:               state.stale,
+			LastSuccess:         state.lastSuccess,
+			FailingSince:        state.failingSince,
+			ConsecutiveFailures: state.consecutiveFailures,
+			LastError:           state.lastError,
+		})
+	}
+	slices.SortFunc(degraded, func(a, b GroupVersionHealth) int {
+		return strings.Compare(a.GroupVersion, b.GroupVersion)
+	})
+	return degraded
+}
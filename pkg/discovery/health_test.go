@@ -0,0 +1,110 @@
+package discovery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMergeCollection(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pods := []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get"}}}
+	nodeMetrics := []metav1.APIResource{{Name: "nodes", Kind: "NodeMetrics", Verbs: metav1.Verbs{"get"}}}
+	errUnavailable := errors.New("the server is currently unable to handle the request")
+
+	r := NewResourceTracker(nil, nil)
+	r.StaleGroupVersionTTL = 10 * time.Minute
+
+	if !r.mergeCollection(APIResourcesByGroupVersion{"v1": pods, "metrics.k8s.io/v1beta1": nodeMetrics}, nil, start) {
+		t.Fatal("expected initial collection to change the cache")
+	}
+	if degraded := r.DegradedGroupVersions(); degraded != nil {
+		t.Fatalf("expected no degraded group versions, got %v", degraded)
+	}
+
+	// A failing GroupVersion keeps its last known good resources.
+	failing := map[string]error{"metrics.k8s.io/v1beta1": errUnavailable}
+	if r.mergeCollection(APIResourcesByGroupVersion{"v1": pods}, failing, start.Add(5*time.Minute)) {
+		t.Error("expected a stale group version to leave the cache unchanged")
+	}
+	if _, ok := r.cache["metrics.k8s.io/v1beta1"]; !ok {
+		t.Fatal("expected stale group version to remain in the cache")
+	}
+	degraded := r.DegradedGroupVersions()
+	if len(degraded) != 1 {
+		t.Fatalf("expected one degraded group version, got %v", degraded)
+	}
+	health := degraded[0]
+	if health.GroupVersion != "metrics.k8s.io/v1beta1" || !health.Stale || health.ConsecutiveFailures != 1 ||
+		!health.FailingSince.Equal(start.Add(5*time.Minute)) || !health.LastSuccess.Equal(start) ||
+		health.LastError != errUnavailable.Error() {
+		t.Errorf("unexpected health %+v", health)
+	}
+
+	// Past the staleness window the GroupVersion is dropped.
+	if !r.mergeCollection(APIResourcesByGroupVersion{"v1": pods}, failing, start.Add(11*time.Minute)) {
+		t.Error("expected dropping the stale group version to change the cache")
+	}
+	if _, ok := r.cache["metrics.k8s.io/v1beta1"]; ok {
+		t.Error("expected group version to be dropped after the staleness window")
+	}
+	health = r.DegradedGroupVersions()[0]
+	if health.Stale || health.ConsecutiveFailures != 2 || !health.FailingSince.Equal(start.Add(5*time.Minute)) {
+		t.Errorf("unexpected health after staleness window %+v", health)
+	}
+
+	// Recovery clears the failure streak.
+	if !r.mergeCollection(APIResourcesByGroupVersion{"v1": pods, "metrics.k8s.io/v1beta1": nodeMetrics}, nil, start.Add(12*time.Minute)) {
+		t.Error("expected recovery to change the cache")
+	}
+	if degraded := r.DegradedGroupVersions(); degraded != nil {
+		t.Errorf("expected no degraded group versions after recovery, got %v", degraded)
+	}
+
+	// GroupVersions removed from discovery are forgotten.
+	r.mergeCollection(APIResourcesByGroupVersion{"v1": pods}, nil, start.Add(13*time.Minute))
+	if _, ok := r.health["metrics.k8s.io/v1beta1"]; ok {
+		t.Error("expected removed group version to be forgotten")
+	}
+}
+
+func TestMergeCollectionNeverSucceeded(t *testing.T) {
+	r := NewResourceTracker(nil, nil)
+	failing := map[string]error{"custom.metrics.k8s.io/v1beta2": errors.New("service unavailable")}
+
+	r.mergeCollection(APIResourcesByGroupVersion{"v1": nil}, failing, time.Now())
+
+	if _, ok := r.cache["custom.metrics.k8s.io/v1beta2"]; ok {
+		t.Error("expected a never successful group version to be omitted")
+	}
+	degraded := r.DegradedGroupVersions()
+	if len(degraded) != 1 || degraded[0].Stale || !degraded[0].LastSuccess.IsZero() {
+		t.Errorf("unexpected degraded group versions %+v", degraded)
+	}
+}
+
+func TestApplyCollectionReportsHealthTransitions(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pods := []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get"}}}
+	nodeMetrics := []metav1.APIResource{{Name: "nodes", Kind: "NodeMetrics", Verbs: metav1.Verbs{"get"}}}
+	failing := map[string]error{"metrics.k8s.io/v1beta1": errors.New("service unavailable")}
+
+	r := NewResourceTracker(nil, nil)
+	r.StaleGroupVersionTTL = 10 * time.Minute
+	r.applyCollection(APIResourcesByGroupVersion{"v1": pods, "metrics.k8s.io/v1beta1": nodeMetrics}, nil, start)
+
+	if !r.applyCollection(APIResourcesByGroupVersion{"v1": pods}, failing, start.Add(time.Minute)) {
+		t.Error("expected a group version turning stale to be reported as a change")
+	}
+	if r.applyCollection(APIResourcesByGroupVersion{"v1": pods}, failing, start.Add(2*time.Minute)) {
+		t.Error("expected a repeated failure to be reported as unchanged")
+	}
+	if !r.applyCollection(APIResourcesByGroupVersion{"v1": pods, "metrics.k8s.io/v1beta1": nodeMetrics}, nil, start.Add(3*time.Minute)) {
+		t.Error("expected recovery to be reported as a change")
+	}
+	if r.applyCollection(APIResourcesByGroupVersion{"v1": pods, "metrics.k8s.io/v1beta1": nodeMetrics}, nil, start.Add(4*time.Minute)) {
+		t.Error("expected an unchanged collection to be reported as unchanged")
+	}
+}
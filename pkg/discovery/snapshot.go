@@ -0,0 +1,95 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// SnapshotPath is the path the controller serves its discovery snapshot on.
+const SnapshotPath = "/debug/discovery"
+
+// Snapshot is the JSON export of the API resources a ResourceTracker holds.
+// It is written by the controller's debug endpoint and by
+// "auth-operator discovery dump", and read by LoadSnapshot.
+type Snapshot struct {
+	// Timestamp is the time of the collection the resources come from.
+	Timestamp metav1.Time `json:"timestamp"`
+	// CRDUIDs are the UIDs of the CustomResourceDefinitions known to the
+	// ResourceTracker, sorted.
+	CRDUIDs []string `json:"crdUIDs,omitempty"`
+	// Resources are the API resources by GroupVersion.
+	Resources APIResourcesByGroupVersion `json:"resources"`
+}
+
+// Snapshot returns the current view of the ResourceTracker. It returns
+// ErrResourceTrackerNotStarted before the startup collection finished.
+func (r *ResourceTracker) Snapshot() (*Snapshot, error) {
+	if !r.started.Load() {
+		return nil, ErrResourceTrackerNotStarted
+	}
+	return r.snapshot(), nil
+}
+
+func (r *ResourceTracker) snapshot() *Snapshot {
+	r.cacheMu.RLock()
+	snapshot := &Snapshot{
+		Timestamp: metav1.NewTime(r.collectedAt),
+		Resources: copyAPIResources(r.cache),
+	}
+	r.cacheMu.RUnlock()
+
+	r.crdsMutex.RLock()
+	snapshot.CRDUIDs = make([]string, 0, len(r.crdsUUIDs))
+	for uid := range r.crdsUUIDs {
+		snapshot.CRDUIDs = append(snapshot.CRDUIDs, uid)
+	}
+	r.crdsMutex.RUnlock()
+	slices.Sort(snapshot.CRDUIDs)
+	return snapshot
+}
+
+// CollectSnapshot runs a single collection against the API server of config,
+// the same way a ResourceTracker does at startup, and returns its snapshot.
+func CollectSnapshot(ctx context.Context, scheme *runtime.Scheme, config *rest.Config) (*Snapshot, error) {
+	r := NewResourceTracker(scheme, config)
+	if err := r.initUUIDMap(ctx); err != nil {
+		return nil, fmt.Errorf("list CRDs: %w", err)
+	}
+	if _, err := r.collectAPIResourcesBlocking(ctx); err != nil {
+		return nil, fmt.Errorf("collect API resources: %w", err)
+	}
+	return r.snapshot(), nil
+}
+
+// SnapshotHandler serves the snapshot of the ResourceTracker as JSON. It
+// answers 503 Service Unavailable until the startup collection finished.
+func SnapshotHandler(r *ResourceTracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		snapshot, err := r.Snapshot()
+		if errors.Is(err, ErrResourceTrackerNotStarted) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(snapshot); err != nil {
+			log.FromContext(req.Context()).Error(err, "failed to write discovery snapshot")
+		}
+	})
+}
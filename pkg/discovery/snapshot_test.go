@@ -0,0 +1,66 @@
+package discovery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSnapshotHandler(t *testing.T) {
+	r := NewResourceTracker(nil, nil)
+	handler := SnapshotHandler(r)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SnapshotPath, nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 before start, got %d", rec.Code)
+	}
+
+	collectedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r.cache = APIResourcesByGroupVersion{
+		"v1": {{Name: "pods", Namespaced: true, Kind: "Pod", Verbs: metav1.Verbs{"get", "list"}}},
+	}
+	r.collectedAt = collectedAt
+	r.setCRDUUIDs(map[string]struct{}{"uid-b": {}, "uid-a": {}})
+	r.started.Store(true)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SnapshotPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(rec.Body.Bytes(), &snapshot); err != nil {
+		t.Fatalf("decode snapshot: %v", err)
+	}
+	if !snapshot.Timestamp.Time.Equal(collectedAt) {
+		t.Errorf("expected timestamp %s, got %s", collectedAt, snapshot.Timestamp)
+	}
+	if !slices.Equal(snapshot.CRDUIDs, []string{"uid-a", "uid-b"}) {
+		t.Errorf("expected sorted CRD UIDs, got %v", snapshot.CRDUIDs)
+	}
+	if !snapshot.Resources.Equals(r.cache) {
+		t.Errorf("expected the cached resources, got %+v", snapshot.Resources)
+	}
+
+	// The endpoint output is accepted as an offline discovery source.
+	source, err := ReadSnapshot(strings.NewReader(rec.Body.String()))
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	if resources, _ := source.GetAPIResources(); !resources.Equals(r.cache) {
+		t.Errorf("expected the snapshot resources, got %+v", resources)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, SnapshotPath, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for POST, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,95 @@
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIResourceSource provides the API resources RoleDefinitions are generated
+// from. The ResourceTracker serves the live resources of a cluster; a
+// SnapshotSource serves a fixed snapshot read from a file.
+type APIResourceSource interface {
+	// GetAPIResources returns a deep copy of the API resources by group version.
+	GetAPIResources() (APIResourcesByGroupVersion, error)
+	// DegradedGroupVersions returns the GroupVersions whose discovery failed.
+	DegradedGroupVersions() []GroupVersionHealth
+	// AddSignalFunc registers a function called whenever the API resources change.
+	AddSignalFunc(f signalFunc)
+}
+
+var (
+	_ APIResourceSource = (*ResourceTracker)(nil)
+	_ APIResourceSource = (*SnapshotSource)(nil)
+)
+
+// SnapshotSource is an APIResourceSource serving a fixed set of API resources,
+// e.g. a Snapshot exported from a cluster, so roles can be generated without
+// contacting an API server.
+type SnapshotSource struct {
+	resources APIResourcesByGroupVersion
+}
+
+// NewSnapshotSource returns a SnapshotSource serving a copy of resources.
+func NewSnapshotSource(resources APIResourcesByGroupVersion) *SnapshotSource {
+	return &SnapshotSource{resources: copyAPIResources(resources)}
+}
+
+// ReadSnapshot decodes a Snapshot JSON document, or a bare
+// APIResourcesByGroupVersion document mapping GroupVersion strings to lists of
+// metav1.APIResource.
+func ReadSnapshot(r io.Reader) (*SnapshotSource, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read API resource snapshot: %w", err)
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil || snapshot.Resources == nil {
+		if err := json.Unmarshal(data, &snapshot.Resources); err != nil {
+			return nil, fmt.Errorf("decode API resource snapshot: %w", err)
+		}
+	}
+	if len(snapshot.Resources) == 0 {
+		return nil, fmt.Errorf("API resource snapshot contains no group versions")
+	}
+	return &SnapshotSource{resources: snapshot.Resources}, nil
+}
+
+// LoadSnapshot reads a snapshot document from path; see ReadSnapshot.
+func LoadSnapshot(path string) (source *SnapshotSource, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open API resource snapshot: %w", err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close %s: %w", path, closeErr)
+		}
+	}()
+	return ReadSnapshot(f)
+}
+
+// GetAPIResources returns a deep copy of the snapshot.
+func (s *SnapshotSource) GetAPIResources() (APIResourcesByGroupVersion, error) {
+	return copyAPIResources(s.resources), nil
+}
+
+// DegradedGroupVersions returns nil: a snapshot has no failing discovery.
+func (s *SnapshotSource) DegradedGroupVersions() []GroupVersionHealth {
+	return nil
+}
+
+// AddSignalFunc is a no-op because a snapshot never changes.
+func (s *SnapshotSource) AddSignalFunc(signalFunc) {}
+
+func copyAPIResources(resources APIResourcesByGroupVersion) APIResourcesByGroupVersion {
+	copied := make(APIResourcesByGroupVersion, len(resources))
+	for gv, list := range resources {
+		copied[gv] = make([]metav1.APIResource, len(list))
+		copy(copied[gv], list)
+	}
+	return copied
+}
@@ -0,0 +1,53 @@
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	snapshot := `{"v1":[{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list"]}]}`
+	if err := os.WriteFile(path, []byte(snapshot), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("load snapshot: %v", err)
+	}
+	resources, err := source.GetAPIResources()
+	if err != nil {
+		t.Fatalf("get resources: %v", err)
+	}
+	if len(resources["v1"]) != 1 || resources["v1"][0].Name != "pods" || !resources["v1"][0].Namespaced {
+		t.Errorf("unexpected resources %+v", resources)
+	}
+	if degraded := source.DegradedGroupVersions(); degraded != nil {
+		t.Errorf("expected no degraded group versions, got %v", degraded)
+	}
+
+	// Callers get a copy and cannot modify the snapshot.
+	resources["v1"][0].Name = "changed"
+	if again, _ := source.GetAPIResources(); again["v1"][0].Name != "pods" {
+		t.Error("expected GetAPIResources to return a copy")
+	}
+}
+
+func TestReadSnapshotErrors(t *testing.T) {
+	for name, input := range map[string]string{
+		"invalid JSON": `{"v1":`,
+		"empty":        `{}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ReadSnapshot(strings.NewReader(input)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+	if _, err := LoadSnapshot(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
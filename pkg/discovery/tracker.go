@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -25,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/telekom/auth-operator/pkg/metrics"
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 // ErrResourceTrackerNotStarted is returned when the ResourceTracker has not been started yet.
@@ -106,12 +108,21 @@ type ResourceTracker struct {
 	collectMu          sync.Mutex   // serialises collectAPIResources calls
 	cacheMu            sync.RWMutex // guards cache reads/writes (held briefly)
 	cache              APIResourcesByGroupVersion
+	collectedAt        time.Time                      // time of the last successful collection, guarded by cacheMu
+	health             map[string]*groupVersionState  // per-GroupVersion discovery state, guarded by cacheMu
+	aggregated         map[string]*aggregatedDocument // last aggregated discovery document per path, guarded by collectMu
 	signalFuncs        []signalFunc
 	crdsMutex          sync.RWMutex
 	crdsUUIDs          map[string]struct{}
 	crdClient          client.Client // reusable client for CRD list operations
 	FullRescanInterval time.Duration // interval between periodic full rescans (0 = use default)
 	CollectionInterval time.Duration // interval between periodic API collections (0 = use default)
+	// StaleGroupVersionTTL is how long a failing GroupVersion keeps serving its
+	// last known good resources (0 = use DefaultStaleGroupVersionTTL).
+	StaleGroupVersionTTL time.Duration
+	// Tracer, when set, traces every collection with a span per GroupVersion
+	// or aggregated discovery document (nil = no tracing).
+	Tracer trace.Tracer
 }
 
 // hasCRDUUID returns true if the given UID is in the CRD UUID map (thread-safe).
@@ -189,7 +200,8 @@ func NewResourceTracker(scheme *runtime.Scheme, config *rest.Config) *ResourceTr
 		rateLimit: rate.Sometimes{Interval: 5 * time.Second},
 
 		// API resources cache
-		cache: make(APIResourcesByGroupVersion),
+		cache:  make(APIResourcesByGroupVersion),
+		health: make(map[string]*groupVersionState),
 	}
 }
 
@@ -324,21 +336,19 @@ func (r *ResourceTracker) GetAPIResources() (APIResourcesByGroupVersion, error)
 	r.cacheMu.RLock()
 	defer r.cacheMu.RUnlock()
 
-	copiedCache := make(APIResourcesByGroupVersion)
-	for gv, resources := range r.cache {
-		copiedCache[gv] = make([]metav1.APIResource, len(resources))
-		copy(copiedCache[gv], resources)
-	}
-	return copiedCache, nil
+	return copyAPIResources(r.cache), nil
 }
 
 // collectAPIResources collects the API resources from the Kubernetes API server
 // and updates the internal cache if there are changes.
-// It returns (true, nil) if the cache was updated, (false, nil) if unchanged or
-// skipped due to another collection in progress.
+// It returns (true, nil) if the cache or the health of a GroupVersion changed,
+// and (false, nil) if both are unchanged. A collection skipped because another
+// one is in progress also returns (true, nil).
 // It uses a mutex to ensure only one collection is in progress at a time.
 // It runs the collection with higher QPS and Burst to speed up the process.
-// It collects resources concurrently for each API group version.
+// It collects resources concurrently for each API group version. A failing
+// group version does not fail the collection; see mergeCollection. Only when
+// every group version fails is an error returned and the cache kept as is.
 func (r *ResourceTracker) collectAPIResources(ctx context.Context) (bool, error) {
 	return r.collectAPIResourcesWithLock(ctx, false)
 }
@@ -347,7 +357,7 @@ func (r *ResourceTracker) collectAPIResourcesBlocking(ctx context.Context) (bool
 	return r.collectAPIResourcesWithLock(ctx, true)
 }
 
-func (r *ResourceTracker) collectAPIResourcesWithLock(ctx context.Context, waitForLock bool) (bool, error) {
+func (r *ResourceTracker) collectAPIResourcesWithLock(ctx context.Context, waitForLock bool) (_ bool, retErr error) {
 	unlock, locked, err := r.acquireCollectLock(ctx, waitForLock)
 	if err != nil {
 		return true, err
@@ -357,6 +367,15 @@ func (r *ResourceTracker) collectAPIResourcesWithLock(ctx context.Context, waitF
 	}
 	defer unlock()
 
+	if r.Tracer != nil {
+		var span trace.Span
+		ctx, span = r.Tracer.Start(ctx, "discovery.Collect")
+		defer func() {
+			tracing.RecordError(span, retErr)
+			span.End()
+		}()
+	}
+
 	startTime := time.Now()
 	logger := log.FromContext(ctx)
 	logger.V(2).Info("collecting API resources")
@@ -372,102 +391,143 @@ func (r *ResourceTracker) collectAPIResourcesWithLock(ctx context.Context, waitF
 		return true, err
 	}
 
+	defer func() {
+		metrics.APIDiscoveryDuration.Observe(time.Since(startTime).Seconds())
+	}()
+
+	// Prefer aggregated discovery: two conditional requests instead of one
+	// request per GroupVersion. Fall back to per-GroupVersion discovery when
+	// the API server does not serve it or the aggregated request fails.
+	apiResourcesByGroupVersion, failures, mode, err := r.collectAggregated(ctx, discoveryConfig, discoveryClient)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			logger.V(1).Info("API resource collection cancelled", "reason", err.Error())
+			return true, err
+		}
+		if !errors.Is(err, errAggregatedDiscoveryUnsupported) {
+			logger.V(1).Info("aggregated discovery failed, falling back to per-GroupVersion discovery", "error", err.Error())
+		}
+		mode = discoveryModeLegacy
+		apiResourcesByGroupVersion, failures, err = r.collectPerGroupVersion(ctx, discoveryClient)
+		if err != nil {
+			return true, err
+		}
+	}
+	metrics.APIDiscoveryCollections.WithLabelValues(mode).Inc()
+	trace.SpanFromContext(ctx).SetAttributes(
+		tracing.AttrDiscoveryMode.String(mode),
+		tracing.AttrResourceCount.Int(len(apiResourcesByGroupVersion)),
+		tracing.AttrFailureCount.Int(len(failures)),
+	)
+
+	if len(apiResourcesByGroupVersion) == 0 && len(failures) > 0 {
+		metrics.APIDiscoveryErrors.Inc()
+		return true, fmt.Errorf("discovery failed for all %d group versions", len(failures))
+	}
+
+	logger.V(2).Info("discovered API resources", "mode", mode, "resourceCount", len(apiResourcesByGroupVersion),
+		"failedGroupVersions", len(failures))
+
+	r.cacheMu.Lock()
+	defer r.cacheMu.Unlock()
+
+	now := time.Now()
+	r.collectedAt = now
+	if !r.applyCollection(apiResourcesByGroupVersion, failures, now) {
+		logger.V(2).Info("API resources cache and discovery health unchanged")
+		return false, nil
+	}
+
+	logger.V(2).Info("API resources cache or discovery health updated")
+	return true, nil
+}
+
+// collectPerGroupVersion discovers the API groups and then the resources of
+// every GroupVersion concurrently. Failing GroupVersions are returned in the
+// failures map instead of failing the collection.
+func (r *ResourceTracker) collectPerGroupVersion(
+	ctx context.Context,
+	discoveryClient *discovery.DiscoveryClient,
+) (APIResourcesByGroupVersion, map[string]error, error) {
+	logger := log.FromContext(ctx)
 	logger.V(2).Info("starting API discovery")
 
 	discoveredAPIGroups, err := discoveryClient.ServerGroups()
 	if err != nil {
 		logger.Error(err, "failed to discover API groups")
 		metrics.APIDiscoveryErrors.Inc()
-		return true, err
+		return nil, nil, err
 	}
 	logger.V(2).Info("discovered API groups", "groupCount", len(discoveredAPIGroups.Groups))
 
-	defer func() {
-		metrics.APIDiscoveryDuration.Observe(time.Since(startTime).Seconds())
-	}()
-
 	errorGroup, groupCtx := errgroup.WithContext(ctx)
 
 	apiResourcesByGroupVersion := make(APIResourcesByGroupVersion)
+	failures := make(map[string]error)
 	mutex := sync.Mutex{}
 
-	coreGV := metav1.GroupVersion{Version: "v1"}
-	errorGroup.Go(func() error {
-		select {
-		case <-groupCtx.Done():
-			return groupCtx.Err()
-		default:
-		}
+	// collectGroupVersion isolates failures per GroupVersion: a failing
+	// GroupVersion (e.g. an unavailable aggregated API) is recorded instead of
+	// cancelling the collection of every other GroupVersion.
+	collectGroupVersion := func(gv metav1.GroupVersion) func() error {
+		return func() error {
+			select {
+			case <-groupCtx.Done():
+				return groupCtx.Err()
+			default:
+			}
 
-		resources, err := r.collectAPIResourcesForGroupVersion(discoveryClient, coreGV.Group, coreGV.Version)
-		if err != nil {
-			logger.Error(err, "failed to discover API resources for core group version",
-				"group", coreGV.Group, "version", coreGV.Version)
-			return err
+			_, span := tracing.StartSpan(groupCtx, "discovery.GroupVersion",
+				tracing.AttrGroupVersion.String(gv.String()))
+			defer span.End()
+
+			resources, err := r.collectAPIResourcesForGroupVersion(discoveryClient, gv.Group, gv.Version)
+			span.SetAttributes(tracing.AttrResourceCount.Int(len(resources)))
+			tracing.RecordError(span, err)
+			if err != nil {
+				if ctxErr := groupCtx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				logger.Error(err, "failed to discover API resources for group version",
+					"group", gv.Group, "version", gv.Version)
+				mutex.Lock()
+				failures[gv.String()] = err
+				mutex.Unlock()
+				return nil
+			}
+			mutex.Lock()
+			apiResourcesByGroupVersion[gv.String()] = append(apiResourcesByGroupVersion[gv.String()], resources...)
+			mutex.Unlock()
+			return nil
 		}
-		mutex.Lock()
-		apiResourcesByGroupVersion[coreGV.String()] = append(apiResourcesByGroupVersion[coreGV.String()], resources...)
-		mutex.Unlock()
-		return nil
-	})
+	}
+
+	errorGroup.Go(collectGroupVersion(metav1.GroupVersion{Version: "v1"}))
 
 	for _, apiGroup := range discoveredAPIGroups.Groups {
 		select {
 		case <-ctx.Done():
 			logger.V(1).Info("stopping API resource collection due to context cancellation")
-			return true, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 		}
 
 		for _, apiGroupVersion := range apiGroup.Versions {
-			apiGroupName := apiGroup.Name
-			apiVersionStr := apiGroupVersion.Version
-			gv := metav1.GroupVersion{
-				Group:   apiGroupName,
-				Version: apiVersionStr,
-			}
-			errorGroup.Go(func() error {
-				select {
-				case <-groupCtx.Done():
-					return groupCtx.Err()
-				default:
-				}
-
-				resources, err := r.collectAPIResourcesForGroupVersion(discoveryClient, apiGroupName, apiVersionStr)
-				if err != nil {
-					logger.Error(err, "failed to discover API resources for group version",
-						"group", apiGroupName, "version", apiVersionStr)
-					return err
-				}
-				mutex.Lock()
-				apiResourcesByGroupVersion[gv.String()] = append(apiResourcesByGroupVersion[gv.String()], resources...)
-				mutex.Unlock()
-				return nil
-			})
+			errorGroup.Go(collectGroupVersion(metav1.GroupVersion{
+				Group:   apiGroup.Name,
+				Version: apiGroupVersion.Version,
+			}))
 		}
 	}
 	if err := errorGroup.Wait(); err != nil {
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			logger.V(1).Info("API resource collection cancelled", "reason", err.Error())
-			return true, err
+			return nil, nil, err
 		}
 		logger.Error(err, "failed to discover resources concurrently")
-		return true, err
-	}
-
-	logger.V(2).Info("discovered API resources", "resourceCount", len(apiResourcesByGroupVersion))
-
-	r.cacheMu.Lock()
-	defer r.cacheMu.Unlock()
-
-	if apiResourcesByGroupVersion.Equals(r.cache) {
-		logger.V(2).Info("API resources cache unchanged")
-		return true, nil
+		return nil, nil, err
 	}
-	r.cache = apiResourcesByGroupVersion
-
-	logger.V(2).Info("API resources cache updated")
-	return true, nil
+	return apiResourcesByGroupVersion, failures, nil
 }
 
 func (r *ResourceTracker) acquireCollectLock(ctx context.Context, waitForLock bool) (unlock func(), locked bool, err error) {
@@ -686,8 +746,6 @@ func (r *ResourceTracker) collectAPIResourcesForGroupVersion(
 	group string,
 	version string,
 ) ([]metav1.APIResource, error) {
-	result := make([]metav1.APIResource, 0)
-
 	gv := metav1.GroupVersion{
 		Group:   group,
 		Version: version,
@@ -697,7 +755,14 @@ func (r *ResourceTracker) collectAPIResourcesForGroupVersion(
 	if err != nil {
 		return nil, err
 	}
-	for _, resource := range discoveredAPIResources.APIResources {
+	return expandAPIResources(group, version, discoveredAPIResources.APIResources), nil
+}
+
+// expandAPIResources adds the verbs and subresources that discovery does not
+// report but RoleDefinitions need, regardless of the discovery format used.
+func expandAPIResources(group, version string, discovered []metav1.APIResource) []metav1.APIResource {
+	result := make([]metav1.APIResource, 0, len(discovered))
+	for _, resource := range discovered {
 		isSubresource := strings.Contains(resource.Name, "/")
 
 		subResourceRequiresExplicitVerbs := isSubresource &&
@@ -734,7 +799,7 @@ func (r *ResourceTracker) collectAPIResourcesForGroupVersion(
 			result = append(result, *nodeMetricsSubresource)
 		}
 	}
-	return result, nil
+	return result
 }
 
 func withExplicitRBACVerbs(group, version string, resource metav1.APIResource) metav1.APIResource {
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exposure scans cluster RBAC for grants that defeat the operator's
+// guardrails, regardless of whether the operator authored them.
+//
+// The only exposure modelled today is the legacy "impersonate" verb, which wins
+// by fallback over every Kubernetes constrained impersonation (KEP-5284)
+// restriction held by the same subject.
+package exposure
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exposure
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// legacyCoreResources are the identity resources the legacy verb is checked
+// against in the core API group.
+var legacyCoreResources = []string{"users", "groups", "serviceaccounts"}
+
+// legacyAuthenticationResources are the identity resources the legacy verb is
+// checked against in the authentication.k8s.io API group.
+var legacyAuthenticationResources = []string{"uids", "userextras"}
+
+// RBAC is the cluster RBAC a scan covers.
+type RBAC struct {
+	ClusterRoles        []rbacv1.ClusterRole
+	Roles               []rbacv1.Role
+	ClusterRoleBindings []rbacv1.ClusterRoleBinding
+	RoleBindings        []rbacv1.RoleBinding
+}
+
+// LegacyImpersonationResult is the outcome of ScanLegacyImpersonation.
+type LegacyImpersonationResult struct {
+	// Findings lists every exposed subject, those with constrained grants first.
+	Findings []authorizationv1alpha1.LegacyImpersonationFinding
+	// ConstrainedSubjects counts findings whose subject also holds a constrained grant.
+	ConstrainedSubjects int
+	// LegacyRoles counts ClusterRoles and Roles carrying the legacy verb.
+	LegacyRoles int
+}
+
+// roleGrants summarizes what a single role grants for impersonation.
+type roleGrants struct {
+	// legacy are the identity resources covered by the legacy verb.
+	legacy []string
+	// namespacedLegacy is true when the legacy verb covers serviceaccounts, the
+	// only identity resource a RoleBinding can grant it for.
+	namespacedLegacy bool
+	// constrained is true when the role carries an `impersonate:<mode>` verb.
+	constrained bool
+}
+
+type subjectKey struct {
+	kind, namespace, name string
+}
+
+// ScanLegacyImpersonation reports every subject bound to a role that carries
+// the legacy "impersonate" verb, directly or through the "*" wildcard, and
+// whether the subject also holds a constrained impersonation grant.
+//
+// Users, groups, uids and userextras are cluster-scoped, so a RoleBinding can
+// only expose serviceaccounts in its namespace. Bindings to missing roles are
+// ignored. Members of system:masters bypass RBAC and cannot be seen here.
+func ScanLegacyImpersonation(rbac *RBAC) LegacyImpersonationResult {
+	var result LegacyImpersonationResult
+
+	clusterRoles := make(map[string]roleGrants, len(rbac.ClusterRoles))
+	for i := range rbac.ClusterRoles {
+		grants := summarizeRules(rbac.ClusterRoles[i].Rules)
+		clusterRoles[rbac.ClusterRoles[i].Name] = grants
+		if len(grants.legacy) > 0 {
+			result.LegacyRoles++
+		}
+	}
+	roles := make(map[string]roleGrants, len(rbac.Roles))
+	for i := range rbac.Roles {
+		grants := summarizeRules(rbac.Roles[i].Rules)
+		roles[rbac.Roles[i].Namespace+"/"+rbac.Roles[i].Name] = grants
+		if len(grants.legacy) > 0 {
+			result.LegacyRoles++
+		}
+	}
+
+	findings := make(map[subjectKey]*authorizationv1alpha1.LegacyImpersonationFinding)
+	constrained := make(map[subjectKey]bool)
+	record := func(subjects []rbacv1.Subject, bindingNamespace string, grants roleGrants, grant *authorizationv1alpha1.LegacyImpersonationGrant) {
+		for _, subject := range subjects {
+			key := keyFor(subject, bindingNamespace)
+			if grants.constrained {
+				constrained[key] = true
+			}
+			if grant == nil {
+				continue
+			}
+			finding, ok := findings[key]
+			if !ok {
+				finding = &authorizationv1alpha1.LegacyImpersonationFinding{Subject: normalizeSubject(subject, key)}
+				findings[key] = finding
+			}
+			if grant.BindingKind == "ClusterRoleBinding" {
+				finding.ClusterWide = true
+			}
+			finding.Grants = append(finding.Grants, *grant)
+		}
+	}
+
+	for i := range rbac.ClusterRoleBindings {
+		binding := &rbac.ClusterRoleBindings[i]
+		if binding.RoleRef.Kind != "ClusterRole" {
+			continue
+		}
+		grants, ok := clusterRoles[binding.RoleRef.Name]
+		if !ok {
+			continue
+		}
+		var grant *authorizationv1alpha1.LegacyImpersonationGrant
+		if len(grants.legacy) > 0 {
+			grant = &authorizationv1alpha1.LegacyImpersonationGrant{
+				BindingKind: "ClusterRoleBinding",
+				BindingName: binding.Name,
+				RoleKind:    "ClusterRole",
+				RoleName:    binding.RoleRef.Name,
+				Resources:   grants.legacy,
+			}
+		}
+		record(binding.Subjects, "", grants, grant)
+	}
+	for i := range rbac.RoleBindings {
+		binding := &rbac.RoleBindings[i]
+		var grants roleGrants
+		var ok bool
+		switch binding.RoleRef.Kind {
+		case "ClusterRole":
+			grants, ok = clusterRoles[binding.RoleRef.Name]
+		case "Role":
+			grants, ok = roles[binding.Namespace+"/"+binding.RoleRef.Name]
+		}
+		if !ok {
+			continue
+		}
+		var grant *authorizationv1alpha1.LegacyImpersonationGrant
+		if grants.namespacedLegacy {
+			grant = &authorizationv1alpha1.LegacyImpersonationGrant{
+				BindingKind:      "RoleBinding",
+				BindingName:      binding.Name,
+				BindingNamespace: binding.Namespace,
+				RoleKind:         binding.RoleRef.Kind,
+				RoleName:         binding.RoleRef.Name,
+				Resources:        []string{"serviceaccounts"},
+			}
+		}
+		record(binding.Subjects, binding.Namespace, grants, grant)
+	}
+
+	result.Findings = make([]authorizationv1alpha1.LegacyImpersonationFinding, 0, len(findings))
+	for key, finding := range findings {
+		finding.HasConstrainedGrant = constrained[key]
+		if finding.HasConstrainedGrant {
+			result.ConstrainedSubjects++
+		}
+		slices.SortFunc(finding.Grants, compareGrants)
+		result.Findings = append(result.Findings, *finding)
+	}
+	slices.SortFunc(result.Findings, compareFindings)
+	return result
+}
+
+// summarizeRules determines which identity resources the legacy verb covers
+// and whether a constrained identity verb is present.
+func summarizeRules(rules []rbacv1.PolicyRule) roleGrants {
+	var grants roleGrants
+	var legacy []string
+	for i := range rules {
+		rule := &rules[i]
+		for _, verb := range rule.Verbs {
+			if parsed, ok := authorizationv1alpha1.ParseImpersonationVerb(verb); ok && !parsed.IsAction {
+				grants.constrained = true
+			}
+		}
+		if !slices.Contains(rule.Verbs, authorizationv1alpha1.LegacyImpersonateVerb) && !slices.Contains(rule.Verbs, rbacv1.VerbAll) {
+			continue
+		}
+		for _, group := range rule.APIGroups {
+			var candidates []string
+			switch group {
+			case rbacv1.APIGroupAll:
+				candidates = append(slices.Clone(legacyCoreResources), legacyAuthenticationResources...)
+			case "":
+				candidates = legacyCoreResources
+			case authenticationv1.GroupName:
+				candidates = legacyAuthenticationResources
+			}
+			for _, resource := range rule.Resources {
+				covered := coveredResource(resource, candidates)
+				if covered == "" {
+					continue
+				}
+				legacy = append(legacy, covered)
+				if (covered == rbacv1.ResourceAll || covered == "serviceaccounts") && (group == "" || group == rbacv1.APIGroupAll) {
+					grants.namespacedLegacy = true
+				}
+			}
+		}
+	}
+	slices.Sort(legacy)
+	grants.legacy = slices.Compact(legacy)
+	return grants
+}
+
+// coveredResource returns resource when it names one of the candidate identity
+// resources (or a userextras/<key> subresource), "*" for the wildcard and ""
+// otherwise.
+func coveredResource(resource string, candidates []string) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	if resource == rbacv1.ResourceAll {
+		return rbacv1.ResourceAll
+	}
+	base, _, _ := strings.Cut(resource, "/")
+	if slices.Contains(candidates, base) {
+		return resource
+	}
+	return ""
+}
+
+func keyFor(subject rbacv1.Subject, bindingNamespace string) subjectKey {
+	key := subjectKey{kind: subject.Kind, name: subject.Name}
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		key.namespace = subject.Namespace
+		if key.namespace == "" {
+			key.namespace = bindingNamespace
+		}
+	}
+	return key
+}
+
+func normalizeSubject(subject rbacv1.Subject, key subjectKey) rbacv1.Subject {
+	normalized := rbacv1.Subject{Kind: key.kind, Name: key.name, Namespace: key.namespace}
+	if key.kind == rbacv1.UserKind || key.kind == rbacv1.GroupKind {
+		normalized.APIGroup = rbacv1.GroupName
+	} else {
+		normalized.APIGroup = subject.APIGroup
+	}
+	return normalized
+}
+
+func compareFindings(a, b authorizationv1alpha1.LegacyImpersonationFinding) int {
+	if a.HasConstrainedGrant != b.HasConstrainedGrant {
+		if a.HasConstrainedGrant {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Or(
+		cmp.Compare(a.Subject.Kind, b.Subject.Kind),
+		cmp.Compare(a.Subject.Namespace, b.Subject.Namespace),
+		cmp.Compare(a.Subject.Name, b.Subject.Name),
+	)
+}
+
+func compareGrants(a, b authorizationv1alpha1.LegacyImpersonationGrant) int {
+	return cmp.Or(
+		cmp.Compare(a.BindingKind, b.BindingKind),
+		cmp.Compare(a.BindingNamespace, b.BindingNamespace),
+		cmp.Compare(a.BindingName, b.BindingName),
+	)
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exposure
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func clusterRole(name string, rules ...rbacv1.PolicyRule) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}, Rules: rules}
+}
+
+func clusterRoleBinding(name, role string, subjects ...rbacv1.Subject) rbacv1.ClusterRoleBinding {
+	return rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: role},
+		Subjects:   subjects,
+	}
+}
+
+func roleBinding(namespace, name, kind, role string, subjects ...rbacv1.Subject) rbacv1.RoleBinding {
+	return rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: kind, Name: role},
+		Subjects:   subjects,
+	}
+}
+
+func TestScanLegacyImpersonation(t *testing.T) {
+	t.Parallel()
+
+	jane := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "jane"}
+	oncall := rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "oncall"}
+	deployer := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deployer"}
+
+	rbac := &RBAC{
+		ClusterRoles: []rbacv1.ClusterRole{
+			clusterRole("legacy-users", rbacv1.PolicyRule{
+				APIGroups: []string{""}, Resources: []string{"users", "groups"}, Verbs: []string{"impersonate"},
+			}),
+			clusterRole("admin-all", rbacv1.PolicyRule{
+				APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"},
+			}),
+			clusterRole("constrained", rbacv1.PolicyRule{
+				APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"users"}, Verbs: []string{"impersonate:user-info"},
+			}),
+			clusterRole("unbound-legacy", rbacv1.PolicyRule{
+				APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"userextras/scopes"}, Verbs: []string{"impersonate"},
+			}),
+			clusterRole("pod-reader", rbacv1.PolicyRule{
+				APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"},
+			}),
+		},
+		Roles: []rbacv1.Role{{
+			ObjectMeta: metav1.ObjectMeta{Name: "sa-impersonator", Namespace: "team-a"},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{""}, Resources: []string{"serviceaccounts"}, Verbs: []string{"impersonate"},
+			}},
+		}},
+		ClusterRoleBindings: []rbacv1.ClusterRoleBinding{
+			clusterRoleBinding("legacy-users", "legacy-users", jane),
+			clusterRoleBinding("constrained", "constrained", jane),
+			clusterRoleBinding("readers", "pod-reader", oncall),
+			clusterRoleBinding("dangling", "missing", oncall),
+		},
+		RoleBindings: []rbacv1.RoleBinding{
+			roleBinding("team-a", "sa-impersonator", "Role", "sa-impersonator", deployer),
+			// users and groups are cluster-scoped, so only serviceaccounts count.
+			roleBinding("team-b", "legacy-users", "ClusterRole", "legacy-users", oncall),
+			roleBinding("team-b", "admin", "ClusterRole", "admin-all", oncall),
+		},
+	}
+
+	got := ScanLegacyImpersonation(rbac)
+
+	want := LegacyImpersonationResult{
+		Findings: []authorizationv1alpha1.LegacyImpersonationFinding{
+			{
+				Subject:             rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "jane"},
+				ClusterWide:         true,
+				HasConstrainedGrant: true,
+				Grants: []authorizationv1alpha1.LegacyImpersonationGrant{{
+					BindingKind: "ClusterRoleBinding", BindingName: "legacy-users",
+					RoleKind: "ClusterRole", RoleName: "legacy-users", Resources: []string{"groups", "users"},
+				}},
+			},
+			{
+				Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "oncall"},
+				Grants: []authorizationv1alpha1.LegacyImpersonationGrant{{
+					BindingKind: "RoleBinding", BindingName: "admin", BindingNamespace: "team-b",
+					RoleKind: "ClusterRole", RoleName: "admin-all", Resources: []string{"serviceaccounts"},
+				}},
+			},
+			{
+				Subject: rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "team-a"},
+				Grants: []authorizationv1alpha1.LegacyImpersonationGrant{{
+					BindingKind: "RoleBinding", BindingName: "sa-impersonator", BindingNamespace: "team-a",
+					RoleKind: "Role", RoleName: "sa-impersonator", Resources: []string{"serviceaccounts"},
+				}},
+			},
+		},
+		ConstrainedSubjects: 1,
+		LegacyRoles:         4,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("scan result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSummarizeRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules []rbacv1.PolicyRule
+		want  roleGrants
+	}{
+		{
+			name:  "no rules",
+			rules: nil,
+			want:  roleGrants{},
+		},
+		{
+			name: "legacy verb on the wrong group",
+			rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"apps"}, Resources: []string{"*"}, Verbs: []string{"impersonate"},
+			}},
+			want: roleGrants{},
+		},
+		{
+			name: "wildcard verb on serviceaccounts",
+			rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{""}, Resources: []string{"serviceaccounts"}, Verbs: []string{"*"},
+			}},
+			want: roleGrants{legacy: []string{"serviceaccounts"}, namespacedLegacy: true},
+		},
+		{
+			name: "authentication group userextras are cluster-scoped",
+			rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"uids", "userextras/scopes"}, Verbs: []string{"impersonate"},
+			}},
+			want: roleGrants{legacy: []string{"uids", "userextras/scopes"}},
+		},
+		{
+			name: "constrained identity verb without legacy",
+			rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"serviceaccounts"}, Verbs: []string{"impersonate:serviceaccount"},
+			}},
+			want: roleGrants{constrained: true},
+		},
+		{
+			name: "constrained action verb alone is not a grant",
+			rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"impersonate-on:user-info:get"},
+			}},
+			want: roleGrants{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := summarizeRules(tt.rules)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(roleGrants{})); diff != "" {
+				t.Errorf("summarizeRules mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
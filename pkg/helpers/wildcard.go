@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import "strings"
+
+// MatchesWildcard checks if value matches a simple wildcard pattern.
+// Supports patterns like "prefix*" (prefix match), "*suffix" (suffix match),
+// "prefix*suffix" (prefix+suffix match), and "*mid*" (contains match).
+// Multiple wildcards are supported by splitting on "*" and matching parts
+// in order. A pattern without wildcards requires an exact match.
+func MatchesWildcard(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		// No wildcards — exact match.
+		return pattern == value
+	}
+
+	// Check prefix (part before first *).
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	remaining := value[len(parts[0]):]
+
+	// Check suffix (part after last *).
+	last := parts[len(parts)-1]
+	if !strings.HasSuffix(remaining, last) {
+		return false
+	}
+	remaining = remaining[:len(remaining)-len(last)]
+
+	// Check middle parts appear in order.
+	for _, mid := range parts[1 : len(parts)-1] {
+		idx := strings.Index(remaining, mid)
+		if idx < 0 {
+			return false
+		}
+		remaining = remaining[idx+len(mid):]
+	}
+
+	return true
+}
+
+// WildcardCovers reports whether every value matched by the narrower pattern is
+// also matched by the wider pattern. It is conservative: when coverage cannot
+// be proven syntactically it returns false.
+//
+// Literal narrower patterns are checked with MatchesWildcard. Wildcard narrower
+// patterns are covered by an identical pattern, by "*", by a "prefix*" pattern
+// whose prefix starts the narrower pattern, or by a "*suffix" pattern whose
+// suffix ends it.
+func WildcardCovers(wider, narrower string) bool {
+	if wider == narrower || wider == "*" {
+		return true
+	}
+	if !strings.Contains(narrower, "*") {
+		return MatchesWildcard(wider, narrower)
+	}
+
+	widerParts := strings.Split(wider, "*")
+	if len(widerParts) != 2 {
+		return false
+	}
+	narrowerParts := strings.Split(narrower, "*")
+	switch {
+	case widerParts[1] == "":
+		return strings.HasPrefix(narrowerParts[0], widerParts[0])
+	case widerParts[0] == "":
+		return strings.HasSuffix(narrowerParts[len(narrowerParts)-1], widerParts[1])
+	default:
+		return strings.HasPrefix(narrowerParts[0], widerParts[0]) &&
+			strings.HasSuffix(narrowerParts[len(narrowerParts)-1], widerParts[1])
+	}
+}
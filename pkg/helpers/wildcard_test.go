@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import "testing"
+
+func TestWildcardCovers(t *testing.T) {
+	tests := []struct {
+		wider    string
+		narrower string
+		want     bool
+	}{
+		{wider: "view", narrower: "view", want: true},
+		{wider: "*", narrower: "team-*", want: true},
+		{wider: "team-*", narrower: "team-a-view", want: true},
+		{wider: "team-*", narrower: "team-a-*", want: true},
+		{wider: "team-a-*", narrower: "team-*", want: false},
+		{wider: "*-view", narrower: "*-team-view", want: true},
+		{wider: "*-view", narrower: "team-*", want: false},
+		{wider: "team-*-view", narrower: "team-a-*-view", want: true},
+		{wider: "team-*", narrower: "*", want: false},
+		{wider: "a*b*c", narrower: "a*c", want: false},
+		{wider: "view", narrower: "view*", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wider+"/"+tt.narrower, func(t *testing.T) {
+			if got := WildcardCovers(tt.wider, tt.narrower); got != tt.want {
+				t.Errorf("WildcardCovers(%q, %q) = %v, want %v", tt.wider, tt.narrower, got, tt.want)
+			}
+		})
+	}
+}
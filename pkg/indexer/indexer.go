@@ -131,6 +131,16 @@ const (
 // SetupBaseIndexes registers field indexes for legacy controller/webhook types.
 // This should be called before starting the manager.
 func SetupBaseIndexes(ctx context.Context, mgr manager.Manager) error {
+	if err := setupRoleDefinitionIndexes(ctx, mgr); err != nil {
+		return err
+	}
+	if err := setupBindDefinitionIndexes(ctx, mgr); err != nil {
+		return err
+	}
+	return setupWebhookAuthorizerIndexes(ctx, mgr)
+}
+
+func setupRoleDefinitionIndexes(ctx context.Context, mgr manager.Manager) error {
 	// Index RoleDefinition by Spec.TargetName for duplicate detection in webhook validation
 	if err := mgr.GetFieldIndexer().IndexField(
 		ctx,
@@ -160,7 +170,10 @@ func SetupBaseIndexes(ctx context.Context, mgr manager.Manager) error {
 	); err != nil {
 		return fmt.Errorf("failed to create index for RoleDefinition.Spec.TargetNamespace: %w", err)
 	}
+	return nil
+}
 
+func setupBindDefinitionIndexes(ctx context.Context, mgr manager.Manager) error {
 	// Index BindDefinition by Spec.TargetName for duplicate detection in webhook validation
 	if err := mgr.GetFieldIndexer().IndexField(
 		ctx,
@@ -177,19 +190,6 @@ func SetupBaseIndexes(ctx context.Context, mgr manager.Manager) error {
 		return fmt.Errorf("failed to create index for BindDefinition.Spec.TargetName: %w", err)
 	}
 
-	// Index WebhookAuthorizer by whether a namespace selector is set.
-	// This enables the webhook handler to efficiently query only those
-	// authorizers that require namespace matching, avoiding full scans
-	// on every SubjectAccessReview evaluation.
-	if err := mgr.GetFieldIndexer().IndexField(
-		ctx,
-		&authorizationv1alpha1.WebhookAuthorizer{},
-		WebhookAuthorizerHasNamespaceSelectorField,
-		WebhookAuthorizerHasNamespaceSelectorFunc,
-	); err != nil {
-		return fmt.Errorf("failed to create index for WebhookAuthorizer.Spec.HasNamespaceSelector: %w", err)
-	}
-
 	// Index BindDefinition by whether it has at least one RoleBinding.
 	// Allows namespace validating webhook and namespace event fan-out paths to
 	// skip cluster-only BindDefinitions.
@@ -201,7 +201,22 @@ func SetupBaseIndexes(ctx context.Context, mgr manager.Manager) error {
 	); err != nil {
 		return fmt.Errorf("failed to create index for BindDefinitions with RoleBindings: %w", err)
 	}
+	return nil
+}
 
+func setupWebhookAuthorizerIndexes(ctx context.Context, mgr manager.Manager) error {
+	// Index WebhookAuthorizer by whether a namespace selector is set.
+	// This enables the webhook handler to efficiently query only those
+	// authorizers that require namespace matching, avoiding full scans
+	// on every SubjectAccessReview evaluation.
+	if err := mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&authorizationv1alpha1.WebhookAuthorizer{},
+		WebhookAuthorizerHasNamespaceSelectorField,
+		WebhookAuthorizerHasNamespaceSelectorFunc,
+	); err != nil {
+		return fmt.Errorf("failed to create index for WebhookAuthorizer.Spec.HasNamespaceSelector: %w", err)
+	}
 	return nil
 }
 
@@ -336,17 +351,45 @@ func SetupIndexes(ctx context.Context, mgr manager.Manager) error {
 	return SetupRestrictedIndexes(ctx, mgr)
 }
 
-// SetupControllerIndexes registers controller-only field indexes on the
-// manager's cache for efficient reconciliation lookups.
+// ControllerIndexes selects the field indexes SetupControllerIndexes registers.
+// Registering an index starts an informer for the indexed type, so only the
+// indexes of the reconcilers that run should be selected; otherwise the
+// operator needs list and watch permissions for types it never reconciles.
+type ControllerIndexes struct {
+	// RoleDefinition selects the RoleDefinition target indexes.
+	RoleDefinition bool
+	// BindDefinition selects the BindDefinition indexes.
+	BindDefinition bool
+	// WebhookAuthorizer selects the WebhookAuthorizer namespace selector index.
+	WebhookAuthorizer bool
+	// Restricted selects the RBACPolicy and restricted CRD indexes and the
+	// RestrictedBindDefinition owner indexes on RBAC bindings and ServiceAccounts.
+	Restricted bool
+}
+
+// SetupControllerIndexes registers the controller-only field indexes selected
+// by indexes on the manager's cache for efficient reconciliation lookups.
 //
 // These indexes intentionally exclude webhook managers because they would start
 // additional informers for RBAC binding types, requiring broader permissions
 // than admission webhooks otherwise need.
-func SetupControllerIndexes(ctx context.Context, mgr manager.Manager, includeRestricted bool) error {
-	if err := SetupBaseIndexes(ctx, mgr); err != nil {
-		return err
+func SetupControllerIndexes(ctx context.Context, mgr manager.Manager, indexes ControllerIndexes) error {
+	if indexes.RoleDefinition {
+		if err := setupRoleDefinitionIndexes(ctx, mgr); err != nil {
+			return err
+		}
+	}
+	if indexes.BindDefinition {
+		if err := setupBindDefinitionIndexes(ctx, mgr); err != nil {
+			return err
+		}
+	}
+	if indexes.WebhookAuthorizer {
+		if err := setupWebhookAuthorizerIndexes(ctx, mgr); err != nil {
+			return err
+		}
 	}
-	if !includeRestricted {
+	if !indexes.Restricted {
 		return nil
 	}
 	if err := SetupRestrictedIndexes(ctx, mgr); err != nil {
@@ -5,6 +5,8 @@ package indexer
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -12,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
 )
@@ -923,3 +926,73 @@ func TestRestrictedRoleDefinitionPolicyRefWithFakeClient(t *testing.T) {
 		t.Errorf("expected 0 RestrictedRoleDefinitions with nonexistent, got %d", len(list.Items))
 	}
 }
+
+// recordingIndexer records the types field indexes are registered for.
+type recordingIndexer struct {
+	types []string
+}
+
+func (r *recordingIndexer) IndexField(_ context.Context, obj client.Object, _ string, _ client.IndexerFunc) error {
+	name := fmt.Sprintf("%T", obj)
+	if !slices.Contains(r.types, name) {
+		r.types = append(r.types, name)
+	}
+	return nil
+}
+
+type recordingManager struct {
+	manager.Manager
+	indexer *recordingIndexer
+}
+
+func (m recordingManager) GetFieldIndexer() client.FieldIndexer {
+	return m.indexer
+}
+
+func TestSetupControllerIndexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes ControllerIndexes
+		want    []string
+	}{
+		{
+			name:    "role generation only",
+			indexes: ControllerIndexes{RoleDefinition: true},
+			want:    []string{"*v1alpha1.RoleDefinition"},
+		},
+		{
+			name:    "role generation and bindings",
+			indexes: ControllerIndexes{RoleDefinition: true, BindDefinition: true},
+			want:    []string{"*v1alpha1.BindDefinition", "*v1alpha1.RoleDefinition"},
+		},
+		{
+			name:    "authorizer only",
+			indexes: ControllerIndexes{WebhookAuthorizer: true},
+			want:    []string{"*v1alpha1.WebhookAuthorizer"},
+		},
+		{
+			name:    "restricted",
+			indexes: ControllerIndexes{Restricted: true},
+			want: []string{
+				"*v1.ClusterRoleBinding", "*v1.RoleBinding", "*v1.ServiceAccount",
+				"*v1alpha1.RBACPolicy", "*v1alpha1.RestrictedBindDefinition", "*v1alpha1.RestrictedRoleDefinition",
+			},
+		},
+		{
+			name:    "none",
+			indexes: ControllerIndexes{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexer := &recordingIndexer{}
+			if err := SetupControllerIndexes(context.Background(), recordingManager{indexer: indexer}, tt.indexes); err != nil {
+				t.Fatalf("SetupControllerIndexes() error = %v", err)
+			}
+			slices.Sort(indexer.types)
+			if !slices.Equal(indexer.types, tt.want) {
+				t.Errorf("indexed types = %v, want %v", indexer.types, tt.want)
+			}
+		})
+	}
+}
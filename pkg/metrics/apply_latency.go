@@ -0,0 +1,86 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ApplyOutcome constants label how a pending change of a definition was
+// reported in status.
+const (
+	// ApplyOutcomeReady means all derived RBAC was applied and Ready=True.
+	ApplyOutcomeReady = "ready"
+	// ApplyOutcomeDegraded means the derived RBAC was applied and Ready=True,
+	// but some role references were missing.
+	ApplyOutcomeDegraded = "degraded"
+	// ApplyOutcomePolicyViolation means a restricted definition was rejected
+	// by its RBACPolicy, so the change was not applied.
+	ApplyOutcomePolicyViolation = "policy_violation"
+)
+
+type applyLatencyKey struct {
+	controller string
+	name       string
+}
+
+type pendingApply struct {
+	generation int64
+	since      time.Time
+}
+
+var (
+	pendingAppliesMu sync.Mutex
+	// pendingApplies tracks, per definition, the oldest change whose derived
+	// RBAC has not yet been reported as applied.
+	pendingApplies = make(map[applyLatencyKey]pendingApply)
+)
+
+// StartApplyLatency records that the derived RBAC of the named definition
+// became stale at since. generation is the definition generation that must be
+// reported as applied, or 0 for the current one (e.g. after a namespace label
+// change). When a change is already pending, its earlier start is kept so the
+// latency covers everything the user has been waiting for.
+func StartApplyLatency(controller, name string, generation int64, since time.Time) {
+	pendingAppliesMu.Lock()
+	defer pendingAppliesMu.Unlock()
+
+	key := applyLatencyKey{controller: controller, name: name}
+	if pending, ok := pendingApplies[key]; ok {
+		if pending.since.Before(since) {
+			since = pending.since
+		}
+		generation = max(generation, pending.generation)
+	}
+	pendingApplies[key] = pendingApply{generation: generation, since: since}
+}
+
+// ObserveApplyLatency observes RBACApplyLatency for the pending change of the
+// named definition once its status has been applied for generation. It does
+// nothing when no change is pending or the pending change is newer than
+// generation, which leaves it pending for a later reconcile.
+func ObserveApplyLatency(ctx context.Context, controller, name string, generation int64, outcome string) {
+	pendingAppliesMu.Lock()
+	key := applyLatencyKey{controller: controller, name: name}
+	pending, ok := pendingApplies[key]
+	if !ok || pending.generation > generation {
+		pendingAppliesMu.Unlock()
+		return
+	}
+	delete(pendingApplies, key)
+	pendingAppliesMu.Unlock()
+
+	observeWithExemplar(ctx, RBACApplyLatency.WithLabelValues(controller, outcome), time.Since(pending.since).Seconds())
+}
+
+// DeleteApplyLatency forgets the pending change of a deleted definition.
+func DeleteApplyLatency(controller, name string) {
+	pendingAppliesMu.Lock()
+	defer pendingAppliesMu.Unlock()
+
+	delete(pendingApplies, applyLatencyKey{controller: controller, name: name})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func applyLatencySamples(t *testing.T, controller, outcome string) (count uint64, sum float64) {
+	t.Helper()
+	h, ok := RBACApplyLatency.WithLabelValues(controller, outcome).(prometheus.Histogram)
+	if !ok {
+		t.Fatal("expected RBACApplyLatency observer to be a histogram")
+	}
+	var m dto.Metric
+	if err := h.Write(&m); err != nil {
+		t.Fatalf("write histogram: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
+
+func TestApplyLatency(t *testing.T) {
+	ctx := context.Background()
+	const name = "apply-latency-test"
+
+	t.Run("observes the earliest start once", func(t *testing.T) {
+		RBACApplyLatency.Reset()
+		defer RBACApplyLatency.Reset()
+
+		StartApplyLatency(ControllerBindDefinition, name, 2, time.Now().Add(-time.Minute))
+		StartApplyLatency(ControllerBindDefinition, name, 3, time.Now())
+
+		// The reconcile for generation 2 must not complete the change to 3.
+		ObserveApplyLatency(ctx, ControllerBindDefinition, name, 2, ApplyOutcomeReady)
+		if count, _ := applyLatencySamples(t, ControllerBindDefinition, ApplyOutcomeReady); count != 0 {
+			t.Fatalf("expected no sample for an older generation, got %d", count)
+		}
+
+		ObserveApplyLatency(ctx, ControllerBindDefinition, name, 3, ApplyOutcomeReady)
+		count, sum := applyLatencySamples(t, ControllerBindDefinition, ApplyOutcomeReady)
+		if count != 1 {
+			t.Fatalf("expected 1 sample, got %d", count)
+		}
+		if sum < time.Minute.Seconds() {
+			t.Errorf("expected latency from the earliest start, got %.1fs", sum)
+		}
+
+		ObserveApplyLatency(ctx, ControllerBindDefinition, name, 3, ApplyOutcomeReady)
+		if count, _ := applyLatencySamples(t, ControllerBindDefinition, ApplyOutcomeReady); count != 1 {
+			t.Errorf("expected the change to be observed once, got %d samples", count)
+		}
+	})
+
+	t.Run("current generation", func(t *testing.T) {
+		RBACApplyLatency.Reset()
+		defer RBACApplyLatency.Reset()
+
+		StartApplyLatency(ControllerRestrictedBindDefinition, name, 0, time.Now())
+		ObserveApplyLatency(ctx, ControllerRestrictedBindDefinition, name, 7, ApplyOutcomePolicyViolation)
+		if count, _ := applyLatencySamples(t, ControllerRestrictedBindDefinition, ApplyOutcomePolicyViolation); count != 1 {
+			t.Errorf("expected 1 sample, got %d", count)
+		}
+	})
+
+	t.Run("deleted definition", func(t *testing.T) {
+		RBACApplyLatency.Reset()
+		defer RBACApplyLatency.Reset()
+
+		StartApplyLatency(ControllerRoleDefinition, name, 1, time.Now())
+		DeleteApplyLatency(ControllerRoleDefinition, name)
+		ObserveApplyLatency(ctx, ControllerRoleDefinition, name, 1, ApplyOutcomeReady)
+		if count, _ := applyLatencySamples(t, ControllerRoleDefinition, ApplyOutcomeReady); count != 0 {
+			t.Errorf("expected no sample after delete, got %d", count)
+		}
+	})
+}
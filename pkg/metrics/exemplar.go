@@ -0,0 +1,49 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exemplar label names. They match the names the OpenTelemetry Prometheus
+// bridge maps to the trace and span ID of an OTLP exemplar.
+const (
+	ExemplarTraceIDLabel = "trace_id"
+	ExemplarSpanIDLabel  = "span_id"
+)
+
+// ObserveReconcileDuration records a reconcile duration for controller, with
+// the reconcile span in ctx as exemplar when it is sampled.
+func ObserveReconcileDuration(ctx context.Context, controller string, d time.Duration) {
+	observeWithExemplar(ctx, ReconcileDuration.WithLabelValues(controller), d.Seconds())
+}
+
+// ObserveAuthorizerRequestDuration records a SubjectAccessReview evaluation
+// latency for decision, with the request span in ctx as exemplar when it is
+// sampled.
+func ObserveAuthorizerRequestDuration(ctx context.Context, decision string, d time.Duration) {
+	observeWithExemplar(ctx, AuthorizerRequestDuration.WithLabelValues(decision), d.Seconds())
+}
+
+// observeWithExemplar observes value and attaches the trace and span ID of
+// the sampled span in ctx as exemplar. Without tracing, or for an unsampled
+// span, it is a plain Observe.
+func observeWithExemplar(ctx context.Context, observer prometheus.Observer, value float64) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !ok || !spanContext.IsSampled() {
+		observer.Observe(value)
+		return
+	}
+	exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{
+		ExemplarTraceIDLabel: spanContext.TraceID().String(),
+		ExemplarSpanIDLabel:  spanContext.SpanID().String(),
+	})
+}
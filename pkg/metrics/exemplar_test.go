@@ -0,0 +1,96 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	testTraceID = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	testSpanID  = trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+)
+
+func spanContext(flags trace.TraceFlags) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    testTraceID,
+		SpanID:     testSpanID,
+		TraceFlags: flags,
+	}))
+}
+
+func histogramExemplars(t *testing.T, h prometheus.Histogram) []*dto.Exemplar {
+	t.Helper()
+	var m dto.Metric
+	if err := h.Write(&m); err != nil {
+		t.Fatalf("write histogram: %v", err)
+	}
+	var exemplars []*dto.Exemplar
+	for _, bucket := range m.GetHistogram().GetBucket() {
+		if ex := bucket.GetExemplar(); ex != nil {
+			exemplars = append(exemplars, ex)
+		}
+	}
+	return exemplars
+}
+
+func TestObserveWithExemplar(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		wantExemplar bool
+	}{
+		{name: "sampled span", ctx: spanContext(trace.FlagsSampled), wantExemplar: true},
+		{name: "unsampled span", ctx: spanContext(0)},
+		{name: "no span", ctx: context.Background()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_duration_seconds"})
+			observeWithExemplar(tt.ctx, h, 0.2)
+
+			exemplars := histogramExemplars(t, h)
+			if !tt.wantExemplar {
+				if len(exemplars) != 0 {
+					t.Fatalf("expected no exemplar, got %v", exemplars)
+				}
+				return
+			}
+			if len(exemplars) != 1 {
+				t.Fatalf("expected 1 exemplar, got %d", len(exemplars))
+			}
+			labels := map[string]string{}
+			for _, label := range exemplars[0].GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels[ExemplarTraceIDLabel] != testTraceID.String() {
+				t.Errorf("trace_id = %q, want %q", labels[ExemplarTraceIDLabel], testTraceID.String())
+			}
+			if labels[ExemplarSpanIDLabel] != testSpanID.String() {
+				t.Errorf("span_id = %q, want %q", labels[ExemplarSpanIDLabel], testSpanID.String())
+			}
+		})
+	}
+}
+
+func TestObserveReconcileDurationExemplar(t *testing.T) {
+	ReconcileDuration.Reset()
+	defer ReconcileDuration.Reset()
+
+	ObserveReconcileDuration(spanContext(trace.FlagsSampled), ControllerRoleDefinition, 0)
+
+	h, ok := ReconcileDuration.WithLabelValues(ControllerRoleDefinition).(prometheus.Histogram)
+	if !ok {
+		t.Fatal("expected ReconcileDuration observer to be a histogram")
+	}
+	if exemplars := histogramExemplars(t, h); len(exemplars) != 1 {
+		t.Errorf("expected 1 exemplar, got %d", len(exemplars))
+	}
+}
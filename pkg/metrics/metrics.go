@@ -20,13 +20,23 @@ const (
 
 	labelBindDefinition = "binddefinition"
 	labelAuthorizer     = "authorizer"
+	labelCapability     = "capability"
+	labelCheck          = "check"
+	labelConstrained    = "constrained"
 	labelController     = "controller"
 	labelDecision       = "decision"
 	labelErrorType      = "error_type"
+	labelGroupVersion   = "group_version"
+	labelManager        = "manager"
+	labelMode           = "mode"
 	labelName           = "name"
 	labelOperation      = "operation"
+	labelOutcome        = "outcome"
+	labelPolicy         = "policy"
+	labelReason         = "reason"
 	labelResourceType   = "resource_type"
 	labelResult         = "result"
+	labelState          = "state"
 	labelWebhook        = "webhook"
 )
 
@@ -81,6 +91,37 @@ var (
 		},
 	)
 
+	// APIDiscoveryCollections counts API resource collections by discovery mode.
+	APIDiscoveryCollections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "api_discovery_collections_total",
+			Help:      "Total number of API resource collections by discovery mode (aggregated, not_modified, legacy)",
+		},
+		[]string{labelMode},
+	)
+
+	// APIDiscoveryGroupVersionHealthy reports whether the latest discovery of a
+	// GroupVersion succeeded (1) or failed (0).
+	APIDiscoveryGroupVersionHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "api_discovery_group_version_healthy",
+			Help:      "Whether the latest API discovery of a GroupVersion succeeded (1) or failed (0)",
+		},
+		[]string{labelGroupVersion},
+	)
+
+	// APIDiscoveryGroupVersionErrors counts failed API discoveries per GroupVersion.
+	APIDiscoveryGroupVersionErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "api_discovery_group_version_errors_total",
+			Help:      "Total number of failed API discoveries per GroupVersion",
+		},
+		[]string{labelGroupVersion},
+	)
+
 	// RBACResourcesDeleted counts the total number of RBAC resources deleted.
 	RBACResourcesDeleted = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -202,6 +243,18 @@ var (
 		[]string{labelBindDefinition},
 	)
 
+	// ServiceAccountsRevoked counts generated ServiceAccounts revoked because
+	// their serviceAccountLifecycle expired, labeled by controller and reason
+	// (TTLExpired or Idle).
+	ServiceAccountsRevoked = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "serviceaccounts_revoked_total",
+			Help:      "Total number of generated ServiceAccounts revoked by their serviceAccountLifecycle, per controller and reason",
+		},
+		[]string{labelController, labelReason},
+	)
+
 	// AuthorizerRequestsTotal counts the total number of SubjectAccessReview
 	// requests processed by the WebhookAuthorizer, labeled by decision and
 	// authorizer name.
@@ -228,8 +281,8 @@ var (
 	)
 
 	// AuthorizerActiveRules is a gauge tracking the total number of active
-	// resource and non-resource rules across all WebhookAuthorizer resources.
-	// NOTE: This counts individual ResourceRules + NonResourceRules entries
+	// resource, selector and non-resource rules across all WebhookAuthorizer resources.
+	// NOTE: This counts individual ResourceRules + SelectorRules + NonResourceRules entries
 	// (not the number of WebhookAuthorizer CRs). The metric name says
 	// "rules" intentionally — use AuthorizerRequestsTotal for CR-level
 	// visibility.
@@ -237,7 +290,7 @@ var (
 		prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "authorizer_active_rules",
-			Help:      "Total number of individual resource and non-resource rule entries across all WebhookAuthorizer resources",
+			Help:      "Total number of individual resource, selector and non-resource rule entries across all WebhookAuthorizer resources",
 		},
 	)
 
@@ -268,6 +321,32 @@ var (
 		},
 	)
 
+	// AuthorizerImpersonationChecksTotal counts constrained impersonation
+	// (KEP-5284) SubjectAccessReviews, labeled by impersonation mode, check
+	// ("identity" for impersonate:<mode>, "action" for impersonate-on:<mode>:<verb>)
+	// and decision. Cardinality is bounded by the fixed set of modes.
+	AuthorizerImpersonationChecksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "authorizer_impersonation_checks_total",
+			Help:      "Total constrained impersonation SubjectAccessReviews by mode, check and decision",
+		},
+		[]string{labelMode, labelCheck, labelDecision},
+	)
+
+	// AuthorizerImpersonationsTotal counts impersonated requests whose identity
+	// and action checks were both seen by the authorizer and correlated into a
+	// single audit event. The decision is denied when either check was denied,
+	// allowed when both were allowed, and no-opinion otherwise.
+	AuthorizerImpersonationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "authorizer_impersonations_total",
+			Help:      "Total correlated constrained impersonation identity and action checks by mode and decision",
+		},
+		[]string{labelMode, labelDecision},
+	)
+
 	// NamespaceFanoutSkipped counts how many rolebinding-scoped BindDefinitions
 	// were filtered out (not enqueued) during namespace-event fan-out because
 	// their namespace routing criteria did not match the changed namespace.
@@ -304,11 +383,120 @@ var (
 		[]string{labelController},
 	)
 
+	// NamespaceTerminationStuck tracks the number of terminating namespaces whose
+	// deletion has been held by the RoleBinding terminator for longer than the
+	// configured grace period. Per-namespace state is tracked in memory so the
+	// exported series stays a single, unlabelled gauge.
+	NamespaceTerminationStuck = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "namespace_termination_stuck",
+			Help:      "Number of terminating namespaces blocked by remaining resources for longer than the configured grace period",
+		},
+	)
+
+	// NamespaceTerminationBlockedDuration measures how long a terminating
+	// namespace was blocked by remaining resources before the RoleBinding
+	// terminator released its RoleBinding finalizers. The duration is measured
+	// from the namespace deletion timestamp.
+	NamespaceTerminationBlockedDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "namespace_termination_blocked_duration_seconds",
+			Help:      "Time from namespace deletion until the RoleBinding terminator released RoleBinding finalizers, in seconds",
+			Buckets:   []float64{10, 30, 60, 300, 600, 1800, 3600, 7200, 21600, 86400},
+		},
+	)
+
+	// RoleBindingFinalizerReleasedByPolicy counts RoleBinding finalizers released by a
+	// namespace termination policy while other resources remained in the namespace.
+	RoleBindingFinalizerReleasedByPolicy = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rolebinding_finalizer_released_by_policy_total",
+			Help:      "Total number of RoleBinding finalizers released by a namespace termination policy while resources remained",
+		},
+		[]string{labelPolicy},
+	)
+
+	// LegacyImpersonationExposedSubjects tracks the number of subjects holding
+	// the legacy "impersonate" verb, as found by the last cluster-wide scan.
+	// The constrained label is "true" for subjects that also hold constrained
+	// impersonation grants, whose restrictions the legacy verb defeats.
+	LegacyImpersonationExposedSubjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "legacy_impersonation_exposed_subjects",
+			Help:      "Number of subjects holding the legacy impersonate verb, by whether they also hold constrained impersonation grants",
+		},
+		[]string{labelConstrained},
+	)
+
+	// LegacyImpersonationRoles tracks the number of ClusterRoles and Roles
+	// carrying the legacy "impersonate" verb, bound or not.
+	LegacyImpersonationRoles = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "legacy_impersonation_roles",
+			Help:      "Number of ClusterRoles and Roles carrying the legacy impersonate verb",
+		},
+	)
+
+	// APIServerCapability reports the detected state of each optional API server
+	// capability: 1 for the current state and 0 for the other states.
+	APIServerCapability = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "api_server_capability",
+			Help:      "Detected state of optional API server capabilities (1 for the current state)",
+		},
+		[]string{labelCapability, labelState},
+	)
+
+	// RBACApplyLatency measures the time from a change of a definition's
+	// generation, or a label change of a namespace its selectors can match,
+	// until the status reports the derived RBAC as applied for that generation.
+	// See ObserveApplyLatency for the outcome label.
+	RBACApplyLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "rbac_apply_latency_seconds",
+			Help:      "Time from a definition spec change or matching namespace label change until the derived RBAC was applied and reported in status, in seconds",
+			Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{labelController, labelOutcome},
+	)
+
+	// SSAConflicts counts server-side applies of generated RBAC resources that
+	// conflicted with fields owned by another field manager, once per competing
+	// manager. The policy label is the conflictPolicy of the source definition.
+	// Use IncSSAConflict, which bounds the manager label.
+	SSAConflicts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "ssa_conflicts_total",
+			Help:      "Total number of server-side apply conflicts with other field managers on generated RBAC resources, by competing manager",
+		},
+		[]string{labelController, labelResourceType, labelManager, labelPolicy},
+	)
+
+	ssaConflictManagersMu sync.Mutex
+	// ssaConflictManagers holds the field managers that have their own manager
+	// label value on SSAConflicts. Field manager names are chosen by clients,
+	// so only the first MaxSSAConflictManagers are tracked individually.
+	ssaConflictManagers = make(map[string]struct{})
+
 	policyViolationsMu sync.Mutex
 	// policyViolationsByResource tracks per-resource violation counts so the
 	// exported metric can publish a controller-level aggregate with bounded
 	// label cardinality.
 	policyViolationsByResource = make(map[string]map[string]int)
+
+	namespaceTerminationStuckMu sync.Mutex
+	// namespaceTerminationStuckSet tracks which namespaces currently exceed the
+	// termination grace period so NamespaceTerminationStuck can be exported as
+	// an aggregate without a per-namespace label.
+	namespaceTerminationStuckSet = make(map[string]struct{})
 )
 
 // allCollectors returns the full list of prometheus.Collector instances
@@ -320,6 +508,9 @@ func allCollectors() []prometheus.Collector {
 		ReconcileErrors,
 		APIDiscoveryDuration,
 		APIDiscoveryErrors,
+		APIDiscoveryCollections,
+		APIDiscoveryGroupVersionHealthy,
+		APIDiscoveryGroupVersionErrors,
 		RBACResourcesApplied,
 		RBACResourcesSkipped,
 		RBACResourcesDeleted,
@@ -330,14 +521,25 @@ func allCollectors() []prometheus.Collector {
 		WebhookRequestsTotal,
 		ServiceAccountSkippedPreExisting,
 		ExternalSAsReferenced,
+		ServiceAccountsRevoked,
 		AuthorizerRequestsTotal,
 		AuthorizerRequestDuration,
 		AuthorizerActiveRules,
 		AuthorizerDeniedPrincipalHitsTotal,
 		AuthorizerRateLimitedTotal,
+		AuthorizerImpersonationChecksTotal,
+		AuthorizerImpersonationsTotal,
 		NamespaceFanoutSkipped,
 		NamespaceFanoutEnqueued,
 		PolicyViolationsActive,
+		NamespaceTerminationStuck,
+		NamespaceTerminationBlockedDuration,
+		RoleBindingFinalizerReleasedByPolicy,
+		LegacyImpersonationExposedSubjects,
+		LegacyImpersonationRoles,
+		APIServerCapability,
+		RBACApplyLatency,
+		SSAConflicts,
 	}
 }
 
@@ -379,6 +581,8 @@ const (
 	ControllerRBACPolicy               = "RBACPolicy"
 	ControllerRestrictedBindDefinition = "RestrictedBindDefinition"
 	ControllerRestrictedRoleDefinition = "RestrictedRoleDefinition"
+	ControllerImpersonationExposure    = "ImpersonationExposure"
+	ControllerOperatorCapabilities     = "OperatorCapabilities"
 )
 
 // ResourceType constants.
@@ -411,9 +615,23 @@ const (
 	AuthorizerDecisionError     = "error"
 )
 
+// AuthorizerImpersonationCheck constants for labeling constrained impersonation checks.
+const (
+	AuthorizerImpersonationCheckIdentity = "identity"
+	AuthorizerImpersonationCheckAction   = "action"
+)
+
 // AuthorizerNameNone is the fallback label value when no specific authorizer matched.
 const AuthorizerNameNone = "none"
 
+// SSAConflicts manager label bounds. The first MaxSSAConflictManagers distinct
+// field managers keep their own label value; later ones share
+// SSAConflictManagerOther.
+const (
+	MaxSSAConflictManagers  = 16
+	SSAConflictManagerOther = "other"
+)
+
 // DeleteManagedResourceSeries removes all ManagedResources gauge series for a
 // specific source resource (e.g. a BindDefinition being deleted). This prevents
 // stale zero-value series from lingering after the resource is removed.
@@ -481,3 +699,39 @@ func setPolicyViolationsAggregateLocked(controller string, resourceCounts map[st
 	}
 	PolicyViolationsActive.WithLabelValues(controller).Set(float64(total))
 }
+
+// IncSSAConflict counts a server-side apply conflict with manager on SSAConflicts.
+func IncSSAConflict(controller, resourceType, manager, policy string) {
+	SSAConflicts.WithLabelValues(controller, resourceType, ssaConflictManagerLabel(manager), policy).Inc()
+}
+
+// ssaConflictManagerLabel returns manager as the manager label value, or
+// SSAConflictManagerOther once MaxSSAConflictManagers other managers have their
+// own value.
+func ssaConflictManagerLabel(manager string) string {
+	ssaConflictManagersMu.Lock()
+	defer ssaConflictManagersMu.Unlock()
+
+	if _, ok := ssaConflictManagers[manager]; ok {
+		return manager
+	}
+	if manager == SSAConflictManagerOther || len(ssaConflictManagers) >= MaxSSAConflictManagers {
+		return SSAConflictManagerOther
+	}
+	ssaConflictManagers[manager] = struct{}{}
+	return manager
+}
+
+// SetNamespaceTerminationStuck records whether a terminating namespace is
+// currently blocked past its grace period and exports the aggregate count.
+func SetNamespaceTerminationStuck(namespace string, stuck bool) {
+	namespaceTerminationStuckMu.Lock()
+	defer namespaceTerminationStuckMu.Unlock()
+
+	if stuck {
+		namespaceTerminationStuckSet[namespace] = struct{}{}
+	} else {
+		delete(namespaceTerminationStuckSet, namespace)
+	}
+	NamespaceTerminationStuck.Set(float64(len(namespaceTerminationStuckSet)))
+}
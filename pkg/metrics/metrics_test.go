@@ -8,6 +8,7 @@ package metrics
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -29,6 +30,9 @@ func TestMetricRegistration(t *testing.T) {
 		{"ReconcileErrors", ReconcileErrors},
 		{"APIDiscoveryDuration", APIDiscoveryDuration},
 		{"APIDiscoveryErrors", APIDiscoveryErrors},
+		{"APIDiscoveryCollections", APIDiscoveryCollections},
+		{"APIDiscoveryGroupVersionHealthy", APIDiscoveryGroupVersionHealthy},
+		{"APIDiscoveryGroupVersionErrors", APIDiscoveryGroupVersionErrors},
 		{"RBACResourcesApplied", RBACResourcesApplied},
 		{"RBACResourcesDeleted", RBACResourcesDeleted},
 		{"RoleRefsMissing", RoleRefsMissing},
@@ -37,9 +41,16 @@ func TestMetricRegistration(t *testing.T) {
 		{"WebhookRequestsTotal", WebhookRequestsTotal},
 		{"ServiceAccountSkippedPreExisting", ServiceAccountSkippedPreExisting},
 		{"ExternalSAsReferenced", ExternalSAsReferenced},
+		{"ServiceAccountsRevoked", ServiceAccountsRevoked},
 		{"AuthorizerRateLimitedTotal", AuthorizerRateLimitedTotal},
+		{"AuthorizerImpersonationChecksTotal", AuthorizerImpersonationChecksTotal},
+		{"AuthorizerImpersonationsTotal", AuthorizerImpersonationsTotal},
 		{"NamespaceFanoutSkipped", NamespaceFanoutSkipped},
 		{"NamespaceFanoutEnqueued", NamespaceFanoutEnqueued},
+		{"NamespaceTerminationStuck", NamespaceTerminationStuck},
+		{"NamespaceTerminationBlockedDuration", NamespaceTerminationBlockedDuration},
+		{"RoleBindingFinalizerReleasedByPolicy", RoleBindingFinalizerReleasedByPolicy},
+		{"SSAConflicts", SSAConflicts},
 	}
 
 	for _, c := range collectors {
@@ -373,6 +384,57 @@ func TestSetAndDeletePolicyViolationContribution(t *testing.T) {
 	}
 }
 
+func TestSetNamespaceTerminationStuck(t *testing.T) {
+	SetNamespaceTerminationStuck("stuck-ns-a", false)
+	SetNamespaceTerminationStuck("stuck-ns-b", false)
+
+	SetNamespaceTerminationStuck("stuck-ns-a", true)
+	SetNamespaceTerminationStuck("stuck-ns-b", true)
+	// Marking the same namespace twice must not double count.
+	SetNamespaceTerminationStuck("stuck-ns-a", true)
+	if val := getGaugeValue(t, NamespaceTerminationStuck); val != 2 {
+		t.Errorf("expected 2 stuck namespaces, got %f", val)
+	}
+
+	SetNamespaceTerminationStuck("stuck-ns-a", false)
+	if val := getGaugeValue(t, NamespaceTerminationStuck); val != 1 {
+		t.Errorf("expected 1 stuck namespace after clearing one, got %f", val)
+	}
+
+	SetNamespaceTerminationStuck("stuck-ns-b", false)
+	if val := getGaugeValue(t, NamespaceTerminationStuck); val != 0 {
+		t.Errorf("expected 0 stuck namespaces after clearing all, got %f", val)
+	}
+}
+
+func TestIncSSAConflictBoundsManagerLabel(t *testing.T) {
+	ssaConflictManagersMu.Lock()
+	ssaConflictManagers = make(map[string]struct{})
+	ssaConflictManagersMu.Unlock()
+	SSAConflicts.Reset()
+
+	for i := range MaxSSAConflictManagers {
+		IncSSAConflict(ControllerBindDefinition, ResourceClusterRoleBinding, fmt.Sprintf("manager-%d", i), "Force")
+	}
+	IncSSAConflict(ControllerBindDefinition, ResourceClusterRoleBinding, "late-manager", "Force")
+	IncSSAConflict(ControllerBindDefinition, ResourceClusterRoleBinding, "another-late-manager", "Force")
+	// A manager that already has its own label value keeps it.
+	IncSSAConflict(ControllerBindDefinition, ResourceClusterRoleBinding, "manager-0", "Force")
+
+	counter := func(manager string) float64 {
+		return getCounterValue(t, SSAConflicts.WithLabelValues(ControllerBindDefinition, ResourceClusterRoleBinding, manager, "Force"))
+	}
+	if val := counter("manager-0"); val != 2 {
+		t.Errorf("expected 2 conflicts for manager-0, got %f", val)
+	}
+	if val := counter(SSAConflictManagerOther); val != 2 {
+		t.Errorf("expected 2 conflicts for managers beyond the bound, got %f", val)
+	}
+	if val := counter("late-manager"); val != 0 {
+		t.Errorf("expected no series for a manager beyond the bound, got %f", val)
+	}
+}
+
 func TestConstants(t *testing.T) {
 	// Verify namespace constant
 	if Namespace != "auth_operator" {
@@ -0,0 +1,173 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	otelprometheus "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	// DefaultOTLPExportInterval is the default interval between OTLP metric exports.
+	DefaultOTLPExportInterval = time.Minute
+
+	// otlpServiceName is the OTLP service name, matching the tracing resource.
+	otlpServiceName = "auth-operator"
+
+	// otlpShutdownTimeout is the maximum time to wait for the final export.
+	otlpShutdownTimeout = 5 * time.Second
+)
+
+// OTLPConfig configures the export of the Prometheus metrics over OTLP.
+type OTLPConfig struct {
+	// Enabled controls whether metrics are exported over OTLP.
+	Enabled bool
+
+	// Endpoint is the OTLP collector endpoint (e.g. "otel-collector:4317").
+	Endpoint string
+
+	// Insecure disables TLS for the OTLP exporter connection.
+	Insecure bool
+
+	// Interval is the time between exports (0 = DefaultOTLPExportInterval).
+	Interval time.Duration
+}
+
+// OTLPExporter periodically pushes the metrics of a Prometheus gatherer to an
+// OTLP collector. Histogram exemplars (see ObserveReconcileDuration) are
+// exported as OTLP exemplars carrying the trace and span ID.
+type OTLPExporter struct {
+	provider *sdkmetric.MeterProvider
+}
+
+// SetupOTLP starts exporting the metrics of gatherer, usually the
+// controller-runtime registry, over OTLP/gRPC. When cfg.Enabled is false it
+// returns an exporter whose Shutdown is a no-op. The Prometheus /metrics
+// endpoint is unaffected either way.
+func SetupOTLP(ctx context.Context, cfg OTLPConfig, gatherer prometheus.Gatherer, version string) (*OTLPExporter, error) {
+	if !cfg.Enabled {
+		return &OTLPExporter{}, nil
+	}
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("OTLP metrics endpoint must be set when OTLP metrics export is enabled")
+	}
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultOTLPExportInterval
+	}
+	if interval < 0 {
+		return nil, fmt.Errorf("OTLP metrics export interval must not be negative, got %s", interval)
+	}
+
+	opts := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	exporter, err := otlpmetricgrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP metric exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String(otlpServiceName),
+			semconv.ServiceVersionKey.String(version),
+		),
+	)
+	if err != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), otlpShutdownTimeout)
+		defer cancel()
+		_ = exporter.Shutdown(shutdownCtx)
+		return nil, fmt.Errorf("creating OTEL resource: %w", err)
+	}
+
+	reader := sdkmetric.NewPeriodicReader(exporter,
+		sdkmetric.WithInterval(interval),
+		sdkmetric.WithProducer(newPrometheusProducer(gatherer)),
+	)
+	return &OTLPExporter{
+		provider: sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader), sdkmetric.WithResource(res)),
+	}, nil
+}
+
+// Enabled reports whether metrics are exported over OTLP.
+func (e *OTLPExporter) Enabled() bool {
+	return e.provider != nil
+}
+
+// Shutdown exports the metrics a last time and stops the exporter. Like
+// tracing.Provider.Shutdown it detaches from the cancellation of ctx, which
+// is usually already cancelled at shutdown, and bounds the wait.
+func (e *OTLPExporter) Shutdown(ctx context.Context) error {
+	if e.provider == nil {
+		return nil
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), otlpShutdownTimeout)
+	defer cancel()
+	return e.provider.Shutdown(shutdownCtx)
+}
+
+// prometheusProducer produces the metrics of a Prometheus gatherer for an
+// OTLP reader. The bridge copies the trace_id and span_id exemplar labels as
+// hex text, while OTLP expects the raw 16 and 8 byte IDs, so they are
+// decoded here; without it collectors cannot link exemplars to traces.
+type prometheusProducer struct {
+	bridge sdkmetric.Producer
+}
+
+func newPrometheusProducer(gatherer prometheus.Gatherer) *prometheusProducer {
+	return &prometheusProducer{bridge: otelprometheus.NewMetricProducer(otelprometheus.WithGatherer(gatherer))}
+}
+
+// Produce implements sdkmetric.Producer.
+func (p *prometheusProducer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	scopeMetrics, err := p.bridge.Produce(ctx)
+	for i := range scopeMetrics {
+		for j := range scopeMetrics[i].Metrics {
+			switch data := scopeMetrics[i].Metrics[j].Data.(type) {
+			case metricdata.Histogram[float64]:
+				for k := range data.DataPoints {
+					decodeExemplarIDs(data.DataPoints[k].Exemplars)
+				}
+			case metricdata.Sum[float64]:
+				for k := range data.DataPoints {
+					decodeExemplarIDs(data.DataPoints[k].Exemplars)
+				}
+			}
+		}
+	}
+	return scopeMetrics, err
+}
+
+// decodeExemplarIDs replaces hex encoded trace and span IDs by their bytes.
+func decodeExemplarIDs(exemplars []metricdata.Exemplar[float64]) {
+	for i := range exemplars {
+		exemplars[i].TraceID = decodeHexID(exemplars[i].TraceID, 16)
+		exemplars[i].SpanID = decodeHexID(exemplars[i].SpanID, 8)
+	}
+}
+
+func decodeHexID(id []byte, size int) []byte {
+	if len(id) != 2*size {
+		return id
+	}
+	decoded := make([]byte, size)
+	if _, err := hex.Decode(decoded, id); err != nil {
+		return id
+	}
+	return decoded
+}
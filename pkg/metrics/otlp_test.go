@@ -0,0 +1,87 @@
+/*
+Copyright © 2026 Deutsche Telekom AG.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetupOTLP(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		exporter, err := SetupOTLP(context.Background(), OTLPConfig{}, prometheus.NewRegistry(), "test")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if exporter.Enabled() {
+			t.Error("expected a disabled exporter")
+		}
+		if err := exporter.Shutdown(context.Background()); err != nil {
+			t.Errorf("Shutdown() of a disabled exporter: %v", err)
+		}
+	})
+
+	t.Run("missing endpoint", func(t *testing.T) {
+		_, err := SetupOTLP(context.Background(), OTLPConfig{Enabled: true}, prometheus.NewRegistry(), "test")
+		if err == nil || !strings.Contains(err.Error(), "endpoint") {
+			t.Fatalf("expected endpoint error, got %v", err)
+		}
+	})
+
+	t.Run("negative interval", func(t *testing.T) {
+		_, err := SetupOTLP(context.Background(), OTLPConfig{
+			Enabled:  true,
+			Endpoint: "localhost:4317",
+			Interval: -time.Second,
+		}, prometheus.NewRegistry(), "test")
+		if err == nil || !strings.Contains(err.Error(), "interval") {
+			t.Fatalf("expected interval error, got %v", err)
+		}
+	})
+}
+
+func TestPrometheusProducerDecodesExemplarIDs(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	h := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_duration_seconds"})
+	registry.MustRegister(h)
+	observeWithExemplar(spanContext(trace.FlagsSampled), h, 0.2)
+
+	reader := sdkmetric.NewManualReader(sdkmetric.WithProducer(newPrometheusProducer(registry)))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	var exemplars []metricdata.Exemplar[float64]
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if hist, ok := m.Data.(metricdata.Histogram[float64]); ok && m.Name == "test_duration_seconds" {
+				for _, dp := range hist.DataPoints {
+					exemplars = append(exemplars, dp.Exemplars...)
+				}
+			}
+		}
+	}
+	if len(exemplars) != 1 {
+		t.Fatalf("expected 1 exemplar, got %d", len(exemplars))
+	}
+	if !bytes.Equal(exemplars[0].TraceID, testTraceID[:]) {
+		t.Errorf("TraceID = %x, want %x", exemplars[0].TraceID, testTraceID[:])
+	}
+	if !bytes.Equal(exemplars[0].SpanID, testSpanID[:]) {
+		t.Errorf("SpanID = %x, want %x", exemplars[0].SpanID, testSpanID[:])
+	}
+}
@@ -0,0 +1,3 @@
+// Package operatorconfig defines the OperatorConfig file format, a versioned
+// alternative to the command-line flags of the controller and webhook commands.
+package operatorconfig
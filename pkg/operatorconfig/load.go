@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatorconfig
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Decode parses an OperatorConfig from YAML or JSON. Unknown fields are
+// rejected so typos do not silently fall back to flag defaults.
+func Decode(data []byte) (*OperatorConfig, error) {
+	config := &OperatorConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("decode OperatorConfig: %w", err)
+	}
+	if config.APIVersion != APIVersion || config.Kind != Kind {
+		return nil, fmt.Errorf("unsupported config %s %s, expected %s %s",
+			config.APIVersion, config.Kind, APIVersion, Kind)
+	}
+	return config, nil
+}
+
+// Load reads and decodes the OperatorConfig at path. It also returns the raw
+// file content so callers can detect changes.
+func Load(path string) (*OperatorConfig, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read OperatorConfig: %w", err)
+	}
+	config, err := Decode(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, data, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatorconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDecode(t *testing.T) {
+	config, err := Decode([]byte(`
+apiVersion: config.t-caas.telekom.com/v1alpha1
+kind: OperatorConfig
+verbosity: 3
+controller:
+  tracker:
+    syncInterval: 90s
+webhook:
+  authorize:
+    rateLimit: 5
+    rateBurst: 10
+`))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if config.Verbosity == nil || *config.Verbosity != 3 {
+		t.Errorf("Verbosity = %v, want 3", config.Verbosity)
+	}
+	if got := config.Controller.Tracker.SyncInterval.Duration; got != 90*time.Second {
+		t.Errorf("Tracker.SyncInterval = %v, want 90s", got)
+	}
+	if got := *config.Webhook.Authorize.RateBurst; got != 10 {
+		t.Errorf("Authorize.RateBurst = %d, want 10", got)
+	}
+	if config.Tracing != nil {
+		t.Errorf("Tracing = %+v, want nil for an unset section", config.Tracing)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"unknown field", "apiVersion: config.t-caas.telekom.com/v1alpha1\nkind: OperatorConfig\nverbosty: 3\n"},
+		{"wrong kind", "apiVersion: config.t-caas.telekom.com/v1alpha1\nkind: ConfigMap\n"},
+		{"wrong apiVersion", "apiVersion: config.t-caas.telekom.com/v1\nkind: OperatorConfig\n"},
+		{"invalid duration", "apiVersion: config.t-caas.telekom.com/v1alpha1\nkind: OperatorConfig\n" +
+			"controller:\n  cacheSyncTimeout: soon\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode([]byte(tt.data)); err == nil {
+				t.Error("Decode() expected error")
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := []byte("apiVersion: config.t-caas.telekom.com/v1alpha1\nkind: OperatorConfig\n")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, raw, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if config.Kind != Kind || string(raw) != string(data) {
+		t.Errorf("Load() = %+v, %q", config, raw)
+	}
+
+	if _, _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Load() expected error for a missing file")
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatorconfig
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// APIVersion is the only supported apiVersion of an OperatorConfig.
+	APIVersion = "config.t-caas.telekom.com/v1alpha1"
+	// Kind is the kind of an OperatorConfig.
+	Kind = "OperatorConfig"
+)
+
+// OperatorConfig configures the controller and webhook commands from a file,
+// typically a mounted ConfigMap. Every field is optional; an unset field keeps
+// the flag default, and a flag given on the command line overrides the file.
+//
+// Fields marked "hot-reloaded" take effect while the process runs when the
+// file changes; all others are read once at startup.
+type OperatorConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Verbosity is the log level (0-9). Hot-reloaded.
+	Verbosity *int `json:"verbosity,omitempty"`
+	// Tracing configures OpenTelemetry tracing.
+	Tracing *TracingConfig `json:"tracing,omitempty"`
+	// Metrics configures the metrics export.
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+	// Controller configures the controller command.
+	Controller *ControllerConfig `json:"controller,omitempty"`
+	// Webhook configures the webhook command.
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+}
+
+// TracingConfig configures OpenTelemetry tracing.
+type TracingConfig struct {
+	// Enabled enables tracing.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Endpoint is the OTLP collector endpoint, e.g. "otel-collector:4317".
+	Endpoint *string `json:"endpoint,omitempty"`
+	// SamplingRate is the ratio of traces to sample (0.0 to 1.0). Hot-reloaded.
+	SamplingRate *float64 `json:"samplingRate,omitempty"`
+	// Insecure disables TLS for the OTLP exporter connection.
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
+// MetricsConfig configures the metrics export. The Prometheus /metrics
+// endpoint is always served.
+type MetricsConfig struct {
+	// OTLP configures pushing the metrics to an OTLP collector.
+	OTLP *OTLPMetricsConfig `json:"otlp,omitempty"`
+}
+
+// OTLPMetricsConfig configures the OTLP metrics export.
+type OTLPMetricsConfig struct {
+	// Enabled enables the OTLP metrics export.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Endpoint is the OTLP collector endpoint, e.g. "otel-collector:4317".
+	Endpoint *string `json:"endpoint,omitempty"`
+	// Insecure disables TLS for the OTLP exporter connection.
+	Insecure *bool `json:"insecure,omitempty"`
+	// Interval is the time between exports.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// ControllerConfig configures the controller command.
+type ControllerConfig struct {
+	// LeaderElect enables leader election.
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+	// Profile selects the set of reconcilers to run, e.g. "rbac-only".
+	Profile *string `json:"profile,omitempty"`
+	// Controllers lists the reconcilers to run, overriding Profile.
+	Controllers []string `json:"controllers,omitempty"`
+	// Concurrency sets the workers per reconciler; 0 disables a reconciler.
+	Concurrency *ConcurrencyConfig `json:"concurrency,omitempty"`
+	// CacheSyncTimeout is the timeout for waiting for CRDs to become available.
+	CacheSyncTimeout *metav1.Duration `json:"cacheSyncTimeout,omitempty"`
+	// GracefulShutdownTimeout is the timeout for graceful shutdown of the manager.
+	GracefulShutdownTimeout *metav1.Duration `json:"gracefulShutdownTimeout,omitempty"`
+	// WaitForCRDs waits for required CRDs before starting controllers.
+	WaitForCRDs *bool `json:"waitForCRDs,omitempty"`
+	// Tracker configures the API resource tracker.
+	Tracker *TrackerConfig `json:"tracker,omitempty"`
+	// NamespaceTermination configures RoleBinding finalizers in terminating namespaces.
+	NamespaceTermination *NamespaceTerminationConfig `json:"namespaceTermination,omitempty"`
+	// ImpersonationExposureScanInterval is the interval between legacy
+	// impersonation scans; 0 disables the scanner.
+	ImpersonationExposureScanInterval *metav1.Duration `json:"impersonationExposureScanInterval,omitempty"`
+	// CapabilityProbeInterval is the interval between API server capability
+	// probes; 0 disables publishing.
+	CapabilityProbeInterval *metav1.Duration `json:"capabilityProbeInterval,omitempty"`
+	// EnableImpersonationGrants generates the RBAC of RoleDefinition
+	// spec.impersonationGrants.
+	EnableImpersonationGrants *bool `json:"enableImpersonationGrants,omitempty"`
+}
+
+// ConcurrencyConfig sets the number of workers per reconciler.
+type ConcurrencyConfig struct {
+	RoleDefinition           *int `json:"roleDefinition,omitempty"`
+	BindDefinition           *int `json:"bindDefinition,omitempty"`
+	WebhookAuthorizer        *int `json:"webhookAuthorizer,omitempty"`
+	RBACPolicy               *int `json:"rbacPolicy,omitempty"`
+	RestrictedBindDefinition *int `json:"restrictedBindDefinition,omitempty"`
+	RestrictedRoleDefinition *int `json:"restrictedRoleDefinition,omitempty"`
+}
+
+// TrackerConfig configures the API resource tracker.
+type TrackerConfig struct {
+	// SyncInterval is the interval between periodic API resource collections.
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
+	// ResyncInterval is the interval between full rescans.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+	// StaleTTL is how long a failing GroupVersion keeps its last known resources.
+	StaleTTL *metav1.Duration `json:"staleTTL,omitempty"`
+}
+
+// NamespaceTerminationConfig configures RoleBinding finalizers in terminating namespaces.
+type NamespaceTerminationConfig struct {
+	// GracePeriod is how long a terminating namespace may be blocked before it is reported.
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// FinalizerRelease is the default finalizer release policy.
+	FinalizerRelease *string `json:"finalizerRelease,omitempty"`
+	// ResourceTypes hold RoleBinding finalizers with the WaitForListed policy.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	// ReleaseTimeout releases finalizers regardless of remaining resources.
+	ReleaseTimeout *metav1.Duration `json:"releaseTimeout,omitempty"`
+}
+
+// WebhookConfig configures the webhook command.
+type WebhookConfig struct {
+	// Port is the port the webhook server binds to.
+	Port *int `json:"port,omitempty"`
+	// CertsDir is the directory of the webhook serving certificate.
+	CertsDir *string `json:"certsDir,omitempty"`
+	// EnableHTTP2 enables HTTP/2 for the webhook server.
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+	// LeaderElect enables leader election.
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+	// CertRotation configures webhook certificate rotation.
+	CertRotation *CertRotationConfig `json:"certRotation,omitempty"`
+	// TDGMigration enables T-DDI to T-CaaS migration mode.
+	TDGMigration *bool `json:"tdgMigration,omitempty"`
+	// CAPIOperatorUpdateBypass enables the CAPI operator namespace update bypass.
+	CAPIOperatorUpdateBypass *bool `json:"capiOperatorUpdateBypass,omitempty"`
+	// Authorize configures the /authorize endpoint.
+	Authorize *AuthorizeConfig `json:"authorize,omitempty"`
+	// RecordServiceAccountUsage records the last use of generated ServiceAccounts.
+	RecordServiceAccountUsage *bool `json:"recordServiceAccountUsage,omitempty"`
+}
+
+// CertRotationConfig configures webhook certificate rotation.
+type CertRotationConfig struct {
+	// Disabled disables certificate rotation and uses existing certificates.
+	Disabled *bool `json:"disabled,omitempty"`
+	// DNSName is the DNS name of the webhook service.
+	DNSName *string `json:"dnsName,omitempty"`
+	// SecretName is the name of the certificate Secret.
+	SecretName *string `json:"secretName,omitempty"`
+	// ValidatingWebhooks are patched with the CA bundle.
+	ValidatingWebhooks []string `json:"validatingWebhooks,omitempty"`
+	// MutatingWebhooks are patched with the CA bundle.
+	MutatingWebhooks []string `json:"mutatingWebhooks,omitempty"`
+}
+
+// AuthorizeConfig configures the /authorize endpoint.
+type AuthorizeConfig struct {
+	// RateLimit is the per-subject sustained requests per second; 0 disables
+	// rate limiting. Hot-reloaded while rate limiting stays enabled.
+	RateLimit *float64 `json:"rateLimit,omitempty"`
+	// RateBurst is the per-subject burst. Hot-reloaded.
+	RateBurst *int `json:"rateBurst,omitempty"`
+	// AuthTokenFile is the bearer token file required by callers.
+	AuthTokenFile *string `json:"authTokenFile,omitempty"`
+	// AllowUnauthenticated allows callers without a bearer token when no token file is configured.
+	AllowUnauthenticated *bool `json:"allowUnauthenticated,omitempty"`
+}
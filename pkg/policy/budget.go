@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// EvaluateBudgets checks a RestrictedBindDefinition against the budgets of its
+// RBACPolicy. siblings are all RestrictedBindDefinitions referencing the
+// policy; rbd replaces its own entry. namespaces resolves namespace selectors.
+//
+// Definitions are admitted to the budgets oldest first, by creation timestamp
+// and then name. When namespace fan-out or a lowered budget pushes the
+// aggregate usage over a limit, the definitions that no longer fit are in
+// violation while earlier ones keep their RBAC.
+func EvaluateBudgets(
+	policy *authorizationv1alpha1.RBACPolicy,
+	rbd *authorizationv1alpha1.RestrictedBindDefinition,
+	siblings []authorizationv1alpha1.RestrictedBindDefinition,
+	namespaces []corev1.Namespace,
+) ([]Violation, error) {
+	budgets := policy.Spec.Budgets
+	if budgets == nil {
+		return nil, nil
+	}
+
+	ordered := make([]authorizationv1alpha1.RestrictedBindDefinition, 0, len(siblings)+1)
+	for i := range siblings {
+		if siblings[i].Name != rbd.Name {
+			ordered = append(ordered, siblings[i])
+		}
+	}
+	ordered = append(ordered, *rbd)
+	slices.SortFunc(ordered, func(a, b authorizationv1alpha1.RestrictedBindDefinition) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	position := slices.IndexFunc(ordered, func(item authorizationv1alpha1.RestrictedBindDefinition) bool {
+		return item.Name == rbd.Name
+	})
+
+	before, err := authorizationv1alpha1.PolicyBudgetUsageFor(ordered[:position], namespaces)
+	if err != nil {
+		return nil, err
+	}
+	after, err := authorizationv1alpha1.PolicyBudgetUsageFor(ordered[:position+1], namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	check := func(name string, limit *int32, used, previous int32, field string) {
+		if limit == nil || used <= *limit || used <= previous {
+			return
+		}
+		violations = append(violations, Violation{
+			Field: field,
+			Message: fmt.Sprintf("raises %s of RBACPolicy %q to %d, exceeding its budget of %d",
+				name, policy.Name, used, *limit),
+		})
+	}
+	check("the number of RestrictedBindDefinitions", budgets.MaxRestrictedBindDefinitions,
+		after.RestrictedBindDefinitions, before.RestrictedBindDefinitions, "spec.policyRef.name")
+	check("the generated RoleBindings", budgets.MaxRoleBindings,
+		after.RoleBindings, before.RoleBindings, "spec.roleBindings")
+	check("the ServiceAccount subjects", budgets.MaxServiceAccounts,
+		after.ServiceAccounts, before.ServiceAccounts, "spec.subjects")
+	return violations, nil
+}
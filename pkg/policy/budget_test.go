@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func TestEvaluateBudgets(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	selectorRBD := func(name string, age time.Duration) authorizationv1alpha1.RestrictedBindDefinition {
+		return authorizationv1alpha1.RestrictedBindDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created.Add(-age))},
+			Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+				RoleBindings: []authorizationv1alpha1.NamespaceBinding{{
+					NamespaceSelector: []metav1.LabelSelector{{MatchLabels: map[string]string{"team": "a"}}},
+					ClusterRoleRefs:   []string{"view"},
+				}},
+			},
+		}
+	}
+	namespaces := func(names ...string) []corev1.Namespace {
+		var items []corev1.Namespace
+		for _, name := range names {
+			items = append(items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": "a"}}})
+		}
+		return items
+	}
+	policy := &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant"},
+		Spec: authorizationv1alpha1.RBACPolicySpec{
+			Budgets: &authorizationv1alpha1.PolicyBudgets{MaxRoleBindings: ptrInt32(4)},
+		},
+	}
+	older := selectorRBD("older", 2*time.Hour)
+	newer := selectorRBD("newer", time.Hour)
+	siblings := []authorizationv1alpha1.RestrictedBindDefinition{older, newer}
+
+	t.Run("within budget", func(t *testing.T) {
+		violations, err := EvaluateBudgets(policy, &newer, siblings, namespaces("a-1", "a-2"))
+		if err != nil || len(violations) != 0 {
+			t.Fatalf("EvaluateBudgets() = %v, %v; want no violations", violations, err)
+		}
+	})
+
+	t.Run("namespace fan-out puts the newer definition in violation", func(t *testing.T) {
+		ns := namespaces("a-1", "a-2", "a-3")
+		violations, err := EvaluateBudgets(policy, &newer, siblings, ns)
+		if err != nil {
+			t.Fatalf("EvaluateBudgets() error = %v", err)
+		}
+		if len(violations) != 1 || violations[0].Field != "spec.roleBindings" {
+			t.Fatalf("EvaluateBudgets(newer) = %v; want one spec.roleBindings violation", violations)
+		}
+		violations, err = EvaluateBudgets(policy, &older, siblings, ns)
+		if err != nil || len(violations) != 0 {
+			t.Fatalf("EvaluateBudgets(older) = %v, %v; want no violations", violations, err)
+		}
+	})
+
+	t.Run("no budgets", func(t *testing.T) {
+		unbudgeted := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "open"}}
+		violations, err := EvaluateBudgets(unbudgeted, &newer, siblings, namespaces("a-1", "a-2", "a-3"))
+		if err != nil || len(violations) != 0 {
+			t.Fatalf("EvaluateBudgets() = %v, %v; want no violations", violations, err)
+		}
+	})
+}
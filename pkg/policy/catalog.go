@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+// descriptionAnnotationFallback is the well-known annotation consulted when a
+// ClusterRole has no authorization.t-caas.telekom.com/description annotation.
+const descriptionAnnotationFallback = "kubernetes.io/description"
+
+// maxRoleDescriptionLength bounds each catalog description so a large
+// annotation cannot inflate the RBACPolicy status.
+const maxRoleDescriptionLength = 256
+
+// ClusterRoleBindable reports whether RestrictedBindDefinitions governed by the
+// policy may reference the ClusterRole with the given name and labels, and
+// whether they may also bind it through a ClusterRoleBinding. It applies the
+// same clusterRoleBindingLimits checks as EvaluateBindDefinitionWithLabels.
+func ClusterRoleBindable(policy *authorizationv1alpha1.RBACPolicy, name string, roleLabels map[string]string) (bindable, clusterWide bool) {
+	limits := policy.Spec.BindingLimits
+	if limits == nil || limits.ClusterRoleBindingLimits == nil {
+		return false, false
+	}
+	if len(checkRoleRef(limits.ClusterRoleBindingLimits, name, "", roleLabels, true)) > 0 {
+		return false, false
+	}
+	return true, limits.AllowClusterRoleBindings
+}
+
+// RoleCatalog returns the ClusterRoles the policy allows binding, sorted by
+// name and capped at MaxRoleCatalogEntries, and the number of matching
+// ClusterRoles omitted by the cap.
+func RoleCatalog(policy *authorizationv1alpha1.RBACPolicy, clusterRoles []rbacv1.ClusterRole) ([]authorizationv1alpha1.RoleOffering, int32) {
+	var catalog []authorizationv1alpha1.RoleOffering
+	for i := range clusterRoles {
+		cr := &clusterRoles[i]
+		bindable, clusterWide := ClusterRoleBindable(policy, cr.Name, cr.Labels)
+		if !bindable {
+			continue
+		}
+		description := cr.Annotations[authorizationv1alpha1.AnnotationKeyDescription]
+		if description == "" {
+			description = cr.Annotations[descriptionAnnotationFallback]
+		}
+		catalog = append(catalog, authorizationv1alpha1.RoleOffering{
+			Name:        cr.Name,
+			Description: truncateDescription(description),
+			RuleCount:   int32(min(len(cr.Rules), math.MaxInt32)), // #nosec G115 -- bounded by min
+			ClusterWide: clusterWide,
+		})
+	}
+	slices.SortFunc(catalog, func(a, b authorizationv1alpha1.RoleOffering) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	if len(catalog) <= authorizationv1alpha1.MaxRoleCatalogEntries {
+		return catalog, 0
+	}
+	omitted := len(catalog) - authorizationv1alpha1.MaxRoleCatalogEntries
+	return catalog[:authorizationv1alpha1.MaxRoleCatalogEntries], int32(min(omitted, math.MaxInt32)) // #nosec G115 -- bounded by min
+}
+
+// truncateDescription shortens description to maxRoleDescriptionLength runes.
+func truncateDescription(description string) string {
+	runes := []rune(description)
+	if len(runes) <= maxRoleDescriptionLength {
+		return description
+	}
+	return string(runes[:maxRoleDescriptionLength-3]) + "..."
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+)
+
+func catalogPolicy(limits *authorizationv1alpha1.BindingLimits) *authorizationv1alpha1.RBACPolicy {
+	return &authorizationv1alpha1.RBACPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant"},
+		Spec:       authorizationv1alpha1.RBACPolicySpec{BindingLimits: limits},
+	}
+}
+
+func catalogClusterRole(name string, labels, annotations map[string]string, rules int) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations},
+		Rules:      make([]rbacv1.PolicyRule, rules),
+	}
+}
+
+func TestRoleCatalog(t *testing.T) {
+	policy := catalogPolicy(&authorizationv1alpha1.BindingLimits{
+		ClusterRoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{
+			AllowedRoleRefs:        []string{"tenant-*"},
+			AllowedRoleRefSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"catalog": "tenant"}},
+			ForbiddenRoleRefs:      []string{"tenant-admin"},
+		},
+	})
+	roles := []rbacv1.ClusterRole{
+		catalogClusterRole("tenant-viewer", nil,
+			map[string]string{"kubernetes.io/description": "fallback", authorizationv1alpha1.AnnotationKeyDescription: "Read-only access"}, 2),
+		catalogClusterRole("tenant-admin", nil, nil, 5),
+		catalogClusterRole("monitoring-reader", map[string]string{"catalog": "tenant"},
+			map[string]string{"kubernetes.io/description": "Read metrics"}, 1),
+		catalogClusterRole("cluster-admin", nil, nil, 1),
+	}
+
+	catalog, omitted := RoleCatalog(policy, roles)
+	if omitted != 0 {
+		t.Errorf("omitted = %d, want 0", omitted)
+	}
+	want := []authorizationv1alpha1.RoleOffering{
+		{Name: "monitoring-reader", Description: "Read metrics", RuleCount: 1},
+		{Name: "tenant-viewer", Description: "Read-only access", RuleCount: 2},
+	}
+	if fmt.Sprint(catalog) != fmt.Sprint(want) {
+		t.Errorf("catalog = %+v, want %+v", catalog, want)
+	}
+}
+
+func TestRoleCatalogClusterWide(t *testing.T) {
+	policy := catalogPolicy(&authorizationv1alpha1.BindingLimits{
+		AllowClusterRoleBindings: true,
+		ClusterRoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{AllowedRoleRefs: []string{"view"}},
+	})
+	catalog, _ := RoleCatalog(policy, []rbacv1.ClusterRole{catalogClusterRole("view", nil, nil, 3)})
+	if len(catalog) != 1 || !catalog[0].ClusterWide {
+		t.Errorf("catalog = %+v, want cluster-wide view offering", catalog)
+	}
+}
+
+func TestRoleCatalogWithoutClusterRoleLimits(t *testing.T) {
+	roles := []rbacv1.ClusterRole{catalogClusterRole("view", nil, nil, 3)}
+	for _, limits := range []*authorizationv1alpha1.BindingLimits{nil, {AllowClusterRoleBindings: true}} {
+		if catalog, _ := RoleCatalog(catalogPolicy(limits), roles); len(catalog) != 0 {
+			t.Errorf("limits %+v: catalog = %+v, want empty", limits, catalog)
+		}
+	}
+}
+
+func TestRoleCatalogCapAndTruncation(t *testing.T) {
+	policy := catalogPolicy(&authorizationv1alpha1.BindingLimits{
+		ClusterRoleBindingLimits: &authorizationv1alpha1.RoleRefLimits{AllowedRoleRefs: []string{"role-*"}},
+	})
+	long := strings.Repeat("x", maxRoleDescriptionLength+10)
+	roles := make([]rbacv1.ClusterRole, 0, authorizationv1alpha1.MaxRoleCatalogEntries+5)
+	for i := range authorizationv1alpha1.MaxRoleCatalogEntries + 5 {
+		roles = append(roles, catalogClusterRole(fmt.Sprintf("role-%04d", i), nil,
+			map[string]string{authorizationv1alpha1.AnnotationKeyDescription: long}, 1))
+	}
+
+	catalog, omitted := RoleCatalog(policy, roles)
+	if len(catalog) != authorizationv1alpha1.MaxRoleCatalogEntries || omitted != 5 {
+		t.Fatalf("len(catalog) = %d, omitted = %d", len(catalog), omitted)
+	}
+	if catalog[0].Name != "role-0000" {
+		t.Errorf("catalog not sorted, first entry %q", catalog[0].Name)
+	}
+	if got := len([]rune(catalog[0].Description)); got != maxRoleDescriptionLength {
+		t.Errorf("description length = %d, want %d", got, maxRoleDescriptionLength)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 // EvaluateBindDefinition checks a RestrictedBindDefinition against its
@@ -26,7 +27,7 @@ func EvaluateBindDefinition(
 	policy *authorizationv1alpha1.RBACPolicy,
 	rbd *authorizationv1alpha1.RestrictedBindDefinition,
 	labelGetter LabelGetter,
-) []Violation {
+) (violations []Violation) {
 	if policy == nil {
 		return []Violation{{Field: "<policy>", Message: "policy must not be nil"}}
 	}
@@ -34,7 +35,15 @@ func EvaluateBindDefinition(
 		return []Violation{{Field: "<rbd>", Message: "RestrictedBindDefinition must not be nil"}}
 	}
 
-	violations := []Violation{}
+	ctx, span := tracing.StartSpan(ctx, "policy.EvaluateBindDefinition",
+		tracing.AttrPolicy.String(policy.Name),
+		tracing.AttrResource.String(rbd.Name))
+	defer func() {
+		span.SetAttributes(tracing.AttrViolationCount.Int(len(violations)))
+		span.End()
+	}()
+
+	violations = []Violation{}
 
 	if len(restrictedBindClusterRoleRefs(rbd.Spec.ClusterRoleBindings)) > 0 &&
 		!scopeAllowsClusterResources(policy.Spec.AppliesTo) {
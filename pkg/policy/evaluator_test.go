@@ -6,12 +6,17 @@ package policy
 
 import (
 	"context"
+	"slices"
 	"testing"
 
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 func ptrInt32(v int32) *int32 { return &v }
@@ -1805,3 +1810,36 @@ func TestEvaluateBindDefinition_AppliesToScope(t *testing.T) {
 		}
 	})
 }
+
+func TestEvaluateBindDefinition_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	policy := &authorizationv1alpha1.RBACPolicy{ObjectMeta: metav1.ObjectMeta{Name: "team-policy"}}
+	rbd := &authorizationv1alpha1.RestrictedBindDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-binding"},
+		Spec: authorizationv1alpha1.RestrictedBindDefinitionSpec{
+			Subjects: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}},
+		},
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "reconcile")
+	violations := EvaluateBindDefinition(ctx, policy, rbd, nil)
+	parent.End()
+
+	ended := recorder.Ended()
+	if len(ended) != 2 || ended[0].Name() != "policy.EvaluateBindDefinition" {
+		t.Fatalf("expected a policy.EvaluateBindDefinition child span, got %d spans", len(ended))
+	}
+	attrs := ended[0].Attributes()
+	for _, want := range []attribute.KeyValue{
+		tracing.AttrPolicy.String("team-policy"),
+		tracing.AttrResource.String("team-binding"),
+		tracing.AttrViolationCount.Int(len(violations)),
+	} {
+		if !slices.Contains(attrs, want) {
+			t.Errorf("expected attribute %v, got %v", want, attrs)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/helpers"
 )
 
 const allNamespacesScope = "*"
@@ -50,44 +51,9 @@ func scopeAllowsClusterResources(scope authorizationv1alpha1.PolicyScope) bool {
 }
 
 // MatchesWildcard checks if value matches a simple wildcard pattern.
-// Supports patterns like "prefix*" (prefix match), "*suffix" (suffix match),
-// "prefix*suffix" (prefix+suffix match), and "*mid*" (contains match).
-// Multiple wildcards are supported by splitting on "*" and matching parts
-// in order. A pattern without wildcards requires an exact match.
+// See helpers.MatchesWildcard for the supported pattern syntax.
 func MatchesWildcard(pattern, value string) bool {
-	if pattern == "*" {
-		return true
-	}
-
-	parts := strings.Split(pattern, "*")
-	if len(parts) == 1 {
-		// No wildcards — exact match.
-		return pattern == value
-	}
-
-	// Check prefix (part before first *).
-	if !strings.HasPrefix(value, parts[0]) {
-		return false
-	}
-	remaining := value[len(parts[0]):]
-
-	// Check suffix (part after last *).
-	last := parts[len(parts)-1]
-	if !strings.HasSuffix(remaining, last) {
-		return false
-	}
-	remaining = remaining[:len(remaining)-len(last)]
-
-	// Check middle parts appear in order.
-	for _, mid := range parts[1 : len(parts)-1] {
-		idx := strings.Index(remaining, mid)
-		if idx < 0 {
-			return false
-		}
-		remaining = remaining[idx+len(mid):]
-	}
-
-	return true
+	return helpers.MatchesWildcard(pattern, value)
 }
 
 // matchesAnyWildcard returns true if value matches any of the given patterns.
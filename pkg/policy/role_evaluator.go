@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	authorizationv1alpha1 "github.com/telekom/auth-operator/api/authorization/v1alpha1"
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 // EvaluateRoleDefinition checks a RestrictedRoleDefinition against its
@@ -27,7 +28,7 @@ func EvaluateRoleDefinitionWithLabels(
 	policy *authorizationv1alpha1.RBACPolicy,
 	rrd *authorizationv1alpha1.RestrictedRoleDefinition,
 	labelGetter LabelGetter,
-) []Violation {
+) (violations []Violation) {
 	if policy == nil {
 		return []Violation{{Field: "<policy>", Message: "policy must not be nil"}}
 	}
@@ -35,7 +36,13 @@ func EvaluateRoleDefinitionWithLabels(
 		return []Violation{{Field: "<rrd>", Message: "RestrictedRoleDefinition must not be nil"}}
 	}
 
-	var violations []Violation
+	ctx, span := tracing.StartSpan(ctx, "policy.EvaluateRoleDefinition",
+		tracing.AttrPolicy.String(policy.Name),
+		tracing.AttrResource.String(rrd.Name))
+	defer func() {
+		span.SetAttributes(tracing.AttrViolationCount.Int(len(violations)))
+		span.End()
+	}()
 
 	if rrd.Spec.TargetRole == authorizationv1alpha1.DefinitionClusterRole &&
 		!scopeAllowsClusterResources(policy.Spec.AppliesTo) {
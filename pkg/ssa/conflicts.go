@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ssa
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// conflictManagerPattern extracts the field manager from the message of a
+// FieldManagerConflict cause, e.g. `conflict with "argocd-controller"` or
+// `conflict with "kubectl-edit" using rbac.authorization.k8s.io/v1`.
+var conflictManagerPattern = regexp.MustCompile(`^conflict with "([^"]+)"`)
+
+// Conflict describes an apply that conflicted with fields owned by other
+// field managers of the target object.
+type Conflict struct {
+	// Kind is the kind of the applied object, e.g. "ClusterRoleBinding".
+	Kind string
+	// Namespace is empty for cluster-scoped objects.
+	Namespace string
+	Name      string
+	// Managers lists the distinct competing field managers, sorted.
+	Managers []string
+}
+
+// ConflictHandler is an apply option for the patch helpers that is called
+// for every apply that conflicts with other field managers.
+//
+// With a ConflictHandler, an apply that forces ownership is first sent
+// without force so the API server reports the competing managers, and is
+// resent with force after the handler ran. An apply without force returns the
+// conflict error after the handler ran, leaving the object unchanged.
+type ConflictHandler func(Conflict)
+
+// ApplyToApply implements client.ApplyOption. The handler is read by the patch
+// helpers and does not change the apply request itself.
+func (ConflictHandler) ApplyToApply(*client.ApplyOptions) {}
+
+// noForceOwnership overrides a preceding client.ForceOwnership.
+type noForceOwnership struct{}
+
+func (noForceOwnership) ApplyToApply(opts *client.ApplyOptions) {
+	opts.Force = ptr.To(false)
+}
+
+// ConflictManagers returns the sorted, distinct field managers named in the
+// FieldManagerConflict causes of an apply conflict error, or nil when err is
+// not such an error.
+func ConflictManagers(err error) []string {
+	var status apierrors.APIStatus
+	if err == nil || !errors.As(err, &status) {
+		return nil
+	}
+	details := status.Status().Details
+	if status.Status().Reason != metav1.StatusReasonConflict || details == nil {
+		return nil
+	}
+	var managers []string
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		if match := conflictManagerPattern.FindStringSubmatch(cause.Message); match != nil {
+			managers = append(managers, match[1])
+		}
+	}
+	slices.Sort(managers)
+	return slices.Compact(managers)
+}
+
+// IsFieldManagerConflict reports whether err is an apply conflict with other
+// field managers, as opposed to any other conflict.
+func IsFieldManagerConflict(err error) bool {
+	return len(ConflictManagers(err)) > 0
+}
+
+// applyReportingConflicts sends an apply and reports field manager conflicts
+// to the ConflictHandler in applyOpts, see ConflictHandler. Without a handler
+// it is a plain c.Apply.
+func applyReportingConflicts(
+	ctx context.Context,
+	c client.Client,
+	ac runtime.ApplyConfiguration,
+	kind, namespace, name string,
+	applyOpts []client.ApplyOption,
+) error {
+	handler := conflictHandler(applyOpts)
+	if handler == nil {
+		return c.Apply(ctx, ac, applyOpts...)
+	}
+	err := c.Apply(ctx, ac, append(slices.Clone(applyOpts), noForceOwnership{})...)
+	managers := ConflictManagers(err)
+	if len(managers) == 0 {
+		return err
+	}
+	handler(Conflict{Kind: kind, Namespace: namespace, Name: name, Managers: managers})
+	if !applyOptionsForceOwnership(applyOpts) {
+		return err
+	}
+	return c.Apply(ctx, ac, applyOpts...)
+}
+
+func conflictHandler(opts []client.ApplyOption) ConflictHandler {
+	var handler ConflictHandler
+	for _, opt := range opts {
+		if h, ok := opt.(ConflictHandler); ok && h != nil {
+			handler = h
+		}
+	}
+	return handler
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 Deutsche Telekom AG
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ssa_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/telekom/auth-operator/pkg/ssa"
+)
+
+func TestConflictManagers(t *testing.T) {
+	g := NewWithT(t)
+
+	conflictErr := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "helm"`, Field: ".subjects"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller"`, Field: ".metadata.labels.app"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "helm" using rbac.authorization.k8s.io/v1`, Field: ".roleRef"},
+		{Type: metav1.CauseTypeFieldValueInvalid, Message: `conflict with "ignored"`},
+	}, "Apply failed with 3 conflicts")
+
+	g.Expect(ssa.ConflictManagers(conflictErr)).To(Equal([]string{"argocd-controller", "helm"}))
+	g.Expect(ssa.ConflictManagers(fmt.Errorf("patch ClusterRoleBinding x: %w", conflictErr))).To(Equal([]string{"argocd-controller", "helm"}))
+	g.Expect(ssa.IsFieldManagerConflict(conflictErr)).To(BeTrue())
+
+	optimisticLockErr := apierrors.NewConflict(schema.GroupResource{Resource: "clusterroles"}, "x", errors.New("object was modified"))
+	g.Expect(ssa.ConflictManagers(optimisticLockErr)).To(BeNil())
+	g.Expect(ssa.IsFieldManagerConflict(optimisticLockErr)).To(BeFalse())
+	g.Expect(ssa.ConflictManagers(errors.New("boom"))).To(BeNil())
+	g.Expect(ssa.ConflictManagers(nil)).To(BeNil())
+}
+
+func TestPatchApplyConflictHandler(t *testing.T) {
+	ctx := context.Background()
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"}
+	desired := func() []rbacv1.Subject {
+		return []rbacv1.Subject{{Kind: "User", Name: "team-user", APIGroup: rbacv1.GroupName}}
+	}
+
+	setup := func(g *WithT) client.Client {
+		c := fake.NewClientBuilder().Build()
+		competing := ssa.RoleBindingWithSubjectsAndRoleRef("team-view", "apps", nil,
+			[]rbacv1.Subject{{Kind: "User", Name: "argo-user", APIGroup: rbacv1.GroupName}}, roleRef)
+		g.Expect(c.Apply(ctx, competing, client.FieldOwner("argocd-controller"))).To(Succeed())
+		return c
+	}
+	subjectName := func(g *WithT, c client.Client) string {
+		rb := &rbacv1.RoleBinding{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Namespace: "apps", Name: "team-view"}, rb)).To(Succeed())
+		g.Expect(rb.Subjects).To(HaveLen(1))
+		return rb.Subjects[0].Name
+	}
+
+	t.Run("forced apply reports and takes over", func(t *testing.T) {
+		g := NewWithT(t)
+		c := setup(g)
+		var conflicts []ssa.Conflict
+		handler := ssa.ConflictHandler(func(conflict ssa.Conflict) { conflicts = append(conflicts, conflict) })
+
+		ac := ssa.RoleBindingWithSubjectsAndRoleRef("team-view", "apps", nil, desired(), roleRef)
+		result, err := ssa.PatchApplyRoleBinding(ctx, c, ac, client.ForceOwnership, handler)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result).To(Equal(ssa.PatchApplyResultPatched))
+		g.Expect(conflicts).To(Equal([]ssa.Conflict{{
+			Kind: "RoleBinding", Namespace: "apps", Name: "team-view", Managers: []string{"argocd-controller"},
+		}}))
+		g.Expect(subjectName(g, c)).To(Equal("team-user"))
+	})
+
+	t.Run("unforced apply reports and leaves the object unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+		c := setup(g)
+		var conflicts []ssa.Conflict
+		handler := ssa.ConflictHandler(func(conflict ssa.Conflict) { conflicts = append(conflicts, conflict) })
+
+		ac := ssa.RoleBindingWithSubjectsAndRoleRef("team-view", "apps", nil, desired(), roleRef)
+		_, err := ssa.PatchApplyRoleBinding(ctx, c, ac, handler)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(ssa.ConflictManagers(err)).To(Equal([]string{"argocd-controller"}))
+		g.Expect(conflicts).To(HaveLen(1))
+		g.Expect(subjectName(g, c)).To(Equal("argo-user"))
+	})
+
+	t.Run("forced apply without handler keeps the previous behavior", func(t *testing.T) {
+		g := NewWithT(t)
+		c := setup(g)
+
+		ac := ssa.RoleBindingWithSubjectsAndRoleRef("team-view", "apps", nil, desired(), roleRef)
+		_, err := ssa.PatchApplyRoleBinding(ctx, c, ac, client.ForceOwnership)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(subjectName(g, c)).To(Equal("team-user"))
+	})
+}
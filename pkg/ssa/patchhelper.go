@@ -20,6 +20,8 @@ import (
 	"slices"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +33,8 @@ import (
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 // PatchApplyResult indicates the outcome of a patch-or-skip operation.
@@ -64,10 +68,36 @@ func applyOptionsForceOwnership(opts []client.ApplyOption) bool {
 	return applyOpts.Force != nil && *applyOpts.Force
 }
 
+// startApplySpan starts the span of one patch-or-skip operation. It records
+// only inside a traced reconcile, see tracing.StartSpan.
+func startApplySpan(ctx context.Context, kind, namespace, name string, forceOwnership bool) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrKind.String(kind),
+		tracing.AttrResource.String(name),
+		tracing.AttrForceOwnership.Bool(forceOwnership),
+		tracing.AttrConflictRetries.Int(0),
+	}
+	if namespace != "" {
+		attrs = append(attrs, tracing.AttrNamespace.String(namespace))
+	}
+	return tracing.StartSpan(ctx, "ssa.Apply"+kind, attrs...)
+}
+
+// endApplySpan records the apply result, or the error, and ends span.
+func endApplySpan(span trace.Span, result PatchApplyResult, err error) {
+	if err != nil {
+		tracing.RecordError(span, err)
+	} else {
+		span.SetAttributes(tracing.AttrApplyResult.String(result.String()))
+	}
+	span.End()
+}
+
 // PatchApplyClusterRole reads the current ClusterRole from cache, compares it to
 // the desired ApplyConfiguration, and only sends an SSA Patch if there is a diff.
 // Returns the result (skipped/created/patched) and any error.
-// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership).
+// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership); a
+// ConflictHandler in opts reports conflicts with other field managers.
 func PatchApplyClusterRole(
 	ctx context.Context,
 	c client.Client,
@@ -122,7 +152,7 @@ func patchApplyClusterRole(
 	shouldPruneLabel func(string) bool,
 	alwaysApply bool,
 	opts ...client.ApplyOption,
-) (PatchApplyResult, error) {
+) (result PatchApplyResult, retErr error) {
 	if ac == nil || ac.Name == nil {
 		return 0, fmt.Errorf("clusterRole ApplyConfiguration must have a name")
 	}
@@ -134,6 +164,10 @@ func patchApplyClusterRole(
 
 	applyOpts := append([]client.ApplyOption{client.FieldOwner(FieldOwner)}, opts...)
 
+	forceOwnership := applyOptionsForceOwnership(applyOpts)
+	ctx, span := startApplySpan(ctx, "ClusterRole", "", *ac.Name, forceOwnership)
+	defer func() { endApplySpan(span, result, retErr) }()
+
 	existing, created, err := getOrCreateClusterRole(ctx, c, ac, applyOpts)
 	if err != nil {
 		return 0, err
@@ -148,7 +182,6 @@ func patchApplyClusterRole(
 	}
 
 	// Compare managed fields: labels, annotations, rules.
-	forceOwnership := applyOptionsForceOwnership(applyOpts)
 	if clusterRoleMatches(existing, ac) && !prunedLabels && !alwaysApply && !forceOwnership {
 		logger.V(3).Info("ClusterRole unchanged, skipping SSA apply",
 			"clusterRole", *ac.Name)
@@ -158,8 +191,8 @@ func patchApplyClusterRole(
 		return PatchApplyResultPatched, nil
 	}
 
-	if applyErr := c.Apply(ctx, ac, applyOpts...); applyErr != nil {
-		if prunedLabels && apierrors.IsConflict(applyErr) {
+	if applyErr := applyReportingConflicts(ctx, c, ac, "ClusterRole", "", *ac.Name, applyOpts); applyErr != nil {
+		if prunedLabels && apierrors.IsConflict(applyErr) && !IsFieldManagerConflict(applyErr) {
 			patched, retryErr := patchApplyClusterRoleAfterPruneConflict(ctx, c, ac, applyOpts, shouldPruneLabel)
 			if patched {
 				return PatchApplyResultPatched, nil
@@ -200,9 +233,12 @@ func patchApplyClusterRoleAfterPruneConflict(
 	applyOpts []client.ApplyOption,
 	shouldPruneLabel func(string) bool,
 ) (bool, error) {
+	retries := 0
 	applyErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		retries++
 		return c.Apply(ctx, ac, applyOpts...)
 	})
+	trace.SpanFromContext(ctx).SetAttributes(tracing.AttrConflictRetries.Int(retries))
 	if applyErr == nil {
 		return true, nil
 	}
@@ -217,7 +253,8 @@ func patchApplyClusterRoleAfterPruneConflict(
 
 // PatchApplyRole reads the current Role from cache, compares it to the desired
 // ApplyConfiguration, and only sends an SSA Patch if there is a diff.
-// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership).
+// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership); a
+// ConflictHandler in opts reports conflicts with other field managers.
 func PatchApplyRole(
 	ctx context.Context,
 	c client.Client,
@@ -244,7 +281,7 @@ func patchApplyRole(
 	ac *rbacv1ac.RoleApplyConfiguration,
 	alwaysApply bool,
 	opts ...client.ApplyOption,
-) (PatchApplyResult, error) {
+) (result PatchApplyResult, retErr error) {
 	if ac == nil || ac.Name == nil {
 		return 0, fmt.Errorf("role ApplyConfiguration must have a name")
 	}
@@ -259,6 +296,9 @@ func patchApplyRole(
 
 	applyOpts := append([]client.ApplyOption{client.FieldOwner(FieldOwner)}, opts...)
 
+	ctx, span := startApplySpan(ctx, "Role", *ac.Namespace, *ac.Name, applyOptionsForceOwnership(applyOpts))
+	defer func() { endApplySpan(span, result, retErr) }()
+
 	existing := &rbacv1.Role{}
 	err := c.Get(ctx, types.NamespacedName{Name: *ac.Name, Namespace: *ac.Namespace}, existing)
 	if err != nil {
@@ -277,7 +317,7 @@ func patchApplyRole(
 		return PatchApplyResultSkipped, nil
 	}
 
-	if applyErr := c.Apply(ctx, ac, applyOpts...); applyErr != nil {
+	if applyErr := applyReportingConflicts(ctx, c, ac, "Role", *ac.Namespace, *ac.Name, applyOpts); applyErr != nil {
 		return 0, fmt.Errorf("patch Role %s/%s: %w", *ac.Namespace, *ac.Name, applyErr)
 	}
 	return PatchApplyResultPatched, nil
@@ -285,7 +325,8 @@ func patchApplyRole(
 
 // PatchApplyClusterRoleBinding reads the current CRB from cache, compares it to
 // the desired ApplyConfiguration, and only sends an SSA Patch if there is a diff.
-// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership).
+// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership); a
+// ConflictHandler in opts reports conflicts with other field managers.
 func PatchApplyClusterRoleBinding(
 	ctx context.Context,
 	c client.Client,
@@ -312,7 +353,7 @@ func patchApplyClusterRoleBinding(
 	ac *rbacv1ac.ClusterRoleBindingApplyConfiguration,
 	alwaysApply bool,
 	opts ...client.ApplyOption,
-) (PatchApplyResult, error) {
+) (result PatchApplyResult, retErr error) {
 	if ac == nil || ac.Name == nil {
 		return 0, fmt.Errorf("clusterRoleBinding ApplyConfiguration must have a name")
 	}
@@ -324,6 +365,9 @@ func patchApplyClusterRoleBinding(
 
 	applyOpts := append([]client.ApplyOption{client.FieldOwner(FieldOwner)}, opts...)
 
+	ctx, span := startApplySpan(ctx, "ClusterRoleBinding", "", *ac.Name, applyOptionsForceOwnership(applyOpts))
+	defer func() { endApplySpan(span, result, retErr) }()
+
 	existing := &rbacv1.ClusterRoleBinding{}
 	err := c.Get(ctx, types.NamespacedName{Name: *ac.Name}, existing)
 	if err != nil {
@@ -342,7 +386,7 @@ func patchApplyClusterRoleBinding(
 		return PatchApplyResultSkipped, nil
 	}
 
-	if applyErr := c.Apply(ctx, ac, applyOpts...); applyErr != nil {
+	if applyErr := applyReportingConflicts(ctx, c, ac, "ClusterRoleBinding", "", *ac.Name, applyOpts); applyErr != nil {
 		return 0, fmt.Errorf("patch ClusterRoleBinding %s: %w", *ac.Name, applyErr)
 	}
 	return PatchApplyResultPatched, nil
@@ -350,7 +394,8 @@ func patchApplyClusterRoleBinding(
 
 // PatchApplyRoleBinding reads the current RB from cache, compares it to the
 // desired ApplyConfiguration, and only sends an SSA Patch if there is a diff.
-// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership).
+// opts are forwarded to every c.Apply call (e.g. client.ForceOwnership); a
+// ConflictHandler in opts reports conflicts with other field managers.
 func PatchApplyRoleBinding(
 	ctx context.Context,
 	c client.Client,
@@ -377,7 +422,7 @@ func patchApplyRoleBinding(
 	ac *rbacv1ac.RoleBindingApplyConfiguration,
 	alwaysApply bool,
 	opts ...client.ApplyOption,
-) (PatchApplyResult, error) {
+) (result PatchApplyResult, retErr error) {
 	if ac == nil || ac.Name == nil {
 		return 0, fmt.Errorf("roleBinding ApplyConfiguration must have a name")
 	}
@@ -392,6 +437,9 @@ func patchApplyRoleBinding(
 
 	applyOpts := append([]client.ApplyOption{client.FieldOwner(FieldOwner)}, opts...)
 
+	ctx, span := startApplySpan(ctx, "RoleBinding", *ac.Namespace, *ac.Name, applyOptionsForceOwnership(applyOpts))
+	defer func() { endApplySpan(span, result, retErr) }()
+
 	existing := &rbacv1.RoleBinding{}
 	err := c.Get(ctx, types.NamespacedName{Name: *ac.Name, Namespace: *ac.Namespace}, existing)
 	if err != nil {
@@ -410,7 +458,7 @@ func patchApplyRoleBinding(
 		return PatchApplyResultSkipped, nil
 	}
 
-	if applyErr := c.Apply(ctx, ac, applyOpts...); applyErr != nil {
+	if applyErr := applyReportingConflicts(ctx, c, ac, "RoleBinding", *ac.Namespace, *ac.Name, applyOpts); applyErr != nil {
 		return 0, fmt.Errorf("patch RoleBinding %s/%s: %w", *ac.Namespace, *ac.Name, applyErr)
 	}
 	return PatchApplyResultPatched, nil
@@ -447,7 +495,7 @@ func patchApplyServiceAccount(
 	ac *corev1ac.ServiceAccountApplyConfiguration,
 	fieldOwner string,
 	alwaysApply bool,
-) (PatchApplyResult, error) {
+) (result PatchApplyResult, retErr error) {
 	if ac == nil || ac.Name == nil {
 		return 0, fmt.Errorf("serviceAccount ApplyConfiguration must have a name")
 	}
@@ -465,6 +513,9 @@ func patchApplyServiceAccount(
 
 	applyOpts := []client.ApplyOption{client.FieldOwner(fieldOwner)}
 
+	ctx, span := startApplySpan(ctx, "ServiceAccount", *ac.Namespace, *ac.Name, false)
+	defer func() { endApplySpan(span, result, retErr) }()
+
 	existing := &corev1.ServiceAccount{}
 	err := c.Get(ctx, types.NamespacedName{Name: *ac.Name, Namespace: *ac.Namespace}, existing)
 	if err != nil {
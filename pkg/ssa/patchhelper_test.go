@@ -10,6 +10,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -20,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/telekom/auth-operator/pkg/ssa"
+	"github.com/telekom/auth-operator/pkg/tracing"
 )
 
 var _ = Describe("PatchHelper - cache-aware SSA diff", func() {
@@ -623,6 +626,47 @@ var _ = Describe("PatchHelper - cache-aware SSA diff", func() {
 		})
 	})
 
+	// -----------------------------------------------------------------------
+	// Tracing
+	// -----------------------------------------------------------------------
+	Context("tracing", func() {
+		It("should record a child span per apply with kind, name and result", func() {
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			DeferCleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+			ac := ssa.ClusterRoleWithLabelsAndRules("ph-traced-cr", nil, []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+			})
+
+			ctx, parent := tp.Tracer("test").Start(testCtx, "reconcile")
+			_, err := ssa.PatchApplyClusterRole(ctx, k8sClient, ac)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = ssa.PatchApplyClusterRole(ctx, k8sClient, ac)
+			Expect(err).NotTo(HaveOccurred())
+			parent.End()
+
+			var results []string
+			for _, span := range recorder.Ended() {
+				if span.Name() != "ssa.ApplyClusterRole" {
+					continue
+				}
+				Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+				Expect(span.Attributes()).To(ContainElements(
+					tracing.AttrKind.String("ClusterRole"),
+					tracing.AttrResource.String("ph-traced-cr"),
+					tracing.AttrConflictRetries.Int(0),
+				))
+				for _, attr := range span.Attributes() {
+					if attr.Key == tracing.AttrApplyResult {
+						results = append(results, attr.Value.AsString())
+					}
+				}
+			}
+			Expect(results).To(Equal([]string{"created", "skipped"}))
+		})
+	})
+
 	// -----------------------------------------------------------------------
 	// PatchApplyResult stringer
 	// -----------------------------------------------------------------------
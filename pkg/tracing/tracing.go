@@ -9,10 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -53,6 +55,43 @@ type Provider struct {
 	tp      trace.TracerProvider
 	tracer  trace.Tracer
 	enabled bool
+	sampler *ratioSampler
+}
+
+// ratioSampler samples a ratio of root traces and follows the parent's
+// decision otherwise. The ratio can be changed while spans are created.
+type ratioSampler struct {
+	sampler atomic.Value // sdktrace.Sampler
+}
+
+func newRatioSampler(rate float64) *ratioSampler {
+	s := &ratioSampler{}
+	s.setRate(rate)
+	return s
+}
+
+func (s *ratioSampler) setRate(rate float64) {
+	s.sampler.Store(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(rate)))
+}
+
+// ShouldSample implements sdktrace.Sampler.
+func (s *ratioSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return s.sampler.Load().(sdktrace.Sampler).ShouldSample(p)
+}
+
+// Description implements sdktrace.Sampler.
+func (s *ratioSampler) Description() string {
+	return s.sampler.Load().(sdktrace.Sampler).Description()
+}
+
+func validateSamplingRate(rate float64) error {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return fmt.Errorf("sampling rate must be a finite number, got %f", rate)
+	}
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("sampling rate must be between 0.0 and 1.0, got %f", rate)
+	}
+	return nil
 }
 
 // Enabled reports whether tracing was configured as active.
@@ -78,6 +117,18 @@ func (p *Provider) TracerIfEnabled() trace.Tracer {
 	return nil
 }
 
+// SetSamplingRate changes the ratio of sampled root traces of a running
+// provider. It is a no-op when tracing is disabled.
+func (p *Provider) SetSamplingRate(rate float64) error {
+	if err := validateSamplingRate(rate); err != nil {
+		return err
+	}
+	if p.sampler != nil {
+		p.sampler.setRate(rate)
+	}
+	return nil
+}
+
 // Shutdown gracefully shuts down the tracer provider, flushing any pending spans.
 // The caller's context is detached from its cancellation signal (via WithoutCancel)
 // so that shutdown can proceed even after signal handling cancels the parent, while
@@ -107,11 +158,8 @@ func Setup(ctx context.Context, cfg Config, version string) (*Provider, error) {
 		return nil, fmt.Errorf("tracing endpoint must be set when tracing is enabled")
 	}
 
-	if math.IsNaN(cfg.SamplingRate) || math.IsInf(cfg.SamplingRate, 0) {
-		return nil, fmt.Errorf("sampling rate must be a finite number, got %f", cfg.SamplingRate)
-	}
-	if cfg.SamplingRate < 0 || cfg.SamplingRate > 1 {
-		return nil, fmt.Errorf("sampling rate must be between 0.0 and 1.0, got %f", cfg.SamplingRate)
+	if err := validateSamplingRate(cfg.SamplingRate); err != nil {
+		return nil, err
 	}
 
 	opts := []otlptracegrpc.Option{
@@ -144,7 +192,7 @@ func Setup(ctx context.Context, cfg Config, version string) (*Provider, error) {
 		return nil, fmt.Errorf("creating OTEL resource: %w", err)
 	}
 
-	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRate))
+	sampler := newRatioSampler(cfg.SamplingRate)
 
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(exporter),
@@ -160,7 +208,7 @@ func Setup(ctx context.Context, cfg Config, version string) (*Provider, error) {
 	))
 
 	tracer := tp.Tracer(TracerName)
-	return &Provider{tp: tp, tracer: tracer, enabled: true}, nil
+	return &Provider{tp: tp, tracer: tracer, enabled: true, sampler: sampler}, nil
 }
 
 // Span attribute keys used across the operator.
@@ -176,4 +224,36 @@ const (
 	AttrDecision     = attribute.Key("auth_operator.decision")
 	AttrReason       = attribute.Key("auth_operator.reason")
 	AttrRuleCount    = attribute.Key("auth_operator.rule_count")
+
+	AttrKind            = attribute.Key("auth_operator.kind")
+	AttrApplyResult     = attribute.Key("auth_operator.apply_result")
+	AttrForceOwnership  = attribute.Key("auth_operator.force_ownership")
+	AttrConflictRetries = attribute.Key("auth_operator.conflict_retries")
+	AttrGroupVersion    = attribute.Key("auth_operator.group_version")
+	AttrDiscoveryMode   = attribute.Key("auth_operator.discovery_mode")
+	AttrResourceCount   = attribute.Key("auth_operator.resource_count")
+	AttrFailureCount    = attribute.Key("auth_operator.failure_count")
+	AttrPolicy          = attribute.Key("auth_operator.policy")
+	AttrViolationCount  = attribute.Key("auth_operator.violation_count")
 )
+
+// StartSpan starts a child of the span in ctx with the tracer of that span's
+// provider. Packages without their own tracer (ssa, policy) use it to extend a
+// reconcile trace: when ctx carries no recording span, ctx is returned as is
+// together with a non-recording span, so untraced callers pay nothing.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.IsRecording() {
+		return ctx, noop.Span{}
+	}
+	return parent.TracerProvider().Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordError marks span as failed with err. It is a no-op for a nil err.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
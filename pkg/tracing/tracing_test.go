@@ -6,9 +6,14 @@ package tracing
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace/noop"
 )
 
@@ -206,3 +211,105 @@ func TestSetup_EnabledRegistersGlobalProvider(t *testing.T) {
 		t.Error("expected global TracerProvider to match the provider returned by Setup")
 	}
 }
+
+func TestProvider_SetSamplingRate(t *testing.T) {
+	saveAndRestoreGlobals(t)
+
+	p, err := Setup(context.Background(), Config{
+		Enabled:      true,
+		Endpoint:     "localhost:4317",
+		SamplingRate: 0.0,
+		Insecure:     true,
+	}, "v1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = p.Shutdown(context.Background()) }()
+
+	_, span := p.Tracer().Start(context.Background(), "before")
+	if span.SpanContext().IsSampled() {
+		t.Error("span should not be sampled at rate 0")
+	}
+	span.End()
+
+	if err := p.SetSamplingRate(1.0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, span = p.Tracer().Start(context.Background(), "after")
+	if !span.SpanContext().IsSampled() {
+		t.Error("span should be sampled after raising the rate to 1")
+	}
+	span.End()
+
+	if err := p.SetSamplingRate(1.5); err == nil {
+		t.Error("expected an error for a sampling rate above 1")
+	}
+}
+
+func TestProvider_SetSamplingRate_Disabled(t *testing.T) {
+	p, err := Setup(context.Background(), Config{Enabled: false}, "v1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.SetSamplingRate(0.5); err != nil {
+		t.Errorf("expected no error when tracing is disabled, got %v", err)
+	}
+}
+
+func TestStartSpan(t *testing.T) {
+	// Without a recording parent no span is started.
+	ctx := context.Background()
+	childCtx, span := StartSpan(ctx, "untraced")
+	if span.IsRecording() {
+		t.Error("expected a non-recording span without a parent span")
+	}
+	if childCtx != ctx {
+		t.Error("expected the context to be returned unchanged without a parent span")
+	}
+	span.End()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child", AttrKind.String("ClusterRole"))
+	RecordError(child, errors.New("conflict"))
+	child.End()
+	parent.End()
+
+	ended := recorder.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 ended spans, got %d", len(ended))
+	}
+	got := ended[0]
+	if got.Name() != "child" {
+		t.Fatalf("expected child span first, got %q", got.Name())
+	}
+	if got.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("expected child span to be parented to the span in the context")
+	}
+	if got.InstrumentationScope().Name != TracerName {
+		t.Errorf("expected instrumentation scope %q, got %q", TracerName, got.InstrumentationScope().Name)
+	}
+	if got.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", got.Status().Code)
+	}
+	if !slices.Contains(got.Attributes(), AttrKind.String("ClusterRole")) {
+		t.Errorf("expected kind attribute, got %v", got.Attributes())
+	}
+}
+
+func TestRecordErrorNil(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	_, span := tp.Tracer("test").Start(context.Background(), "ok")
+	RecordError(span, nil)
+	span.End()
+
+	if code := recorder.Ended()[0].Status().Code; code != codes.Unset {
+		t.Errorf("expected unset status for a nil error, got %v", code)
+	}
+}
@@ -336,6 +336,8 @@ var _ = Describe("Helm Chart E2E", Ordered, Label("helm"), func() {
 				"rbacpolicies.authorization.t-caas.telekom.com",
 				"restrictedroledefinitions.authorization.t-caas.telekom.com",
 				"restrictedbinddefinitions.authorization.t-caas.telekom.com",
+				"impersonationexposurereports.authorization.t-caas.telekom.com",
+				"operatorcapabilities.authorization.t-caas.telekom.com",
 			} {
 				By("Checking CRD exists: " + crd)
 				cmd := utils.CommandContext(context.Background(), "kubectl", "get", "crd", crd) // #nosec G204